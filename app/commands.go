@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/augustin-wien/augustina-backend/database"
+)
+
+// commands are run instead of the server if their name is the first
+// argument, after the config, Keycloak and the database are initialized
+var commands = map[string]func(args []string) error{
+	"reconcile-keycloak": reconcileKeycloakCommand,
+	"import-customers":   importCustomersCommand,
+}
+
+// runCommand runs a command and returns the exit code of the program
+func runCommand(name string, args []string) int {
+	command, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q, commands are:\n", name)
+		for name := range commands {
+			fmt.Fprintln(os.Stderr, "  "+name)
+		}
+		return 2
+	}
+	if err := command(args); err != nil {
+		log.Error(name, ": ", err)
+		return 1
+	}
+	return 0
+}
+
+// reconcileKeycloakCommand reconciles customers and vendors with Keycloak
+// and prints the report as JSON
+func reconcileKeycloakCommand(args []string) error {
+	flags := flag.NewFlagSet("reconcile-keycloak", flag.ContinueOnError)
+	direction := flags.String("direction", "", "side to repair: keycloak or database, empty only reports mismatches")
+	dryRun := flags.Bool("dry-run", false, "report the repairs without applying them")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	reconcileDirection, err := database.ParseReconcileDirection(*direction)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	report, err := database.NewKeycloakReconciler(&database.Db).Reconcile(ctx, reconcileDirection, *dryRun)
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if encodeErr := encoder.Encode(report); encodeErr != nil {
+		return encodeErr
+	}
+	return err
+}
+
+// importCustomersCommand imports customers and abonements from a CSV file
+// of a legacy subscriber list and prints the report as JSON
+func importCustomersCommand(args []string) error {
+	flags := flag.NewFlagSet("import-customers", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "validate the rows without importing them")
+	welcome := flags.Bool("welcome", false, "send the welcome mail to new Keycloak users")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: import-customers [-dry-run] [-welcome] file.csv (- reads stdin)")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected one CSV file, got %d arguments", flags.NArg())
+	}
+
+	var file io.Reader = os.Stdin
+	if name := flags.Arg(0); name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		file = f
+	}
+	report, err := database.NewCustomerImporter(&database.Db).Import(file, database.CustomerImportOptions{DryRun: *dryRun, SendWelcome: *welcome})
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -19,6 +19,7 @@ type config struct {
 	PaypalPercentageCosts             float64
 	TransactionCostsName              string
 	DonationName                      string
+	DiscountName                      string
 	IntervalToDeletePDFsInWeeks       int
 	VivaWalletVerificationKey         string
 	VivaWalletAPIURL                  string
@@ -28,10 +29,17 @@ type config struct {
 	VivaWalletSmartCheckoutClientKey  string
 	VivaWalletSourceCode              string
 	VivaWalletTransactionTypeIDPaypal int
+	VivaWalletMerchantAPIURL          string
+	VivaWalletMerchantID              string
+	VivaWalletAPIKey                  string
 	KeycloakHostname                  string
 	KeycloakRealm                     string
 	KeycloakClientID                  string
 	KeycloakClientSecret              string
+	KeycloakLocalTokenValidation      bool
+	KeycloakIssuer                    string
+	KeycloakJWKSRefreshMinutes        int
+	KeycloakAuthCacheSeconds          int
 	SendCustomerEmail                 bool
 	OnlinePaperUrl                    string
 	FrontendURL                       string
@@ -50,6 +58,39 @@ type config struct {
 	FlourWebhookToken                 string
 	OdooWebhookURL                    string
 	OdooWebhookToken                  string
+	GeocoderProvider                  string
+	GeocoderURL                       string
+	GeocoderUserAgent                 string
+	GeocoderCountryCodes              string
+	GeocoderFixtures                  string
+	ItemScheduleIntervalSeconds       int
+	PDFRetentionIntervalHours         int
+	AbonementLifecycleIntervalHours   int
+	AbonementReminderDays             int
+	AbonementRenewalIntervalHours     int
+	AbonementRenewalLeadDays          int
+	AbonementRenewalRetryHours        int
+	AbonementRenewalMaxAttempts       int
+	GiftDeliveryIntervalMinutes       int
+	DiscountReleaseIntervalMinutes    int
+	DiscountReservationMinutes        int
+	KeycloakReconcileIntervalHours    int
+	KeycloakReconcileDirection        string
+	KeycloakReconcileMaxRemovals      int
+	PDFWatermarkEnabled               bool
+	PDFWatermarkCacheDir              string
+	StorageBackend                    string
+	StorageLocalDir                   string
+	StoragePublicURL                  string
+	StorageSigningKey                 string
+	StorageSignedURLTTLSeconds        int
+	StorageRedirectDownloads          bool
+	StorageS3Endpoint                 string
+	StorageS3Region                   string
+	StorageS3Bucket                   string
+	StorageS3AccessKey                string
+	StorageS3SecretKey                string
+	StorageS3PathStyle                bool
 	// TrustedProxies is a list of proxy IPs whose X-Forwarded-For / X-Real-Ip headers may be
 	// trusted for client IP resolution. When empty, those headers are trusted unconditionally
 	// (legacy behavior); when set, they are only honored for requests coming from a listed proxy.
@@ -75,6 +116,7 @@ func InitConfig() error {
 		PaypalPercentageCosts:             getEnvFloat("PAYPAL_PERCENTAGE_COSTS", 0.00),
 		DonationName:                      getEnv("DONATION_NAME", "donation"),
 		TransactionCostsName:              getEnv("TRANSACTION_COSTS_NAME", "transactionCosts"),
+		DiscountName:                      getEnv("DISCOUNT_NAME", "discount"),
 		IntervalToDeletePDFsInWeeks:       getEnvInt("INTERVAL_TO_DELETE_PDFS_IN_WEEKS", 0),
 		VivaWalletVerificationKey:         getEnv("VIVA_WALLET_VERIFICATION_KEY", ""),
 		VivaWalletAPIURL:                  getEnv("VIVA_WALLET_API_URL", ""),
@@ -84,10 +126,17 @@ func InitConfig() error {
 		VivaWalletSmartCheckoutClientKey:  getEnv("VIVA_WALLET_SMART_CHECKOUT_CLIENT_KEY", ""),
 		VivaWalletSourceCode:              getEnv("VIVA_WALLET_SOURCE_CODE", ""),
 		VivaWalletTransactionTypeIDPaypal: getEnvInt("VIVA_WALLET_TRANSACTION_TYPE_ID_PAYPAL", 0),
+		VivaWalletMerchantAPIURL:          getEnv("VIVA_WALLET_MERCHANT_API_URL", ""),
+		VivaWalletMerchantID:              getEnv("VIVA_WALLET_MERCHANT_ID", ""),
+		VivaWalletAPIKey:                  getEnv("VIVA_WALLET_API_KEY", ""),
 		KeycloakHostname:                  getEnv("KEYCLOAK_HOST", ""),
 		KeycloakRealm:                     getEnv("KEYCLOAK_REALM", ""),
 		KeycloakClientID:                  getEnv("KEYCLOAK_CLIENT_ID", ""),
 		KeycloakClientSecret:              getEnv("KEYCLOAK_CLIENT_SECRET", ""),
+		KeycloakLocalTokenValidation:      (getEnv("KEYCLOAK_LOCAL_TOKEN_VALIDATION", "true") == "true"),
+		KeycloakIssuer:                    getEnv("KEYCLOAK_ISSUER", ""),
+		KeycloakJWKSRefreshMinutes:        getEnvInt("KEYCLOAK_JWKS_REFRESH_MINUTES", 60),
+		KeycloakAuthCacheSeconds:          getEnvInt("KEYCLOAK_AUTH_CACHE_SECONDS", 30),
 		SendCustomerEmail:                 (getEnv("SEND_CUSTOMER_EMAIL", "false") == "true"),
 		OnlinePaperUrl:                    getEnv("ONLINE_PAPER_URL", ""),
 		Development:                       (getEnv("DEVELOPMENT", "false") == "true"),
@@ -106,6 +155,39 @@ func InitConfig() error {
 		FlourWebhookToken:                 getEnv("FLOUR_WEBHOOK_TOKEN", ""),
 		OdooWebhookURL:                    getEnv("ODOO_WEBHOOK_URL", ""),
 		OdooWebhookToken:                  getEnv("ODOO_WEBHOOK_TOKEN", ""),
+		GeocoderProvider:                  getEnv("GEOCODER", ""),
+		GeocoderURL:                       getEnv("GEOCODER_URL", "https://nominatim.openstreetmap.org"),
+		GeocoderUserAgent:                 getEnv("GEOCODER_USER_AGENT", "augustina-backend"),
+		GeocoderCountryCodes:              getEnv("GEOCODER_COUNTRY_CODES", "at"),
+		GeocoderFixtures:                  getEnv("GEOCODER_FIXTURES", ""),
+		ItemScheduleIntervalSeconds:       getEnvInt("ITEM_SCHEDULE_INTERVAL_SECONDS", 60),
+		PDFRetentionIntervalHours:         getEnvInt("PDF_RETENTION_INTERVAL_HOURS", 24),
+		AbonementLifecycleIntervalHours:   getEnvInt("ABONEMENT_LIFECYCLE_INTERVAL_HOURS", 24),
+		AbonementReminderDays:             getEnvInt("ABONEMENT_REMINDER_DAYS", 30),
+		AbonementRenewalIntervalHours:     getEnvInt("ABONEMENT_RENEWAL_INTERVAL_HOURS", 6),
+		AbonementRenewalLeadDays:          getEnvInt("ABONEMENT_RENEWAL_LEAD_DAYS", 7),
+		AbonementRenewalRetryHours:        getEnvInt("ABONEMENT_RENEWAL_RETRY_HOURS", 24),
+		AbonementRenewalMaxAttempts:       getEnvInt("ABONEMENT_RENEWAL_MAX_ATTEMPTS", 4),
+		GiftDeliveryIntervalMinutes:       getEnvInt("GIFT_DELIVERY_INTERVAL_MINUTES", 15),
+		DiscountReleaseIntervalMinutes:    getEnvInt("DISCOUNT_RELEASE_INTERVAL_MINUTES", 15),
+		DiscountReservationMinutes:        getEnvInt("DISCOUNT_RESERVATION_MINUTES", 60),
+		KeycloakReconcileIntervalHours:    getEnvInt("KEYCLOAK_RECONCILE_INTERVAL_HOURS", 0),
+		KeycloakReconcileDirection:        getEnv("KEYCLOAK_RECONCILE_DIRECTION", ""),
+		KeycloakReconcileMaxRemovals:      getEnvInt("KEYCLOAK_RECONCILE_MAX_REMOVALS", 10),
+		PDFWatermarkEnabled:               (getEnv("PDF_WATERMARK", "true") == "true"),
+		PDFWatermarkCacheDir:              getEnv("PDF_WATERMARK_CACHE_DIR", "pdf/stamped"),
+		StorageBackend:                    getEnv("STORAGE_BACKEND", "local"),
+		StorageLocalDir:                   getEnv("STORAGE_LOCAL_DIR", "."),
+		StoragePublicURL:                  getEnv("STORAGE_PUBLIC_URL", ""),
+		StorageSigningKey:                 getEnv("STORAGE_SIGNING_KEY", ""),
+		StorageSignedURLTTLSeconds:        getEnvInt("STORAGE_SIGNED_URL_TTL_SECONDS", 300),
+		StorageRedirectDownloads:          (getEnv("STORAGE_REDIRECT_DOWNLOADS", "false") == "true"),
+		StorageS3Endpoint:                 getEnv("STORAGE_S3_ENDPOINT", ""),
+		StorageS3Region:                   getEnv("STORAGE_S3_REGION", "us-east-1"),
+		StorageS3Bucket:                   getEnv("STORAGE_S3_BUCKET", ""),
+		StorageS3AccessKey:                getEnv("STORAGE_S3_ACCESS_KEY", ""),
+		StorageS3SecretKey:                getEnv("STORAGE_S3_SECRET_KEY", ""),
+		StorageS3PathStyle:                (getEnv("STORAGE_S3_PATH_STYLE", "true") == "true"),
 		TrustedProxies:                    getEnvStringSlice("TRUSTED_PROXIES", ""),
 		DEBUG_payments:                    (getEnv("DEBUG_payments", "false") == "true"),
 	}
@@ -1,15 +1,21 @@
 package database
 
 import (
+	"errors"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"gopkg.in/guregu/null.v4"
 )
 
-// DigitalLicenseAssigner assigns a digital license group to a Keycloak user.
+// DigitalLicenseAssigner assigns digital license groups to a Keycloak user.
 // Extracted as an interface so it can be replaced with a mock in tests.
 type DigitalLicenseAssigner interface {
 	AssignDigitalLicenseGroup(userID string, licenseGroup string) error
+	SyncLicenseGroupsDiffToKeycloak(userID string, oldGroups, newGroups []string) error
 }
 
 // AbonementService handles business logic related to abonements
@@ -23,104 +29,125 @@ func NewAbonementService(db *Database) *AbonementService {
 	return &AbonementService{db: db, keycloakAssigner: &keycloak.KeycloakClient}
 }
 
-// ProcessAbonementLicenseGroupsForDate processes and updates license groups for customers
-// with active abonements on a given date. This is called when a new issue is created.
+// ProcessAbonementLicenseGroupsForDate updates the entitlements of all
+// abonements active on a given date and projects the license groups of their
+// customers to Keycloak.
 func (as *AbonementService) ProcessAbonementLicenseGroupsForDate(issueDate time.Time) error {
-	// Get all active abonements for this date
-	abonements, err := as.db.GetActiveAbonementsByDate(issueDate)
+	return as.processActiveAbonements(issueDate, nil)
+}
+
+// GrantIssueToActiveAbonements grants every customer whose abonement is
+// active at now and covers the license group of issue a lasting entitlement
+// for the issue, so it stays readable after the abonement ends. This is
+// called when a new online issue is published.
+func (as *AbonementService) GrantIssueToActiveAbonements(issue Item, now time.Time) error {
+	return as.processActiveAbonements(now, &issue)
+}
+
+func (as *AbonementService) processActiveAbonements(date time.Time, issue *Item) error {
+	abonements, err := as.db.GetActiveAbonementsByDate(date)
 	if err != nil {
 		return err
 	}
 
-	// For each abonement, update the customer's license groups
+	customers := map[int]bool{}
 	for _, abonement := range abonements {
-		// Get the item to get its license group
-		item, err := as.db.GetItem(abonement.ItemID)
-		if err != nil {
-			continue // Skip if item not found
+		if err := as.db.SyncAbonementEntitlement(abonement); err != nil {
+			// Log error but continue processing other abonements
+			log.Error("processActiveAbonements: sync entitlement of abonement ", abonement.ID, err)
+			continue
 		}
+		customers[abonement.CustomerID] = true
 
-		// Add the license group to the customer (handle null.String)
-		licenseGroup := ""
-		if item.LicenseGroup.Valid {
-			licenseGroup = item.LicenseGroup.String
+		if issue == nil || !issue.LicenseGroup.Valid || issue.LicenseGroup.String == "" {
+			continue
 		}
-		if licenseGroup == "" {
+		item, err := as.db.getEntitlementItem(abonement.ItemID)
+		if err != nil || item.LicenseGroup.String != issue.LicenseGroup.String {
 			continue
 		}
-		updatedCustomer, err := as.db.AddLicenseGroupToCustomer(abonement.CustomerID, licenseGroup)
+		_, err = as.db.GrantEntitlement(Entitlement{
+			CustomerID:   abonement.CustomerID,
+			ItemID:       null.IntFrom(int64(issue.ID)),
+			LicenseGroup: issue.LicenseGroup.String,
+			Source:       EntitlementSourceAbonement,
+			AbonementID:  null.IntFrom(int64(abonement.ID)),
+			ValidFrom:    date,
+		})
 		if err != nil {
-			// Log error but continue processing other abonements
-			continue
+			log.Error("processActiveAbonements: grant issue of abonement ", abonement.ID, err)
 		}
+	}
 
-		if updatedCustomer.KeycloakID != "" {
-			_ = as.SyncAbonementLicensesToKeycloak(updatedCustomer.KeycloakID, updatedCustomer.LicenseGroups)
+	for customerID := range customers {
+		if err := as.ProjectEntitlements(customerID, date); err != nil {
+			log.Error("processActiveAbonements: project entitlements of customer ", customerID, err)
 		}
 	}
-
 	return nil
 }
 
-// ProcessAbonementForCustomer updates a customer's license groups based on their active abonements
+// ProcessAbonementForCustomer updates the entitlements of all abonements of a
+// customer and projects the resulting license groups to Keycloak
 func (as *AbonementService) ProcessAbonementForCustomer(customerID int, checkDate time.Time) error {
-	// Get all abonements for the customer that are active on checkDate
 	abonements, err := as.db.ListAbonementsByCustomer(customerID)
 	if err != nil {
 		return err
 	}
 
-	var licenseGroups []string
-	now := checkDate
-
 	for _, abonement := range abonements {
-		// Check if abonement is active on the check date
-		if abonement.FromDate.Before(now) && abonement.ToDate.After(now) && abonement.Status == "active" {
-			// Get the item to get its license group
-			item, err := as.db.GetItem(abonement.ItemID)
-			if err != nil {
-				continue
-			}
-
-			// Get license group value, handling null.String
-			licenseGroupValue := ""
-			if item.LicenseGroup.Valid {
-				licenseGroupValue = item.LicenseGroup.String
-			}
-
-			// Add license group if not already in the list
-			found := false
-			for _, lg := range licenseGroups {
-				if lg == licenseGroupValue {
-					found = true
-					break
-				}
-			}
-			if !found && licenseGroupValue != "" {
-				licenseGroups = append(licenseGroups, licenseGroupValue)
-			}
+		if err := as.db.SyncAbonementEntitlement(abonement); err != nil {
+			log.Error("ProcessAbonementForCustomer: sync entitlement of abonement ", abonement.ID, err)
 		}
 	}
 
-	// Update customer with combined license groups
+	return as.ProjectEntitlements(customerID, checkDate)
+}
+
+// ProjectEntitlements sets the license groups of a customer to those of the
+// entitlements active at now and applies the difference to Keycloak.
+// Entitlements are the source of truth, the license groups only a
+// projection for WordPress and other readers that gate on Keycloak groups.
+func (as *AbonementService) ProjectEntitlements(customerID int, now time.Time) error {
 	customer, err := as.db.GetCustomerByID(customerID)
 	if err != nil {
 		return err
 	}
-
-	customer.LicenseGroups = licenseGroups
-	_, err = as.db.UpdateCustomer(customer)
+	groups, err := as.db.EntitledLicenseGroups(customerID, now)
 	if err != nil {
 		return err
 	}
+	oldGroups := customer.LicenseGroups
+	if !sameLicenseGroups(oldGroups, groups) {
+		customer.LicenseGroups = groups
+		if _, err = as.db.UpdateCustomer(customer); err != nil {
+			return err
+		}
+	}
 
 	if customer.KeycloakID != "" {
-		return as.SyncAbonementLicensesToKeycloak(customer.KeycloakID, licenseGroups)
+		return as.keycloakAssigner.SyncLicenseGroupsDiffToKeycloak(customer.KeycloakID, oldGroups, groups)
 	}
-
 	return nil
 }
 
+// sameLicenseGroups compares two lists of license groups ignoring order
+func sameLicenseGroups(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, g := range a {
+		set[g] = true
+	}
+	for _, g := range b {
+		if !set[g] {
+			return false
+		}
+	}
+	return true
+}
+
 // SyncAbonementLicensesToKeycloak assigns each license group to the user's Keycloak account.
 func (as *AbonementService) SyncAbonementLicensesToKeycloak(keycloakID string, licenseGroups []string) error {
 	for _, group := range licenseGroups {
@@ -133,3 +160,70 @@ func (as *AbonementService) SyncAbonementLicensesToKeycloak(keycloakID string, l
 	}
 	return nil
 }
+
+// RunLifecycle advances the abonement lifecycle at now. Customers of
+// expired abonements lose their license groups in Keycloak, customers of
+// abonements ending within reminderDays get a reminder to renew.
+func (as *AbonementService) RunLifecycle(now time.Time, reminderDays int) (AbonementLifecycleReport, error) {
+	report, err := as.db.AdvanceAbonementLifecycle(now, reminderDays)
+	if err != nil {
+		return report, err
+	}
+
+	customers := map[int]bool{}
+	for _, abonement := range report.Expired {
+		customers[abonement.CustomerID] = true
+	}
+	for customerID := range customers {
+		if err := as.ProcessAbonementForCustomer(customerID, now); err != nil {
+			log.Error("RunLifecycle: revoke access of customer ", customerID, err)
+		}
+	}
+
+	renewURL := ""
+	if len(report.Expiring) > 0 {
+		settings, err := as.db.GetSettings()
+		if err == nil {
+			renewURL = settings.AbonementUrl
+		}
+	}
+	for _, abonement := range report.Expiring {
+		if err := as.sendExpiryReminder(abonement, now, renewURL); err != nil {
+			log.Error("RunLifecycle: remind customer of abonement ", abonement.ID, err)
+		}
+	}
+	return report, nil
+}
+
+func (as *AbonementService) sendExpiryReminder(abonement Abonement, now time.Time, renewURL string) error {
+	customer, err := as.db.GetCustomerByID(abonement.CustomerID)
+	if err != nil {
+		return err
+	}
+	if customer.Email == "" || !customer.Preferences.NotifyAbonementReminders {
+		return nil
+	}
+	itemName := ""
+	if abonement.ItemID > 0 {
+		if item, err := as.db.getEntitlementItem(abonement.ItemID); err == nil {
+			itemName = item.Name
+		}
+	}
+	templateData := map[string]interface{}{
+		"CustomerName": strings.TrimSpace(customer.FirstName + " " + customer.LastName),
+		"ItemName":     itemName,
+		"FromDate":     abonement.FromDate.Format("2006-01-02"),
+		"ToDate":       abonement.ToDate.Format("2006-01-02"),
+		"DaysLeft":     int(math.Ceil(abonement.ToDate.Sub(now).Hours() / 24)),
+		"RenewURL":     renewURL,
+	}
+	mail, err := BuildEmailRequestFromTemplate("abonementExpiryReminder", []string{customer.Email}, templateData)
+	if err != nil || mail == nil {
+		return err
+	}
+	success, err := mailer.Send(mail)
+	if err == nil && !success {
+		err = errors.New("mail was not sent")
+	}
+	return err
+}
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/mailer"
+)
+
+// RecurringCharger charges a payment mandate again. Implemented by the
+// payment providers and replaced with a mock in tests.
+type RecurringCharger interface {
+	// Charge charges amount in cents and returns the ID of the transaction
+	Charge(mandate PaymentMandate, amount int, description string) (transactionID string, err error)
+}
+
+// errRenewalNotExtended is returned if an abonement was charged but could
+// not be extended
+var errRenewalNotExtended = errors.New("abonement charged but not extended")
+
+// RenewalPolicy configures when abonements are renewed and how often a
+// failed renewal is retried
+type RenewalPolicy struct {
+	// Renew abonements ending within LeadDays
+	LeadDays    int
+	RetryAfter  time.Duration
+	MaxAttempts int
+}
+
+// AbonementRenewalReport lists the abonements handled by RunRenewals
+type AbonementRenewalReport struct {
+	Renewed []Abonement `json:"renewed"`
+	Failed  []Abonement `json:"failed"`
+}
+
+// RunRenewals charges the payment mandates of all abonements due for
+// renewal at now with the charger of their provider. Renewed abonements are
+// extended by a year and billed by a verified order. After a failure the
+// customer is asked to check their payment method and the renewal is
+// retried until policy.MaxAttempts is reached or the abonement ends.
+func (as *AbonementService) RunRenewals(now time.Time, policy RenewalPolicy, chargers map[string]RecurringCharger) (AbonementRenewalReport, error) {
+	report := AbonementRenewalReport{Renewed: []Abonement{}, Failed: []Abonement{}}
+	due, err := as.db.ClaimDueRenewals(now, policy.LeadDays, policy.RetryAfter)
+	if err != nil {
+		return report, err
+	}
+
+	renewURL := ""
+	if len(due) > 0 {
+		settings, err := as.db.GetSettings()
+		if err == nil {
+			renewURL = settings.AbonementUrl
+		}
+	}
+	for _, abonement := range due {
+		renewed, amount, err := as.renewAbonement(abonement, chargers)
+		if err == nil {
+			report.Renewed = append(report.Renewed, *renewed)
+			if err := as.ProcessAbonementForCustomer(renewed.CustomerID, now); err != nil {
+				log.Error("RunRenewals: sync entitlements of customer ", renewed.CustomerID, err)
+			}
+			if err := as.sendRenewalMail(*renewed, "abonementRenewed", map[string]interface{}{
+				"Amount": fmt.Sprintf("%.2f", float64(amount)/100),
+			}); err != nil {
+				log.Error("RunRenewals: notify customer of abonement ", renewed.ID, err)
+			}
+			continue
+		}
+		if errors.Is(err, errRenewalNotExtended) {
+			// Charged already, auto renew is turned off so the next run does
+			// not charge the customer twice
+			return report, err
+		}
+		log.Error("RunRenewals: renewal of abonement ", abonement.ID, " failed: ", err)
+
+		attempts := abonement.RenewalAttempts + 1
+		next := now.Add(policy.RetryAfter)
+		final := attempts >= policy.MaxAttempts || !next.Before(abonement.ToDate)
+		var nextAttempt *time.Time
+		if !final {
+			nextAttempt = &next
+		}
+		failed, err := as.db.RecordAbonementRenewalFailure(abonement.ID, attempts, nextAttempt)
+		if err != nil {
+			return report, err
+		}
+		report.Failed = append(report.Failed, *failed)
+		if err := as.sendRenewalMail(*failed, "abonementRenewalFailed", map[string]interface{}{
+			"Attempt":      attempts,
+			"FinalAttempt": final,
+			"NextAttempt":  next.Format("2006-01-02"),
+			"RenewURL":     renewURL,
+		}); err != nil {
+			log.Error("RunRenewals: notify customer of abonement ", failed.ID, err)
+		}
+	}
+	return report, nil
+}
+
+// renewAbonement charges the renewal of an abonement and extends it. It
+// returns the charged amount in cents.
+func (as *AbonementService) renewAbonement(abonement Abonement, chargers map[string]RecurringCharger) (*Abonement, int, error) {
+	mandate, err := as.db.GetPaymentMandate(abonement.PaymentMandateID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if mandate.RevokedAt.Valid {
+		return nil, 0, ErrNoPaymentMandate
+	}
+	charger, ok := chargers[mandate.Provider]
+	if !ok {
+		return nil, 0, errors.New("no charger for payment provider " + mandate.Provider)
+	}
+	order, err := as.db.BuildRenewalOrder(abonement)
+	if err != nil {
+		return nil, 0, err
+	}
+	amount := order.GetTotal()
+
+	description := "Abonement renewal"
+	if item, err := as.db.getEntitlementItem(abonement.ItemID); err == nil {
+		description = item.Name
+	}
+	transactionID, err := charger.Charge(mandate, amount, description)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// The customer paid, so the abonement is extended even if the order
+	// can not be booked. The transaction ID is logged for manual booking.
+	order.TransactionID = transactionID
+	orderID, err := as.db.CreateOrder(order)
+	if err == nil {
+		err = as.db.VerifyRenewalOrder(orderID)
+	}
+	if err != nil {
+		log.Error("renewAbonement: booking transaction ", transactionID, " of abonement ", abonement.ID, " failed: ", err)
+	}
+	renewed, err := as.db.ExtendAbonement(abonement.ID)
+	if err != nil {
+		log.Error("renewAbonement: extending abonement ", abonement.ID, " after transaction ", transactionID, " failed, extend it manually: ", err)
+		if err := as.db.StopAbonementRenewal(abonement.ID); err != nil {
+			log.Error("renewAbonement: turning off auto renew of abonement ", abonement.ID, " failed: ", err)
+		}
+		return nil, 0, fmt.Errorf("%w: %w", errRenewalNotExtended, err)
+	}
+	return renewed, amount, nil
+}
+
+func (as *AbonementService) sendRenewalMail(abonement Abonement, template string, data map[string]interface{}) error {
+	customer, err := as.db.GetCustomerByID(abonement.CustomerID)
+	if err != nil {
+		return err
+	}
+	if customer.Email == "" {
+		return nil
+	}
+	itemName := ""
+	if abonement.ItemID > 0 {
+		if item, err := as.db.getEntitlementItem(abonement.ItemID); err == nil {
+			itemName = item.Name
+		}
+	}
+	data["CustomerName"] = strings.TrimSpace(customer.FirstName + " " + customer.LastName)
+	data["ItemName"] = itemName
+	data["ToDate"] = abonement.ToDate.Format("2006-01-02")
+	mail, err := BuildEmailRequestFromTemplate(template, []string{customer.Email}, data)
+	if err != nil || mail == nil {
+		return err
+	}
+	success, err := mailer.Send(mail)
+	if err == nil && !success {
+		err = errors.New("mail was not sent")
+	}
+	return err
+}
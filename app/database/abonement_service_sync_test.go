@@ -10,9 +10,16 @@ import (
 // mockAssigner records calls to AssignDigitalLicenseGroup for assertion in tests.
 type mockAssigner struct {
 	calls []assignCall
+	syncs []syncCall
 	err   error
 }
 
+type syncCall struct {
+	userID    string
+	oldGroups []string
+	newGroups []string
+}
+
 type assignCall struct {
 	userID       string
 	licenseGroup string
@@ -23,6 +30,11 @@ func (m *mockAssigner) AssignDigitalLicenseGroup(userID, licenseGroup string) er
 	return m.err
 }
 
+func (m *mockAssigner) SyncLicenseGroupsDiffToKeycloak(userID string, oldGroups, newGroups []string) error {
+	m.syncs = append(m.syncs, syncCall{userID, oldGroups, newGroups})
+	return m.err
+}
+
 func newTestService(assigner DigitalLicenseAssigner) *AbonementService {
 	return &AbonementService{db: &Db, keycloakAssigner: assigner}
 }
@@ -20,6 +20,11 @@ type Database struct {
 	IsProduction bool
 	EntClient    *ent.Client
 	DB           *sql.DB
+
+	// actor is recorded in the audit log, see As
+	actor *AuditActor
+	// tx is the transaction EntClient is bound to, see inTx
+	tx *ent.Tx
 }
 
 // Db is the global database connection pool that is used by all handlers
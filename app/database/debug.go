@@ -73,6 +73,20 @@ func (d *DebugTx) Query(ctx context.Context, query string, args, v any) error {
 	return err
 }
 
+// ExecContext runs raw statements like SET LOCAL in the transaction
+func (d *DebugTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ex, ok := d.Tx.(interface {
+		ExecContext(context.Context, string, ...any) (sql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	start := time.Now()
+	res, err := ex.ExecContext(ctx, query, args...)
+	logQuery(ctx, "Tx.ExecContext", query, args, time.Since(start), err)
+	return res, err
+}
+
 func (d *DebugTx) Commit() error {
 	return d.Tx.Commit()
 }
@@ -467,6 +467,10 @@ func (db *Database) createDevCustomersAndAbonements() error {
 			log.Error("createDevCustomersAndAbonements: customer creation failed ", zap.Error(err))
 			return err
 		}
+		if err = db.ApplyManualLicenseGroups(created.ID, nil, c.LicenseGroups); err != nil {
+			log.Error("createDevCustomersAndAbonements: entitlement creation failed ", zap.Error(err))
+			return err
+		}
 		createdIDs = append(createdIDs, created.ID)
 	}
 
@@ -500,11 +504,15 @@ func (db *Database) createDevCustomersAndAbonements() error {
 	}
 
 	for _, a := range abonements {
-		_, err := db.CreateAbonement(&a)
+		created, err := db.CreateAbonement(&a)
 		if err != nil {
 			log.Error("createDevCustomersAndAbonements: abonement creation failed ", zap.Error(err))
 			return err
 		}
+		if err = db.SyncAbonementEntitlement(created); err != nil {
+			log.Error("createDevCustomersAndAbonements: entitlement creation failed ", zap.Error(err))
+			return err
+		}
 	}
 
 	return nil
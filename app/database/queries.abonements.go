@@ -2,22 +2,70 @@ package database
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/ent"
 	entabonement "github.com/augustin-wien/augustina-backend/ent/abonement"
 )
 
+// Statuses of abonements
+const (
+	AbonementStatusActive = "active"
+	// Active, but ends soon and the customer was reminded to renew
+	AbonementStatusExpiring = "expiring"
+	AbonementStatusExpired  = "expired"
+	// Cancelled by the customer, access is kept until the end of the period
+	AbonementStatusCancelled = "cancelled"
+	// Suspended by an admin, no access until it is active again
+	AbonementStatusPaused = "paused"
+)
+
+// AbonementStatuses lists all valid statuses of abonements
+var AbonementStatuses = []string{
+	AbonementStatusActive,
+	AbonementStatusExpiring,
+	AbonementStatusExpired,
+	AbonementStatusCancelled,
+	AbonementStatusPaused,
+}
+
+// abonementAccessStatuses are the statuses of abonements that grant access
+// within their period
+var abonementAccessStatuses = []string{
+	AbonementStatusActive,
+	AbonementStatusExpiring,
+	AbonementStatusCancelled,
+}
+
+// ValidAbonementStatus reports whether status is a known abonement status
+func ValidAbonementStatus(status string) bool {
+	return slices.Contains(AbonementStatuses, status)
+}
+
+// AbonementGrantsAccess reports whether an abonement with status grants
+// access to its license group within its period
+func AbonementGrantsAccess(status string) bool {
+	return slices.Contains(abonementAccessStatuses, status)
+}
+
 // Abonement represents a subscription/abonement in the system
 type Abonement struct {
-	ID         int        `json:"id"`
-	CustomerID int        `json:"customer_id"`
-	ItemID     int        `json:"item_id"`
-	FromDate   time.Time  `json:"from_date"`
-	ToDate     time.Time  `json:"to_date"`
-	Status     string     `json:"status"`
-	CreatedAt  *time.Time `json:"created_at,omitempty"`
-	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	ID               int        `json:"id"`
+	CustomerID       int        `json:"customer_id"`
+	ItemID           int        `json:"item_id"`
+	FromDate         time.Time  `json:"from_date"`
+	ToDate           time.Time  `json:"to_date"`
+	Status           string     `json:"status"`
+	CancelledAt      *time.Time `json:"cancelled_at,omitempty"`
+	RemindedAt       *time.Time `json:"reminded_at,omitempty"`
+	OrderID          int        `json:"order_id,omitempty"`
+	AutoRenew        bool       `json:"auto_renew"`
+	PaymentMandateID int        `json:"payment_mandate_id,omitempty"`
+	RenewalAttempts  int        `json:"renewal_attempts"`
+	NextRenewalAt    *time.Time `json:"next_renewal_at,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
 }
 
 // AbonementEntIntoAbonement converts an ent.Abonement to Abonement struct
@@ -30,28 +78,47 @@ func (db *Database) AbonementEntIntoAbonement(a *ent.Abonement) Abonement {
 	}
 
 	return Abonement{
-		ID:         a.ID,
-		CustomerID: a.CustomerID,
-		ItemID:     itemID,
-		FromDate:   a.FromDate,
-		ToDate:     a.ToDate,
-		Status:     a.Status,
-		CreatedAt:  a.CreatedAt,
-		UpdatedAt:  a.UpdatedAt,
+		ID:               a.ID,
+		CustomerID:       a.CustomerID,
+		ItemID:           itemID,
+		FromDate:         a.FromDate,
+		ToDate:           a.ToDate,
+		Status:           a.Status,
+		CancelledAt:      a.CancelledAt,
+		RemindedAt:       a.RemindedAt,
+		OrderID:          derefInt(a.OrderID),
+		AutoRenew:        a.AutoRenew,
+		PaymentMandateID: derefInt(a.PaymentMandateID),
+		RenewalAttempts:  a.RenewalAttempts,
+		NextRenewalAt:    a.NextRenewalAt,
+		CreatedAt:        a.CreatedAt,
+		UpdatedAt:        a.UpdatedAt,
 	}
 }
 
+// derefInt returns the value of an optional foreign key or 0 if it is unset
+func derefInt(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
 // CreateAbonement creates a new abonement in the database
 func (db *Database) CreateAbonement(abonement *Abonement) (*Abonement, error) {
 	ctx := context.Background()
 
-	var itemID *int
+	var itemID, orderID *int
 	if abonement.ItemID > 0 {
 		itemID = &abonement.ItemID
 	}
+	if abonement.OrderID > 0 {
+		orderID = &abonement.OrderID
+	}
 	entAbonement, err := db.EntClient.Abonement.Create().
 		SetCustomerID(abonement.CustomerID).
 		SetNillableItemID(itemID).
+		SetNillableOrderID(orderID).
 		SetFromDate(abonement.FromDate).
 		SetToDate(abonement.ToDate).
 		SetStatus(abonement.Status).
@@ -143,13 +210,18 @@ func (db *Database) UpdateAbonement(abonement *Abonement) (*Abonement, error) {
 	return &result, nil
 }
 
-// DeleteAbonement deletes an abonement from the database
+// DeleteAbonement deletes an abonement and the entitlements it granted from
+// the database
 func (db *Database) DeleteAbonement(id int) error {
 	ctx := context.Background()
+	if err := db.DeleteEntitlementsByAbonement(id); err != nil {
+		return err
+	}
 	return db.EntClient.Abonement.DeleteOneID(id).Exec(ctx)
 }
 
-// GetActiveAbonementsByDate retrieves all active abonements for a given date
+// GetActiveAbonementsByDate retrieves all abonements granting access on a
+// given date
 func (db *Database) GetActiveAbonementsByDate(date time.Time) ([]*Abonement, error) {
 	ctx := context.Background()
 
@@ -157,7 +229,7 @@ func (db *Database) GetActiveAbonementsByDate(date time.Time) ([]*Abonement, err
 		Where(
 			entabonement.FromDateLTE(date),
 			entabonement.ToDateGTE(date),
-			entabonement.Status("active"),
+			entabonement.StatusIn(abonementAccessStatuses...),
 		).
 		All(ctx)
 
@@ -182,7 +254,7 @@ func (db *Database) GetAbonementsByDateRange(fromDate, toDate time.Time) ([]*Abo
 		Where(
 			entabonement.FromDateLTE(toDate),
 			entabonement.ToDateGTE(fromDate),
-			entabonement.Status("active"),
+			entabonement.StatusIn(abonementAccessStatuses...),
 		).
 		All(ctx)
 
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entabonement "github.com/augustin-wien/augustina-backend/ent/abonement"
+)
+
+var (
+	// ErrAbonementNotFound is returned if the abonement does not exist or
+	// belongs to another customer
+	ErrAbonementNotFound = errors.New("abonement not found")
+	// ErrAbonementNotCancellable is returned if the abonement already ended
+	// or is paused or cancelled
+	ErrAbonementNotCancellable = errors.New("abonement can not be cancelled")
+)
+
+// AbonementLifecycleReport lists the abonements moved to another status by
+// AdvanceAbonementLifecycle
+type AbonementLifecycleReport struct {
+	Expiring []Abonement `json:"expiring"`
+	Expired  []Abonement `json:"expired"`
+}
+
+// AdvanceAbonementLifecycle expires all abonements whose period ended and
+// marks active abonements ending within reminderDays as expiring, so the
+// customers get reminded once. Abonements renewing automatically are not
+// reminded. A reminderDays of 0 disables reminders.
+// Each abonement is moved by a conditional update, so it is reported by
+// only one of several running instances.
+func (db *Database) AdvanceAbonementLifecycle(now time.Time, reminderDays int) (report AbonementLifecycleReport, err error) {
+	ctx := context.Background()
+	report = AbonementLifecycleReport{Expiring: []Abonement{}, Expired: []Abonement{}}
+
+	running := []string{AbonementStatusActive, AbonementStatusExpiring, AbonementStatusCancelled, AbonementStatusPaused}
+	ended, err := db.EntClient.Abonement.Query().
+		Where(entabonement.StatusIn(running...), entabonement.ToDateLTE(now)).
+		All(ctx)
+	if err != nil {
+		log.Error("AdvanceAbonementLifecycle: ", err)
+		return report, err
+	}
+	for _, a := range ended {
+		n, err := db.EntClient.Abonement.Update().
+			Where(entabonement.ID(a.ID), entabonement.StatusIn(running...), entabonement.ToDateLTE(now)).
+			SetStatus(AbonementStatusExpired).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			log.Error("AdvanceAbonementLifecycle: ", err)
+			return report, err
+		}
+		if n == 0 {
+			continue
+		}
+		a.Status = AbonementStatusExpired
+		report.Expired = append(report.Expired, db.AbonementEntIntoAbonement(a))
+	}
+
+	if reminderDays <= 0 {
+		return report, nil
+	}
+	remindBefore := now.AddDate(0, 0, reminderDays)
+	ending, err := db.EntClient.Abonement.Query().
+		Where(
+			entabonement.Status(AbonementStatusActive),
+			entabonement.AutoRenew(false),
+			entabonement.ToDateGT(now),
+			entabonement.ToDateLTE(remindBefore),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error("AdvanceAbonementLifecycle: ", err)
+		return report, err
+	}
+	for _, a := range ending {
+		n, err := db.EntClient.Abonement.Update().
+			Where(entabonement.ID(a.ID), entabonement.Status(AbonementStatusActive), entabonement.AutoRenew(false)).
+			SetStatus(AbonementStatusExpiring).
+			SetRemindedAt(now).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			log.Error("AdvanceAbonementLifecycle: ", err)
+			return report, err
+		}
+		if n == 0 {
+			continue
+		}
+		a.Status = AbonementStatusExpiring
+		a.RemindedAt = &now
+		report.Expiring = append(report.Expiring, db.AbonementEntIntoAbonement(a))
+	}
+	return report, nil
+}
+
+// CancelAbonement cancels a running abonement of a customer and turns off its
+// automatic renewal. The customer keeps access until the end of the period.
+func (db *Database) CancelAbonement(id int, customerID int) (*Abonement, error) {
+	ctx := context.Background()
+	now := time.Now()
+	n, err := db.EntClient.Abonement.Update().
+		Where(
+			entabonement.ID(id),
+			entabonement.CustomerID(customerID),
+			entabonement.StatusIn(AbonementStatusActive, AbonementStatusExpiring),
+			entabonement.ToDateGT(now),
+		).
+		SetStatus(AbonementStatusCancelled).
+		SetCancelledAt(now).
+		SetAutoRenew(false).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err != nil {
+		log.Error("CancelAbonement: ", err)
+		return nil, err
+	}
+
+	a, err := db.EntClient.Abonement.Query().
+		Where(entabonement.ID(id), entabonement.CustomerID(customerID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAbonementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ErrAbonementNotCancellable
+	}
+	result := db.AbonementEntIntoAbonement(a)
+	return &result, nil
+}
@@ -0,0 +1,103 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+// TestAbonementLifecycle expires ended abonements, reminds customers once
+// and lets customers cancel their own abonements
+func TestAbonementLifecycle(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	var reminded []string
+	origBuild := BuildEmailRequestFromTemplate
+	defer func() { BuildEmailRequestFromTemplate = origBuild }()
+	BuildEmailRequestFromTemplate = func(name string, to []string, data interface{}) (*mailer.EmailRequest, error) {
+		if name == "abonementExpiryReminder" {
+			reminded = append(reminded, to[0])
+			require.Equal(t, 10, data.(map[string]interface{})["DaysLeft"])
+		}
+		return nil, nil
+	}
+
+	customer, err := Db.CreateCustomer(&Customer{KeycloakID: "lifecycle-customer", Email: "lifecycle@example.com"})
+	require.NoError(t, err)
+	other, err := Db.CreateCustomer(&Customer{KeycloakID: "lifecycle-other", Email: "other@example.com"})
+	require.NoError(t, err)
+	itemID, err := Db.CreateItem(Item{Name: "Lifecycle abonement", Description: "One year of online issues", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("digital")})
+	require.NoError(t, err)
+
+	create := func(customerID int, from, to time.Time) *Abonement {
+		a, err := Db.CreateAbonement(&Abonement{CustomerID: customerID, ItemID: itemID, FromDate: from, ToDate: to, Status: AbonementStatusActive})
+		require.NoError(t, err)
+		require.NoError(t, Db.SyncAbonementEntitlement(a))
+		return a
+	}
+	ended := create(customer.ID, now.AddDate(-1, 0, 0), now.Add(-time.Hour))
+	ending := create(other.ID, now.AddDate(-1, 0, 0), now.Add(10*24*time.Hour-time.Minute))
+	running := create(customer.ID, now, now.AddDate(1, 0, 0))
+
+	mock := &mockAssigner{}
+	svc := newTestService(mock)
+	report, err := svc.RunLifecycle(now, 30)
+	require.NoError(t, err)
+	require.Len(t, report.Expired, 1)
+	require.Equal(t, ended.ID, report.Expired[0].ID)
+	require.Len(t, report.Expiring, 1)
+	require.Equal(t, ending.ID, report.Expiring[0].ID)
+	require.Equal(t, []string{"other@example.com"}, reminded)
+
+	// The expired abonement no longer grants access, the running one does
+	a, err := Db.GetAbonementByID(ended.ID)
+	require.NoError(t, err)
+	require.Equal(t, AbonementStatusExpired, a.Status)
+	require.Len(t, mock.syncs, 1)
+	require.Equal(t, "lifecycle-customer", mock.syncs[0].userID)
+	require.Equal(t, []string{"digital"}, mock.syncs[0].newGroups)
+
+	// Reminders are sent once
+	report, err = svc.RunLifecycle(now, 30)
+	require.NoError(t, err)
+	require.Empty(t, report.Expired)
+	require.Empty(t, report.Expiring)
+	require.Len(t, reminded, 1)
+
+	// Customers can only cancel their own running abonements
+	_, err = Db.CancelAbonement(running.ID, other.ID)
+	require.ErrorIs(t, err, ErrAbonementNotFound)
+	_, err = Db.CancelAbonement(ended.ID, customer.ID)
+	require.ErrorIs(t, err, ErrAbonementNotCancellable)
+	cancelled, err := Db.CancelAbonement(running.ID, customer.ID)
+	require.NoError(t, err)
+	require.Equal(t, AbonementStatusCancelled, cancelled.Status)
+	require.NotNil(t, cancelled.CancelledAt)
+	_, err = Db.CancelAbonement(running.ID, customer.ID)
+	require.ErrorIs(t, err, ErrAbonementNotCancellable)
+
+	// Cancelled abonements keep access until they end
+	require.NoError(t, Db.SyncAbonementEntitlement(cancelled))
+	groups, err := Db.EntitledLicenseGroups(customer.ID, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, []string{"digital"}, groups)
+	report, err = svc.RunLifecycle(now.AddDate(1, 0, 1), 30)
+	require.NoError(t, err)
+	require.Len(t, report.Expired, 2)
+	groups, err = Db.EntitledLicenseGroups(customer.ID, now.AddDate(1, 0, 1))
+	require.NoError(t, err)
+	require.Empty(t, groups)
+}
+
+func TestAbonementStatuses(t *testing.T) {
+	require.True(t, ValidAbonementStatus(AbonementStatusPaused))
+	require.False(t, ValidAbonementStatus("inactive"))
+	require.True(t, AbonementGrantsAccess(AbonementStatusCancelled))
+	require.True(t, AbonementGrantsAccess(AbonementStatusExpiring))
+	require.False(t, AbonementGrantsAccess(AbonementStatusPaused))
+	require.False(t, AbonementGrantsAccess(AbonementStatusExpired))
+}
@@ -0,0 +1,220 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entabonement "github.com/augustin-wien/augustina-backend/ent/abonement"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	// ErrNoPaymentMandate is returned if automatic renewal is turned on for
+	// an abonement without a usable payment mandate
+	ErrNoPaymentMandate = errors.New("abonement has no stored payment method")
+	// ErrAbonementNotRenewable is returned if automatic renewal is turned on
+	// for an abonement that already ended or was cancelled or paused
+	ErrAbonementNotRenewable = errors.New("abonement can not be renewed")
+)
+
+// abonementRenewableStatuses are the statuses of abonements that are renewed
+// automatically if auto renew is on
+var abonementRenewableStatuses = []string{
+	AbonementStatusActive,
+	AbonementStatusExpiring,
+}
+
+// ClaimDueRenewals returns the abonements renewing automatically that end
+// within leadDays and are due for a renewal attempt. A claimed abonement is
+// not due again before retryAfter, which is also the delay between failed
+// attempts. Abonements are claimed by a conditional update, so each one is
+// charged by only one of several running instances.
+func (db *Database) ClaimDueRenewals(now time.Time, leadDays int, retryAfter time.Duration) ([]Abonement, error) {
+	ctx := context.Background()
+	due := []predicate.Abonement{
+		entabonement.AutoRenew(true),
+		entabonement.PaymentMandateIDNotNil(),
+		entabonement.StatusIn(abonementRenewableStatuses...),
+		entabonement.ToDateGT(now),
+		entabonement.ToDateLTE(now.AddDate(0, 0, leadDays)),
+		entabonement.Or(entabonement.NextRenewalAtIsNil(), entabonement.NextRenewalAtLTE(now)),
+	}
+	abonements, err := db.EntClient.Abonement.Query().Where(due...).All(ctx)
+	if err != nil {
+		log.Error("ClaimDueRenewals: ", err)
+		return nil, err
+	}
+
+	claimed := []Abonement{}
+	next := now.Add(retryAfter)
+	for _, a := range abonements {
+		n, err := db.EntClient.Abonement.Update().
+			Where(append([]predicate.Abonement{entabonement.ID(a.ID)}, due...)...).
+			SetNextRenewalAt(next).
+			Save(ctx)
+		if err != nil {
+			log.Error("ClaimDueRenewals: ", err)
+			return claimed, err
+		}
+		if n == 0 {
+			continue
+		}
+		a.NextRenewalAt = &next
+		claimed = append(claimed, db.AbonementEntIntoAbonement(a))
+	}
+	return claimed, nil
+}
+
+// ExtendAbonement extends a renewed abonement by a year. It is active again
+// and a later renewal starts without failed attempts.
+func (db *Database) ExtendAbonement(id int) (*Abonement, error) {
+	ctx := context.Background()
+	a, err := db.EntClient.Abonement.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Abonements are sold for a year, see VerifyOrderAndCreatePayments
+	a, err = a.Update().
+		SetToDate(a.ToDate.AddDate(1, 0, 0)).
+		SetStatus(AbonementStatusActive).
+		ClearRemindedAt().
+		SetRenewalAttempts(0).
+		ClearNextRenewalAt().
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error("ExtendAbonement: ", err)
+		return nil, err
+	}
+	result := db.AbonementEntIntoAbonement(a)
+	return &result, nil
+}
+
+// RecordAbonementRenewalFailure stores the number of failed renewal attempts
+// of an abonement and when to try again. Without a next attempt automatic
+// renewal is turned off and the abonement ends at its to_date.
+func (db *Database) RecordAbonementRenewalFailure(id int, attempts int, nextAttempt *time.Time) (*Abonement, error) {
+	update := db.EntClient.Abonement.UpdateOneID(id).
+		SetRenewalAttempts(attempts).
+		SetUpdatedAt(time.Now())
+	if nextAttempt != nil {
+		update = update.SetNextRenewalAt(*nextAttempt)
+	} else {
+		update = update.SetAutoRenew(false).ClearNextRenewalAt()
+	}
+	a, err := update.Save(context.Background())
+	if err != nil {
+		log.Error("RecordAbonementRenewalFailure: ", err)
+		return nil, err
+	}
+	result := db.AbonementEntIntoAbonement(a)
+	return &result, nil
+}
+
+// StopAbonementRenewal turns off automatic renewal of an abonement that has
+// to be handled manually, so it is not claimed and charged again
+func (db *Database) StopAbonementRenewal(id int) error {
+	err := db.EntClient.Abonement.UpdateOneID(id).
+		SetAutoRenew(false).
+		ClearNextRenewalAt().
+		SetUpdatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		log.Error("StopAbonementRenewal: ", err)
+	}
+	return err
+}
+
+// SetAbonementAutoRenew turns automatic renewal of an abonement of a
+// customer on or off. It can only be turned on for running abonements with
+// a payment mandate.
+func (db *Database) SetAbonementAutoRenew(id int, customerID int, autoRenew bool) (*Abonement, error) {
+	ctx := context.Background()
+	a, err := db.EntClient.Abonement.Query().
+		Where(entabonement.ID(id), entabonement.CustomerID(customerID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrAbonementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if autoRenew {
+		if a.PaymentMandateID == nil {
+			return nil, ErrNoPaymentMandate
+		}
+		mandate, err := db.GetPaymentMandate(*a.PaymentMandateID)
+		if err != nil || mandate.RevokedAt.Valid {
+			return nil, ErrNoPaymentMandate
+		}
+		if !slices.Contains(abonementRenewableStatuses, a.Status) || !a.ToDate.After(time.Now()) {
+			return nil, ErrAbonementNotRenewable
+		}
+	}
+
+	a, err = a.Update().
+		SetAutoRenew(autoRenew).
+		SetRenewalAttempts(0).
+		ClearNextRenewalAt().
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error("SetAbonementAutoRenew: ", err)
+		return nil, err
+	}
+	result := db.AbonementEntIntoAbonement(a)
+	return &result, nil
+}
+
+// BuildRenewalOrder builds an order billing the renewal of an abonement
+// like its first purchase at the current prices. Only the entries of the
+// abonement item and its license item are billed again. The order goes to
+// the current email of the customer, so it gets an invoice.
+func (db *Database) BuildRenewalOrder(abonement Abonement) (Order, error) {
+	var renewal Order
+	if abonement.OrderID == 0 {
+		return renewal, errors.New("abonement has no order to renew")
+	}
+	original, err := db.GetOrderByID(abonement.OrderID)
+	if err != nil {
+		return renewal, err
+	}
+	item, err := db.getEntitlementItem(abonement.ItemID)
+	if err != nil {
+		return renewal, err
+	}
+
+	renewal.Vendor = original.Vendor
+	renewal.User = original.User
+	renewal.CustomerEmail = original.CustomerEmail
+	renewal.BillingAddress = original.BillingAddress
+	if customer, err := db.GetCustomerByID(abonement.CustomerID); err == nil && customer.Email != "" {
+		renewal.CustomerEmail = null.StringFrom(customer.Email)
+	}
+	for _, entry := range original.Entries {
+		if entry.Item != item.ID && (!item.LicenseItem.Valid || entry.Item != int(item.LicenseItem.Int64)) {
+			continue
+		}
+		current, err := db.GetItem(entry.Item)
+		if err != nil {
+			return renewal, err
+		}
+		renewal.Entries = append(renewal.Entries, OrderEntry{
+			Item:     entry.Item,
+			Quantity: entry.Quantity,
+			Price:    current.Price,
+			Sender:   entry.Sender,
+			Receiver: entry.Receiver,
+			IsSale:   entry.IsSale,
+		})
+	}
+	if renewal.GetTotal() <= 0 {
+		return renewal, errors.New("abonement renewal has nothing to charge")
+	}
+	return renewal, nil
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+type mockCharger struct {
+	err     error
+	charged []int
+}
+
+func (m *mockCharger) Charge(mandate PaymentMandate, amount int, description string) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.charged = append(m.charged, amount)
+	return "renewal-" + mandate.Token, nil
+}
+
+// TestAbonementRenewal charges stored payment mandates before abonements end
+// and retries failed renewals with dunning mails
+func TestAbonementRenewal(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	mails := map[string]int{}
+	origBuild := BuildEmailRequestFromTemplate
+	defer func() { BuildEmailRequestFromTemplate = origBuild }()
+	BuildEmailRequestFromTemplate = func(name string, to []string, data interface{}) (*mailer.EmailRequest, error) {
+		mails[name]++
+		return nil, nil
+	}
+
+	vendorID, err := Db.CreateVendor(Vendor{FirstName: "Renewal", LastName: "Vendor", Email: "renewal@vendor.com", LicenseID: null.StringFrom("rv-1")})
+	utils.CheckError(t, err)
+	vendorAccount, err := Db.GetAccountByVendorID(vendorID)
+	utils.CheckError(t, err)
+	itemID, err := Db.CreateItem(Item{Name: "Renewal abonement", Description: "Abonement renewed with a stored card", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("digital")})
+	utils.CheckError(t, err)
+	orderID, err := Db.CreateOrder(Order{
+		Vendor:    vendorID,
+		AutoRenew: true,
+		Entries: []OrderEntry{
+			{Item: itemID, Quantity: 1, Sender: vendorAccount.ID, Receiver: vendorAccount.ID, IsSale: true},
+		},
+	})
+	utils.CheckError(t, err)
+	manualOrderID, err := Db.CreateOrder(Order{
+		Vendor: vendorID,
+		Entries: []OrderEntry{
+			{Item: itemID, Quantity: 1, Sender: vendorAccount.ID, Receiver: vendorAccount.ID, IsSale: true},
+		},
+	})
+	utils.CheckError(t, err)
+
+	customer, err := Db.CreateCustomer(&Customer{KeycloakID: "renewal-customer", Email: "renewal@example.com"})
+	require.NoError(t, err)
+	create := func(to time.Time) *Abonement {
+		a, err := Db.CreateAbonement(&Abonement{CustomerID: customer.ID, ItemID: itemID, OrderID: orderID, FromDate: to.AddDate(-1, 0, 0), ToDate: to, Status: AbonementStatusActive})
+		require.NoError(t, err)
+		return a
+	}
+	due := create(now.AddDate(0, 0, 3))
+	later := create(now.AddDate(0, 2, 0))
+
+	// Auto renew needs a payment mandate
+	_, err = Db.SetAbonementAutoRenew(due.ID, customer.ID, true)
+	require.ErrorIs(t, err, ErrNoPaymentMandate)
+	// Without consent at checkout no mandate is stored
+	manual, err := Db.CreateAbonement(&Abonement{CustomerID: customer.ID, ItemID: itemID, OrderID: manualOrderID, FromDate: now, ToDate: now.AddDate(1, 0, 0), Status: AbonementStatusActive})
+	require.NoError(t, err)
+	noMandate, err := Db.AttachPaymentMandateToOrder(manualOrderID, PaymentMandate{Provider: PaymentProviderVivaWallet, Token: "tx-0"})
+	require.NoError(t, err)
+	require.Nil(t, noMandate)
+	manual, err = Db.GetAbonementByID(manual.ID)
+	require.NoError(t, err)
+	require.False(t, manual.AutoRenew)
+	mandate, err := Db.AttachPaymentMandateToOrder(orderID, PaymentMandate{Provider: PaymentProviderVivaWallet, Token: "tx-1", CardNumber: "4147XXXXXXXX0133"})
+	require.NoError(t, err)
+	require.Equal(t, customer.ID, mandate.CustomerID)
+	again, err := Db.AttachPaymentMandateToOrder(orderID, PaymentMandate{Provider: PaymentProviderVivaWallet, Token: "tx-1"})
+	require.NoError(t, err)
+	require.Equal(t, mandate.ID, again.ID)
+
+	policy := RenewalPolicy{LeadDays: 7, RetryAfter: 24 * time.Hour, MaxAttempts: 2}
+	charger := &mockCharger{}
+	chargers := map[string]RecurringCharger{PaymentProviderVivaWallet: charger}
+	svc := newTestService(&mockAssigner{})
+
+	report, err := svc.RunRenewals(now, policy, chargers)
+	require.NoError(t, err)
+	require.Len(t, report.Renewed, 1)
+	require.Empty(t, report.Failed)
+	require.Equal(t, []int{5000}, charger.charged)
+	renewed := report.Renewed[0]
+	require.Equal(t, due.ID, renewed.ID)
+	require.True(t, renewed.ToDate.Equal(due.ToDate.AddDate(1, 0, 0)))
+	require.Equal(t, 1, mails["abonementRenewed"])
+
+	// The renewal is billed by a verified order
+	orders, err := Db.GetOrders()
+	require.NoError(t, err)
+	require.Len(t, orders, 3)
+	var renewalOrder Order
+	for _, order := range orders {
+		if order.TransactionID == "renewal-tx-1" {
+			renewalOrder = order
+		}
+	}
+	require.True(t, renewalOrder.Verified)
+	// Sent to the customer, so it gets an invoice
+	require.Equal(t, null.StringFrom("renewal@example.com"), renewalOrder.CustomerEmail)
+	// The renewal extends the abonement instead of selling a new one
+	abonements, err := Db.ListAbonementsByCustomer(customer.ID)
+	require.NoError(t, err)
+	require.Len(t, abonements, 3)
+
+	// Abonements are not charged twice
+	report, err = svc.RunRenewals(now, policy, chargers)
+	require.NoError(t, err)
+	require.Empty(t, report.Renewed)
+
+	// Failed renewals are retried and turn off auto renew after the last attempt
+	charger.err = errors.New("card declined")
+	soon := now.AddDate(0, 1, 27)
+	report, err = svc.RunRenewals(soon, policy, chargers)
+	require.NoError(t, err)
+	require.Len(t, report.Failed, 1)
+	require.Equal(t, later.ID, report.Failed[0].ID)
+	require.True(t, report.Failed[0].AutoRenew)
+	require.Equal(t, 1, report.Failed[0].RenewalAttempts)
+
+	report, err = svc.RunRenewals(soon.Add(time.Hour), policy, chargers)
+	require.NoError(t, err)
+	require.Empty(t, report.Failed)
+
+	report, err = svc.RunRenewals(soon.Add(25*time.Hour), policy, chargers)
+	require.NoError(t, err)
+	require.Len(t, report.Failed, 1)
+	require.False(t, report.Failed[0].AutoRenew)
+	require.Equal(t, 2, mails["abonementRenewalFailed"])
+
+	// Customers can turn auto renew on again and off
+	a, err := Db.SetAbonementAutoRenew(later.ID, customer.ID, true)
+	require.NoError(t, err)
+	require.True(t, a.AutoRenew)
+	require.Zero(t, a.RenewalAttempts)
+	a, err = Db.SetAbonementAutoRenew(later.ID, customer.ID, false)
+	require.NoError(t, err)
+	require.False(t, a.AutoRenew)
+	_, err = Db.SetAbonementAutoRenew(later.ID, customer.ID+1, false)
+	require.ErrorIs(t, err, ErrAbonementNotFound)
+}
@@ -210,7 +210,12 @@ func (db *Database) UpdateAccountBalanceByOpenPayments(vendorID int) (payoutAmou
 			err = tx.Commit()
 		}
 	}()
+	return db.updateAccountBalanceByOpenPaymentsTx(ctx, tx, vendorID)
+}
 
+// updateAccountBalanceByOpenPaymentsTx updates the balance of the account of
+// a vendor within tx
+func (db *Database) updateAccountBalanceByOpenPaymentsTx(ctx context.Context, tx *ent.Tx, vendorID int) (payoutAmount int, err error) {
 	// Get account within the transaction
 	// The original SQL used FOR UPDATE. To achieve this in ent, we can use a custom predicate
 	// or rely on the later update.
@@ -300,16 +305,25 @@ func (db *Database) UpdateAccountBalanceByOpenPayments(vendorID int) (payoutAmou
 	return openPaymentsSum, err
 }
 
-// RecalculateAllVendorBalances recalculates and persists the balance for every vendor
+// RecalculateAllVendorBalances recalculates and persists the balance for
+// every vendor in one transaction
 func (db *Database) RecalculateAllVendorBalances() error {
 	vendors, err := db.ListVendors()
 	if err != nil {
 		return err
 	}
-	for _, v := range vendors {
-		if _, err := db.UpdateAccountBalanceByOpenPayments(v.ID); err != nil {
-			log.Errorf("RecalculateAllVendorBalances: vendor %d: %v", v.ID, err)
+	return db.inTx(func(txDb *Database) error {
+		before := map[string]any{}
+		after := map[string]any{}
+		for _, v := range vendors {
+			balance, err := txDb.updateAccountBalanceByOpenPaymentsTx(context.Background(), txDb.tx, v.ID)
+			if err != nil {
+				log.Errorf("RecalculateAllVendorBalances: vendor %d: %v", v.ID, err)
+				return err
+			}
+			before[v.LicenseID.String] = v.Balance
+			after[v.LicenseID.String] = balance
 		}
-	}
-	return nil
+		return txDb.audit(AuditVendorBalancesRecalculate, "vendor", 0, before, after)
+	})
 }
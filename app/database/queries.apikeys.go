@@ -0,0 +1,339 @@
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entapikey "github.com/augustin-wien/augustina-backend/ent/apikey"
+	"gopkg.in/guregu/null.v4"
+)
+
+// APIKeyPrefix starts every API key, so the auth middleware can tell keys
+// from Keycloak tokens
+const APIKeyPrefix = "ak_"
+
+// apiKeyLastUsedInterval throttles the writes of the last use of a key
+const apiKeyLastUsedInterval = time.Minute
+
+var (
+	// ErrAPIKeyNotFound is returned if no API key matches
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrAPIKeyInvalid is returned for unknown, revoked and expired keys
+	ErrAPIKeyInvalid = errors.New("api key is not valid")
+	// ErrAPIKeyIPNotAllowed is returned if a key is used from an IP outside
+	// of its allowlist
+	ErrAPIKeyIPNotAllowed = errors.New("api key is not allowed from this ip")
+	// ErrAPIKeyRevoked is returned when revoking or rotating a revoked or
+	// expired key
+	ErrAPIKeyRevoked = errors.New("api key has been revoked or expired")
+)
+
+// APIKey authenticates a machine client. It grants its permissions on the
+// routes of its route groups. An empty AllowedIPs allows all IPs.
+type APIKey struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	Prefix        string    `json:"prefix"`
+	Permissions   []string  `json:"permissions"`
+	RouteGroups   []string  `json:"route_groups"`
+	AllowedIPs    []string  `json:"allowed_ips"`
+	ExpiresAt     null.Time `json:"expires_at" swaggertype:"string" format:"date-time"`
+	RevokedAt     null.Time `json:"revoked_at" swaggertype:"string" format:"date-time"`
+	LastUsedAt    null.Time `json:"last_used_at" swaggertype:"string" format:"date-time"`
+	LastUsedIP    string    `json:"last_used_ip"`
+	RotatedFromID null.Int  `json:"rotated_from_id" swaggertype:"integer"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// APIKeyEntIntoAPIKey converts an ent.APIKey to APIKey struct
+func APIKeyEntIntoAPIKey(k *ent.APIKey) APIKey {
+	return APIKey{
+		ID:            k.ID,
+		Name:          k.Name,
+		Prefix:        k.Prefix,
+		Permissions:   k.Permissions,
+		RouteGroups:   k.RouteGroups,
+		AllowedIPs:    k.AllowedIps,
+		ExpiresAt:     null.TimeFromPtr(k.ExpiresAt),
+		RevokedAt:     null.TimeFromPtr(k.RevokedAt),
+		LastUsedAt:    null.TimeFromPtr(k.LastUsedAt),
+		LastUsedIP:    k.LastUsedIP,
+		RotatedFromID: null.IntFromPtr(intPtrToInt64Ptr(k.RotatedFromID)),
+		CreatedBy:     k.CreatedBy,
+		CreatedAt:     k.CreatedAt,
+	}
+}
+
+// Validate checks the name, scopes and IP allowlist of an API key. The
+// names of permissions and route groups are checked by the caller.
+func (k APIKey) Validate() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return errors.New("name is required")
+	}
+	if len(k.Permissions) == 0 {
+		return errors.New("at least one permission is required")
+	}
+	if len(k.RouteGroups) == 0 {
+		return errors.New("at least one route group is required")
+	}
+	for _, allowed := range k.AllowedIPs {
+		if _, err := parseIPPrefix(allowed); err != nil {
+			return errors.New("allowed_ips must contain IP addresses or CIDR ranges")
+		}
+	}
+	return nil
+}
+
+// ActiveAt reports whether the key is neither revoked nor expired at t
+func (k APIKey) ActiveAt(t time.Time) bool {
+	if k.RevokedAt.Valid {
+		return false
+	}
+	return !k.ExpiresAt.Valid || k.ExpiresAt.Time.After(t)
+}
+
+// AllowsIP reports whether the key may be used from ip. Ports and further
+// addresses of forwarding headers are ignored.
+func (k APIKey) AllowsIP(ip string) bool {
+	if len(k.AllowedIPs) == 0 {
+		return true
+	}
+	ip, _, _ = strings.Cut(ip, ",")
+	ip = strings.TrimSpace(ip)
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range k.AllowedIPs {
+		prefix, err := parseIPPrefix(allowed)
+		if err == nil && prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseIPPrefix parses a CIDR range or a single IP address
+func parseIPPrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// HashAPIKey returns the hex encoded SHA-256 hash stored for a key
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// newAPIKey returns a random key like "ak_<prefix>_<secret>" and its prefix
+func newAPIKey() (key string, prefix string) {
+	prefix = strings.ToLower(rand.Text()[:12])
+	secret := strings.ToLower(rand.Text() + rand.Text())
+	return APIKeyPrefix + prefix + "_" + secret, prefix
+}
+
+// apiKeyPrefix returns the prefix part of a key
+func apiKeyPrefix(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, APIKeyPrefix)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	return prefix, ok && prefix != "" && secret != ""
+}
+
+// CreateAPIKey stores a new API key and returns it with the key itself,
+// which is not stored and can not be shown again
+func (db *Database) CreateAPIKey(k APIKey) (APIKey, string, error) {
+	return createAPIKey(db.EntClient.APIKey, k)
+}
+
+func createAPIKey(client *ent.APIKeyClient, k APIKey) (APIKey, string, error) {
+	key, prefix := newAPIKey()
+	allowedIPs := k.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+	created, err := client.Create().
+		SetName(strings.TrimSpace(k.Name)).
+		SetPrefix(prefix).
+		SetKeyHash(HashAPIKey(key)).
+		SetPermissions(k.Permissions).
+		SetRouteGroups(k.RouteGroups).
+		SetAllowedIps(allowedIPs).
+		SetNillableExpiresAt(k.ExpiresAt.Ptr()).
+		SetNillableRotatedFromID(intPtrFromNullInt(k.RotatedFromID)).
+		SetCreatedBy(k.CreatedBy).
+		SetCreatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		log.Error("CreateAPIKey: ", err)
+		return APIKey{}, "", err
+	}
+	return APIKeyEntIntoAPIKey(created), key, nil
+}
+
+// GetAPIKey returns an API key by its ID
+func (db *Database) GetAPIKey(id int) (APIKey, error) {
+	k, err := db.EntClient.APIKey.Get(context.Background(), id)
+	if ent.IsNotFound(err) {
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+	if err != nil {
+		log.Error("GetAPIKey: ", err)
+		return APIKey{}, err
+	}
+	return APIKeyEntIntoAPIKey(k), nil
+}
+
+// ListAPIKeys returns all API keys, the newest first
+func (db *Database) ListAPIKeys() ([]APIKey, error) {
+	keys, err := db.EntClient.APIKey.Query().
+		Order(ent.Desc(entapikey.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListAPIKeys: ", err)
+		return nil, err
+	}
+	result := make([]APIKey, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, APIKeyEntIntoAPIKey(k))
+	}
+	return result, nil
+}
+
+// RevokeAPIKey revokes an API key right away
+func (db *Database) RevokeAPIKey(id int, now time.Time) (APIKey, error) {
+	n, err := db.EntClient.APIKey.Update().
+		Where(entapikey.ID(id), entapikey.RevokedAtIsNil()).
+		SetRevokedAt(now).
+		Save(context.Background())
+	if err != nil {
+		log.Error("RevokeAPIKey: ", err)
+		return APIKey{}, err
+	}
+	k, err := db.GetAPIKey(id)
+	if err == nil && n == 0 {
+		err = ErrAPIKeyRevoked
+	}
+	return k, err
+}
+
+// RotateAPIKey issues a new key with the settings of an active key. The old
+// key keeps working for the grace period, so clients can be switched over
+// without downtime. Without a grace period it is revoked right away.
+func (db *Database) RotateAPIKey(id int, grace time.Duration, createdBy string, now time.Time) (APIKey, string, error) {
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("RotateAPIKey: ", err)
+		return APIKey{}, "", err
+	}
+	defer tx.Rollback()
+
+	old, err := tx.APIKey.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return APIKey{}, "", ErrAPIKeyNotFound
+	}
+	if err != nil {
+		log.Error("RotateAPIKey: ", err)
+		return APIKey{}, "", err
+	}
+	oldKey := APIKeyEntIntoAPIKey(old)
+	if !oldKey.ActiveAt(now) {
+		return APIKey{}, "", ErrAPIKeyRevoked
+	}
+
+	update := tx.APIKey.UpdateOneID(id)
+	if grace <= 0 {
+		update = update.SetRevokedAt(now)
+	} else if end := now.Add(grace); !oldKey.ExpiresAt.Valid || end.Before(oldKey.ExpiresAt.Time) {
+		update = update.SetExpiresAt(end)
+	}
+	if err = update.Exec(ctx); err != nil {
+		log.Error("RotateAPIKey: ", err)
+		return APIKey{}, "", err
+	}
+
+	rotated := oldKey
+	rotated.RotatedFromID = null.IntFrom(int64(id))
+	rotated.CreatedBy = createdBy
+	created, key, err := createAPIKey(tx.APIKey, rotated)
+	if err != nil {
+		return APIKey{}, "", err
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("RotateAPIKey: commit ", err)
+		return APIKey{}, "", err
+	}
+	return created, key, nil
+}
+
+// AuthenticateAPIKey returns the active API key matching key and records
+// its use from ip
+func (db *Database) AuthenticateAPIKey(key string, ip string, now time.Time) (APIKey, error) {
+	prefix, ok := apiKeyPrefix(key)
+	if !ok {
+		return APIKey{}, ErrAPIKeyInvalid
+	}
+	found, err := db.EntClient.APIKey.Query().
+		Where(entapikey.Prefix(prefix)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return APIKey{}, ErrAPIKeyInvalid
+	}
+	if err != nil {
+		log.Error("AuthenticateAPIKey: ", err)
+		return APIKey{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(found.KeyHash), []byte(HashAPIKey(key))) != 1 {
+		return APIKey{}, ErrAPIKeyInvalid
+	}
+	k := APIKeyEntIntoAPIKey(found)
+	if !k.ActiveAt(now) {
+		return APIKey{}, ErrAPIKeyInvalid
+	}
+	if !k.AllowsIP(ip) {
+		return APIKey{}, ErrAPIKeyIPNotAllowed
+	}
+
+	// Only write the last use once in a while, keys are used on every request
+	_, err = db.EntClient.APIKey.Update().
+		Where(
+			entapikey.ID(k.ID),
+			entapikey.Or(
+				entapikey.LastUsedAtIsNil(),
+				entapikey.LastUsedAtLT(now.Add(-apiKeyLastUsedInterval)),
+				entapikey.LastUsedIPNEQ(ip),
+			),
+		).
+		SetLastUsedAt(now).
+		SetLastUsedIP(ip).
+		Save(context.Background())
+	if err != nil {
+		log.Error("AuthenticateAPIKey: recording last use failed: ", err)
+	}
+	return k, nil
+}
@@ -0,0 +1,108 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestAPIKeyScopes(t *testing.T) {
+	now := time.Now()
+	apiKey := APIKey{Name: "odoo", Permissions: []string{"items:write"}, RouteGroups: []string{"integrations"}}
+	require.NoError(t, apiKey.Validate())
+	require.True(t, apiKey.AllowsIP("198.51.100.7"), "no allowlist allows all IPs")
+
+	apiKey.AllowedIPs = []string{"192.0.2.0/24", "2001:db8::1"}
+	require.NoError(t, apiKey.Validate())
+	require.True(t, apiKey.AllowsIP("192.0.2.10"))
+	require.True(t, apiKey.AllowsIP("192.0.2.10:51234"))
+	require.True(t, apiKey.AllowsIP("192.0.2.10, 10.0.0.1"))
+	require.True(t, apiKey.AllowsIP("[2001:db8::1]:443"))
+	require.False(t, apiKey.AllowsIP("198.51.100.7"))
+	require.False(t, apiKey.AllowsIP("not an ip"))
+
+	require.Error(t, APIKey{Name: "x", Permissions: []string{"items:write"}}.Validate())
+	require.Error(t, APIKey{Name: "x", RouteGroups: []string{"integrations"}}.Validate())
+	require.Error(t, APIKey{Name: " ", Permissions: []string{"items:write"}, RouteGroups: []string{"integrations"}}.Validate())
+	apiKey.AllowedIPs = []string{"192.0.2.0/33"}
+	require.Error(t, apiKey.Validate())
+
+	require.True(t, apiKey.ActiveAt(now))
+	apiKey.ExpiresAt = null.TimeFrom(now)
+	require.False(t, apiKey.ActiveAt(now))
+	apiKey.ExpiresAt = null.Time{}
+	apiKey.RevokedAt = null.TimeFrom(now)
+	require.False(t, apiKey.ActiveAt(now))
+
+	key, prefix := newAPIKey()
+	parsed, ok := apiKeyPrefix(key)
+	require.True(t, ok)
+	require.Equal(t, prefix, parsed)
+	_, ok = apiKeyPrefix("eyJhbGciOiJSUzI1NiJ9.e30.c2ln")
+	require.False(t, ok)
+}
+
+// TestAPIKeys authenticates, rotates and revokes an API key
+func TestAPIKeys(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	apiKey, key, err := Db.CreateAPIKey(APIKey{
+		Name:        "odoo",
+		Permissions: []string{"items:read", "items:write"},
+		RouteGroups: []string{"integrations"},
+		AllowedIPs:  []string{"192.0.2.0/24"},
+		CreatedBy:   "admin",
+	})
+	utils.CheckError(t, err)
+	stored, err := Db.EntClient.APIKey.Get(t.Context(), apiKey.ID)
+	require.NoError(t, err)
+	require.Equal(t, HashAPIKey(key), stored.KeyHash, "only the hash is stored")
+
+	found, err := Db.AuthenticateAPIKey(key, "192.0.2.1", now)
+	require.NoError(t, err)
+	require.Equal(t, apiKey.ID, found.ID)
+	require.Equal(t, []string{"items:read", "items:write"}, found.Permissions)
+	used, err := Db.GetAPIKey(apiKey.ID)
+	require.NoError(t, err)
+	require.True(t, used.LastUsedAt.Valid)
+	require.Equal(t, "192.0.2.1", used.LastUsedIP)
+
+	_, err = Db.AuthenticateAPIKey(key+"x", "192.0.2.1", now)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+	_, err = Db.AuthenticateAPIKey(key, "198.51.100.1", now)
+	require.ErrorIs(t, err, ErrAPIKeyIPNotAllowed)
+
+	// The old key keeps working during the grace period
+	rotated, newKey, err := Db.RotateAPIKey(apiKey.ID, time.Hour, "admin", now)
+	require.NoError(t, err)
+	require.NotEqual(t, key, newKey)
+	require.Equal(t, apiKey.ID, int(rotated.RotatedFromID.Int64))
+	require.Equal(t, apiKey.AllowedIPs, rotated.AllowedIPs)
+	_, err = Db.AuthenticateAPIKey(key, "192.0.2.1", now.Add(time.Minute))
+	require.NoError(t, err)
+	_, err = Db.AuthenticateAPIKey(key, "192.0.2.1", now.Add(2*time.Hour))
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+	_, err = Db.AuthenticateAPIKey(newKey, "192.0.2.1", now.Add(2*time.Hour))
+	require.NoError(t, err)
+
+	revoked, err := Db.RevokeAPIKey(rotated.ID, now)
+	require.NoError(t, err)
+	require.True(t, revoked.RevokedAt.Valid)
+	_, err = Db.AuthenticateAPIKey(newKey, "192.0.2.1", now)
+	require.ErrorIs(t, err, ErrAPIKeyInvalid)
+	_, err = Db.RevokeAPIKey(rotated.ID, now)
+	require.ErrorIs(t, err, ErrAPIKeyRevoked)
+	_, _, err = Db.RotateAPIKey(rotated.ID, 0, "admin", now)
+	require.ErrorIs(t, err, ErrAPIKeyRevoked)
+	_, err = Db.RevokeAPIKey(rotated.ID+100, now)
+	require.ErrorIs(t, err, ErrAPIKeyNotFound)
+
+	keys, err := Db.ListAPIKeys()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, rotated.ID, keys[0].ID)
+}
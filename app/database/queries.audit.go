@@ -0,0 +1,247 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entauditlog "github.com/augustin-wien/augustina-backend/ent/auditlog"
+)
+
+// Actions recorded in the audit log
+const (
+	AuditVendorCreate              = "vendor.create"
+	AuditVendorUpdate              = "vendor.update"
+	AuditVendorDelete              = "vendor.delete"
+	AuditVendorBalancesRecalculate = "vendor.recalculate_balances"
+	AuditCommentCreate             = "comment.create"
+	AuditCommentUpdate             = "comment.update"
+	AuditCommentDelete             = "comment.delete"
+	AuditItemCreate                = "item.create"
+	AuditItemUpdate                = "item.update"
+	AuditItemDelete                = "item.delete"
+	AuditItemStockSettings         = "item.stock_settings"
+	AuditSettingsUpdate            = "settings.update"
+	AuditOrderVerify               = "order.verify"
+	AuditOrderTransactionID        = "order.transaction_id"
+	AuditPaymentPayout             = "payment.payout"
+	AuditCustomerMerge             = "customer.merge"
+)
+
+// AuditActor is the user an administrative action is recorded for
+type AuditActor struct {
+	// Actor is the name of the user or API key
+	Actor     string
+	ActorID   string
+	RequestID string
+	IP        string
+}
+
+// AuditChange is the value of a field before and after an action
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditEntry is an entry of the audit log
+type AuditEntry struct {
+	ID         int                    `json:"id"`
+	Actor      string                 `json:"actor"`
+	ActorID    string                 `json:"actor_id"`
+	Action     string                 `json:"action"`
+	EntityType string                 `json:"entity_type"`
+	EntityID   int                    `json:"entity_id"`
+	Changes    map[string]AuditChange `json:"changes"`
+	RequestID  string                 `json:"request_id"`
+	IP         string                 `json:"ip"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// AuditLogEntIntoAuditEntry converts an ent.AuditLog to AuditEntry struct
+func AuditLogEntIntoAuditEntry(a *ent.AuditLog) AuditEntry {
+	changes := make(map[string]AuditChange, len(a.Changes))
+	for field, change := range a.Changes {
+		values, _ := change.(map[string]any)
+		changes[field] = AuditChange{Before: values["before"], After: values["after"]}
+	}
+	return AuditEntry{
+		ID:         a.ID,
+		Actor:      a.Actor,
+		ActorID:    a.ActorID,
+		Action:     a.Action,
+		EntityType: a.EntityType,
+		EntityID:   a.EntityID,
+		Changes:    changes,
+		RequestID:  a.RequestID,
+		IP:         a.IP,
+		CreatedAt:  a.CreatedAt,
+	}
+}
+
+// As returns a copy of the database that records the changes it makes in
+// the audit log for actor. Without an actor nothing is recorded, e.g. for
+// payment webhooks and background jobs.
+func (db *Database) As(actor AuditActor) *Database {
+	audited := *db
+	audited.actor = &actor
+	return &audited
+}
+
+// AuditDiff returns the fields that differ between two states of an
+// entity, compared by their JSON representation. A nil state stands for an
+// entity that did not exist before or does not exist anymore.
+func AuditDiff(before, after any) map[string]AuditChange {
+	beforeFields := auditFields(before)
+	afterFields := auditFields(after)
+	changes := map[string]AuditChange{}
+	for field, value := range beforeFields {
+		if other, ok := afterFields[field]; !ok || !reflect.DeepEqual(value, other) {
+			changes[field] = AuditChange{Before: value, After: afterFields[field]}
+		}
+	}
+	for field, value := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			changes[field] = AuditChange{After: value}
+		}
+	}
+	return changes
+}
+
+// auditFields returns the JSON fields of a state, values that are no JSON
+// objects are stored as field "value"
+func auditFields(state any) map[string]any {
+	if state == nil || (reflect.ValueOf(state).Kind() == reflect.Pointer && reflect.ValueOf(state).IsNil()) {
+		return nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		log.Error("auditFields: ", err)
+		return nil
+	}
+	var fields map[string]any
+	if json.Unmarshal(data, &fields) != nil {
+		var value any
+		_ = json.Unmarshal(data, &value)
+		return map[string]any{"value": value}
+	}
+	return fields
+}
+
+// audit records an action of the actor of the database. The database is
+// bound to the transaction of the action, see inTx, so the entry is only
+// kept together with the action. Callers fail the action if the entry can
+// not be recorded.
+func (db *Database) audit(action string, entityType string, entityID int, before any, after any) error {
+	if db.actor == nil {
+		return nil
+	}
+	changes := map[string]any{}
+	for field, change := range AuditDiff(before, after) {
+		changes[field] = change
+	}
+	err := db.EntClient.AuditLog.Create().
+		SetActor(db.actor.Actor).
+		SetActorID(db.actor.ActorID).
+		SetAction(action).
+		SetEntityType(entityType).
+		SetEntityID(entityID).
+		SetChanges(changes).
+		SetRequestID(db.actor.RequestID).
+		SetIP(db.actor.IP).
+		SetCreatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		log.Errorf("audit: recording %s of %s %d by %s failed: %v", action, entityType, entityID, db.actor.Actor, err)
+	}
+	return err
+}
+
+// withTx returns a copy of the database bound to a transaction
+func (db *Database) withTx(tx *ent.Tx) *Database {
+	txDb := *db
+	txDb.EntClient = tx.Client()
+	txDb.tx = tx
+	return &txDb
+}
+
+// inTx runs fn with a copy of the database bound to a transaction, which is
+// committed if fn succeeds. Audited actions use it to record their entry
+// together with the action. If the database is bound to a transaction
+// already, fn runs in it.
+func (db *Database) inTx(fn func(txDb *Database) error) error {
+	if db.tx != nil {
+		return fn(db)
+	}
+	tx, err := db.EntClient.Tx(context.Background())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := fn(db.withTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// auditing reports whether the database records an audit log, so callers
+// only load the state before a change if it is needed
+func (db *Database) auditing() bool {
+	return db.actor != nil
+}
+
+// AuditFilter selects entries of the audit log. Zero values match all
+// entries.
+type AuditFilter struct {
+	Actor      string
+	Action     string
+	EntityType string
+	EntityID   int
+	From       time.Time
+	To         time.Time
+	Limit      int
+	Offset     int
+}
+
+// ListAuditEntries returns the entries of the audit log matching filter,
+// the newest first
+func (db *Database) ListAuditEntries(filter AuditFilter) ([]AuditEntry, error) {
+	query := db.EntClient.AuditLog.Query()
+	if filter.Actor != "" {
+		query = query.Where(entauditlog.Actor(filter.Actor))
+	}
+	if filter.Action != "" {
+		query = query.Where(entauditlog.Action(filter.Action))
+	}
+	if filter.EntityType != "" {
+		query = query.Where(entauditlog.EntityType(filter.EntityType))
+	}
+	if filter.EntityID != 0 {
+		query = query.Where(entauditlog.EntityID(filter.EntityID))
+	}
+	if !filter.From.IsZero() {
+		query = query.Where(entauditlog.CreatedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(entauditlog.CreatedAtLT(filter.To))
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	if filter.Offset > 0 {
+		query = query.Offset(filter.Offset)
+	}
+	entries, err := query.
+		Order(ent.Desc(entauditlog.FieldCreatedAt), ent.Desc(entauditlog.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListAuditEntries: ", err)
+		return nil, err
+	}
+	result := make([]AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, AuditLogEntIntoAuditEntry(entry))
+	}
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := Vendor{FirstName: "Anna", LastName: "Muster", Telephone: "1"}
+	after := before
+	after.Telephone = "2"
+
+	changes := AuditDiff(before, after)
+	require.Len(t, changes, 1)
+	require.Equal(t, AuditChange{Before: "1", After: "2"}, changes["Telephone"])
+
+	created := AuditDiff(nil, map[string]any{"amount": 500})
+	require.Equal(t, AuditChange{After: float64(500)}, created["amount"])
+	deleted := AuditDiff(&before, (*Vendor)(nil))
+	require.Equal(t, AuditChange{Before: "Anna"}, deleted["FirstName"])
+	require.Empty(t, AuditDiff(before, before))
+}
+
+// TestAuditLog records vendor changes of an actor and filters the log
+func TestAuditLog(t *testing.T) {
+	Db.InitEmptyTestDb()
+	start := time.Now().Add(-time.Second)
+
+	// Without an actor nothing is recorded
+	vendorID, err := Db.CreateVendor(Vendor{FirstName: "Audit", LastName: "Vendor", Email: "audit@vendor.com", LicenseID: null.StringFrom("av-1")})
+	utils.CheckError(t, err)
+	entries, err := Db.ListAuditEntries(AuditFilter{})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	audited := Db.As(AuditActor{Actor: "backoffice-user", ActorID: "kc-1", RequestID: "req-1", IP: "192.0.2.1"})
+	vendor, err := Db.GetVendor(vendorID)
+	require.NoError(t, err)
+	vendor.Telephone = "+43 1 234"
+	require.NoError(t, audited.UpdateVendor(vendorID, vendor))
+	require.NoError(t, audited.DeleteVendor(vendorID))
+	otherID, err := audited.CreateVendor(Vendor{FirstName: "Other", LastName: "Vendor", Email: "other@vendor.com", LicenseID: null.StringFrom("av-2")})
+	require.NoError(t, err)
+
+	entries, err = Db.ListAuditEntries(AuditFilter{EntityType: "vendor", EntityID: vendorID})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, AuditVendorDelete, entries[0].Action)
+	update := entries[1]
+	require.Equal(t, AuditVendorUpdate, update.Action)
+	require.Equal(t, "backoffice-user", update.Actor)
+	require.Equal(t, "kc-1", update.ActorID)
+	require.Equal(t, "req-1", update.RequestID)
+	require.Equal(t, "192.0.2.1", update.IP)
+	require.Equal(t, AuditChange{Before: "", After: "+43 1 234"}, update.Changes["Telephone"])
+	require.NotContains(t, update.Changes, "FirstName")
+
+	entries, err = Db.ListAuditEntries(AuditFilter{Action: AuditVendorCreate, From: start})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, otherID, entries[0].EntityID)
+
+	entries, err = Db.ListAuditEntries(AuditFilter{Actor: "backoffice-user", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	entries, err = Db.ListAuditEntries(AuditFilter{Actor: "someone-else"})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// Secrets of the settings are only recorded as changed
+	settings, err := Db.GetSettings()
+	require.NoError(t, err)
+	settings.WordPressInviteAPIKey = "audit-secret"
+	require.NoError(t, audited.UpdateSettings(settings))
+	entries, err = Db.ListAuditEntries(AuditFilter{Action: AuditSettingsUpdate})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, AuditChange{After: "changed"}, entries[0].Changes["WordPressInviteAPIKey"])
+}
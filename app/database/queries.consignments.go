@@ -0,0 +1,345 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entconsignment "github.com/augustin-wien/augustina-backend/ent/consignment"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrConsignmentReturnTooHigh is returned when a vendor returns more copies
+// than are still open
+var ErrConsignmentReturnTooHigh = errors.New("more copies returned than handed out")
+
+// ErrConsignmentNoPrice is returned when copies of an issue without license
+// item are handed out without a price
+var ErrConsignmentNoPrice = errors.New("issue has no license item, a price is required")
+
+// Consignment counts the copies of an issue a vendor took from the office.
+// Open copies are neither returned nor settled yet.
+type Consignment struct {
+	ID        int       `json:"id"`
+	VendorID  int       `json:"vendor_id"`
+	ItemID    int       `json:"item_id"`
+	HandedOut int       `json:"handed_out"`
+	Returned  int       `json:"returned"`
+	Settled   int       `json:"settled"`
+	Open      int       `json:"open"`
+	Price     int       `json:"price"` // Price per copy in cents
+	SettledAt null.Time `json:"settled_at" swaggertype:"string"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ConsignmentEntIntoConsignment converts an ent.Consignment to Consignment struct
+func (db *Database) ConsignmentEntIntoConsignment(c *ent.Consignment) Consignment {
+	consignment := Consignment{
+		ID:        c.ID,
+		VendorID:  c.VendorID,
+		ItemID:    c.ItemID,
+		HandedOut: c.HandedOut,
+		Returned:  c.Returned,
+		Settled:   c.Settled,
+		Open:      c.HandedOut - c.Returned - c.Settled,
+		Price:     c.Price,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+	if c.SettledAt != nil {
+		consignment.SettledAt = null.TimeFrom(*c.SettledAt)
+	}
+	return consignment
+}
+
+// HandOutConsignment records copies of an issue handed to a vendor. The
+// price per copy is fixed with the first handout; price 0 uses the price of
+// the license item, which vendors pay for each copy they sell. If the item
+// tracks stock the copies leave the office stock.
+func (db *Database) HandOutConsignment(vendorID int, itemID int, quantity int, price int, authorizedBy string) (*Consignment, error) {
+	if quantity <= 0 {
+		return nil, errors.New("quantity must be greater than 0")
+	}
+	item, err := db.GetItem(itemID)
+	if err != nil {
+		return nil, err
+	}
+	if price <= 0 {
+		if !item.LicenseItem.Valid {
+			return nil, ErrConsignmentNoPrice
+		}
+		licenseItem, err := db.GetItem(int(item.LicenseItem.Int64))
+		if err != nil {
+			return nil, err
+		}
+		price = licenseItem.Price
+	}
+
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("HandOutConsignment: ", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Updating first locks an existing row for the rest of the transaction
+	now := time.Now()
+	var c *ent.Consignment
+	updated, err := tx.Consignment.Update().
+		Where(entconsignment.VendorID(vendorID), entconsignment.ItemID(itemID)).
+		AddHandedOut(quantity).
+		SetUpdatedAt(now).
+		Save(ctx)
+	if err == nil && updated == 0 {
+		c, err = tx.Consignment.Create().
+			SetVendorID(vendorID).
+			SetItemID(itemID).
+			SetHandedOut(quantity).
+			SetPrice(price).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			Save(ctx)
+	} else if err == nil {
+		c, err = tx.Consignment.Query().
+			Where(entconsignment.VendorID(vendorID), entconsignment.ItemID(itemID)).
+			Only(ctx)
+	}
+	if err != nil {
+		log.Error("HandOutConsignment: ", err)
+		return nil, err
+	}
+
+	var alert *lowStockAlert
+	if item.TrackStock {
+		_, alert, err = createStockMovementTx(tx, StockMovement{
+			ItemID:    itemID,
+			VendorID:  vendorID,
+			Type:      StockMovementHandout,
+			Quantity:  quantity,
+			Note:      "Consignment",
+			CreatedBy: authorizedBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("HandOutConsignment: commit ", err)
+		return nil, err
+	}
+	sendLowStockAlerts([]*lowStockAlert{alert})
+	consignment := db.ConsignmentEntIntoConsignment(c)
+	return &consignment, nil
+}
+
+// ReturnConsignment records unsold copies a vendor brought back. If the
+// item tracks stock the copies are added to the office stock again.
+func (db *Database) ReturnConsignment(vendorID int, itemID int, quantity int, authorizedBy string) (*Consignment, error) {
+	if quantity <= 0 {
+		return nil, errors.New("quantity must be greater than 0")
+	}
+	item, err := db.GetItem(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("ReturnConsignment: ", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := tx.Consignment.Update().
+		Where(entconsignment.VendorID(vendorID), entconsignment.ItemID(itemID)).
+		AddReturned(quantity).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error("ReturnConsignment: ", err)
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrConsignmentReturnTooHigh
+	}
+	c, err := tx.Consignment.Query().
+		Where(entconsignment.VendorID(vendorID), entconsignment.ItemID(itemID)).
+		Only(ctx)
+	if err != nil {
+		log.Error("ReturnConsignment: ", err)
+		return nil, err
+	}
+	if c.HandedOut-c.Returned-c.Settled < 0 {
+		return nil, ErrConsignmentReturnTooHigh
+	}
+
+	if item.TrackStock {
+		_, _, err = createStockMovementTx(tx, StockMovement{
+			ItemID:    itemID,
+			VendorID:  vendorID,
+			Type:      StockMovementReturn,
+			Quantity:  quantity,
+			Note:      "Consignment",
+			CreatedBy: authorizedBy,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("ReturnConsignment: commit ", err)
+		return nil, err
+	}
+	consignment := db.ConsignmentEntIntoConsignment(c)
+	return &consignment, nil
+}
+
+// SettleConsignment charges the vendor account for all open copies, which
+// count as sold from then on. The money flows Vendor → Orga → Backoffice
+// like a POS order paid from the vendor balance. It returns the charged
+// amount in cents.
+func (db *Database) SettleConsignment(consignmentID int, authorizedBy string) (consignment *Consignment, amount int, err error) {
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("SettleConsignment: ", err)
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	// Updating first locks the row so concurrent settlements can not charge
+	// the same copies twice
+	c, err := tx.Consignment.UpdateOneID(consignmentID).SetUpdatedAt(time.Now()).Save(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			log.Error("SettleConsignment: ", err)
+		}
+		return nil, 0, err
+	}
+	open := c.HandedOut - c.Returned - c.Settled
+	if open > 0 {
+		vendorAccount, err := db.GetAccountByVendorID(c.VendorID)
+		if err != nil {
+			return nil, 0, err
+		}
+		orgaAccount, err := db.GetAccountByType("Orga")
+		if err != nil {
+			return nil, 0, err
+		}
+		backofficeAccount, err := db.GetAccountByType("Backoffice")
+		if err != nil {
+			return nil, 0, err
+		}
+		amount = open * c.Price
+		for _, payment := range []Payment{
+			{Sender: vendorAccount.ID, Receiver: orgaAccount.ID},
+			{Sender: orgaAccount.ID, Receiver: backofficeAccount.ID},
+		} {
+			payment.Amount = amount
+			payment.AuthorizedBy = authorizedBy
+			payment.Item = null.IntFrom(int64(c.ItemID))
+			payment.Quantity = open
+			payment.Price = c.Price
+			if _, err := createPaymentTx(tx, payment); err != nil {
+				log.Error("SettleConsignment: ", err)
+				return nil, 0, err
+			}
+		}
+	}
+
+	now := time.Now()
+	c, err = c.Update().AddSettled(open).SetSettledAt(now).SetUpdatedAt(now).Save(ctx)
+	if err != nil {
+		log.Error("SettleConsignment: ", err)
+		return nil, 0, err
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("SettleConsignment: commit ", err)
+		return nil, 0, err
+	}
+	result := db.ConsignmentEntIntoConsignment(c)
+	return &result, amount, nil
+}
+
+// ListConsignments returns consignments, newest first. vendorID and itemID 0
+// disable the respective filter, openOnly hides fully settled or returned
+// consignments.
+func (db *Database) ListConsignments(vendorID int, itemID int, openOnly bool) ([]Consignment, error) {
+	query := db.EntClient.Consignment.Query()
+	if vendorID != 0 {
+		query = query.Where(entconsignment.VendorID(vendorID))
+	}
+	if itemID != 0 {
+		query = query.Where(entconsignment.ItemID(itemID))
+	}
+	entConsignments, err := query.Order(ent.Desc(entconsignment.FieldCreatedAt), ent.Desc(entconsignment.FieldID)).All(context.Background())
+	if err != nil {
+		log.Error("ListConsignments: ", err)
+		return nil, err
+	}
+	consignments := make([]Consignment, 0, len(entConsignments))
+	for _, c := range entConsignments {
+		consignment := db.ConsignmentEntIntoConsignment(c)
+		if openOnly && consignment.Open == 0 {
+			continue
+		}
+		consignments = append(consignments, consignment)
+	}
+	return consignments, nil
+}
+
+// ConsignmentStatistics summarizes the consignments of an issue. Open
+// copies are still with the vendors and count as neither sold nor returned.
+type ConsignmentStatistics struct {
+	ItemID        int     `json:"item_id"`
+	ItemName      string  `json:"item_name"`
+	Vendors       int     `json:"vendors"`
+	HandedOut     int     `json:"handed_out"`
+	Returned      int     `json:"returned"`
+	Sold          int     `json:"sold"`
+	Open          int     `json:"open"`
+	SellThrough   float64 `json:"sell_through"` // Sold copies per settled or returned copy
+	SettledAmount int     `json:"settled_amount"`
+}
+
+// GetConsignmentStatistics returns the sell-through of every issue handed
+// out to vendors, or of a single issue if itemID is not 0
+func (db *Database) GetConsignmentStatistics(itemID int) ([]ConsignmentStatistics, error) {
+	query := db.EntClient.Consignment.Query().WithItem()
+	if itemID != 0 {
+		query = query.Where(entconsignment.ItemID(itemID))
+	}
+	entConsignments, err := query.Order(ent.Asc(entconsignment.FieldItemID)).All(context.Background())
+	if err != nil {
+		log.Error("GetConsignmentStatistics: ", err)
+		return nil, err
+	}
+
+	statistics := []ConsignmentStatistics{}
+	for _, c := range entConsignments {
+		if len(statistics) == 0 || statistics[len(statistics)-1].ItemID != c.ItemID {
+			stat := ConsignmentStatistics{ItemID: c.ItemID}
+			if c.Edges.Item != nil {
+				stat.ItemName = c.Edges.Item.Name
+			}
+			statistics = append(statistics, stat)
+		}
+		stat := &statistics[len(statistics)-1]
+		stat.Vendors++
+		stat.HandedOut += c.HandedOut
+		stat.Returned += c.Returned
+		stat.Sold += c.Settled
+		stat.Open += c.HandedOut - c.Returned - c.Settled
+		stat.SettledAmount += c.Settled * c.Price
+	}
+	for i := range statistics {
+		if closed := statistics[i].Sold + statistics[i].Returned; closed > 0 {
+			statistics[i].SellThrough = float64(statistics[i].Sold) / float64(closed)
+		}
+	}
+	return statistics, nil
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+// TestConsignments hands out, returns and settles copies of an issue and
+// checks the vendor account and the sell-through statistics
+func TestConsignments(t *testing.T) {
+	Db.InitEmptyTestDb()
+
+	vendorID, err := Db.CreateVendor(Vendor{
+		FirstName: "Consignment",
+		LastName:  "Vendor",
+		Email:     "consignment-vendor@vendor.com",
+		LicenseID: null.StringFrom("cons-001"),
+	})
+	require.NoError(t, err)
+	licenseItemID, err := Db.CreateItem(Item{Name: "License Issue 600", Description: "Paid by vendors per copy", Price: 150, Type: "license_item", IsLicenseItem: true})
+	require.NoError(t, err)
+	itemID, err := Db.CreateItem(Item{Name: "Issue 600", Description: "Newspaper", Price: 300, Type: "issue", LicenseItem: null.IntFrom(int64(licenseItemID))})
+	require.NoError(t, err)
+
+	// Vendors are charged the price of the license item, not the sale price
+	withoutLicense, err := Db.CreateItem(Item{Name: "Issue 601", Description: "Newspaper", Price: 300, Type: "issue"})
+	require.NoError(t, err)
+	_, err = Db.HandOutConsignment(vendorID, withoutLicense, 20, 0, "test")
+	require.ErrorIs(t, err, ErrConsignmentNoPrice)
+
+	consignment, err := Db.HandOutConsignment(vendorID, itemID, 20, 0, "test")
+	require.NoError(t, err)
+	require.Equal(t, 150, consignment.Price)
+	consignment, err = Db.HandOutConsignment(vendorID, itemID, 10, 0, "test")
+	require.NoError(t, err)
+	require.Equal(t, 30, consignment.HandedOut)
+
+	_, err = Db.ReturnConsignment(vendorID, itemID, 31, "test")
+	require.ErrorIs(t, err, ErrConsignmentReturnTooHigh)
+	consignment, err = Db.ReturnConsignment(vendorID, itemID, 6, "test")
+	require.NoError(t, err)
+	require.Equal(t, 24, consignment.Open)
+
+	consignment, amount, err := Db.SettleConsignment(consignment.ID, "test")
+	require.NoError(t, err)
+	require.Equal(t, 24*150, amount)
+	require.Equal(t, 24, consignment.Settled)
+	require.Equal(t, 0, consignment.Open)
+	require.True(t, consignment.SettledAt.Valid)
+
+	account, err := Db.GetAccountByVendorID(vendorID)
+	require.NoError(t, err)
+	require.Equal(t, -24*150, account.Balance)
+
+	// Settling again charges nothing and settled copies can not be returned
+	_, amount, err = Db.SettleConsignment(consignment.ID, "test")
+	require.NoError(t, err)
+	require.Equal(t, 0, amount)
+	_, err = Db.ReturnConsignment(vendorID, itemID, 1, "test")
+	require.ErrorIs(t, err, ErrConsignmentReturnTooHigh)
+
+	open, err := Db.ListConsignments(vendorID, 0, true)
+	require.NoError(t, err)
+	require.Empty(t, open)
+
+	statistics, err := Db.GetConsignmentStatistics(itemID)
+	require.NoError(t, err)
+	require.Len(t, statistics, 1)
+	require.Equal(t, "Issue 600", statistics[0].ItemName)
+	require.Equal(t, 30, statistics[0].HandedOut)
+	require.Equal(t, 6, statistics[0].Returned)
+	require.Equal(t, 24, statistics[0].Sold)
+	require.InDelta(t, 0.8, statistics[0].SellThrough, 1e-9)
+}
@@ -7,18 +7,27 @@ import (
 
 	"github.com/augustin-wien/augustina-backend/ent"
 	entcustomer "github.com/augustin-wien/augustina-backend/ent/customer"
+	ententitlement "github.com/augustin-wien/augustina-backend/ent/entitlement"
+	entpaymentmandate "github.com/augustin-wien/augustina-backend/ent/paymentmandate"
 )
 
 // Customer represents a customer in the system
 type Customer struct {
-	ID            int        `json:"id"`
-	KeycloakID    string     `json:"keycloakid"`
-	Email         string     `json:"email"`
-	FirstName     string     `json:"firstname"`
-	LastName      string     `json:"lastname"`
-	LicenseGroups []string   `json:"licensegroups"`
-	CreatedAt     *time.Time `json:"created_at,omitempty"`
-	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+	ID         int    `json:"id"`
+	KeycloakID string `json:"keycloakid"`
+	Email      string `json:"email"`
+	// PendingEmail is a changed email the customer has not verified yet
+	PendingEmail  string              `json:"pending_email,omitempty"`
+	FirstName     string              `json:"firstname"`
+	LastName      string              `json:"lastname"`
+	LicenseGroups []string            `json:"licensegroups"`
+	Preferences   CustomerPreferences `json:"preferences"`
+	// DeletionRequestedAt is set while the customer asked for their account
+	// to be deleted
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	DeletionReason      string     `json:"deletion_reason,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
 }
 
 func licenseGroupsFromString(s string) []string {
@@ -38,11 +47,19 @@ func (db *Database) CustomerEntIntoCustomer(c *ent.Customer) Customer {
 		ID:            c.ID,
 		KeycloakID:    c.Keycloakid,
 		Email:         c.Email,
+		PendingEmail:  c.PendingEmail,
 		FirstName:     c.Firstname,
 		LastName:      c.Lastname,
 		LicenseGroups: licenseGroupsFromString(c.Licensegroups),
-		CreatedAt:     c.CreatedAt,
-		UpdatedAt:     c.UpdatedAt,
+		Preferences: CustomerPreferences{
+			Newsletter:               c.Newsletter,
+			NotifyNewIssues:          c.NotifyNewIssues,
+			NotifyAbonementReminders: c.NotifyAbonementReminders,
+		},
+		DeletionRequestedAt: c.DeletionRequestedAt,
+		DeletionReason:      c.DeletionReason,
+		CreatedAt:           c.CreatedAt,
+		UpdatedAt:           c.UpdatedAt,
 	}
 }
 
@@ -136,9 +153,22 @@ func (db *Database) UpdateCustomer(customer *Customer) (*Customer, error) {
 	return &result, nil
 }
 
-// DeleteCustomer deletes a customer from the database
+// DeleteCustomer deletes a customer with their entitlements and payment
+// mandates from the database
 func (db *Database) DeleteCustomer(id int) error {
 	ctx := context.Background()
+	_, err := db.EntClient.Entitlement.Delete().
+		Where(ententitlement.CustomerID(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = db.EntClient.PaymentMandate.Delete().
+		Where(entpaymentmandate.CustomerID(id)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
 	return db.EntClient.Customer.DeleteOneID(id).Exec(ctx)
 }
 
@@ -206,7 +236,8 @@ func (db *Database) GetOrCreateCustomerByEmail(email, keycloakID string) (*Custo
 	return created, nil
 }
 
-// AddLicenseGroupToCustomer adds a license group to a customer's licensegroups
+// AddLicenseGroupToCustomer grants a customer a manual entitlement for a
+// license group and adds it to the customer's licensegroups
 func (db *Database) AddLicenseGroupToCustomer(customerID int, licenseGroup string) (*Customer, error) {
 	ctx := context.Background()
 
@@ -216,6 +247,15 @@ func (db *Database) AddLicenseGroupToCustomer(customerID int, licenseGroup strin
 		return nil, err
 	}
 
+	_, err = db.GrantEntitlement(Entitlement{
+		CustomerID:   customerID,
+		LicenseGroup: licenseGroup,
+		Source:       EntitlementSourceManual,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	// Add license group (avoid duplicates)
 	groups := customer.LicenseGroups
 	found := false
@@ -0,0 +1,390 @@
+package database
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/mailer"
+)
+
+// ErrCustomerImportNoEmailColumn is returned for CSV files without email
+// column
+var ErrCustomerImportNoEmailColumn = errors.New("the CSV file needs an email column")
+
+// CustomerImportDirectory is the part of Keycloak an import creates users
+// in. Extracted as an interface so it can be replaced with a mock in tests.
+type CustomerImportDirectory interface {
+	GetOrCreateUser(email string) (userID string, newUser bool, err error)
+	AssignGroup(userID string, groupName string) error
+	GetCustomerGroup() string
+}
+
+// Results of imported rows
+const (
+	// CustomerImportValid is a row that would be imported in a dry run
+	CustomerImportValid = "valid"
+	// CustomerImportImported is a row that was imported
+	CustomerImportImported = "imported"
+	// CustomerImportExists is a row whose customer and abonement exist
+	CustomerImportExists = "exists"
+	// CustomerImportInvalid is a row with errors that was not imported
+	CustomerImportInvalid = "invalid"
+	// CustomerImportFailed is a valid row that could not be imported
+	CustomerImportFailed = "failed"
+)
+
+// CustomerImportOptions are the options of an import
+type CustomerImportOptions struct {
+	// DryRun validates the rows without importing them
+	DryRun bool
+	// SendWelcome sends the welcome mail to customers with a new Keycloak
+	// user
+	SendWelcome bool
+}
+
+// CustomerImportRow is the result of a row of the CSV file
+type CustomerImportRow struct {
+	// Line is the line of the row in the CSV file, the header is line 1
+	Line        int      `json:"line"`
+	Email       string   `json:"email"`
+	Result      string   `json:"result"`
+	Errors      []string `json:"errors,omitempty"`
+	CustomerID  int      `json:"customer_id,omitempty"`
+	NewCustomer bool     `json:"new_customer"`
+	AbonementID int      `json:"abonement_id,omitempty"`
+	WelcomeSent bool     `json:"welcome_sent"`
+}
+
+// CustomerImport is the report of an import
+type CustomerImport struct {
+	DryRun   bool                `json:"dry_run"`
+	Rows     []CustomerImportRow `json:"rows"`
+	Imported int                 `json:"imported"`
+	Existing int                 `json:"existing"`
+	Invalid  int                 `json:"invalid"`
+	Failed   int                 `json:"failed"`
+}
+
+// customerImportRecord is a validated row
+type customerImportRecord struct {
+	email, firstName, lastName string
+	item                       *Item
+	from, to                   time.Time
+}
+
+// customerImportColumns maps normalized headers to the columns they name
+var customerImportColumns = map[string]string{
+	"email": "email", "emailaddress": "email", "mail": "email",
+	"firstname": "firstname", "vorname": "firstname",
+	"lastname": "lastname", "nachname": "lastname",
+	"item": "item", "itemid": "item", "abonement": "item",
+	"from": "from", "fromdate": "from", "validfrom": "from",
+	"to": "to", "todate": "to", "validto": "to",
+}
+
+// customerImportDateLayouts are the accepted date formats, ISO and the
+// format of German spreadsheets
+var customerImportDateLayouts = []string{"2006-01-02", "02.01.2006", "2.1.2006"}
+
+// CustomerImporter imports customers and their abonements from CSV files
+// of legacy subscriber lists
+type CustomerImporter struct {
+	db         *Database
+	directory  CustomerImportDirectory
+	abonements *AbonementService
+}
+
+// NewCustomerImporter creates an importer backed by the global Keycloak
+// client
+func NewCustomerImporter(db *Database) *CustomerImporter {
+	return &CustomerImporter{db: db, directory: &keycloak.KeycloakClient, abonements: NewAbonementService(db)}
+}
+
+// Import imports the customers of a CSV file with a header row. The
+// columns are email, firstname, lastname and optionally item (ID or name
+// of an abonement item), from and to (dates) of an abonement. Columns are
+// separated by commas or semicolons. Each row gets a Keycloak user and a
+// customer, existing ones are reused, so a file can be imported again.
+func (ci *CustomerImporter) Import(r io.Reader, options CustomerImportOptions) (CustomerImport, error) {
+	report := CustomerImport{DryRun: options.DryRun, Rows: []CustomerImportRow{}}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return report, err
+	}
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return report, fmt.Errorf("read header: %w", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+		if column, ok := customerImportColumns[name]; ok {
+			columns[column] = i
+		}
+	}
+	if _, ok := columns["email"]; !ok {
+		return report, ErrCustomerImportNoEmailColumn
+	}
+
+	items, err := ci.db.ListItemsWithDisabled(false, false)
+	if err != nil {
+		return report, err
+	}
+	seen := map[string]int{}
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			// A malformed row has no field positions, the reader continues
+			// with the next row
+			report.add(CustomerImportRow{Line: parseErr.StartLine, Result: CustomerImportInvalid, Errors: []string{err.Error()}})
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+		line, _ := reader.FieldPos(0)
+		row := CustomerImportRow{Line: line}
+		value := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(fields) {
+				return strings.TrimSpace(fields[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(fields, "")) == "" {
+			continue
+		}
+
+		record, errs := parseCustomerImportRecord(value, items)
+		row.Email = record.email
+		key := record.email
+		if record.item != nil {
+			key += fmt.Sprintf("|%d|%s|%s", record.item.ID, record.from.Format(time.DateOnly), record.to.Format(time.DateOnly))
+		}
+		if previous, ok := seen[key]; ok {
+			errs = append(errs, fmt.Sprintf("same as line %d", previous))
+		}
+		seen[key] = line
+		if len(errs) > 0 {
+			row.Result = CustomerImportInvalid
+			row.Errors = errs
+			report.add(row)
+			continue
+		}
+		report.add(ci.importRecord(row, record, options))
+	}
+	return report, nil
+}
+
+// add adds a row to the report and counts its result
+func (report *CustomerImport) add(row CustomerImportRow) {
+	switch row.Result {
+	case CustomerImportImported:
+		report.Imported++
+	case CustomerImportExists:
+		report.Existing++
+	case CustomerImportInvalid:
+		report.Invalid++
+	case CustomerImportFailed:
+		report.Failed++
+	}
+	report.Rows = append(report.Rows, row)
+}
+
+// parseCustomerImportRecord validates the values of a row
+func parseCustomerImportRecord(value func(column string) string, items []Item) (record customerImportRecord, errs []string) {
+	record.email = strings.ToLower(value("email"))
+	record.firstName = value("firstname")
+	record.lastName = value("lastname")
+	if address, err := mail.ParseAddress(record.email); err != nil || address.Address != record.email {
+		errs = append(errs, "invalid email")
+	}
+
+	itemValue, fromValue, toValue := value("item"), value("from"), value("to")
+	if itemValue == "" && fromValue == "" && toValue == "" {
+		return record, errs
+	}
+	id, idErr := strconv.Atoi(itemValue)
+	for i := range items {
+		if (idErr == nil && items[i].ID == id) || (idErr != nil && strings.EqualFold(items[i].Name, itemValue)) {
+			record.item = &items[i]
+			break
+		}
+	}
+	if record.item == nil {
+		errs = append(errs, fmt.Sprintf("unknown item %q", itemValue))
+	} else if record.item.Type != "abonement" {
+		errs = append(errs, fmt.Sprintf("item %q is no abonement", itemValue))
+	}
+	var fromErr, toErr error
+	record.from, fromErr = parseCustomerImportDate(fromValue)
+	if fromErr != nil {
+		errs = append(errs, "invalid from date: "+fromErr.Error())
+	}
+	record.to, toErr = parseCustomerImportDate(toValue)
+	if toErr != nil {
+		errs = append(errs, "invalid to date: "+toErr.Error())
+	}
+	if fromErr == nil && toErr == nil && !record.to.After(record.from) {
+		errs = append(errs, "to must be after from")
+	}
+	return record, errs
+}
+
+// parseCustomerImportDate parses a date in one of the accepted formats
+func parseCustomerImportDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, errors.New("missing")
+	}
+	for _, layout := range customerImportDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is no date like 2026-01-31 or 31.01.2026", s)
+}
+
+// importRecord creates the Keycloak user, customer and abonement of a
+// valid row
+func (ci *CustomerImporter) importRecord(row CustomerImportRow, record customerImportRecord, options CustomerImportOptions) CustomerImportRow {
+	existing, err := ci.db.GetCustomerByEmail(record.email)
+	row.NewCustomer = err != nil
+	if options.DryRun {
+		row.Result = CustomerImportValid
+		if existing != nil {
+			row.CustomerID = existing.ID
+		}
+		return row
+	}
+	fail := func(format string, args ...any) CustomerImportRow {
+		row.Result = CustomerImportFailed
+		row.Errors = append(row.Errors, fmt.Sprintf(format, args...))
+		log.Errorf("CustomerImporter: line %d: %s", row.Line, row.Errors[len(row.Errors)-1])
+		return row
+	}
+
+	keycloakID, newUser, err := ci.directory.GetOrCreateUser(record.email)
+	if err != nil {
+		return fail("create Keycloak user: %v", err)
+	}
+	if err = ci.directory.AssignGroup(keycloakID, ci.directory.GetCustomerGroup()); err != nil {
+		return fail("assign customer group: %v", err)
+	}
+	customer, err := ci.db.GetOrCreateCustomerByEmail(record.email, keycloakID)
+	if err != nil {
+		return fail("create customer: %v", err)
+	}
+	row.CustomerID = customer.ID
+	if customer.FirstName == "" && customer.LastName == "" && (record.firstName != "" || record.lastName != "") {
+		customer.FirstName, customer.LastName = record.firstName, record.lastName
+		if customer, err = ci.db.UpdateCustomer(customer); err != nil {
+			return fail("update customer: %v", err)
+		}
+	}
+
+	row.Result = CustomerImportExists
+	if row.NewCustomer {
+		row.Result = CustomerImportImported
+	}
+	if record.item != nil {
+		abonementID, created, err := ci.importAbonement(customer.ID, record)
+		if err != nil {
+			return fail("create abonement: %v", err)
+		}
+		row.AbonementID = abonementID
+		if created {
+			row.Result = CustomerImportImported
+			// Grants the entitlements and license groups of the abonement
+			if err = ci.abonements.ProcessAbonementForCustomer(customer.ID, time.Now()); err != nil {
+				return fail("assign license groups: %v", err)
+			}
+		}
+	}
+
+	if options.SendWelcome && newUser {
+		if err = sendCustomerWelcomeMail(*customer); err != nil {
+			row.Errors = append(row.Errors, "welcome mail: "+err.Error())
+		} else {
+			row.WelcomeSent = true
+		}
+	}
+	return row
+}
+
+// importAbonement creates the abonement of a row unless the customer has
+// the same one
+func (ci *CustomerImporter) importAbonement(customerID int, record customerImportRecord) (id int, created bool, err error) {
+	abonements, err := ci.db.ListAbonementsByCustomer(customerID)
+	if err != nil {
+		return 0, false, err
+	}
+	for _, a := range abonements {
+		if a.ItemID == record.item.ID && sameDay(a.FromDate, record.from) && sameDay(a.ToDate, record.to) {
+			return a.ID, false, nil
+		}
+	}
+	status := AbonementStatusActive
+	if !record.to.After(time.Now()) {
+		status = AbonementStatusExpired
+	}
+	abonement, err := ci.db.CreateAbonement(&Abonement{
+		CustomerID: customerID,
+		ItemID:     record.item.ID,
+		FromDate:   record.from,
+		ToDate:     record.to,
+		Status:     status,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	return abonement.ID, true, nil
+}
+
+// sameDay reports whether two times are on the same local date
+func sameDay(a, b time.Time) bool {
+	return a.In(time.Local).Format(time.DateOnly) == b.In(time.Local).Format(time.DateOnly)
+}
+
+// sendCustomerWelcomeMail sends the welcome mail that new Keycloak users
+// get after their first purchase
+func sendCustomerWelcomeMail(customer Customer) error {
+	templateData := map[string]interface{}{
+		"URL":   config.Config.OnlinePaperUrl,
+		"EMAIL": customer.Email,
+		"Name":  strings.TrimSpace(customer.FirstName + " " + customer.LastName),
+	}
+	mail, err := BuildEmailRequestFromTemplate("welcome", []string{customer.Email}, templateData)
+	if err != nil {
+		return err
+	}
+	if mail == nil {
+		return nil
+	}
+	success, err := mailer.Send(mail)
+	if err != nil {
+		return err
+	}
+	if !success {
+		return errors.New("mail was not sent")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestParseCustomerImportRecord(t *testing.T) {
+	items := []Item{{ID: 1, Name: "Jahresabo", Type: "abonement"}, {ID: 2, Name: "Zeitung", Type: "issue"}}
+	parse := func(values map[string]string) (customerImportRecord, []string) {
+		return parseCustomerImportRecord(func(column string) string { return values[column] }, items)
+	}
+
+	record, errs := parse(map[string]string{"email": "Anna@Example.com", "firstname": "Anna", "item": "jahresabo", "from": "01.02.2024", "to": "2025-01-31"})
+	require.Empty(t, errs)
+	require.Equal(t, "anna@example.com", record.email)
+	require.Equal(t, 1, record.item.ID)
+	require.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local), record.from)
+	require.Equal(t, time.Date(2025, 1, 31, 0, 0, 0, 0, time.Local), record.to)
+
+	// Customers without abonement
+	record, errs = parse(map[string]string{"email": "ben@example.com"})
+	require.Empty(t, errs)
+	require.Nil(t, record.item)
+
+	_, errs = parse(map[string]string{"email": "Ben <ben@example.com>", "item": "2", "from": "2025-01-01", "to": "2024-01-01"})
+	require.Equal(t, []string{"invalid email", `item "2" is no abonement`, "to must be after from"}, errs)
+	_, errs = parse(map[string]string{"email": "carla@example.com", "item": "9", "from": "1/2/2024"})
+	require.Len(t, errs, 3)
+	require.Equal(t, `unknown item "9"`, errs[0])
+}
+
+func TestImportCustomers(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	directory := newMockDirectory()
+	directory.addUser("kc-anna", "anna@example.com", "/customer")
+	assigner := &mockAssigner{}
+	importer := &CustomerImporter{db: &Db, directory: directory, abonements: newTestService(assigner)}
+	itemID, err := Db.CreateItem(Item{Name: "Jahresabo", Description: "Abonement", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("digital")})
+	require.NoError(t, err)
+	_, err = Db.CreateCustomer(&Customer{KeycloakID: "kc-anna", Email: "anna@example.com"})
+	require.NoError(t, err)
+
+	to := time.Now().AddDate(0, 6, 0).Format("02.01.2006")
+	csvFile := "E-Mail;Vorname;Nachname;Item;From;To\n" +
+		"anna@example.com;Anna;Adler;Jahresabo;01.01.2024;" + to + "\n" +
+		"ben@example.com;Ben;Berg;;;\n" +
+		"anna@example.com;Anna;Adler;Jahresabo;01.01.2024;" + to + "\n" +
+		"no-email;;;;;\n"
+
+	report, err := importer.Import(strings.NewReader(csvFile), CustomerImportOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Invalid)
+	require.Equal(t, CustomerImportValid, report.Rows[0].Result)
+	require.False(t, report.Rows[0].NewCustomer)
+	require.True(t, report.Rows[1].NewCustomer)
+	require.Equal(t, []string{"same as line 2"}, report.Rows[2].Errors)
+	require.Equal(t, 5, report.Rows[3].Line)
+	customers, err := Db.ListCustomers()
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+
+	report, err = importer.Import(strings.NewReader(csvFile), CustomerImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 2, report.Imported)
+	require.Equal(t, 2, report.Invalid)
+	anna, err := Db.GetCustomerByEmail("anna@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "Adler", anna.LastName)
+	require.Equal(t, []string{"digital"}, anna.LicenseGroups)
+	require.Len(t, assigner.syncs, 1)
+	require.Equal(t, "kc-anna", assigner.syncs[0].userID)
+	require.Equal(t, []string{"digital"}, assigner.syncs[0].newGroups)
+	ben, err := Db.GetCustomerByEmail("ben@example.com")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/customer"}, directory.groups[ben.KeycloakID])
+
+	// Importing a file again changes nothing
+	report, err = importer.Import(strings.NewReader(csvFile), CustomerImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 0, report.Imported)
+	require.Equal(t, 2, report.Existing)
+	abonements, err := Db.ListAbonementsByCustomer(anna.ID)
+	require.NoError(t, err)
+	require.Len(t, abonements, 1)
+	require.Equal(t, itemID, abonements[0].ItemID)
+	require.Equal(t, report.Rows[0].AbonementID, abonements[0].ID)
+}
+
+// TestImportCustomersMalformedRow reports rows the CSV reader can not parse
+// as invalid and imports the others
+func TestImportCustomersMalformedRow(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	importer := &CustomerImporter{db: &Db, directory: newMockDirectory(), abonements: newTestService(&mockAssigner{})}
+
+	csvFile := "E-Mail;Vorname;Nachname\n" +
+		"\"a\"b;c;d\n" +
+		"ben@example.com;Ben;Berg\n"
+	report, err := importer.Import(strings.NewReader(csvFile), CustomerImportOptions{DryRun: true})
+	require.NoError(t, err)
+	require.Len(t, report.Rows, 2)
+	require.Equal(t, 1, report.Invalid)
+	require.Equal(t, 2, report.Rows[0].Line)
+	require.Equal(t, CustomerImportInvalid, report.Rows[0].Result)
+	require.Equal(t, 3, report.Rows[1].Line)
+	require.Equal(t, CustomerImportValid, report.Rows[1].Result)
+}
@@ -0,0 +1,366 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entabonement "github.com/augustin-wien/augustina-backend/ent/abonement"
+	entcustomer "github.com/augustin-wien/augustina-backend/ent/customer"
+	ententitlement "github.com/augustin-wien/augustina-backend/ent/entitlement"
+	entgiftcode "github.com/augustin-wien/augustina-backend/ent/giftcode"
+	entinvoice "github.com/augustin-wien/augustina-backend/ent/invoice"
+	entorder "github.com/augustin-wien/augustina-backend/ent/order"
+	entpaymentmandate "github.com/augustin-wien/augustina-backend/ent/paymentmandate"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+)
+
+// ErrCustomerMergeSelf is returned when a customer is merged into itself
+var ErrCustomerMergeSelf = errors.New("a customer can not be merged into itself")
+
+// CustomerMergeDirectory is the part of Keycloak a merge changes.
+// Extracted as an interface so it can be replaced with a mock in tests.
+type CustomerMergeDirectory interface {
+	GetUserLicenseGroups(userID string) ([]string, error)
+	AssignGroup(userID string, groupName string) error
+	AssignDigitalLicenseGroup(userID string, licenseGroup string) error
+	DisableUser(userID string) error
+	GetCustomerGroup() string
+}
+
+// CustomerMerge is the report of a merge of a duplicate customer into
+// another customer
+type CustomerMerge struct {
+	// Customer is the customer after the merge
+	Customer Customer `json:"customer"`
+	// MergedID is the ID of the deleted duplicate
+	MergedID        int `json:"merged_id"`
+	Abonements      int `json:"abonements"`
+	Entitlements    int `json:"entitlements"`
+	PaymentMandates int `json:"payment_mandates"`
+	GiftCodes       int `json:"gift_codes"`
+	Invoices        int `json:"invoices"`
+	Orders          int `json:"orders"`
+	// DisabledKeycloakID is the Keycloak user of the duplicate, disabled
+	// after its groups were assigned to the user of the customer
+	DisabledKeycloakID string `json:"disabled_keycloak_id,omitempty"`
+	// KeycloakErrors are failed Keycloak updates. The database is merged
+	// anyway, a reconciliation repairs the groups later.
+	KeycloakErrors []string `json:"keycloak_errors,omitempty"`
+}
+
+// CustomerMerger merges duplicate customers
+type CustomerMerger struct {
+	db        *Database
+	directory CustomerMergeDirectory
+}
+
+// NewCustomerMerger creates a merger backed by the global Keycloak client
+func NewCustomerMerger(db *Database) *CustomerMerger {
+	return &CustomerMerger{db: db, directory: &keycloak.KeycloakClient}
+}
+
+// Merge moves the abonements, entitlements, payment mandates, gift codes,
+// invoices and orders of the duplicate customer sourceID to the customer
+// targetID, adds its license groups and deletes it. Empty names, email and
+// Keycloak ID of the target are taken from the duplicate. If both have a
+// Keycloak user, the user of the target gets the groups of the other one,
+// which is disabled.
+func (m *CustomerMerger) Merge(sourceID, targetID int) (CustomerMerge, error) {
+	report := CustomerMerge{MergedID: sourceID}
+	if sourceID == targetID {
+		return report, ErrCustomerMergeSelf
+	}
+	db := m.db
+	source, err := db.GetCustomerByID(sourceID)
+	if err != nil {
+		return report, err
+	}
+	target, err := db.GetCustomerByID(targetID)
+	if err != nil {
+		return report, err
+	}
+
+	merged := *target
+	if merged.FirstName == "" && merged.LastName == "" {
+		merged.FirstName, merged.LastName = source.FirstName, source.LastName
+	}
+	if merged.Email == "" {
+		merged.Email = source.Email
+	}
+	if merged.KeycloakID == "" {
+		merged.KeycloakID = source.KeycloakID
+	}
+	merged.LicenseGroups = slices.Clone(target.LicenseGroups)
+	for _, group := range source.LicenseGroups {
+		if group != "" && !slices.Contains(merged.LicenseGroups, group) {
+			merged.LicenseGroups = append(merged.LicenseGroups, group)
+		}
+	}
+
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		return report, err
+	}
+	defer tx.Rollback()
+	if err = mergeCustomerTx(ctx, tx, *source, merged, &report); err != nil {
+		log.Error("CustomerMerger.Merge: ", err)
+		return report, err
+	}
+	txDb := db.withTx(tx)
+	updated, err := txDb.GetCustomerByID(targetID)
+	if err != nil {
+		return report, err
+	}
+	report.Customer = *updated
+	err = txDb.audit(AuditCustomerMerge, "customer", targetID,
+		map[string]any{"merged": source, "customer": target},
+		map[string]any{"customer": updated})
+	if err != nil {
+		return report, err
+	}
+	if err = tx.Commit(); err != nil {
+		return report, err
+	}
+
+	m.mergeKeycloakUsers(*source, *target, merged, &report)
+	return report, nil
+}
+
+// mergeCustomerTx moves everything of source to merged, deletes source and
+// updates merged
+func mergeCustomerTx(ctx context.Context, tx *ent.Tx, source, merged Customer, report *CustomerMerge) (err error) {
+	if report.Abonements, err = tx.Abonement.Update().
+		Where(entabonement.CustomerID(source.ID)).
+		SetCustomerID(merged.ID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("move abonements: %w", err)
+	}
+	if report.Entitlements, err = tx.Entitlement.Update().
+		Where(ententitlement.CustomerID(source.ID)).
+		SetCustomerID(merged.ID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("move entitlements: %w", err)
+	}
+	if report.PaymentMandates, err = tx.PaymentMandate.Update().
+		Where(entpaymentmandate.CustomerID(source.ID)).
+		SetCustomerID(merged.ID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("move payment mandates: %w", err)
+	}
+	if report.GiftCodes, err = tx.GiftCode.Update().
+		Where(entgiftcode.CustomerID(source.ID)).
+		SetCustomerID(merged.ID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("move gift codes: %w", err)
+	}
+	if report.Invoices, err = tx.Invoice.Update().
+		Where(entinvoice.CustomerID(source.ID)).
+		SetCustomerID(merged.ID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("move invoices: %w", err)
+	}
+
+	// Orders belong to customers by email and Keycloak user
+	if source.Email != "" && !strings.EqualFold(source.Email, merged.Email) {
+		n, err := tx.Order.Update().
+			Where(entorder.CustomerEmailEqualFold(source.Email)).
+			SetCustomerEmail(merged.Email).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("move orders: %w", err)
+		}
+		report.Orders += n
+	}
+	if source.KeycloakID != "" && source.KeycloakID != merged.KeycloakID {
+		n, err := tx.Order.Update().
+			Where(entorder.UserID(source.KeycloakID)).
+			SetUserID(merged.KeycloakID).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("move orders of Keycloak user: %w", err)
+		}
+		report.Orders += n
+	}
+
+	// Delete the duplicate first, its email and Keycloak ID are unique
+	if err = tx.Customer.DeleteOneID(source.ID).Exec(ctx); err != nil {
+		return fmt.Errorf("delete duplicate: %w", err)
+	}
+	err = tx.Customer.UpdateOneID(merged.ID).
+		SetFirstname(merged.FirstName).
+		SetLastname(merged.LastName).
+		SetEmail(merged.Email).
+		SetKeycloakid(merged.KeycloakID).
+		SetLicensegroups(licenseGroupsToString(merged.LicenseGroups)).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update customer: %w", err)
+	}
+	return nil
+}
+
+// mergeKeycloakUsers gives the Keycloak user of the merged customer the
+// groups of the duplicate and disables the user of the duplicate.
+// Failures are recorded in the report.
+func (m *CustomerMerger) mergeKeycloakUsers(source, target, merged Customer, report *CustomerMerge) {
+	if merged.KeycloakID == "" {
+		return
+	}
+	bothUsers := target.KeycloakID != "" && source.KeycloakID != ""
+	fail := func(format string, args ...any) {
+		msg := fmt.Sprintf(format, args...)
+		log.Error("CustomerMerger.mergeKeycloakUsers: ", msg)
+		report.KeycloakErrors = append(report.KeycloakErrors, msg)
+	}
+
+	// Groups the user of the merged customer is missing. A user taken over
+	// from the duplicate is missing the groups of the target.
+	groups := slices.Clone(target.LicenseGroups)
+	if target.KeycloakID != "" {
+		// The user of the target has its groups, it is missing the ones of
+		// the duplicate, also those it only has in Keycloak
+		groups = slices.Clone(merged.LicenseGroups)
+		if bothUsers {
+			sourceGroups, err := m.directory.GetUserLicenseGroups(source.KeycloakID)
+			if err != nil {
+				fail("license groups of %s: %v", source.KeycloakID, err)
+			}
+			for _, group := range sourceGroups {
+				if !slices.Contains(groups, group) {
+					groups = append(groups, group)
+				}
+			}
+			if err := m.directory.AssignGroup(merged.KeycloakID, m.directory.GetCustomerGroup()); err != nil {
+				fail("assign customer group to %s: %v", merged.KeycloakID, err)
+			}
+		}
+		groups = slices.DeleteFunc(groups, func(g string) bool { return slices.Contains(target.LicenseGroups, g) })
+	}
+	for _, group := range groups {
+		if err := m.directory.AssignDigitalLicenseGroup(merged.KeycloakID, group); err != nil {
+			fail("assign license group %s to %s: %v", group, merged.KeycloakID, err)
+		}
+	}
+
+	if bothUsers {
+		if err := m.directory.DisableUser(source.KeycloakID); err != nil {
+			fail("disable %s: %v", source.KeycloakID, err)
+			return
+		}
+		report.DisabledKeycloakID = source.KeycloakID
+	}
+}
+
+// DuplicateCustomers are customers that might be the same person
+type DuplicateCustomers struct {
+	// Reason is email for customers with the same normalized email and name
+	// for customers with the same normalized name
+	Reason    string     `json:"reason"`
+	Key       string     `json:"key"`
+	Customers []Customer `json:"customers"`
+}
+
+// Reasons of duplicate candidates
+const (
+	DuplicateByEmail = "email"
+	DuplicateByName  = "name"
+)
+
+// NormalizeEmail returns an email address in a form that is the same for
+// addresses delivered to the same mailbox: lower case without +tags and,
+// for Gmail, without dots in the local part
+func NormalizeEmail(email string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return email
+	}
+	local, _, _ = strings.Cut(local, "+")
+	if domain == "gmail.com" || domain == "googlemail.com" {
+		local = strings.ReplaceAll(local, ".", "")
+		domain = "gmail.com"
+	}
+	return local + "@" + domain
+}
+
+// nameReplacer spells out umlauts and removes accents, so both spellings
+// of a name are the same
+var nameReplacer = strings.NewReplacer(
+	"ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss",
+	"á", "a", "à", "a", "â", "a", "é", "e", "è", "e", "ê", "e",
+	"í", "i", "ì", "i", "ó", "o", "ò", "o", "ô", "o", "ú", "u", "ù", "u",
+	"ç", "c", "č", "c", "ć", "c", "š", "s", "ž", "z", "ñ", "n",
+	"-", " ", ".", " ",
+)
+
+// normalizeName returns the words of a full name in lower case and sorted,
+// so swapped first and last names match. It is empty unless both names
+// are set.
+func normalizeName(firstName, lastName string) string {
+	if strings.TrimSpace(firstName) == "" || strings.TrimSpace(lastName) == "" {
+		return ""
+	}
+	words := strings.Fields(nameReplacer.Replace(strings.ToLower(firstName + " " + lastName)))
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+// duplicateCustomerCandidates groups customers with the same normalized
+// email or name, sorted by reason and key
+func duplicateCustomerCandidates(customers []Customer) []DuplicateCustomers {
+	byEmail := map[string][]Customer{}
+	byName := map[string][]Customer{}
+	for _, c := range customers {
+		if c.Email != "" {
+			key := NormalizeEmail(c.Email)
+			byEmail[key] = append(byEmail[key], c)
+		}
+		if key := normalizeName(c.FirstName, c.LastName); key != "" {
+			byName[key] = append(byName[key], c)
+		}
+	}
+	candidates := []DuplicateCustomers{}
+	for _, group := range []struct {
+		reason string
+		keys   map[string][]Customer
+	}{{DuplicateByEmail, byEmail}, {DuplicateByName, byName}} {
+		keys := make([]string, 0, len(group.keys))
+		for key, matches := range group.keys {
+			if len(matches) > 1 {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			matches := group.keys[key]
+			sort.Slice(matches, func(i, j int) bool { return matches[i].ID < matches[j].ID })
+			candidates = append(candidates, DuplicateCustomers{Reason: group.reason, Key: key, Customers: matches})
+		}
+	}
+	return candidates
+}
+
+// ListDuplicateCustomerCandidates returns customers that might be
+// duplicates because their normalized emails or names are the same
+func (db *Database) ListDuplicateCustomerCandidates() ([]DuplicateCustomers, error) {
+	res, err := db.EntClient.Customer.Query().
+		Order(ent.Asc(entcustomer.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListDuplicateCustomerCandidates: ", err)
+		return nil, err
+	}
+	customers := make([]Customer, 0, len(res))
+	for _, c := range res {
+		customers = append(customers, db.CustomerEntIntoCustomer(c))
+	}
+	return duplicateCustomerCandidates(customers), nil
+}
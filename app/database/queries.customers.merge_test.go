@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+// mergeDirectory records the Keycloak users disabled by a merge
+type mergeDirectory struct {
+	*mockDirectory
+	disabled []string
+}
+
+func (m *mergeDirectory) DisableUser(userID string) error {
+	m.disabled = append(m.disabled, userID)
+	return nil
+}
+
+func TestDuplicateCustomerCandidates(t *testing.T) {
+	require.Equal(t, "anna.adler@example.com", NormalizeEmail(" Anna.Adler+shop@Example.com"))
+	require.Equal(t, "annaadler@gmail.com", NormalizeEmail("anna.adler+news@googlemail.com"))
+	require.Equal(t, "adler anna", normalizeName("Anna", " Adler"))
+	require.Equal(t, "anna mueller", normalizeName("Müller", "Anna"))
+	require.Empty(t, normalizeName("Anna", ""))
+
+	candidates := duplicateCustomerCandidates([]Customer{
+		{ID: 1, Email: "anna.adler@gmail.com", FirstName: "Anna", LastName: "Müller"},
+		{ID: 2, Email: "annaadler+shop@gmail.com"},
+		{ID: 3, Email: "ben@example.com", FirstName: "anna", LastName: "mueller"},
+		{ID: 4, Email: "carla@example.com", FirstName: "Carla", LastName: "Berg"},
+	})
+	require.Len(t, candidates, 2)
+	require.Equal(t, DuplicateByEmail, candidates[0].Reason)
+	require.Equal(t, "annaadler@gmail.com", candidates[0].Key)
+	require.Equal(t, []int{1, 2}, []int{candidates[0].Customers[0].ID, candidates[0].Customers[1].ID})
+	require.Equal(t, DuplicateByName, candidates[1].Reason)
+	require.Equal(t, []int{1, 3}, []int{candidates[1].Customers[0].ID, candidates[1].Customers[1].ID})
+}
+
+func TestCustomerMerge(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	ctx := context.Background()
+	directory := &mergeDirectory{mockDirectory: newMockDirectory()}
+	directory.addUser("kc-anna", "anna@example.com", "/customer", directory.LicenseGroupPath("digital"))
+	directory.addUser("kc-typo", "anna@exmaple.com", "/customer", directory.LicenseGroupPath("print"), directory.LicenseGroupPath("archive"))
+	merger := &CustomerMerger{db: &Db, directory: directory}
+
+	target, err := Db.CreateCustomer(&Customer{KeycloakID: "kc-anna", Email: "anna@example.com", LicenseGroups: []string{"digital"}})
+	require.NoError(t, err)
+	source, err := Db.CreateCustomer(&Customer{KeycloakID: "kc-typo", Email: "anna@exmaple.com", FirstName: "Anna", LastName: "Adler", LicenseGroups: []string{"print"}})
+	require.NoError(t, err)
+
+	itemID, err := Db.CreateItem(Item{Name: "Merge abonement", Description: "Abonement", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("print")})
+	require.NoError(t, err)
+	_, err = Db.CreateAbonement(&Abonement{CustomerID: source.ID, ItemID: itemID, FromDate: time.Now(), ToDate: time.Now().AddDate(1, 0, 0), Status: AbonementStatusActive})
+	require.NoError(t, err)
+	_, err = Db.GrantEntitlement(Entitlement{CustomerID: source.ID, LicenseGroup: "print", Source: EntitlementSourceManual})
+	require.NoError(t, err)
+	byEmail, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("merge-1"), CustomerEmail: null.StringFrom("Anna@Exmaple.com")})
+	require.NoError(t, err)
+	byUser, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("merge-2"), User: null.StringFrom("kc-typo")})
+	require.NoError(t, err)
+
+	_, err = merger.Merge(target.ID, target.ID)
+	require.ErrorIs(t, err, ErrCustomerMergeSelf)
+
+	report, err := merger.Merge(source.ID, target.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Abonements)
+	require.Equal(t, 1, report.Entitlements)
+	require.Equal(t, 2, report.Orders)
+	require.Empty(t, report.KeycloakErrors)
+	require.Equal(t, "Anna", report.Customer.FirstName)
+	require.Equal(t, "anna@example.com", report.Customer.Email)
+	require.ElementsMatch(t, []string{"digital", "print"}, report.Customer.LicenseGroups)
+
+	_, err = Db.GetCustomerByID(source.ID)
+	require.Error(t, err)
+	abonements, err := Db.EntClient.Abonement.Query().All(ctx)
+	require.NoError(t, err)
+	require.Equal(t, target.ID, abonements[0].CustomerID)
+	entitlements, err := Db.ListEntitlementsByCustomer(target.ID)
+	require.NoError(t, err)
+	require.Len(t, entitlements, 1)
+	o, err := Db.GetOrderByID(byEmail)
+	require.NoError(t, err)
+	require.Equal(t, "anna@example.com", o.CustomerEmail.String)
+	o, err = Db.GetOrderByID(byUser)
+	require.NoError(t, err)
+	require.Equal(t, "kc-anna", o.User.String)
+
+	// The user of the customer gets the groups of the duplicate's user
+	groups, err := directory.GetUserLicenseGroups("kc-anna")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"digital", "print", "archive"}, groups)
+	require.Equal(t, []string{"kc-typo"}, directory.disabled)
+	require.Equal(t, "kc-typo", report.DisabledKeycloakID)
+}
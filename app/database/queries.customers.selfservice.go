@@ -0,0 +1,276 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entcustomer "github.com/augustin-wien/augustina-backend/ent/customer"
+	entorder "github.com/augustin-wien/augustina-backend/ent/order"
+	entpdfdownload "github.com/augustin-wien/augustina-backend/ent/pdfdownload"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"github.com/augustin-wien/augustina-backend/notifications"
+)
+
+// ErrCustomerEmailTaken is returned if a customer changes their email to
+// the one of another customer
+var ErrCustomerEmailTaken = errors.New("email is used by another customer")
+
+// ErrCustomerDeletionNotRequested is returned when a deletion request is
+// withdrawn that does not exist
+var ErrCustomerDeletionNotRequested = errors.New("account deletion was not requested")
+
+// CustomerPreferences are the notification settings a customer manages
+// themselves
+type CustomerPreferences struct {
+	Newsletter bool `json:"newsletter"`
+	// NotifyNewIssues sends an email when an online issue of an abonement
+	// is published
+	NotifyNewIssues bool `json:"notify_new_issues"`
+	// NotifyAbonementReminders sends an email before an abonement ends
+	NotifyAbonementReminders bool `json:"notify_abonement_reminders"`
+}
+
+// notifyDeletionRequest tells the admins about a deletion request. Tests
+// can override it.
+var notifyDeletionRequest = func(subject, message string) {
+	if notifications.NotificationsClient.Client == nil {
+		return
+	}
+	go notifications.NotificationsClient.SendNotification(subject, message)
+}
+
+// UpdateCustomerProfile changes the name of a customer. A changed email
+// becomes the pending email, which ConfirmCustomerEmail applies once the
+// customer verified it. Until then orders are matched by the old email.
+func (db *Database) UpdateCustomerProfile(id int, firstName, lastName, email string) (*Customer, error) {
+	ctx := context.Background()
+	email = strings.ToLower(strings.TrimSpace(email))
+	customer, err := db.GetCustomerByID(id)
+	if err != nil {
+		return nil, err
+	}
+	pending := ""
+	if email != customer.Email {
+		taken, err := db.EntClient.Customer.Query().
+			Where(entcustomer.EmailEqualFold(email), entcustomer.IDNEQ(id)).
+			Exist(ctx)
+		if err != nil {
+			log.Error("UpdateCustomerProfile: ", err)
+			return nil, err
+		}
+		if taken {
+			return nil, ErrCustomerEmailTaken
+		}
+		pending = email
+	}
+	entCustomer, err := db.EntClient.Customer.UpdateOneID(id).
+		SetFirstname(strings.TrimSpace(firstName)).
+		SetLastname(strings.TrimSpace(lastName)).
+		SetPendingEmail(pending).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error("UpdateCustomerProfile: ", err)
+		return nil, err
+	}
+	result := db.CustomerEntIntoCustomer(entCustomer)
+	return &result, nil
+}
+
+// ConfirmCustomerEmail makes the pending email of a customer their email
+// if it is the verified email of their Keycloak user
+func (db *Database) ConfirmCustomerEmail(id int, verifiedEmail string) (*Customer, error) {
+	customer, err := db.GetCustomerByID(id)
+	if err != nil {
+		return nil, err
+	}
+	verifiedEmail = strings.ToLower(strings.TrimSpace(verifiedEmail))
+	if customer.PendingEmail == "" || customer.PendingEmail != verifiedEmail {
+		return customer, nil
+	}
+	ctx := context.Background()
+	taken, err := db.EntClient.Customer.Query().
+		Where(entcustomer.EmailEqualFold(verifiedEmail), entcustomer.IDNEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		log.Error("ConfirmCustomerEmail: ", err)
+		return nil, err
+	}
+	if taken {
+		return nil, ErrCustomerEmailTaken
+	}
+	entCustomer, err := db.EntClient.Customer.UpdateOneID(id).
+		SetEmail(verifiedEmail).
+		SetPendingEmail("").
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrCustomerEmailTaken
+		}
+		log.Error("ConfirmCustomerEmail: ", err)
+		return nil, err
+	}
+	result := db.CustomerEntIntoCustomer(entCustomer)
+	return &result, nil
+}
+
+// UpdateCustomerPreferences stores the preferences of a customer
+func (db *Database) UpdateCustomerPreferences(id int, preferences CustomerPreferences) (*Customer, error) {
+	entCustomer, err := db.EntClient.Customer.UpdateOneID(id).
+		SetNewsletter(preferences.Newsletter).
+		SetNotifyNewIssues(preferences.NotifyNewIssues).
+		SetNotifyAbonementReminders(preferences.NotifyAbonementReminders).
+		SetUpdatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		log.Error("UpdateCustomerPreferences: ", err)
+		return nil, err
+	}
+	result := db.CustomerEntIntoCustomer(entCustomer)
+	return &result, nil
+}
+
+// RequestCustomerDeletion records that a customer asked for their account
+// to be deleted and notifies the admins, who delete it. Asking again keeps
+// the time of the first request.
+func (db *Database) RequestCustomerDeletion(id int, reason string, now time.Time) (*Customer, error) {
+	customer, err := db.GetCustomerByID(id)
+	if err != nil {
+		return nil, err
+	}
+	update := db.EntClient.Customer.UpdateOneID(id).
+		SetDeletionReason(strings.TrimSpace(reason)).
+		SetUpdatedAt(now)
+	if customer.DeletionRequestedAt == nil {
+		update = update.SetDeletionRequestedAt(now)
+	}
+	entCustomer, err := update.Save(context.Background())
+	if err != nil {
+		log.Error("RequestCustomerDeletion: ", err)
+		return nil, err
+	}
+	if customer.DeletionRequestedAt == nil {
+		notifyDeletionRequest("Account deletion requested",
+			"Customer "+customer.Email+" asked for their account to be deleted.")
+	}
+	result := db.CustomerEntIntoCustomer(entCustomer)
+	return &result, nil
+}
+
+// WithdrawCustomerDeletion removes the deletion request of a customer
+func (db *Database) WithdrawCustomerDeletion(id int) (*Customer, error) {
+	customer, err := db.GetCustomerByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if customer.DeletionRequestedAt == nil {
+		return nil, ErrCustomerDeletionNotRequested
+	}
+	entCustomer, err := db.EntClient.Customer.UpdateOneID(id).
+		ClearDeletionRequestedAt().
+		SetDeletionReason("").
+		SetUpdatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		log.Error("WithdrawCustomerDeletion: ", err)
+		return nil, err
+	}
+	result := db.CustomerEntIntoCustomer(entCustomer)
+	return &result, nil
+}
+
+// ListCustomerDeletionRequests returns the customers that asked for their
+// account to be deleted, the oldest request first
+func (db *Database) ListCustomerDeletionRequests() ([]*Customer, error) {
+	customers, err := db.EntClient.Customer.Query().
+		Where(entcustomer.DeletionRequestedAtNotNil()).
+		Order(ent.Asc(entcustomer.FieldDeletionRequestedAt)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListCustomerDeletionRequests: ", err)
+		return nil, err
+	}
+	result := make([]*Customer, len(customers))
+	for i, c := range customers {
+		customer := db.CustomerEntIntoCustomer(c)
+		result[i] = &customer
+	}
+	return result, nil
+}
+
+// customerOrderPredicate selects the orders of a customer, by their
+// verified email or by their Keycloak user for orders placed while logged
+// in. A pending email is never matched.
+func customerOrderPredicate(customer Customer) predicate.Order {
+	predicates := []predicate.Order{entorder.CustomerEmailEqualFold(customer.Email)}
+	if customer.KeycloakID != "" {
+		predicates = append(predicates, entorder.UserID(customer.KeycloakID))
+	}
+	return entorder.Or(predicates...)
+}
+
+// ListCustomerOrders returns the verified orders of a customer with their
+// entries, the newest first
+func (db *Database) ListCustomerOrders(customer Customer) ([]Order, error) {
+	orders, err := db.EntClient.Order.Query().
+		Where(entorder.Verified(true), customerOrderPredicate(customer)).
+		WithEntries(func(q *ent.OrderEntryQuery) {
+			q.WithSender().WithReceiver()
+		}).
+		Order(ent.Desc(entorder.FieldTimestamp), ent.Desc(entorder.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListCustomerOrders: ", err)
+		return nil, err
+	}
+	result := make([]Order, 0, len(orders))
+	for _, o := range orders {
+		result = append(result, convertOrder(o))
+	}
+	return result, nil
+}
+
+// GetCustomerOrder returns a verified order of a customer. Orders of other
+// customers are not found.
+func (db *Database) GetCustomerOrder(customer Customer, orderID int) (Order, error) {
+	o, err := db.EntClient.Order.Query().
+		Where(entorder.ID(orderID), entorder.Verified(true), customerOrderPredicate(customer)).
+		WithEntries(func(q *ent.OrderEntryQuery) {
+			q.WithSender().WithReceiver()
+		}).
+		Only(context.Background())
+	if err != nil {
+		return Order{}, err
+	}
+	return convertOrder(o), nil
+}
+
+// ListCustomerPDFDownloads returns the download links of the verified
+// orders of a customer, the newest first
+func (db *Database) ListCustomerPDFDownloads(customer Customer) ([]PDFDownload, error) {
+	ctx := context.Background()
+	orderIDs, err := db.EntClient.Order.Query().
+		Where(entorder.Verified(true), customerOrderPredicate(customer)).
+		IDs(ctx)
+	if err != nil {
+		log.Error("ListCustomerPDFDownloads: ", err)
+		return nil, err
+	}
+	downloads, err := db.EntClient.PDFDownload.Query().
+		Where(entpdfdownload.OrderIDIn(orderIDs...)).
+		Order(ent.Desc(entpdfdownload.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error("ListCustomerPDFDownloads: ", err)
+		return nil, err
+	}
+	result := make([]PDFDownload, 0, len(downloads))
+	for _, d := range downloads {
+		result = append(result, db.PDFDownloadEntIntoPDFDownload(d))
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestCustomerSelfService(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	var notified []string
+	notifyDeletionRequest = func(subject, message string) { notified = append(notified, message) }
+
+	anna, err := Db.CreateCustomer(&Customer{KeycloakID: "kc-anna", Email: "anna@example.com"})
+	require.NoError(t, err)
+	_, err = Db.CreateCustomer(&Customer{Email: "ben@example.com"})
+	require.NoError(t, err)
+	require.True(t, anna.Preferences.NotifyNewIssues)
+	require.True(t, anna.Preferences.NotifyAbonementReminders)
+	require.False(t, anna.Preferences.Newsletter)
+
+	// Profile
+	_, err = Db.UpdateCustomerProfile(anna.ID, "Anna", "A", "Ben@example.com")
+	require.ErrorIs(t, err, ErrCustomerEmailTaken)
+	updated, err := Db.UpdateCustomerProfile(anna.ID, " Anna ", "Adler", "Anna.Adler@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "Anna", updated.FirstName)
+	require.Equal(t, "anna@example.com", updated.Email)
+	require.Equal(t, "anna.adler@example.com", updated.PendingEmail)
+
+	// Orders of the pending email are not the customer's until it is verified
+	ctx := context.Background()
+	byEmail, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("self-1"), CustomerEmail: null.StringFrom("ANNA.ADLER@example.com")})
+	require.NoError(t, err)
+	require.NoError(t, Db.EntClient.Order.UpdateOneID(byEmail).SetVerified(true).Exec(ctx))
+	orders, err := Db.ListCustomerOrders(*updated)
+	require.NoError(t, err)
+	require.Empty(t, orders)
+	updated, err = Db.ConfirmCustomerEmail(anna.ID, "someone@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "anna@example.com", updated.Email)
+	updated, err = Db.ConfirmCustomerEmail(anna.ID, "anna.adler@example.com")
+	require.NoError(t, err)
+	require.Equal(t, "anna.adler@example.com", updated.Email)
+	require.Empty(t, updated.PendingEmail)
+
+	// Preferences
+	updated, err = Db.UpdateCustomerPreferences(anna.ID, CustomerPreferences{Newsletter: true})
+	require.NoError(t, err)
+	require.Equal(t, CustomerPreferences{Newsletter: true}, updated.Preferences)
+
+	// Deletion requests
+	_, err = Db.WithdrawCustomerDeletion(anna.ID)
+	require.ErrorIs(t, err, ErrCustomerDeletionNotRequested)
+	requestedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	updated, err = Db.RequestCustomerDeletion(anna.ID, "moving away", requestedAt)
+	require.NoError(t, err)
+	require.Equal(t, "moving away", updated.DeletionReason)
+	updated, err = Db.RequestCustomerDeletion(anna.ID, "", time.Now())
+	require.NoError(t, err)
+	require.True(t, requestedAt.Equal(*updated.DeletionRequestedAt))
+	require.Len(t, notified, 1)
+	requests, err := Db.ListCustomerDeletionRequests()
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, anna.ID, requests[0].ID)
+	_, err = Db.WithdrawCustomerDeletion(anna.ID)
+	require.NoError(t, err)
+	requests, err = Db.ListCustomerDeletionRequests()
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	// Orders by email or Keycloak user, only verified ones
+	byUser, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("self-2"), User: null.StringFrom("kc-anna")})
+	require.NoError(t, err)
+	unverified, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("self-3"), CustomerEmail: null.StringFrom("anna.adler@example.com")})
+	require.NoError(t, err)
+	other, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("self-4"), CustomerEmail: null.StringFrom("ben@example.com")})
+	require.NoError(t, err)
+	for _, id := range []int{byUser, other} {
+		require.NoError(t, Db.EntClient.Order.UpdateOneID(id).SetVerified(true).Exec(ctx))
+	}
+
+	orders, err = Db.ListCustomerOrders(*updated)
+	require.NoError(t, err)
+	ids := []int{}
+	for _, o := range orders {
+		ids = append(ids, o.ID)
+	}
+	require.ElementsMatch(t, []int{byEmail, byUser}, ids)
+	_, err = Db.GetCustomerOrder(*updated, unverified)
+	require.Error(t, err)
+	_, err = Db.GetCustomerOrder(*updated, other)
+	require.Error(t, err)
+	order, err := Db.GetCustomerOrder(*updated, byUser)
+	require.NoError(t, err)
+	require.Equal(t, "self-2", order.OrderCode.String)
+}
@@ -0,0 +1,604 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/ent"
+	entdiscountcode "github.com/augustin-wien/augustina-backend/ent/discountcode"
+	entdiscountredemption "github.com/augustin-wien/augustina-backend/ent/discountredemption"
+	entorder "github.com/augustin-wien/augustina-backend/ent/order"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Kinds of discount codes
+const (
+	// Value is a percentage of the price of the discounted items
+	DiscountKindPercentage = "percentage"
+	// Value is an amount in cents
+	DiscountKindFixed = "fixed"
+)
+
+var (
+	// ErrDiscountCodeNotFound is returned if no discount code matches
+	ErrDiscountCodeNotFound = errors.New("discount code not found")
+	// ErrDiscountCodeInvalid is returned if a discount code is disabled or
+	// outside of its validity window
+	ErrDiscountCodeInvalid = errors.New("discount code is not valid")
+	// ErrDiscountCodeExhausted is returned if a discount code reached its
+	// maximum number of uses
+	ErrDiscountCodeExhausted = errors.New("discount code has been used up")
+	// ErrDiscountNotApplicable is returned if a discount code does not
+	// discount any item of an order
+	ErrDiscountNotApplicable = errors.New("discount code does not apply to this order")
+)
+
+// DiscountCode reduces the price of an order by a percentage or a fixed
+// amount. If ItemID or LicenseGroup is set only those items are discounted.
+// A MaxUses of 0 allows unlimited uses.
+type DiscountCode struct {
+	ID           int       `json:"id"`
+	Code         string    `json:"code"`
+	Description  string    `json:"description"`
+	Kind         string    `json:"kind"`
+	Value        int       `json:"value"`
+	ItemID       null.Int  `json:"item_id" swaggertype:"integer"`
+	LicenseGroup string    `json:"license_group"`
+	ValidFrom    null.Time `json:"valid_from" swaggertype:"string" format:"date-time"`
+	ValidTo      null.Time `json:"valid_to" swaggertype:"string" format:"date-time"`
+	MaxUses      int       `json:"max_uses"`
+	Uses         int       `json:"uses"`
+	Disabled     bool      `json:"disabled"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// DiscountCodeEntIntoDiscountCode converts an ent.DiscountCode to
+// DiscountCode struct
+func DiscountCodeEntIntoDiscountCode(d *ent.DiscountCode) DiscountCode {
+	return DiscountCode{
+		ID:           d.ID,
+		Code:         d.Code,
+		Description:  d.Description,
+		Kind:         d.Kind,
+		Value:        d.Value,
+		ItemID:       null.IntFromPtr(intPtrToInt64Ptr(d.ItemID)),
+		LicenseGroup: d.LicenseGroup,
+		ValidFrom:    null.TimeFromPtr(d.ValidFrom),
+		ValidTo:      null.TimeFromPtr(d.ValidTo),
+		MaxUses:      d.MaxUses,
+		Uses:         d.Uses,
+		Disabled:     d.Disabled,
+		CreatedAt:    d.CreatedAt,
+	}
+}
+
+// NormalizeDiscountCode uppercases a code typed by a customer and removes
+// surrounding whitespace
+func NormalizeDiscountCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Validate checks the code, kind and value of a discount code
+func (d DiscountCode) Validate() error {
+	if NormalizeDiscountCode(d.Code) == "" {
+		return errors.New("code is required")
+	}
+	switch d.Kind {
+	case DiscountKindPercentage:
+		if d.Value <= 0 || d.Value > 100 {
+			return errors.New("percentage must be between 1 and 100")
+		}
+	case DiscountKindFixed:
+		if d.Value <= 0 {
+			return errors.New("fixed discount must be positive")
+		}
+	default:
+		return errors.New("kind must be percentage or fixed")
+	}
+	if d.MaxUses < 0 {
+		return errors.New("max_uses must not be negative")
+	}
+	if d.ValidFrom.Valid && d.ValidTo.Valid && !d.ValidTo.Time.After(d.ValidFrom.Time) {
+		return errors.New("valid_to must be after valid_from")
+	}
+	return nil
+}
+
+// ValidAt reports whether the discount code can be used at t, ignoring its
+// number of uses
+func (d DiscountCode) ValidAt(t time.Time) bool {
+	if d.Disabled {
+		return false
+	}
+	if d.ValidFrom.Valid && d.ValidFrom.Time.After(t) {
+		return false
+	}
+	return !d.ValidTo.Valid || d.ValidTo.Time.After(t)
+}
+
+// Applies reports whether the discount code discounts item. Donations and
+// other special items are never discounted.
+func (d DiscountCode) Applies(item Item) bool {
+	switch item.Type {
+	case "donation", "transaction_costs", "discount":
+		return false
+	}
+	if d.ItemID.Valid && int(d.ItemID.Int64) != item.ID {
+		return false
+	}
+	return d.LicenseGroup == "" || (item.LicenseGroup.Valid && item.LicenseGroup.String == d.LicenseGroup)
+}
+
+// Discount returns the discount in cents for the sale entries of an order.
+// items maps the item IDs of the entries to their items. The discount never
+// exceeds the price of the discounted entries.
+func (d DiscountCode) Discount(entries []OrderEntry, items map[int]Item) int {
+	base := 0
+	for _, entry := range d.discounted(entries, items) {
+		base += entry.Price * entry.Quantity
+	}
+	if base <= 0 {
+		return 0
+	}
+	if d.Kind == DiscountKindPercentage {
+		return int(math.Round(float64(base) * float64(d.Value) / 100))
+	}
+	return min(d.Value, base)
+}
+
+// discounted returns the sale entries of an order the discount code applies
+// to
+func (d DiscountCode) discounted(entries []OrderEntry, items map[int]Item) []OrderEntry {
+	result := []OrderEntry{}
+	for _, entry := range entries {
+		item, ok := items[entry.Item]
+		if entry.IsSale && ok && d.Applies(item) {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// DiscountShare is the part of a discount on the entries of one VAT rate
+type DiscountShare struct {
+	VATRate float64
+	Amount  int
+}
+
+// DiscountShares splits a discount of amount cents over the VAT rates of
+// the entries it discounts in proportion to their prices, so it reduces
+// the VAT of each rate. The shares add up to amount.
+func (d DiscountCode) DiscountShares(amount int, entries []OrderEntry, items map[int]Item, defaultRate float64) []DiscountShare {
+	bases := map[float64]int{}
+	total := 0
+	for _, entry := range d.discounted(entries, items) {
+		rate := itemVATRate(items[entry.Item], defaultRate)
+		bases[rate] += entry.Price * entry.Quantity
+		total += entry.Price * entry.Quantity
+	}
+	if total <= 0 {
+		return nil
+	}
+	rates := make([]float64, 0, len(bases))
+	for rate := range bases {
+		rates = append(rates, rate)
+	}
+	sort.Float64s(rates)
+	shares := make([]DiscountShare, 0, len(rates))
+	remaining := amount
+	for i, rate := range rates {
+		share := remaining
+		if i < len(rates)-1 {
+			share = int(math.Round(float64(amount) * float64(bases[rate]) / float64(total)))
+		}
+		remaining -= share
+		if share != 0 {
+			shares = append(shares, DiscountShare{VATRate: rate, Amount: share})
+		}
+	}
+	return shares
+}
+
+// CreateDiscountCode stores a new discount code
+func (db *Database) CreateDiscountCode(d DiscountCode) (DiscountCode, error) {
+	created, err := db.EntClient.DiscountCode.Create().
+		SetCode(NormalizeDiscountCode(d.Code)).
+		SetDescription(d.Description).
+		SetKind(d.Kind).
+		SetValue(d.Value).
+		SetNillableItemID(intPtrFromNullInt(d.ItemID)).
+		SetLicenseGroup(d.LicenseGroup).
+		SetNillableValidFrom(d.ValidFrom.Ptr()).
+		SetNillableValidTo(d.ValidTo.Ptr()).
+		SetMaxUses(d.MaxUses).
+		SetDisabled(d.Disabled).
+		SetCreatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		log.Error("CreateDiscountCode: ", err)
+		return DiscountCode{}, err
+	}
+	return DiscountCodeEntIntoDiscountCode(created), nil
+}
+
+// UpdateDiscountCode updates a discount code. Its code and number of uses
+// are kept.
+func (db *Database) UpdateDiscountCode(d DiscountCode) (DiscountCode, error) {
+	update := db.EntClient.DiscountCode.UpdateOneID(d.ID).
+		SetDescription(d.Description).
+		SetKind(d.Kind).
+		SetValue(d.Value).
+		SetLicenseGroup(d.LicenseGroup).
+		SetMaxUses(d.MaxUses).
+		SetDisabled(d.Disabled)
+	if d.ItemID.Valid {
+		update = update.SetItemID(int(d.ItemID.Int64))
+	} else {
+		update = update.ClearItemID()
+	}
+	if d.ValidFrom.Valid {
+		update = update.SetValidFrom(d.ValidFrom.Time)
+	} else {
+		update = update.ClearValidFrom()
+	}
+	if d.ValidTo.Valid {
+		update = update.SetValidTo(d.ValidTo.Time)
+	} else {
+		update = update.ClearValidTo()
+	}
+	updated, err := update.Save(context.Background())
+	if ent.IsNotFound(err) {
+		return DiscountCode{}, ErrDiscountCodeNotFound
+	}
+	if err != nil {
+		log.Error("UpdateDiscountCode: ", err)
+		return DiscountCode{}, err
+	}
+	return DiscountCodeEntIntoDiscountCode(updated), nil
+}
+
+// GetDiscountCode retrieves a discount code by ID
+func (db *Database) GetDiscountCode(id int) (DiscountCode, error) {
+	d, err := db.EntClient.DiscountCode.Get(context.Background(), id)
+	if ent.IsNotFound(err) {
+		return DiscountCode{}, ErrDiscountCodeNotFound
+	}
+	if err != nil {
+		return DiscountCode{}, err
+	}
+	return DiscountCodeEntIntoDiscountCode(d), nil
+}
+
+// GetDiscountCodeByCode retrieves a discount code by its code
+func (db *Database) GetDiscountCodeByCode(code string) (DiscountCode, error) {
+	d, err := db.EntClient.DiscountCode.Query().
+		Where(entdiscountcode.Code(NormalizeDiscountCode(code))).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return DiscountCode{}, ErrDiscountCodeNotFound
+	}
+	if err != nil {
+		return DiscountCode{}, err
+	}
+	return DiscountCodeEntIntoDiscountCode(d), nil
+}
+
+// ListDiscountCodes lists all discount codes, newest first
+func (db *Database) ListDiscountCodes() ([]DiscountCode, error) {
+	codes, err := db.EntClient.DiscountCode.Query().
+		Order(ent.Desc(entdiscountcode.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListDiscountCodes: ", err)
+		return nil, err
+	}
+	result := make([]DiscountCode, len(codes))
+	for i, d := range codes {
+		result[i] = DiscountCodeEntIntoDiscountCode(d)
+	}
+	return result, nil
+}
+
+// ReserveDiscountCode counts a use of a discount code valid at now. The use
+// is counted by a conditional update, so concurrent orders can not exceed
+// the maximum number of uses. Callers release the use with
+// ReleaseDiscountCode if the order is not created, uses of orders that are
+// not paid are released by ReleaseUnpaidDiscountCodes.
+func (db *Database) ReserveDiscountCode(id int, now time.Time) error {
+	ctx := context.Background()
+	n, err := db.EntClient.DiscountCode.Update().
+		Where(
+			entdiscountcode.ID(id),
+			entdiscountcode.Disabled(false),
+			entdiscountcode.Or(entdiscountcode.ValidFromIsNil(), entdiscountcode.ValidFromLTE(now)),
+			entdiscountcode.Or(entdiscountcode.ValidToIsNil(), entdiscountcode.ValidToGT(now)),
+			entdiscountcode.Or(
+				entdiscountcode.MaxUses(0),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(entdiscountcode.FieldUses), s.C(entdiscountcode.FieldMaxUses)))
+				},
+			),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		log.Error("ReserveDiscountCode: ", err)
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	d, err := db.GetDiscountCode(id)
+	if err != nil {
+		return err
+	}
+	if !d.ValidAt(now) {
+		return ErrDiscountCodeInvalid
+	}
+	return ErrDiscountCodeExhausted
+}
+
+// ReleaseDiscountCode gives back a use reserved by ReserveDiscountCode
+func (db *Database) ReleaseDiscountCode(id int) error {
+	_, err := db.EntClient.DiscountCode.Update().
+		Where(entdiscountcode.ID(id), entdiscountcode.UsesGT(0)).
+		AddUses(-1).
+		Save(context.Background())
+	if err != nil {
+		log.Error("ReleaseDiscountCode: ", err)
+	}
+	return err
+}
+
+// RecordDiscountRedemption stores the discount granted to an order
+func (db *Database) RecordDiscountRedemption(discountCodeID int, orderID int, amount int) error {
+	err := db.EntClient.DiscountRedemption.Create().
+		SetDiscountCodeID(discountCodeID).
+		SetOrderID(orderID).
+		SetAmount(amount).
+		SetCreatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		log.Error("RecordDiscountRedemption: ", err)
+	}
+	return err
+}
+
+// ReleaseUnpaidDiscountCodes gives back the uses of discount codes by
+// orders created before the deadline that are still not paid, so abandoned
+// checkouts do not use up codes. It returns the number of released uses.
+func (db *Database) ReleaseUnpaidDiscountCodes(deadline time.Time, now time.Time) (int, error) {
+	ctx := context.Background()
+	redemptions, err := db.EntClient.DiscountRedemption.Query().
+		Where(
+			entdiscountredemption.ReleasedAtIsNil(),
+			entdiscountredemption.CreatedAtLT(deadline),
+			// Orders that were not paid before the deadline
+			func(s *sql.Selector) {
+				orders := sql.Table(entorder.Table)
+				s.Where(sql.In(s.C(entdiscountredemption.FieldOrderID),
+					sql.Select(orders.C(entorder.FieldID)).
+						From(orders).
+						Where(sql.And(
+							sql.EQ(orders.C(entorder.FieldVerified), false),
+							sql.LT(orders.C(entorder.FieldTimestamp), deadline),
+						)),
+				))
+			},
+		).
+		All(ctx)
+	if err != nil {
+		log.Error("ReleaseUnpaidDiscountCodes: ", err)
+		return 0, err
+	}
+
+	released := 0
+	for _, r := range redemptions {
+		ok, err := db.releaseDiscountRedemption(ctx, r, now)
+		if err != nil {
+			log.Error("ReleaseUnpaidDiscountCodes: redemption ", r.ID, err)
+			return released, err
+		}
+		if ok {
+			released++
+		}
+	}
+	return released, nil
+}
+
+// releaseDiscountRedemption marks a redemption released and gives back its
+// use. It reports false if another run released it first.
+func (db *Database) releaseDiscountRedemption(ctx context.Context, r *ent.DiscountRedemption, now time.Time) (bool, error) {
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+	n, err := tx.DiscountRedemption.Update().
+		Where(entdiscountredemption.ID(r.ID), entdiscountredemption.ReleasedAtIsNil()).
+		SetReleasedAt(now).
+		Save(ctx)
+	if err != nil || n == 0 {
+		return false, err
+	}
+	err = tx.DiscountCode.Update().
+		Where(entdiscountcode.ID(r.DiscountCodeID), entdiscountcode.UsesGT(0)).
+		AddUses(-1).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// reclaimDiscountCodesTx counts the uses of discount codes again that were
+// released before the order was paid after all. The maximum number of uses
+// is not checked, the customer already got the discount.
+func reclaimDiscountCodesTx(ctx context.Context, tx *ent.Tx, orderID int) error {
+	redemptions, err := tx.DiscountRedemption.Query().
+		Where(entdiscountredemption.OrderID(orderID), entdiscountredemption.ReleasedAtNotNil()).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range redemptions {
+		if err = tx.DiscountRedemption.UpdateOne(r).ClearReleasedAt().Exec(ctx); err != nil {
+			return err
+		}
+		if err = tx.DiscountCode.UpdateOneID(r.DiscountCodeID).AddUses(1).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetOrCreateDiscountItem returns the item of discount order entries and
+// creates it on first use. Discount entries have this item, a price of 1
+// and the negative discount in cents as quantity, like transaction costs.
+func (db *Database) GetOrCreateDiscountItem() (Item, error) {
+	if config.Config.DiscountName == "" {
+		return Item{}, errors.New("discount name is not set")
+	}
+	item, err := db.GetItemByName(config.Config.DiscountName)
+	if err == nil {
+		return item, nil
+	}
+	_, err = db.CreateItem(Item{
+		Name:        config.Config.DiscountName,
+		Description: "Rabatt durch Gutscheincodes",
+		Price:       1,
+		Type:        "discount",
+	})
+	if err != nil {
+		log.Error("GetOrCreateDiscountItem: ", err)
+	}
+	// Another request may have created it concurrently
+	return db.GetItemByName(config.Config.DiscountName)
+}
+
+// DiscountRedemption is a row of the discount redemption report
+type DiscountRedemption struct {
+	ID             int    `json:"id"`
+	DiscountCodeID int    `json:"discount_code_id"`
+	Code           string `json:"code"`
+	OrderID        int    `json:"order_id"`
+	OrderCode      string `json:"order_code"`
+	// Paid is false until the payment of the order is verified
+	Paid      bool      `json:"paid"`
+	Amount    int       `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+	// ReleasedAt is set if the use was given back because the order was
+	// not paid in time
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+}
+
+// DiscountCodeUsage sums up the redemptions of a discount code
+type DiscountCodeUsage struct {
+	DiscountCodeID int    `json:"discount_code_id"`
+	Code           string `json:"code"`
+	Redemptions    int    `json:"redemptions"`
+	Paid           int    `json:"paid"`
+	// Discount in cents granted to paid orders
+	PaidAmount int `json:"paid_amount"`
+}
+
+// DiscountRedemptionReport lists the redemptions within a period and sums
+// them up per discount code
+type DiscountRedemptionReport struct {
+	Codes       []DiscountCodeUsage  `json:"codes"`
+	Redemptions []DiscountRedemption `json:"redemptions"`
+}
+
+// GetDiscountRedemptionReport reports the redemptions created between
+// minDate and maxDate, optionally only those of one discount code. Zero
+// dates leave the period open.
+func (db *Database) GetDiscountRedemptionReport(minDate, maxDate time.Time, discountCodeID int) (DiscountRedemptionReport, error) {
+	ctx := context.Background()
+	report := DiscountRedemptionReport{Codes: []DiscountCodeUsage{}, Redemptions: []DiscountRedemption{}}
+	query := db.EntClient.DiscountRedemption.Query()
+	if !minDate.IsZero() {
+		query = query.Where(entdiscountredemption.CreatedAtGTE(minDate))
+	}
+	if !maxDate.IsZero() {
+		query = query.Where(entdiscountredemption.CreatedAtLTE(maxDate))
+	}
+	if discountCodeID > 0 {
+		query = query.Where(entdiscountredemption.DiscountCodeID(discountCodeID))
+	}
+	redemptions, err := query.Order(ent.Asc(entdiscountredemption.FieldCreatedAt)).All(ctx)
+	if err != nil {
+		log.Error("GetDiscountRedemptionReport: ", err)
+		return report, err
+	}
+	if len(redemptions) == 0 {
+		return report, nil
+	}
+
+	codeIDs := []int{}
+	orderIDs := []int{}
+	for _, r := range redemptions {
+		codeIDs = append(codeIDs, r.DiscountCodeID)
+		orderIDs = append(orderIDs, r.OrderID)
+	}
+	codes, err := db.EntClient.DiscountCode.Query().Where(entdiscountcode.IDIn(codeIDs...)).All(ctx)
+	if err != nil {
+		return report, err
+	}
+	orders, err := db.EntClient.Order.Query().Where(entorder.IDIn(orderIDs...)).All(ctx)
+	if err != nil {
+		return report, err
+	}
+	codeByID := map[int]*ent.DiscountCode{}
+	for _, c := range codes {
+		codeByID[c.ID] = c
+	}
+	orderByID := map[int]*ent.Order{}
+	for _, o := range orders {
+		orderByID[o.ID] = o
+	}
+
+	usage := map[int]*DiscountCodeUsage{}
+	usageOrder := []int{}
+	for _, r := range redemptions {
+		row := DiscountRedemption{
+			ID:             r.ID,
+			DiscountCodeID: r.DiscountCodeID,
+			OrderID:        r.OrderID,
+			Amount:         r.Amount,
+			CreatedAt:      r.CreatedAt,
+			ReleasedAt:     r.ReleasedAt,
+		}
+		if c, ok := codeByID[r.DiscountCodeID]; ok {
+			row.Code = c.Code
+		}
+		if o, ok := orderByID[r.OrderID]; ok {
+			row.Paid = o.Verified
+			if o.OrderCode != nil {
+				row.OrderCode = *o.OrderCode
+			}
+		}
+		report.Redemptions = append(report.Redemptions, row)
+
+		u, ok := usage[r.DiscountCodeID]
+		if !ok {
+			u = &DiscountCodeUsage{DiscountCodeID: r.DiscountCodeID, Code: row.Code}
+			usage[r.DiscountCodeID] = u
+			usageOrder = append(usageOrder, r.DiscountCodeID)
+		}
+		u.Redemptions++
+		if row.Paid {
+			u.Paid++
+			u.PaidAmount += r.Amount
+		}
+	}
+	for _, id := range usageOrder {
+		report.Codes = append(report.Codes, *usage[id])
+	}
+	return report, nil
+}
@@ -0,0 +1,137 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestDiscountCodeDiscount(t *testing.T) {
+	now := time.Now()
+	newspaper := Item{ID: 1, Price: 300, Type: "normal_item"}
+	digital := Item{ID: 2, Price: 1000, Type: "normal_item", LicenseGroup: null.StringFrom("digital")}
+	donation := Item{ID: 3, Price: 1, Type: "donation"}
+	items := map[int]Item{1: newspaper, 2: digital, 3: donation}
+	entries := []OrderEntry{
+		{Item: 1, Quantity: 2, Price: 300, IsSale: true},
+		{Item: 2, Quantity: 1, Price: 1000, IsSale: true},
+		{Item: 3, Quantity: 500, Price: 1, IsSale: true},
+		// License entries are not paid by the buyer
+		{Item: 2, Quantity: 1, Price: 400},
+	}
+
+	percentage := DiscountCode{Code: "half", Kind: DiscountKindPercentage, Value: 50}
+	require.NoError(t, percentage.Validate())
+	require.Equal(t, 800, percentage.Discount(entries, items))
+
+	percentage.LicenseGroup = "digital"
+	require.False(t, percentage.Applies(newspaper))
+	require.Equal(t, 500, percentage.Discount(entries, items))
+
+	fixed := DiscountCode{Code: "fixed", Kind: DiscountKindFixed, Value: 5000, ItemID: null.IntFrom(1)}
+	require.NoError(t, fixed.Validate())
+	require.Equal(t, 600, fixed.Discount(entries, items), "discount is capped at the price")
+	require.Zero(t, fixed.Discount(entries[1:], items))
+
+	require.Error(t, DiscountCode{Code: "x", Kind: DiscountKindPercentage, Value: 101}.Validate())
+	require.Error(t, DiscountCode{Code: "x", Kind: "free"}.Validate())
+	require.Error(t, DiscountCode{Code: " ", Kind: DiscountKindFixed, Value: 1}.Validate())
+
+	window := DiscountCode{ValidFrom: null.TimeFrom(now), ValidTo: null.TimeFrom(now.AddDate(0, 1, 0))}
+	require.False(t, window.ValidAt(now.Add(-time.Hour)))
+	require.True(t, window.ValidAt(now.Add(time.Hour)))
+	require.False(t, window.ValidAt(now.AddDate(0, 2, 0)))
+	window.Disabled = true
+	require.False(t, window.ValidAt(now.Add(time.Hour)))
+}
+
+// TestDiscountCodes reserves a capped discount code and reports its
+// redemptions
+func TestDiscountCodes(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	code, err := Db.CreateDiscountCode(DiscountCode{Code: " solidarity ", Kind: DiscountKindPercentage, Value: 20, MaxUses: 1})
+	utils.CheckError(t, err)
+	require.Equal(t, "SOLIDARITY", code.Code)
+	found, err := Db.GetDiscountCodeByCode("Solidarity")
+	require.NoError(t, err)
+	require.Equal(t, code.ID, found.ID)
+	_, err = Db.GetDiscountCodeByCode("unknown")
+	require.ErrorIs(t, err, ErrDiscountCodeNotFound)
+
+	// The cap holds and released uses can be taken again
+	require.NoError(t, Db.ReserveDiscountCode(code.ID, now))
+	require.ErrorIs(t, Db.ReserveDiscountCode(code.ID, now), ErrDiscountCodeExhausted)
+	require.NoError(t, Db.ReleaseDiscountCode(code.ID))
+	require.NoError(t, Db.ReserveDiscountCode(code.ID, now))
+
+	code.Disabled = true
+	code.MaxUses = 0
+	_, err = Db.UpdateDiscountCode(code)
+	require.NoError(t, err)
+	require.ErrorIs(t, Db.ReserveDiscountCode(code.ID, now), ErrDiscountCodeInvalid)
+
+	discountItem, err := Db.GetOrCreateDiscountItem()
+	require.NoError(t, err)
+	again, err := Db.GetOrCreateDiscountItem()
+	require.NoError(t, err)
+	require.Equal(t, discountItem.ID, again.ID)
+
+	vendorID, err := Db.CreateVendor(Vendor{FirstName: "Discount", LastName: "Vendor", Email: "discount@vendor.com", LicenseID: null.StringFrom("dv-1")})
+	utils.CheckError(t, err)
+	vendorAccount, err := Db.GetAccountByVendorID(vendorID)
+	utils.CheckError(t, err)
+	orderID, err := Db.CreateOrder(Order{
+		Vendor: vendorID,
+		Entries: []OrderEntry{
+			{Item: discountItem.ID, Quantity: -200, Price: 1, Sender: vendorAccount.ID, Receiver: vendorAccount.ID, IsSale: true},
+		},
+	})
+	utils.CheckError(t, err)
+	require.NoError(t, Db.RecordDiscountRedemption(code.ID, orderID, 200))
+
+	report, err := Db.GetDiscountRedemptionReport(now.Add(-time.Hour), time.Time{}, 0)
+	require.NoError(t, err)
+	require.Len(t, report.Redemptions, 1)
+	require.Equal(t, "SOLIDARITY", report.Redemptions[0].Code)
+	require.False(t, report.Redemptions[0].Paid)
+	require.Equal(t, []DiscountCodeUsage{{DiscountCodeID: code.ID, Code: "SOLIDARITY", Redemptions: 1}}, report.Codes)
+
+	report, err = Db.GetDiscountRedemptionReport(time.Time{}, now.Add(-time.Hour), 0)
+	require.NoError(t, err)
+	require.Empty(t, report.Redemptions)
+
+	// Uses of unpaid orders are given back after the reservation time and
+	// counted again if the order is paid after all
+	uses := func() int {
+		d, err := Db.GetDiscountCode(code.ID)
+		require.NoError(t, err)
+		return d.Uses
+	}
+	require.Equal(t, 1, uses())
+	released, err := Db.ReleaseUnpaidDiscountCodes(now.Add(-time.Hour), now)
+	require.NoError(t, err)
+	require.Equal(t, 0, released)
+	released, err = Db.ReleaseUnpaidDiscountCodes(time.Now().Add(time.Minute), now)
+	require.NoError(t, err)
+	require.Equal(t, 1, released)
+	require.Equal(t, 0, uses())
+	released, err = Db.ReleaseUnpaidDiscountCodes(time.Now().Add(time.Minute), now)
+	require.NoError(t, err)
+	require.Equal(t, 0, released)
+	report, err = Db.GetDiscountRedemptionReport(time.Time{}, time.Time{}, code.ID)
+	require.NoError(t, err)
+	require.NotNil(t, report.Redemptions[0].ReleasedAt)
+
+	ctx := context.Background()
+	tx, err := Db.EntClient.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, reclaimDiscountCodesTx(ctx, tx, orderID))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 1, uses())
+}
@@ -0,0 +1,386 @@
+package database
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	ententitlement "github.com/augustin-wien/augustina-backend/ent/entitlement"
+	"gopkg.in/guregu/null.v4"
+)
+
+// Sources of entitlements
+const (
+	EntitlementSourceOrder     = "order"
+	EntitlementSourceAbonement = "abonement"
+	EntitlementSourceManual    = "manual"
+	EntitlementSourceGift      = "gift"
+	// Granted from the license groups customers had before entitlements existed
+	EntitlementSourceLegacy = "legacy"
+)
+
+// Entitlement grants a customer access to a single item or, if ItemID is
+// not set, to all items of a license group. Keycloak license groups are a
+// projection of the active entitlements.
+type Entitlement struct {
+	ID           int       `json:"id"`
+	CustomerID   int       `json:"customer_id"`
+	ItemID       null.Int  `json:"item_id" swaggertype:"integer"`
+	LicenseGroup string    `json:"license_group"`
+	Source       string    `json:"source"`
+	OrderID      null.Int  `json:"order_id" swaggertype:"integer"`
+	AbonementID  null.Int  `json:"abonement_id" swaggertype:"integer"`
+	ValidFrom    time.Time `json:"valid_from"`
+	ValidTo      null.Time `json:"valid_to" swaggertype:"string" format:"date-time"`
+	RevokedAt    null.Time `json:"revoked_at" swaggertype:"string" format:"date-time"`
+	CreatedAt    time.Time `json:"created_at"`
+	Active       bool      `json:"active"`
+}
+
+// EntitlementEntIntoEntitlement converts an ent.Entitlement to Entitlement
+// struct. Active is evaluated at now.
+func EntitlementEntIntoEntitlement(e *ent.Entitlement, now time.Time) Entitlement {
+	entitlement := Entitlement{
+		ID:           e.ID,
+		CustomerID:   e.CustomerID,
+		ItemID:       null.IntFromPtr(intPtrToInt64Ptr(e.ItemID)),
+		LicenseGroup: e.LicenseGroup,
+		Source:       e.Source,
+		OrderID:      null.IntFromPtr(intPtrToInt64Ptr(e.OrderID)),
+		AbonementID:  null.IntFromPtr(intPtrToInt64Ptr(e.AbonementID)),
+		ValidFrom:    e.ValidFrom,
+		ValidTo:      null.TimeFromPtr(e.ValidTo),
+		RevokedAt:    null.TimeFromPtr(e.RevokedAt),
+		CreatedAt:    e.CreatedAt,
+	}
+	entitlement.Active = entitlement.ActiveAt(now)
+	return entitlement
+}
+
+func intPtrToInt64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
+
+// ActiveAt reports whether the entitlement is valid and not revoked at t
+func (e Entitlement) ActiveAt(t time.Time) bool {
+	if e.RevokedAt.Valid && !e.RevokedAt.Time.After(t) {
+		return false
+	}
+	if e.ValidFrom.After(t) {
+		return false
+	}
+	return !e.ValidTo.Valid || e.ValidTo.Time.After(t)
+}
+
+// Covers reports whether the entitlement grants access to item. Entitlements
+// for a single item only cover that item, the others every item of their
+// license group.
+func (e Entitlement) Covers(item Item) bool {
+	if e.ItemID.Valid {
+		return int(e.ItemID.Int64) == item.ID
+	}
+	return e.LicenseGroup != "" && item.LicenseGroup.Valid && e.LicenseGroup == item.LicenseGroup.String
+}
+
+// GrantEntitlement stores an entitlement. Granting the same access from the
+// same source again returns the existing entitlement, so callers can safely
+// retry.
+func (db *Database) GrantEntitlement(e Entitlement) (Entitlement, error) {
+	ctx := context.Background()
+	now := time.Now()
+	query := db.EntClient.Entitlement.Query().
+		Where(
+			ententitlement.CustomerID(e.CustomerID),
+			ententitlement.LicenseGroup(e.LicenseGroup),
+			ententitlement.Source(e.Source),
+			ententitlement.RevokedAtIsNil(),
+		)
+	if e.ItemID.Valid {
+		query = query.Where(ententitlement.ItemID(int(e.ItemID.Int64)))
+	} else {
+		query = query.Where(ententitlement.ItemIDIsNil())
+	}
+	if e.OrderID.Valid {
+		query = query.Where(ententitlement.OrderID(int(e.OrderID.Int64)))
+	} else {
+		query = query.Where(ententitlement.OrderIDIsNil())
+	}
+	if e.AbonementID.Valid {
+		query = query.Where(ententitlement.AbonementID(int(e.AbonementID.Int64)))
+	} else {
+		query = query.Where(ententitlement.AbonementIDIsNil())
+	}
+	existing, err := query.First(ctx)
+	if err == nil {
+		return EntitlementEntIntoEntitlement(existing, now), nil
+	}
+	if !ent.IsNotFound(err) {
+		log.Error("GrantEntitlement: ", err)
+		return Entitlement{}, err
+	}
+
+	if e.ValidFrom.IsZero() {
+		e.ValidFrom = now
+	}
+	created, err := db.EntClient.Entitlement.Create().
+		SetCustomerID(e.CustomerID).
+		SetNillableItemID(nullIntPtr(e.ItemID)).
+		SetLicenseGroup(e.LicenseGroup).
+		SetSource(e.Source).
+		SetNillableOrderID(nullIntPtr(e.OrderID)).
+		SetNillableAbonementID(nullIntPtr(e.AbonementID)).
+		SetValidFrom(e.ValidFrom).
+		SetNillableValidTo(e.ValidTo.Ptr()).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		log.Error("GrantEntitlement: ", err)
+		return Entitlement{}, err
+	}
+	return EntitlementEntIntoEntitlement(created, now), nil
+}
+
+// ListEntitlementsByCustomer returns all entitlements of a customer, the
+// newest first
+func (db *Database) ListEntitlementsByCustomer(customerID int) ([]Entitlement, error) {
+	entitlements, err := db.EntClient.Entitlement.Query().
+		Where(ententitlement.CustomerID(customerID)).
+		Order(ent.Desc(ententitlement.FieldValidFrom), ent.Desc(ententitlement.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListEntitlementsByCustomer: ", err)
+		return nil, err
+	}
+	now := time.Now()
+	result := make([]Entitlement, len(entitlements))
+	for i, e := range entitlements {
+		result[i] = EntitlementEntIntoEntitlement(e, now)
+	}
+	return result, nil
+}
+
+// ListActiveEntitlementsByCustomer returns the entitlements of a customer
+// that are active at now
+func (db *Database) ListActiveEntitlementsByCustomer(customerID int, now time.Time) ([]Entitlement, error) {
+	entitlements, err := db.EntClient.Entitlement.Query().
+		Where(
+			ententitlement.CustomerID(customerID),
+			ententitlement.ValidFromLTE(now),
+			ententitlement.Or(ententitlement.ValidToIsNil(), ententitlement.ValidToGT(now)),
+			ententitlement.Or(ententitlement.RevokedAtIsNil(), ententitlement.RevokedAtGT(now)),
+		).
+		Order(ent.Asc(ententitlement.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListActiveEntitlementsByCustomer: ", err)
+		return nil, err
+	}
+	result := make([]Entitlement, len(entitlements))
+	for i, e := range entitlements {
+		result[i] = EntitlementEntIntoEntitlement(e, now)
+	}
+	return result, nil
+}
+
+// getEntitlementItem returns an item regardless of whether it is disabled or
+// archived, since customers keep access to old issues
+func (db *Database) getEntitlementItem(id int) (Item, error) {
+	e, err := db.EntClient.Item.Get(context.Background(), id)
+	if err != nil {
+		return Item{}, err
+	}
+	return convertEntItem(e), nil
+}
+
+// GetCustomerEntitlementForItem returns the active entitlement that grants
+// a customer access to an item. found is false if the customer may not read
+// the item.
+func (db *Database) GetCustomerEntitlementForItem(customerID int, itemID int, now time.Time) (entitlement Entitlement, found bool, err error) {
+	item, err := db.getEntitlementItem(itemID)
+	if err != nil {
+		return entitlement, false, err
+	}
+	entitlements, err := db.ListActiveEntitlementsByCustomer(customerID, now)
+	if err != nil {
+		return entitlement, false, err
+	}
+	for _, e := range entitlements {
+		if e.Covers(item) {
+			return e, true, nil
+		}
+	}
+	return entitlement, false, nil
+}
+
+// EntitledLicenseGroups returns the sorted license groups of the active
+// entitlements of a customer. This is what the customer should have in
+// Keycloak.
+func (db *Database) EntitledLicenseGroups(customerID int, now time.Time) ([]string, error) {
+	entitlements, err := db.ListActiveEntitlementsByCustomer(customerID, now)
+	if err != nil {
+		return nil, err
+	}
+	return entitledLicenseGroups(entitlements), nil
+}
+
+func entitledLicenseGroups(entitlements []Entitlement) []string {
+	seen := map[string]bool{}
+	groups := []string{}
+	for _, e := range entitlements {
+		if e.LicenseGroup == "" || seen[e.LicenseGroup] {
+			continue
+		}
+		seen[e.LicenseGroup] = true
+		groups = append(groups, e.LicenseGroup)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// RevokeLicenseGroupEntitlements revokes all entitlements of a customer for
+// a license group, including those for single items of the group
+func (db *Database) RevokeLicenseGroupEntitlements(customerID int, licenseGroup string) error {
+	now := time.Now()
+	_, err := db.EntClient.Entitlement.Update().
+		Where(
+			ententitlement.CustomerID(customerID),
+			ententitlement.LicenseGroup(licenseGroup),
+			ententitlement.Or(ententitlement.RevokedAtIsNil(), ententitlement.RevokedAtGT(now)),
+		).
+		SetRevokedAt(now).
+		Save(context.Background())
+	if err != nil {
+		log.Error("RevokeLicenseGroupEntitlements: ", err)
+	}
+	return err
+}
+
+// ApplyManualLicenseGroups records license groups an admin added to a
+// customer as manual entitlements and revokes the entitlements of groups
+// the admin removed
+func (db *Database) ApplyManualLicenseGroups(customerID int, oldGroups, newGroups []string) error {
+	oldSet := map[string]bool{}
+	for _, g := range oldGroups {
+		oldSet[g] = true
+	}
+	newSet := map[string]bool{}
+	for _, g := range newGroups {
+		newSet[g] = true
+		if g == "" || oldSet[g] {
+			continue
+		}
+		_, err := db.GrantEntitlement(Entitlement{
+			CustomerID:   customerID,
+			LicenseGroup: g,
+			Source:       EntitlementSourceManual,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for g := range oldSet {
+		if g == "" || newSet[g] {
+			continue
+		}
+		if err := db.RevokeLicenseGroupEntitlements(customerID, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncAbonementEntitlement makes the license group entitlement of an
+// abonement match its item, period and status. Entitlements for single
+// issues granted during the abonement are kept.
+func (db *Database) SyncAbonementEntitlement(abonement *Abonement) error {
+	ctx := context.Background()
+	licenseGroup := ""
+	if abonement.ItemID > 0 {
+		item, err := db.getEntitlementItem(abonement.ItemID)
+		if err != nil {
+			return err
+		}
+		licenseGroup = item.LicenseGroup.String
+	}
+	revoke := !AbonementGrantsAccess(abonement.Status) || licenseGroup == ""
+
+	existing, err := db.EntClient.Entitlement.Query().
+		Where(
+			ententitlement.AbonementID(abonement.ID),
+			ententitlement.ItemIDIsNil(),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		if revoke {
+			return nil
+		}
+		_, err = db.GrantEntitlement(Entitlement{
+			CustomerID:   abonement.CustomerID,
+			LicenseGroup: licenseGroup,
+			Source:       EntitlementSourceAbonement,
+			AbonementID:  null.IntFrom(int64(abonement.ID)),
+			ValidFrom:    abonement.FromDate,
+			ValidTo:      null.TimeFrom(abonement.ToDate),
+		})
+		return err
+	}
+	if err != nil {
+		log.Error("SyncAbonementEntitlement: ", err)
+		return err
+	}
+
+	update := existing.Update().
+		SetCustomerID(abonement.CustomerID).
+		SetValidFrom(abonement.FromDate).
+		SetValidTo(abonement.ToDate)
+	if licenseGroup != "" {
+		update = update.SetLicenseGroup(licenseGroup)
+	}
+	if !revoke {
+		update = update.ClearRevokedAt()
+	} else if existing.RevokedAt == nil {
+		update = update.SetRevokedAt(time.Now())
+	}
+	if _, err = update.Save(ctx); err != nil {
+		log.Error("SyncAbonementEntitlement: ", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteEntitlementsByAbonement deletes all entitlements granted by an
+// abonement
+func (db *Database) DeleteEntitlementsByAbonement(abonementID int) error {
+	_, err := db.EntClient.Entitlement.Delete().
+		Where(ententitlement.AbonementID(abonementID)).
+		Exec(context.Background())
+	if err != nil {
+		log.Error("DeleteEntitlementsByAbonement: ", err)
+	}
+	return err
+}
+
+// grantLatestOnlineIssue grants the customer of a new abonement the latest
+// published online issue of its license group, so they can read it
+// immediately. It stays readable after the abonement ends.
+func (db *Database) grantLatestOnlineIssue(abonement *Abonement, licenseGroup string, orderID null.Int, validFrom time.Time) error {
+	latestIssue, found, err := db.GetLatestPublishedOnlineIssueByLicenseGroup(licenseGroup)
+	if err != nil || !found || !latestIssue.LicenseGroup.Valid || latestIssue.LicenseGroup.String == "" {
+		return err
+	}
+	_, err = db.GrantEntitlement(Entitlement{
+		CustomerID:   abonement.CustomerID,
+		ItemID:       null.IntFrom(int64(latestIssue.ID)),
+		LicenseGroup: latestIssue.LicenseGroup.String,
+		Source:       EntitlementSourceAbonement,
+		OrderID:      orderID,
+		AbonementID:  null.IntFrom(int64(abonement.ID)),
+		ValidFrom:    validFrom,
+	})
+	return err
+}
@@ -0,0 +1,129 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestEntitlementActiveAtAndCovers(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	e := Entitlement{ValidFrom: now.AddDate(0, -1, 0), ValidTo: null.TimeFrom(now.AddDate(0, 1, 0))}
+	require.True(t, e.ActiveAt(now))
+	require.False(t, e.ActiveAt(now.AddDate(0, -2, 0)))
+	require.False(t, e.ActiveAt(now.AddDate(0, 1, 0)))
+	e.RevokedAt = null.TimeFrom(now)
+	require.False(t, e.ActiveAt(now))
+	require.True(t, e.ActiveAt(now.Add(-time.Second)))
+
+	issue := Item{ID: 7, LicenseGroup: null.StringFrom("digital")}
+	other := Item{ID: 8, LicenseGroup: null.StringFrom("digital")}
+	group := Entitlement{LicenseGroup: "digital"}
+	require.True(t, group.Covers(issue))
+	require.True(t, group.Covers(other))
+	require.False(t, group.Covers(Item{ID: 9}))
+	single := Entitlement{ItemID: null.IntFrom(7), LicenseGroup: "digital"}
+	require.True(t, single.Covers(issue))
+	require.False(t, single.Covers(other))
+	require.False(t, Entitlement{}.Covers(Item{ID: 9, LicenseGroup: null.StringFrom("")}))
+
+	require.Equal(t, []string{"a", "b"}, entitledLicenseGroups([]Entitlement{
+		{LicenseGroup: "b"}, {LicenseGroup: ""}, {LicenseGroup: "a"}, {LicenseGroup: "b"},
+	}))
+}
+
+// TestEntitlements grants access through an abonement and a single issue
+// and projects the license groups to Keycloak
+func TestEntitlements(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	customer, err := Db.CreateCustomer(&Customer{KeycloakID: "entitlement-customer", Email: "entitlement@example.com"})
+	require.NoError(t, err)
+	aboItemID, err := Db.CreateItem(Item{Name: "Digital abonement", Description: "One year of online issues", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("digital")})
+	require.NoError(t, err)
+	issueID, err := Db.CreateItem(Item{Name: "Online issue 601", Description: "Online issue", Price: 300, Type: "online_issue", LicenseGroup: null.StringFrom("digital")})
+	require.NoError(t, err)
+	singleID, err := Db.CreateItem(Item{Name: "Online special", Description: "Online special issue", Price: 300, Type: "online_issue", LicenseGroup: null.StringFrom("special")})
+	require.NoError(t, err)
+
+	_, found, err := Db.GetCustomerEntitlementForItem(customer.ID, issueID, now)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	abonement, err := Db.CreateAbonement(&Abonement{
+		CustomerID: customer.ID,
+		ItemID:     aboItemID,
+		FromDate:   now.AddDate(0, -1, 0),
+		ToDate:     now.AddDate(0, 11, 0),
+		Status:     "active",
+	})
+	require.NoError(t, err)
+	mock := &mockAssigner{}
+	svc := newTestService(mock)
+	require.NoError(t, svc.ProcessAbonementForCustomer(customer.ID, now))
+
+	entitlement, found, err := Db.GetCustomerEntitlementForItem(customer.ID, issueID, now)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, EntitlementSourceAbonement, entitlement.Source)
+	require.Equal(t, int64(abonement.ID), entitlement.AbonementID.Int64)
+	_, found, err = Db.GetCustomerEntitlementForItem(customer.ID, singleID, now)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Len(t, mock.syncs, 1)
+	require.Equal(t, []string{"digital"}, mock.syncs[0].newGroups)
+
+	// Issues published during the abonement stay readable after it ends
+	issue, err := Db.GetItemIncludingDisabled(issueID)
+	require.NoError(t, err)
+	require.NoError(t, svc.GrantIssueToActiveAbonements(issue, now))
+	require.NoError(t, svc.GrantIssueToActiveAbonements(issue, now))
+	_, err = Db.GrantEntitlement(Entitlement{
+		CustomerID:   customer.ID,
+		ItemID:       null.IntFrom(int64(singleID)),
+		LicenseGroup: "special",
+		Source:       EntitlementSourceOrder,
+	})
+	require.NoError(t, err)
+
+	abonement.Status = "cancelled"
+	_, err = Db.UpdateAbonement(abonement)
+	require.NoError(t, err)
+	require.NoError(t, svc.ProcessAbonementForCustomer(customer.ID, now.Add(time.Second)))
+
+	entitlement, found, err = Db.GetCustomerEntitlementForItem(customer.ID, issueID, now.Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(issueID), entitlement.ItemID.Int64)
+	_, found, err = Db.GetCustomerEntitlementForItem(customer.ID, singleID, now.Add(time.Second))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	entitlements, err := Db.ListEntitlementsByCustomer(customer.ID)
+	require.NoError(t, err)
+	require.Len(t, entitlements, 3)
+	active := 0
+	for _, e := range entitlements {
+		if e.Active {
+			active++
+		}
+	}
+	require.Equal(t, 2, active)
+	groups, err := Db.EntitledLicenseGroups(customer.ID, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, []string{"digital", "special"}, groups)
+
+	// Removing a group as admin revokes all of its entitlements
+	require.NoError(t, Db.ApplyManualLicenseGroups(customer.ID, []string{"digital", "special"}, []string{"special", "print"}))
+	groups, err = Db.EntitledLicenseGroups(customer.ID, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, []string{"print", "special"}, groups)
+
+	require.NoError(t, Db.DeleteAbonement(abonement.ID))
+	entitlements, err = Db.ListEntitlementsByCustomer(customer.ID)
+	require.NoError(t, err)
+	require.Len(t, entitlements, 2)
+}
@@ -0,0 +1,376 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/ent"
+	entgiftcode "github.com/augustin-wien/augustina-backend/ent/giftcode"
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	// ErrGiftCodeNotFound is returned if no gift code matches
+	ErrGiftCodeNotFound = errors.New("gift code not found")
+	// ErrGiftCodeRedeemed is returned if a gift code was already redeemed
+	ErrGiftCodeRedeemed = errors.New("gift code already redeemed")
+)
+
+// giftCodeAlphabet leaves out characters that are easily confused when a
+// code is typed from a printed voucher
+const giftCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// GiftCode grants an abonement or digital item bought as a gift to the
+// customer redeeming it
+type GiftCode struct {
+	ID             int         `json:"id"`
+	Code           string      `json:"code"`
+	ItemID         int         `json:"item_id"`
+	OrderID        null.Int    `json:"order_id" swaggertype:"integer"`
+	BuyerEmail     string      `json:"buyer_email"`
+	RecipientEmail null.String `json:"recipient_email" swaggertype:"string"`
+	Message        string      `json:"message"`
+	SendAt         null.Time   `json:"send_at" swaggertype:"string" format:"date-time"`
+	SentAt         null.Time   `json:"sent_at" swaggertype:"string" format:"date-time"`
+	RedeemedAt     null.Time   `json:"redeemed_at" swaggertype:"string" format:"date-time"`
+	CustomerID     null.Int    `json:"customer_id" swaggertype:"integer"`
+	AbonementID    null.Int    `json:"abonement_id" swaggertype:"integer"`
+	CreatedAt      time.Time   `json:"created_at"`
+}
+
+// GiftCodeEntIntoGiftCode converts an ent.GiftCode to GiftCode struct
+func GiftCodeEntIntoGiftCode(g *ent.GiftCode) GiftCode {
+	return GiftCode{
+		ID:             g.ID,
+		Code:           g.Code,
+		ItemID:         g.ItemID,
+		OrderID:        null.IntFromPtr(intPtrToInt64Ptr(g.OrderID)),
+		BuyerEmail:     g.BuyerEmail,
+		RecipientEmail: null.StringFromPtr(g.RecipientEmail),
+		Message:        g.Message,
+		SendAt:         null.TimeFromPtr(g.SendAt),
+		SentAt:         null.TimeFromPtr(g.SentAt),
+		RedeemedAt:     null.TimeFromPtr(g.RedeemedAt),
+		CustomerID:     null.IntFromPtr(intPtrToInt64Ptr(g.CustomerID)),
+		AbonementID:    null.IntFromPtr(intPtrToInt64Ptr(g.AbonementID)),
+		CreatedAt:      g.CreatedAt,
+	}
+}
+
+// newGiftCode returns a random code like "GIFT-7KQM-2XPA-R9TD"
+func newGiftCode() string {
+	groups := make([]string, 3)
+	for i := range groups {
+		group := make([]byte, 4)
+		for j := range group {
+			group[j] = giftCodeAlphabet[utils.RandomInt(0, len(giftCodeAlphabet))]
+		}
+		groups[i] = string(group)
+	}
+	return "GIFT-" + strings.Join(groups, "-")
+}
+
+// NormalizeGiftCode uppercases a code typed by a customer and removes
+// surrounding whitespace
+func NormalizeGiftCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CreateGiftCode stores a gift code with a new random code
+func (db *Database) CreateGiftCode(g GiftCode) (GiftCode, error) {
+	created, err := db.EntClient.GiftCode.Create().
+		SetCode(newGiftCode()).
+		SetItemID(g.ItemID).
+		SetNillableOrderID(intPtrFromNullInt(g.OrderID)).
+		SetBuyerEmail(g.BuyerEmail).
+		SetNillableRecipientEmail(g.RecipientEmail.Ptr()).
+		SetMessage(g.Message).
+		SetNillableSendAt(g.SendAt.Ptr()).
+		SetCreatedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		log.Error("CreateGiftCode: ", err)
+		return GiftCode{}, err
+	}
+	return GiftCodeEntIntoGiftCode(created), nil
+}
+
+func intPtrFromNullInt(v null.Int) *int {
+	if !v.Valid {
+		return nil
+	}
+	i := int(v.Int64)
+	return &i
+}
+
+// GetGiftCodeByCode retrieves a gift code by its code
+func (db *Database) GetGiftCodeByCode(code string) (GiftCode, error) {
+	g, err := db.EntClient.GiftCode.Query().
+		Where(entgiftcode.Code(NormalizeGiftCode(code))).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return GiftCode{}, ErrGiftCodeNotFound
+	}
+	if err != nil {
+		return GiftCode{}, err
+	}
+	return GiftCodeEntIntoGiftCode(g), nil
+}
+
+// ListGiftCodes lists gift codes, newest first. If redeemed is set only
+// redeemed or unredeemed codes are listed.
+func (db *Database) ListGiftCodes(redeemed null.Bool) ([]GiftCode, error) {
+	query := db.EntClient.GiftCode.Query()
+	if redeemed.Valid && redeemed.Bool {
+		query = query.Where(entgiftcode.RedeemedAtNotNil())
+	} else if redeemed.Valid {
+		query = query.Where(entgiftcode.RedeemedAtIsNil())
+	}
+	codes, err := query.Order(ent.Desc(entgiftcode.FieldCreatedAt)).All(context.Background())
+	if err != nil {
+		log.Error("ListGiftCodes: ", err)
+		return nil, err
+	}
+	result := make([]GiftCode, len(codes))
+	for i, g := range codes {
+		result[i] = GiftCodeEntIntoGiftCode(g)
+	}
+	return result, nil
+}
+
+// ClaimDueGiftDeliveries returns the gift codes with a recipient due to be
+// sent at now and marks them as sent. They are claimed by a conditional
+// update, so each one is sent by only one of several running instances.
+func (db *Database) ClaimDueGiftDeliveries(now time.Time) ([]GiftCode, error) {
+	ctx := context.Background()
+	codes, err := db.EntClient.GiftCode.Query().
+		Where(
+			entgiftcode.RecipientEmailNotNil(),
+			entgiftcode.SentAtIsNil(),
+			entgiftcode.RedeemedAtIsNil(),
+			entgiftcode.Or(entgiftcode.SendAtIsNil(), entgiftcode.SendAtLTE(now)),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error("ClaimDueGiftDeliveries: ", err)
+		return nil, err
+	}
+	claimed := []GiftCode{}
+	for _, g := range codes {
+		n, err := db.EntClient.GiftCode.Update().
+			Where(entgiftcode.ID(g.ID), entgiftcode.SentAtIsNil()).
+			SetSentAt(now).
+			Save(ctx)
+		if err != nil {
+			log.Error("ClaimDueGiftDeliveries: ", err)
+			return claimed, err
+		}
+		if n == 0 {
+			continue
+		}
+		g.SentAt = &now
+		claimed = append(claimed, GiftCodeEntIntoGiftCode(g))
+	}
+	return claimed, nil
+}
+
+// ResetGiftDelivery marks a gift code as not sent, so its delivery is
+// retried
+func (db *Database) ResetGiftDelivery(id int) error {
+	return db.EntClient.GiftCode.UpdateOneID(id).ClearSentAt().Exec(context.Background())
+}
+
+// RedeemGiftCode grants the item of a gift code to a customer. Abonements
+// start at now, other items are entitled directly. A code can only be
+// redeemed once, even by concurrent requests, and stays unredeemed if
+// granting its item fails. Callers project the entitlements of the customer
+// to Keycloak afterwards.
+func (db *Database) RedeemGiftCode(code string, customerID int, now time.Time) (g GiftCode, err error) {
+	ctx := context.Background()
+	code = NormalizeGiftCode(code)
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("RedeemGiftCode: ", err)
+		return GiftCode{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	// Grant the item with the queries of db inside the transaction
+	txDb := *db
+	txDb.EntClient = tx.Client()
+
+	n, err := tx.GiftCode.Update().
+		Where(entgiftcode.Code(code), entgiftcode.RedeemedAtIsNil()).
+		SetRedeemedAt(now).
+		SetCustomerID(customerID).
+		Save(ctx)
+	if err != nil {
+		log.Error("RedeemGiftCode: ", err)
+		return GiftCode{}, err
+	}
+	g, err = txDb.GetGiftCodeByCode(code)
+	if err != nil {
+		return GiftCode{}, err
+	}
+	if n == 0 {
+		return g, ErrGiftCodeRedeemed
+	}
+
+	item, err := txDb.getEntitlementItem(g.ItemID)
+	if err != nil {
+		return g, err
+	}
+	lg := item.LicenseGroup.String
+	if item.Type != "abonement" {
+		_, err = txDb.GrantEntitlement(Entitlement{
+			CustomerID:   customerID,
+			ItemID:       null.IntFrom(int64(item.ID)),
+			LicenseGroup: lg,
+			Source:       EntitlementSourceGift,
+			OrderID:      g.OrderID,
+			ValidFrom:    now,
+		})
+		if err != nil {
+			return g, err
+		}
+		return g, tx.Commit()
+	}
+
+	// Gift abonements are not linked to the order, so they are never
+	// renewed with the buyer's payment method
+	abonement, err := txDb.CreateAbonement(&Abonement{
+		CustomerID: customerID,
+		ItemID:     item.ID,
+		FromDate:   now,
+		ToDate:     now.AddDate(1, 0, 0),
+		Status:     AbonementStatusActive,
+	})
+	if err != nil {
+		return g, err
+	}
+	if err = tx.GiftCode.UpdateOneID(g.ID).SetAbonementID(abonement.ID).Exec(ctx); err != nil {
+		log.Error("RedeemGiftCode: ", err)
+		return g, err
+	}
+	g.AbonementID = null.IntFrom(int64(abonement.ID))
+	if err = txDb.SyncAbonementEntitlement(abonement); err != nil {
+		return g, err
+	}
+	if lg != "" {
+		if err = txDb.grantLatestOnlineIssue(abonement, lg, g.OrderID, now); err != nil {
+			return g, err
+		}
+	}
+	return g, tx.Commit()
+}
+
+// createGiftCodesForEntry creates a gift code for each unit of an order
+// entry and returns the codes with the item name for the buyer's mail
+func (db *Database) createGiftCodesForEntry(o Order, entry OrderEntry, item Item) []map[string]interface{} {
+	codes := []map[string]interface{}{}
+	sendAt := o.GiftSendAt
+	if o.GiftRecipientEmail.Valid && !sendAt.Valid {
+		sendAt = null.TimeFrom(time.Now())
+	}
+	for i := 0; i < max(entry.Quantity, 1); i++ {
+		g, err := db.CreateGiftCode(GiftCode{
+			ItemID:         item.ID,
+			OrderID:        null.IntFrom(int64(o.ID)),
+			BuyerEmail:     o.CustomerEmail.String,
+			RecipientEmail: o.GiftRecipientEmail,
+			Message:        o.GiftMessage,
+			SendAt:         sendAt,
+		})
+		if err != nil {
+			log.Error("createGiftCodesForEntry: failed to create gift code: ", o.ID, err)
+			continue
+		}
+		codes = append(codes, map[string]interface{}{"Code": g.Code, "ItemName": item.Name})
+	}
+	return codes
+}
+
+// sendGiftPurchasedMail sends the gift codes of an order to the buyer
+func (db *Database) sendGiftPurchasedMail(o Order, codes []map[string]interface{}) {
+	sendAt := ""
+	if o.GiftSendAt.Valid {
+		sendAt = o.GiftSendAt.Time.Format("2006-01-02")
+	}
+	templateData := map[string]interface{}{
+		"Codes":          codes,
+		"RecipientEmail": o.GiftRecipientEmail.String,
+		"SendAt":         sendAt,
+		"RedeemURL":      GiftRedeemURL(""),
+	}
+	mail, err := BuildEmailRequestFromTemplate("giftPurchased", []string{o.CustomerEmail.String}, templateData)
+	if err != nil {
+		log.Error("sendGiftPurchasedMail: failed to build mail: ", o.ID, err)
+		return
+	}
+	if mail == nil {
+		return
+	}
+	go func(m *mailer.EmailRequest) {
+		success, err := mailer.Send(m)
+		if err != nil || !success {
+			log.Error("sendGiftPurchasedMail: failed to send mail: ", o.ID, err)
+		}
+	}(mail)
+}
+
+// GiftRedeemURL returns the URL of the frontend page redeeming gift codes,
+// prefilled with code if it is not empty
+func GiftRedeemURL(code string) string {
+	return config.Config.FrontendURL + "/gift/" + code
+}
+
+// DeliverDueGiftCodes mails the gift codes due at now to their recipients
+// and returns the number of codes sent. Codes whose mail fails are sent
+// again on the next run.
+func (db *Database) DeliverDueGiftCodes(now time.Time) (int, error) {
+	codes, err := db.ClaimDueGiftDeliveries(now)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	for _, g := range codes {
+		if err := db.sendGiftReceivedMail(g); err != nil {
+			log.Error("DeliverDueGiftCodes: failed to send gift code ", g.ID, err)
+			if err := db.ResetGiftDelivery(g.ID); err != nil {
+				log.Error("DeliverDueGiftCodes: ", err)
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func (db *Database) sendGiftReceivedMail(g GiftCode) error {
+	itemName := ""
+	if item, err := db.getEntitlementItem(g.ItemID); err == nil {
+		itemName = item.Name
+	}
+	templateData := map[string]interface{}{
+		"Code":       g.Code,
+		"ItemName":   itemName,
+		"BuyerEmail": g.BuyerEmail,
+		"Message":    g.Message,
+		"RedeemURL":  GiftRedeemURL(g.Code),
+	}
+	mail, err := BuildEmailRequestFromTemplate("giftReceived", []string{g.RecipientEmail.String}, templateData)
+	if err != nil || mail == nil {
+		return err
+	}
+	success, err := mailer.Send(mail)
+	if err == nil && !success {
+		err = errors.New("mail was not sent")
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestNewGiftCode(t *testing.T) {
+	code := newGiftCode()
+	require.Regexp(t, regexp.MustCompile(`^GIFT-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}-[A-HJ-NP-Z2-9]{4}$`), code)
+	require.NotEqual(t, code, newGiftCode())
+	require.Equal(t, "GIFT-ABCD-EFGH-JKLM", NormalizeGiftCode(" gift-abcd-efgh-jklm\n"))
+}
+
+// TestGiftCodes buys an abonement as a gift, delivers the code to the
+// recipient and redeems it once
+func TestGiftCodes(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	mails := map[string][]string{}
+	origBuild := BuildEmailRequestFromTemplate
+	defer func() { BuildEmailRequestFromTemplate = origBuild }()
+	BuildEmailRequestFromTemplate = func(name string, to []string, data interface{}) (*mailer.EmailRequest, error) {
+		mails[name] = append(mails[name], to[0])
+		return nil, nil
+	}
+
+	vendorID, err := Db.CreateVendor(Vendor{FirstName: "Gift", LastName: "Vendor", Email: "gift@vendor.com", LicenseID: null.StringFrom("gv-1")})
+	utils.CheckError(t, err)
+	vendorAccount, err := Db.GetAccountByVendorID(vendorID)
+	utils.CheckError(t, err)
+	itemID, err := Db.CreateItem(Item{Name: "Gift abonement", Description: "Abonement bought as a gift", Price: 5000, Type: "abonement", LicenseGroup: null.StringFrom("digital")})
+	utils.CheckError(t, err)
+
+	orderID, err := Db.CreateOrder(Order{
+		Vendor:             vendorID,
+		CustomerEmail:      null.StringFrom("buyer@example.com"),
+		Gift:               true,
+		GiftRecipientEmail: null.StringFrom("recipient@example.com"),
+		GiftSendAt:         null.TimeFrom(now.AddDate(0, 0, 2)),
+		GiftMessage:        "Happy birthday!",
+		Entries: []OrderEntry{
+			{Item: itemID, Quantity: 1, Sender: vendorAccount.ID, Receiver: vendorAccount.ID, IsSale: true},
+		},
+	})
+	utils.CheckError(t, err)
+	require.NoError(t, Db.VerifyOrderAndCreatePayments(orderID, 0))
+
+	// The buyer gets the code instead of an abonement
+	require.Equal(t, []string{"buyer@example.com"}, mails["giftPurchased"])
+	abonements, err := Db.ListAbonements()
+	require.NoError(t, err)
+	require.Empty(t, abonements)
+	codes, err := Db.ListGiftCodes(null.BoolFrom(false))
+	require.NoError(t, err)
+	require.Len(t, codes, 1)
+	code := codes[0]
+	require.Equal(t, "Happy birthday!", code.Message)
+	require.Equal(t, int64(orderID), code.OrderID.Int64)
+
+	// The recipient gets the code on the chosen date
+	sent, err := Db.DeliverDueGiftCodes(now)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+	sent, err = Db.DeliverDueGiftCodes(now.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Equal(t, 1, sent)
+	sent, err = Db.DeliverDueGiftCodes(now.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Zero(t, sent)
+	require.Equal(t, []string{"recipient@example.com"}, mails["giftReceived"])
+
+	// Redeeming grants the abonement, but only once
+	customer, err := Db.CreateCustomer(&Customer{KeycloakID: "gift-recipient", Email: "recipient@example.com"})
+	require.NoError(t, err)
+	_, err = Db.RedeemGiftCode("GIFT-0000-0000-0000", customer.ID, now)
+	require.ErrorIs(t, err, ErrGiftCodeNotFound)
+	redeemed, err := Db.RedeemGiftCode(code.Code, customer.ID, now)
+	require.NoError(t, err)
+	require.True(t, redeemed.AbonementID.Valid)
+	_, err = Db.RedeemGiftCode(code.Code, customer.ID, now)
+	require.ErrorIs(t, err, ErrGiftCodeRedeemed)
+
+	abonement, err := Db.GetAbonementByID(int(redeemed.AbonementID.Int64))
+	require.NoError(t, err)
+	require.Equal(t, customer.ID, abonement.CustomerID)
+	require.Zero(t, abonement.OrderID)
+	groups, err := Db.EntitledLicenseGroups(customer.ID, now.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, []string{"digital"}, groups)
+
+	codes, err = Db.ListGiftCodes(null.BoolFrom(false))
+	require.NoError(t, err)
+	require.Empty(t, codes)
+	codes, err = Db.ListGiftCodes(null.Bool{})
+	require.NoError(t, err)
+	require.Len(t, codes, 1)
+}
@@ -0,0 +1,352 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entinvoice "github.com/augustin-wien/augustina-backend/ent/invoice"
+	"github.com/augustin-wien/augustina-backend/ent/invoicesequence"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/pdfdoc"
+)
+
+var (
+	ErrInvoicesDisabled     = errors.New("invoices are disabled")
+	ErrInvoiceOrderNotValid = errors.New("invoices can only be issued for verified orders with a customer email")
+)
+
+// Invoice is an invoice of a verified online order. Amounts are in cents.
+type Invoice struct {
+	ID             int                    `json:"id"`
+	Number         string                 `json:"number"`
+	OrderID        int                    `json:"order_id"`
+	CustomerID     *int                   `json:"customer_id"`
+	Email          string                 `json:"email"`
+	BillingAddress *schema.BillingAddress `json:"billing_address"`
+	Issuer         schema.InvoiceIssuer   `json:"issuer"`
+	Lines          []schema.InvoiceLine   `json:"lines"`
+	Net            int                    `json:"net"`
+	Tax            int                    `json:"tax"`
+	Gross          int                    `json:"gross"`
+	IssuedAt       time.Time              `json:"issued_at"`
+	SentAt         *time.Time             `json:"sent_at"`
+}
+
+// VATSubtotal sums up the amounts with the same VAT rate
+type VATSubtotal struct {
+	Rate  float64 `json:"rate"`
+	Net   int     `json:"net"`
+	Tax   int     `json:"tax"`
+	Gross int     `json:"gross"`
+}
+
+// VATBreakdown returns the net amount and VAT per rate, lowest rate first
+func (inv Invoice) VATBreakdown() []VATSubtotal {
+	subtotals := []VATSubtotal{}
+	for _, line := range inv.Lines {
+		i := sort.Search(len(subtotals), func(i int) bool { return subtotals[i].Rate >= line.VATRate })
+		if i == len(subtotals) || subtotals[i].Rate != line.VATRate {
+			subtotals = append(subtotals[:i], append([]VATSubtotal{{Rate: line.VATRate}}, subtotals[i:]...)...)
+		}
+		subtotals[i].Net += line.Net
+		subtotals[i].Tax += line.Tax
+		subtotals[i].Gross += line.Gross
+	}
+	return subtotals
+}
+
+// InvoiceEntIntoInvoice converts an ent.Invoice to an Invoice
+func InvoiceEntIntoInvoice(i *ent.Invoice) Invoice {
+	return Invoice{
+		ID:             i.ID,
+		Number:         i.Number,
+		OrderID:        i.OrderID,
+		CustomerID:     i.CustomerID,
+		Email:          i.Email,
+		BillingAddress: i.BillingAddress,
+		Issuer:         i.Issuer,
+		Lines:          i.Lines,
+		Net:            i.Net,
+		Tax:            i.Tax,
+		Gross:          i.Gross,
+		IssuedAt:       i.IssuedAt,
+		SentAt:         i.SentAt,
+	}
+}
+
+// InvoiceNumber formats the number of the n-th invoice of a year
+func InvoiceNumber(year, sequence int) string {
+	return fmt.Sprintf("%d-%05d", year, sequence)
+}
+
+// invoiceLines returns the lines of the sold entries of an order. Entries
+// with a price of one cent, like donations and discounts, carry their
+// amount in the quantity and become a single amount.
+func invoiceLines(o Order, item func(id int) Item, defaultRate float64) []schema.InvoiceLine {
+	lines := []schema.InvoiceLine{}
+	for _, entry := range o.Entries {
+		if !entry.IsSale {
+			continue
+		}
+		line := schema.InvoiceLine{
+			ItemID:    entry.Item,
+			Name:      item(entry.Item).Name,
+			Quantity:  entry.Quantity,
+			UnitPrice: entry.Price,
+		}
+		line.VATRate, line.Net, line.Tax, line.Gross = entryTaxes(entry, func(id int) float64 {
+			return itemVATRate(item(id), defaultRate)
+		})
+		if entry.Price == 1 || entry.Price == -1 {
+			line.Quantity = 1
+			line.UnitPrice = line.Gross
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// nextInvoiceSequenceTx counts an invoice of the year and returns its
+// sequence number. Incrementing in SQL locks the counter for the rest of
+// the transaction, so numbers are neither skipped nor used twice.
+func nextInvoiceSequenceTx(tx *ent.Tx, year int) (int, error) {
+	ctx := context.Background()
+	n, err := tx.InvoiceSequence.Update().
+		Where(invoicesequence.Year(year)).
+		AddLast(1).
+		Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		_, err = tx.InvoiceSequence.Create().SetYear(year).SetLast(1).Save(ctx)
+		return 1, err
+	}
+	return tx.InvoiceSequence.Query().
+		Where(invoicesequence.Year(year)).
+		Select(invoicesequence.FieldLast).
+		Int(ctx)
+}
+
+// IssueInvoice issues the invoice of a verified order with a customer
+// email. An order has at most one invoice, if it exists it is returned.
+func (db *Database) IssueInvoice(orderID int, now time.Time) (Invoice, error) {
+	if existing, err := db.GetInvoiceByOrderID(orderID); err == nil {
+		return existing, nil
+	} else if !ent.IsNotFound(err) {
+		return Invoice{}, err
+	}
+	settings, err := db.GetSettings()
+	if err != nil {
+		return Invoice{}, err
+	}
+	if !settings.InvoicesEnabled {
+		return Invoice{}, ErrInvoicesDisabled
+	}
+	o, err := db.GetOrderByID(orderID)
+	if err != nil {
+		return Invoice{}, err
+	}
+	if !o.Verified || !o.CustomerEmail.Valid || o.CustomerEmail.String == "" {
+		return Invoice{}, ErrInvoiceOrderNotValid
+	}
+
+	items := map[int]Item{}
+	lines := invoiceLines(o, func(id int) Item {
+		if _, ok := items[id]; !ok {
+			item, err := db.GetItem(id)
+			if err != nil {
+				item = Item{ID: id, Name: fmt.Sprintf("Item %d", id)}
+			}
+			items[id] = item
+		}
+		return items[id]
+	}, settings.DefaultVATRate)
+	var net, tax, gross int
+	for _, line := range lines {
+		net += line.Net
+		tax += line.Tax
+		gross += line.Gross
+	}
+	issuerName := settings.InvoiceIssuerName
+	if issuerName == "" {
+		issuerName = settings.NewspaperName
+	}
+
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		return Invoice{}, err
+	}
+	defer tx.Rollback()
+	year := now.Year()
+	sequence, err := nextInvoiceSequenceTx(tx, year)
+	if err != nil {
+		log.Error("IssueInvoice: next sequence: ", err)
+		return Invoice{}, err
+	}
+	create := tx.Invoice.Create().
+		SetNumber(InvoiceNumber(year, sequence)).
+		SetYear(year).
+		SetSequence(sequence).
+		SetOrderID(orderID).
+		SetEmail(strings.ToLower(o.CustomerEmail.String)).
+		SetIssuer(schema.InvoiceIssuer{
+			Name:    issuerName,
+			Address: settings.InvoiceIssuerAddress,
+			VATID:   settings.InvoiceIssuerVATID,
+			Footer:  settings.InvoiceFooter,
+		}).
+		SetLines(lines).
+		SetNet(net).
+		SetTax(tax).
+		SetGross(gross).
+		SetIssuedAt(now)
+	if o.BillingAddress != nil {
+		create.SetBillingAddress(o.BillingAddress)
+	}
+	if customer, err := db.GetCustomerByEmail(o.CustomerEmail.String); err == nil {
+		create.SetCustomerID(customer.ID)
+	}
+	created, err := create.Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Issued concurrently for the same order
+		_ = tx.Rollback()
+		return db.GetInvoiceByOrderID(orderID)
+	}
+	if err != nil {
+		log.Error("IssueInvoice: ", err)
+		return Invoice{}, err
+	}
+	if err := tx.Commit(); err != nil {
+		return Invoice{}, err
+	}
+	return InvoiceEntIntoInvoice(created), nil
+}
+
+// GetInvoice returns an invoice by its ID
+func (db *Database) GetInvoice(id int) (Invoice, error) {
+	i, err := db.EntClient.Invoice.Get(context.Background(), id)
+	if err != nil {
+		return Invoice{}, err
+	}
+	return InvoiceEntIntoInvoice(i), nil
+}
+
+// GetInvoiceByOrderID returns the invoice of an order
+func (db *Database) GetInvoiceByOrderID(orderID int) (Invoice, error) {
+	i, err := db.EntClient.Invoice.Query().
+		Where(entinvoice.OrderID(orderID)).
+		Only(context.Background())
+	if err != nil {
+		return Invoice{}, err
+	}
+	return InvoiceEntIntoInvoice(i), nil
+}
+
+// ListInvoices returns the invoices issued in [from, to), the newest
+// first. Zero times disable the respective bound.
+func (db *Database) ListInvoices(from, to time.Time) ([]Invoice, error) {
+	query := db.EntClient.Invoice.Query()
+	if !from.IsZero() {
+		query = query.Where(entinvoice.IssuedAtGTE(from))
+	}
+	if !to.IsZero() {
+		query = query.Where(entinvoice.IssuedAtLT(to))
+	}
+	res, err := query.Order(ent.Desc(entinvoice.FieldID)).All(context.Background())
+	if err != nil {
+		log.Error("ListInvoices: ", err)
+		return nil, err
+	}
+	invoices := make([]Invoice, 0, len(res))
+	for _, i := range res {
+		invoices = append(invoices, InvoiceEntIntoInvoice(i))
+	}
+	return invoices, nil
+}
+
+// ListCustomerInvoices returns the invoices of the orders of a customer,
+// the newest first
+func (db *Database) ListCustomerInvoices(customer Customer) ([]Invoice, error) {
+	ctx := context.Background()
+	orderIDs, err := db.EntClient.Order.Query().
+		Where(customerOrderPredicate(customer)).
+		IDs(ctx)
+	if err != nil {
+		log.Error("ListCustomerInvoices: ", err)
+		return nil, err
+	}
+	res, err := db.EntClient.Invoice.Query().
+		Where(entinvoice.OrderIDIn(orderIDs...)).
+		Order(ent.Desc(entinvoice.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error("ListCustomerInvoices: ", err)
+		return nil, err
+	}
+	invoices := make([]Invoice, 0, len(res))
+	for _, i := range res {
+		invoices = append(invoices, InvoiceEntIntoInvoice(i))
+	}
+	return invoices, nil
+}
+
+// SendInvoiceMail sends the invoice as PDF to the customer and records
+// when it was sent
+func (db *Database) SendInvoiceMail(inv Invoice) error {
+	customerName := inv.Email
+	if inv.BillingAddress != nil && inv.BillingAddress.Name != "" {
+		customerName = inv.BillingAddress.Name
+	}
+	templateData := map[string]interface{}{
+		"Number":       inv.Number,
+		"CustomerName": customerName,
+		"Total":        pdfdoc.FormatEuro(inv.Gross),
+		"Issuer":       inv.Issuer.Name,
+	}
+	mail, err := BuildEmailRequestFromTemplate("invoiceIssued", []string{inv.Email}, templateData)
+	if err != nil {
+		return err
+	}
+	if mail == nil {
+		return errors.New("mail template invoiceIssued is missing")
+	}
+	if err := mail.Attach(inv.Filename(), "application/pdf", RenderInvoicePDF(inv)); err != nil {
+		return err
+	}
+	success, err := mailer.Send(mail)
+	if err == nil && !success {
+		err = errors.New("mail was not sent")
+	}
+	if err != nil {
+		return err
+	}
+	return db.EntClient.Invoice.UpdateOneID(inv.ID).
+		SetSentAt(time.Now()).
+		Exec(context.Background())
+}
+
+// issueOrderInvoice issues and mails the invoice of a freshly verified
+// order. Failures are logged, the order stays verified.
+func (db *Database) issueOrderInvoice(orderID int) {
+	inv, err := db.IssueInvoice(orderID, time.Now())
+	if errors.Is(err, ErrInvoicesDisabled) {
+		return
+	}
+	if err != nil {
+		log.Error("issueOrderInvoice: failed to issue invoice for order ", orderID, err)
+		return
+	}
+	go func() {
+		if err := db.SendInvoiceMail(inv); err != nil {
+			log.Error("issueOrderInvoice: failed to send invoice ", inv.Number, err)
+		}
+	}()
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/augustin-wien/augustina-backend/pdfdoc"
+)
+
+// Filename returns the file name of the invoice PDF
+func (inv Invoice) Filename() string {
+	return "Rechnung-" + inv.Number + ".pdf"
+}
+
+// formatVATRate formats a rate in percent like 10 % or 5,5 %
+func formatVATRate(rate float64) string {
+	return strings.Replace(strconv.FormatFloat(rate, 'f', -1, 64), ".", ",", 1) + " %"
+}
+
+// RenderInvoicePDF renders an invoice as A4 PDF in German
+func RenderInvoicePDF(inv Invoice) []byte {
+	doc := pdfdoc.New("Rechnung "+inv.Number, inv.Issuer.Name)
+	const left, right = 50.0, pdfdoc.PageWidth - 50
+	const quantityX, unitPriceX, vatX = right - 210, right - 140, right - 80
+	top := pdfdoc.PageHeight - 60
+	// Every page ends with the footer of the issuer
+	addPage := func() *pdfdoc.Page {
+		page := doc.AddPage()
+		y := 60.0
+		for _, line := range strings.Split(inv.Issuer.Footer, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				page.Text(left, y, 8, false, line)
+				y -= 11
+			}
+		}
+		return page
+	}
+	page := addPage()
+
+	// Issuer on the left, invoice details on the right
+	y := top
+	page.Text(left, y, 14, true, inv.Issuer.Name)
+	for _, line := range strings.Split(inv.Issuer.Address, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			y -= 13
+			page.Text(left, y, 9, false, line)
+		}
+	}
+	if inv.Issuer.VATID != "" {
+		y -= 13
+		page.Text(left, y, 9, false, "UID: "+inv.Issuer.VATID)
+	}
+	page.TextRight(right, top, 16, true, "Rechnung")
+	date := inv.IssuedAt.Format("02.01.2006")
+	page.TextRight(right, top-20, 9, false, "Rechnungsnummer: "+inv.Number)
+	page.TextRight(right, top-33, 9, false, "Rechnungsdatum: "+date)
+	page.TextRight(right, top-46, 9, false, "Liefer-/Leistungsdatum: "+date)
+
+	// Recipient
+	y -= 40
+	recipient := []string{}
+	if b := inv.BillingAddress; b != nil {
+		recipient = append(recipient, b.Company, b.Name, b.Street, strings.TrimSpace(b.Zip+" "+b.City), b.Country)
+		if b.VATID != "" {
+			recipient = append(recipient, "UID: "+b.VATID)
+		}
+	}
+	recipient = append(recipient, inv.Email)
+	for _, line := range recipient {
+		if line != "" {
+			page.Text(left, y, 10, false, line)
+			y -= 14
+		}
+	}
+
+	// Lines
+	y -= 20
+	header := func() {
+		page.Text(left, y, 9, true, "Artikel")
+		page.TextRight(quantityX, y, 9, true, "Menge")
+		page.TextRight(unitPriceX, y, 9, true, "Einzelpreis")
+		page.TextRight(vatX, y, 9, true, "USt.")
+		page.TextRight(right, y, 9, true, "Betrag")
+		y -= 6
+		page.Line(left, y, right, y)
+	}
+	header()
+	for _, line := range inv.Lines {
+		// Continue on a new page before the footer area
+		if y < 140 {
+			page = addPage()
+			y = top
+			header()
+		}
+		y -= 15
+		page.Text(left, y, 9, false, line.Name)
+		page.TextRight(quantityX, y, 9, false, strconv.Itoa(line.Quantity))
+		page.TextRight(unitPriceX, y, 9, false, pdfdoc.FormatEuro(line.UnitPrice))
+		page.TextRight(vatX, y, 9, false, formatVATRate(line.VATRate))
+		page.TextRight(right, y, 9, false, pdfdoc.FormatEuro(line.Gross))
+	}
+	y -= 8
+	page.Line(left, y, right, y)
+
+	// Totals with the VAT per rate
+	if y < 140+13*float64(len(inv.VATBreakdown())) {
+		page = addPage()
+		y = top
+	}
+	y -= 15
+	page.Text(vatX-120, y, 9, false, "Summe netto")
+	page.TextRight(right, y, 9, false, pdfdoc.FormatEuro(inv.Net))
+	for _, subtotal := range inv.VATBreakdown() {
+		y -= 13
+		page.Text(vatX-120, y, 9, false, "USt. "+formatVATRate(subtotal.Rate)+" von "+pdfdoc.FormatEuro(subtotal.Net))
+		page.TextRight(right, y, 9, false, pdfdoc.FormatEuro(subtotal.Tax))
+	}
+	y -= 17
+	page.Text(vatX-120, y, 10, true, "Gesamt")
+	page.TextRight(right, y, 10, true, pdfdoc.FormatEuro(inv.Gross))
+	y -= 25
+	page.Text(left, y, 9, false, "Der Betrag wurde bereits bezahlt.")
+
+	return doc.Bytes()
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestInvoiceLines(t *testing.T) {
+	net, tax := splitVAT(1100, 10)
+	require.Equal(t, 1000, net)
+	require.Equal(t, 100, tax)
+	net, tax = splitVAT(300, 0)
+	require.Equal(t, 300, net)
+	require.Equal(t, 0, tax)
+	require.Equal(t, "2026-00042", InvoiceNumber(2026, 42))
+
+	items := map[int]Item{1: {ID: 1, Name: "Zeitung", Type: "issue"}, 2: {ID: 2, Name: "Spende", Type: "donation"}}
+	order := Order{Entries: []OrderEntry{
+		{Item: 1, Quantity: 2, Price: 550, IsSale: true},
+		{Item: 2, Quantity: 150, Price: 1, IsSale: true},
+		{Item: 1, Quantity: 2, Price: 550},
+	}}
+	lines := invoiceLines(order, func(id int) Item { return items[id] }, 10)
+	require.Equal(t, []schema.InvoiceLine{
+		{ItemID: 1, Name: "Zeitung", Quantity: 2, UnitPrice: 550, VATRate: 10, Net: 1000, Tax: 100, Gross: 1100},
+		{ItemID: 2, Name: "Spende", Quantity: 1, UnitPrice: 150, VATRate: 0, Net: 150, Tax: 0, Gross: 150},
+	}, lines)
+
+	inv := Invoice{Number: "2026-00001", Email: "anna@example.com", Lines: lines, Net: 1150, Tax: 100, Gross: 1250,
+		Issuer:   schema.InvoiceIssuer{Name: "Augustin", Address: "Reinprechtsdorfer Str. 31\n1050 Wien", Footer: "Bank Austria"},
+		IssuedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)}
+	require.Equal(t, []VATSubtotal{{Rate: 0, Net: 150, Gross: 150}, {Rate: 10, Net: 1000, Tax: 100, Gross: 1100}}, inv.VATBreakdown())
+	require.Equal(t, "Rechnung-2026-00001.pdf", inv.Filename())
+
+	data := RenderInvoicePDF(inv)
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+	require.Contains(t, string(data), "(Rechnungsnummer: 2026-00001) Tj")
+	require.Contains(t, string(data), "(12,50 \x80) Tj")
+}
+
+func TestIssueInvoice(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	ctx := context.Background()
+	itemID, err := Db.CreateItem(Item{Name: "Issue 700", Description: "Newspaper", Price: 550, Type: "issue"})
+	require.NoError(t, err)
+	newOrder := func(code string, email string) int {
+		id, err := Db.CreateOrder(Order{
+			OrderCode:      null.StringFrom(code),
+			CustomerEmail:  null.StringFrom(email),
+			BillingAddress: &schema.BillingAddress{Name: "Anna Adler", Street: "Gasse 1", Zip: "1050", City: "Wien", Country: "AT"},
+			Entries:        []OrderEntry{{Item: itemID, Quantity: 2, Price: 550, IsSale: true}},
+		})
+		require.NoError(t, err)
+		require.NoError(t, Db.EntClient.Order.UpdateOneID(id).SetVerified(true).Exec(ctx))
+		return id
+	}
+	first := newOrder("inv-1", "Anna@example.com")
+	second := newOrder("inv-2", "ben@example.com")
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	_, err = Db.IssueInvoice(first, now)
+	require.ErrorIs(t, err, ErrInvoicesDisabled)
+	require.NoError(t, Db.EntClient.Settings.Update().SetInvoicesEnabled(true).SetDefaultVATRate(10).Exec(ctx))
+
+	inv, err := Db.IssueInvoice(first, now)
+	require.NoError(t, err)
+	require.Equal(t, "2026-00001", inv.Number)
+	require.Equal(t, "anna@example.com", inv.Email)
+	require.Equal(t, "Anna Adler", inv.BillingAddress.Name)
+	require.Equal(t, 1000, inv.Net)
+	require.Equal(t, 100, inv.Tax)
+	require.Equal(t, 1100, inv.Gross)
+
+	// An order has one invoice
+	again, err := Db.IssueInvoice(first, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, inv.ID, again.ID)
+
+	inv, err = Db.IssueInvoice(second, now)
+	require.NoError(t, err)
+	require.Equal(t, "2026-00002", inv.Number)
+	inv, err = Db.IssueInvoice(newOrder("inv-3", "ben@example.com"), now.AddDate(1, 0, 0))
+	require.NoError(t, err)
+	require.Equal(t, "2027-00001", inv.Number)
+
+	unverified, err := Db.CreateOrder(Order{OrderCode: null.StringFrom("inv-4"), CustomerEmail: null.StringFrom("ben@example.com")})
+	require.NoError(t, err)
+	_, err = Db.IssueInvoice(unverified, now)
+	require.ErrorIs(t, err, ErrInvoiceOrderNotValid)
+
+	invoices, err := Db.ListInvoices(now, now.AddDate(0, 1, 0))
+	require.NoError(t, err)
+	require.Len(t, invoices, 2)
+}
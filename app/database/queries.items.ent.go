@@ -5,24 +5,68 @@ import (
 	"errors"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/augustin-wien/augustina-backend/config"
 	ent "github.com/augustin-wien/augustina-backend/ent"
 	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
 	"gopkg.in/guregu/null.v4"
 )
 
+// visibleItem matches items visible at the given time. An item without
+// publish time is visible unless disabled. A due publish time makes the item
+// visible even before the scheduler enabled it.
+func visibleItem(now time.Time) predicate.Item {
+	return entitem.And(
+		entitem.Or(
+			entitem.And(entitem.PublishAtIsNil(), entitem.DisabledEQ(false)),
+			entitem.PublishAtLTE(now),
+		),
+		entitem.Or(entitem.UnpublishAtIsNil(), entitem.UnpublishAtGT(now)),
+	)
+}
+
+// IsVisible reports whether the item is shown in the shop at the given time,
+// see visibleItem
+func (item Item) IsVisible(now time.Time) bool {
+	if item.UnpublishAt.Valid && !item.UnpublishAt.Time.After(now) {
+		return false
+	}
+	if item.PublishAt.Valid {
+		return !item.PublishAt.Time.After(now)
+	}
+	return !item.Disabled
+}
+
+// checkVisible returns an error if the item can not be sold at the given time
+func (item Item) checkVisible(now time.Time) error {
+	if item.IsVisible(now) {
+		return nil
+	}
+	if item.Disabled {
+		return errors.New("item is disabled")
+	}
+	return errors.New("item is not published")
+}
+
 func (db *Database) ListItems(skipHiddenItems bool, skipLicenses bool, skipDisabled bool) ([]Item, error) {
 	var out []Item
 	ctx := context.Background()
-	ents, err := db.EntClient.Item.Query().Where(entitem.ArchivedEQ(false), entitem.DisabledEQ(skipDisabled)).Order(ent.Desc(entitem.FieldItemOrder)).WithLicenseItem().WithPDF().All(ctx)
+	query := db.EntClient.Item.Query().Where(entitem.ArchivedEQ(false))
+	if skipDisabled {
+		query = query.Where(entitem.DisabledEQ(true))
+	} else {
+		query = query.Where(visibleItem(time.Now()))
+	}
+	ents, err := query.Order(ent.Desc(entitem.FieldItemOrder)).WithLicenseItem().WithPDF().All(ctx)
 	if err != nil {
 		log.Error("ListItems (ent): ", err)
 		return out, err
 	}
 	for _, e := range ents {
 		it := convertEntItem(e)
-		if skipHiddenItems && (it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName) {
+		if skipHiddenItems && (it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName || it.Name == config.Config.DiscountName) {
 			continue
 		}
 		if skipLicenses && it.IsLicenseItem {
@@ -44,7 +88,7 @@ func (db *Database) ListItemsWithDisabled(skipHiddenItems bool, skipLicenses boo
 	}
 	for _, e := range ents {
 		it := convertEntItem(e)
-		if skipHiddenItems && (it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName) {
+		if skipHiddenItems && (it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName || it.Name == config.Config.DiscountName) {
 			continue
 		}
 		if skipLicenses && it.IsLicenseItem {
@@ -85,14 +129,14 @@ func (db *Database) ListLicenseGroups() ([]string, error) {
 func (db *Database) ListItemsShop() ([]Item, error) {
 	var out []Item
 	ctx := context.Background()
-	ents, err := db.EntClient.Item.Query().Where(entitem.ArchivedEQ(false), entitem.DisabledEQ(false)).Order(ent.Desc(entitem.FieldItemOrder)).WithLicenseItem().WithPDF().All(ctx)
+	ents, err := db.EntClient.Item.Query().Where(entitem.ArchivedEQ(false), visibleItem(time.Now())).Order(ent.Desc(entitem.FieldItemOrder)).WithLicenseItem().WithPDF().All(ctx)
 	if err != nil {
 		log.Error("ListItemsShop (ent): ", err)
 		return out, err
 	}
 	for _, e := range ents {
 		it := convertEntItem(e)
-		if it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName {
+		if it.Name == config.Config.TransactionCostsName || it.Name == config.Config.DonationName || it.Name == config.Config.DiscountName {
 			continue
 		}
 		if it.IsLicenseItem {
@@ -112,8 +156,8 @@ func (db *Database) GetItemByName(name string) (item Item, err error) {
 		return
 	}
 	item = convertEntItem(e)
-	if item.Disabled {
-		return item, errors.New("item is disabled")
+	if err = item.checkVisible(time.Now()); err != nil {
+		return item, err
 	}
 	return
 }
@@ -127,8 +171,8 @@ func (db *Database) GetItem(id int) (item Item, err error) {
 		return
 	}
 	item = convertEntItem(e)
-	if item.Disabled {
-		return item, errors.New("item is disabled")
+	if err = item.checkVisible(time.Now()); err != nil {
+		return item, err
 	}
 	return
 }
@@ -162,8 +206,8 @@ func (db *Database) GetItemTx(tx *ent.Tx, id int) (item Item, err error) {
 		return
 	}
 	item = convertEntItem(e)
-	if item.Disabled {
-		return item, errors.New("item is disabled")
+	if err = item.checkVisible(time.Now()); err != nil {
+		return item, err
 	}
 	return
 }
@@ -175,6 +219,7 @@ func convertEntItem(e *ent.Item) Item {
 	it.Description = e.Description
 	it.Price = int(math.Round(e.Price))
 	it.Image = e.Image
+	it.ImageVariants = e.ImageVariants
 	it.Archived = e.Archived
 	it.Disabled = e.Disabled
 	it.IsLicenseItem = e.IsLicenseItem
@@ -187,6 +232,24 @@ func convertEntItem(e *ent.Item) Item {
 		it.PDF = null.IntFrom(int64(e.Edges.PDF.ID))
 	}
 	it.ItemOrder = e.ItemOrder
+	it.TrackStock = e.TrackStock
+	it.Stock = e.Stock
+	it.LowStockThreshold = e.LowStockThreshold
+	if e.PublishAt != nil {
+		it.PublishAt = null.TimeFrom(*e.PublishAt)
+	}
+	if e.UnpublishAt != nil {
+		it.UnpublishAt = null.TimeFrom(*e.UnpublishAt)
+	}
+	if e.PDFDownloadLimit != nil {
+		it.PDFDownloadLimit = null.IntFrom(int64(*e.PDFDownloadLimit))
+	}
+	if e.PDFDownloadExpiryDays != nil {
+		it.PDFDownloadExpiryDays = null.IntFrom(int64(*e.PDFDownloadExpiryDays))
+	}
+	if e.VATRate != nil {
+		it.VATRate = null.FloatFrom(*e.VATRate)
+	}
 	if e.ItemColor != "" {
 		it.ItemColor = null.NewString(e.ItemColor, true)
 	}
@@ -255,21 +318,35 @@ func (db *Database) CreateItem(item Item) (id int, err error) {
 		return 0, errors.New("Item with the same name already exists. Update it or delete it first")
 	}
 
-	builder := db.EntClient.Item.Create().SetName(item.Name).SetDescription(item.Description).SetPrice(float64(item.Price)).SetImage(item.Image).SetArchived(item.Archived).SetDisabled(item.Disabled).SetIsLicenseItem(item.IsLicenseItem).SetLicenseGroup(item.LicenseGroup.String).SetIsPDFItem(item.IsPDFItem).SetItemOrder(item.ItemOrder).SetItemColor(item.ItemColor.String).SetItemTextColor(item.ItemTextColor.String).SetType(item.Type)
-	if item.LicenseItem.Valid {
-		v := int(item.LicenseItem.ValueOrZero())
-		builder = builder.SetNillableLicenseItemID(&v)
-	}
-	if item.PDF.Valid {
-		v := int(item.PDF.ValueOrZero())
-		builder = builder.SetNillablePDFID(&v)
-	}
-	e, err := builder.Save(ctx)
+	err = db.inTx(func(txDb *Database) error {
+		builder := txDb.EntClient.Item.Create().SetName(item.Name).SetDescription(item.Description).SetPrice(float64(item.Price)).SetImage(item.Image).SetArchived(item.Archived).SetDisabled(item.Disabled).SetIsLicenseItem(item.IsLicenseItem).SetLicenseGroup(item.LicenseGroup.String).SetIsPDFItem(item.IsPDFItem).SetItemOrder(item.ItemOrder).SetItemColor(item.ItemColor.String).SetItemTextColor(item.ItemTextColor.String).SetType(item.Type).SetImageVariants(item.ImageVariants)
+		if item.LicenseItem.Valid {
+			v := int(item.LicenseItem.ValueOrZero())
+			builder = builder.SetNillableLicenseItemID(&v)
+		}
+		if item.PDF.Valid {
+			v := int(item.PDF.ValueOrZero())
+			builder = builder.SetNillablePDFID(&v)
+		}
+		builder = builder.SetNillablePublishAt(item.PublishAt.Ptr()).SetNillableUnpublishAt(item.UnpublishAt.Ptr())
+		builder = builder.SetNillablePDFDownloadLimit(nullIntPtr(item.PDFDownloadLimit)).SetNillablePDFDownloadExpiryDays(nullIntPtr(item.PDFDownloadExpiryDays))
+		builder = builder.SetNillableVATRate(item.VATRate.Ptr())
+		e, err := builder.Save(ctx)
+		if err != nil {
+			log.Error("CreateItem (ent) failed: ", err)
+			return err
+		}
+		id = e.ID
+		if !txDb.auditing() {
+			return nil
+		}
+		after, _ := txDb.GetItem(e.ID)
+		return txDb.audit(AuditItemCreate, "item", e.ID, nil, after)
+	})
 	if err != nil {
-		log.Error("CreateItem (ent) failed: ", err)
 		return 0, err
 	}
-	return e.ID, nil
+	return id, nil
 }
 
 // createItemWithLicense is the shared implementation for creating an item with an
@@ -306,6 +383,7 @@ func (db *Database) createItemWithLicense(item Item, licenseCost int, itemType s
 		Description:   licenseDesc,
 		Price:         licenseCost,
 		Image:         item.Image,
+		ImageVariants: item.ImageVariants,
 		Archived:      false,
 		Disabled:      false,
 		IsLicenseItem: true,
@@ -328,6 +406,7 @@ func (db *Database) createItemWithLicense(item Item, licenseCost int, itemType s
 		SetDescription(licenseItem.Description).
 		SetPrice(float64(licenseItem.Price)).
 		SetImage(licenseItem.Image).
+		SetImageVariants(licenseItem.ImageVariants).
 		SetArchived(licenseItem.Archived).
 		SetDisabled(licenseItem.Disabled).
 		SetIsLicenseItem(licenseItem.IsLicenseItem).
@@ -361,6 +440,7 @@ func (db *Database) createItemWithLicense(item Item, licenseCost int, itemType s
 		SetDescription(item.Description).
 		SetPrice(float64(item.Price)).
 		SetImage(item.Image).
+		SetImageVariants(item.ImageVariants).
 		SetArchived(item.Archived).
 		SetDisabled(item.Disabled).
 		SetIsLicenseItem(item.IsLicenseItem).
@@ -379,6 +459,9 @@ func (db *Database) createItemWithLicense(item Item, licenseCost int, itemType s
 		v := int(item.PDF.ValueOrZero())
 		mainBuilder = mainBuilder.SetNillablePDFID(&v)
 	}
+	mainBuilder = mainBuilder.SetNillablePublishAt(item.PublishAt.Ptr()).SetNillableUnpublishAt(item.UnpublishAt.Ptr())
+	mainBuilder = mainBuilder.SetNillablePDFDownloadLimit(nullIntPtr(item.PDFDownloadLimit)).SetNillablePDFDownloadExpiryDays(nullIntPtr(item.PDFDownloadExpiryDays))
+	mainBuilder = mainBuilder.SetNillableVATRate(item.VATRate.Ptr())
 
 	mainEnt, err := mainBuilder.Save(context.Background())
 	if err != nil {
@@ -408,61 +491,118 @@ func (db *Database) CreateAbonementItemWithLicense(item Item, licenseCost int) (
 func (db *Database) UpdateItem(id int, item Item) (err error) {
 	log.Infof("UpdateItem: %s", item.Name)
 	ctx := context.Background()
-	ub := db.EntClient.Item.UpdateOneID(id).
-		SetName(item.Name).
-		SetDescription(item.Description).
-		SetPrice(float64(item.Price)).
-		SetImage(item.Image).
-		SetArchived(item.Archived).
-		SetDisabled(item.Disabled).
-		SetIsLicenseItem(item.IsLicenseItem).
-		SetLicenseGroup(item.LicenseGroup.String).
-		SetIsPDFItem(item.IsPDFItem).
-		SetItemOrder(item.ItemOrder).
-		SetItemColor(item.ItemColor.String).
-		SetItemTextColor(item.ItemTextColor.String).
-		SetType(item.Type)
-	if item.LicenseItem.Valid {
-		v := int(item.LicenseItem.ValueOrZero())
-		// Avoid redundant license-edge update when the item already has this license assigned.
-		// Fetch current item and compare its license edge; only set the edge when it actually changes.
-		cur, errCur := db.EntClient.Item.Query().Where(entitem.IDEQ(id)).WithLicenseItem().Only(ctx)
-		if errCur != nil {
-			// Could not fetch current item, fall back to setting the license (ent will validate)
-			ub = ub.SetNillableLicenseItemID(&v)
-		} else {
-			if cur.Edges.LicenseItem != nil && cur.Edges.LicenseItem.ID == v {
-				// license already assigned to this item — skip changing the edge to avoid triggering constraint
-				// No-op: do not call SetNillableLicenseItemID
-			} else {
+	return db.inTx(func(txDb *Database) error {
+		var before Item
+		if txDb.auditing() {
+			before, _ = txDb.GetItem(id)
+		}
+		ub := txDb.EntClient.Item.UpdateOneID(id).
+			SetName(item.Name).
+			SetDescription(item.Description).
+			SetPrice(float64(item.Price)).
+			SetImage(item.Image).
+			SetImageVariants(item.ImageVariants).
+			SetArchived(item.Archived).
+			SetDisabled(item.Disabled).
+			SetIsLicenseItem(item.IsLicenseItem).
+			SetLicenseGroup(item.LicenseGroup.String).
+			SetIsPDFItem(item.IsPDFItem).
+			SetItemOrder(item.ItemOrder).
+			SetItemColor(item.ItemColor.String).
+			SetItemTextColor(item.ItemTextColor.String).
+			SetType(item.Type)
+		if item.LicenseItem.Valid {
+			v := int(item.LicenseItem.ValueOrZero())
+			// Avoid redundant license-edge update when the item already has this license assigned.
+			// Fetch current item and compare its license edge; only set the edge when it actually changes.
+			cur, errCur := txDb.EntClient.Item.Query().Where(entitem.IDEQ(id)).WithLicenseItem().Only(ctx)
+			if errCur != nil {
+				// Could not fetch current item, fall back to setting the license (ent will validate)
 				ub = ub.SetNillableLicenseItemID(&v)
+			} else {
+				if cur.Edges.LicenseItem != nil && cur.Edges.LicenseItem.ID == v {
+					// license already assigned to this item — skip changing the edge to avoid triggering constraint
+					// No-op: do not call SetNillableLicenseItemID
+				} else {
+					ub = ub.SetNillableLicenseItemID(&v)
+				}
 			}
+		} else {
+			// clear the license edge when license is not provided
+			ub = ub.ClearLicenseItem()
 		}
-	} else {
-		// clear the license edge when license is not provided
-		ub = ub.ClearLicenseItem()
-	}
-	if item.PDF.Valid {
-		v := int(item.PDF.ValueOrZero())
-		ub = ub.SetNillablePDFID(&v)
-	} else {
-		ub = ub.ClearPDF()
-	}
-	_, err = ub.Save(ctx)
-	if err != nil {
-		log.Errorf("UpdateItem 1(ent): %s %+v", err, item)
-	}
-	return err
+		if item.PDF.Valid {
+			v := int(item.PDF.ValueOrZero())
+			ub = ub.SetNillablePDFID(&v)
+		} else {
+			ub = ub.ClearPDF()
+		}
+		if item.PublishAt.Valid {
+			ub = ub.SetPublishAt(item.PublishAt.Time)
+		} else {
+			ub = ub.ClearPublishAt()
+		}
+		if item.UnpublishAt.Valid {
+			ub = ub.SetUnpublishAt(item.UnpublishAt.Time)
+		} else {
+			ub = ub.ClearUnpublishAt()
+		}
+		if item.PDFDownloadLimit.Valid {
+			ub = ub.SetPDFDownloadLimit(int(item.PDFDownloadLimit.Int64))
+		} else {
+			ub = ub.ClearPDFDownloadLimit()
+		}
+		if item.PDFDownloadExpiryDays.Valid {
+			ub = ub.SetPDFDownloadExpiryDays(int(item.PDFDownloadExpiryDays.Int64))
+		} else {
+			ub = ub.ClearPDFDownloadExpiryDays()
+		}
+		if item.VATRate.Valid {
+			ub = ub.SetVATRate(item.VATRate.Float64)
+		} else {
+			ub = ub.ClearVATRate()
+		}
+		_, err = ub.Save(ctx)
+		if err != nil {
+			log.Errorf("UpdateItem 1(ent): %s %+v", err, item)
+			return err
+		}
+		if !txDb.auditing() {
+			return nil
+		}
+		after, _ := txDb.GetItem(id)
+		return txDb.audit(AuditItemUpdate, "item", id, before, after)
+	})
 }
 
 // DeleteItem archives an item in the database
 func (db *Database) DeleteItem(id int) (err error) {
 	ctx := context.Background()
-	// Clear any assigned license on this item and mark it archived so that
-	// digital licenses become unassigned when the owning item is deleted.
-	_, err = db.EntClient.Item.UpdateOneID(id).ClearLicenseItem().SetArchived(true).Save(ctx)
-	if err != nil {
-		log.Error("DeleteItem (ent): ", err)
+	return db.inTx(func(txDb *Database) error {
+		var before Item
+		if txDb.auditing() {
+			before, _ = txDb.GetItem(id)
+		}
+		// Clear any assigned license on this item and mark it archived so that
+		// digital licenses become unassigned when the owning item is deleted.
+		_, err := txDb.EntClient.Item.UpdateOneID(id).ClearLicenseItem().SetArchived(true).Save(ctx)
+		if err != nil {
+			log.Error("DeleteItem (ent): ", err)
+			return err
+		}
+		if !txDb.auditing() {
+			return nil
+		}
+		after, _ := txDb.GetItem(id)
+		return txDb.audit(AuditItemDelete, "item", id, before, after)
+	})
+}
+
+// nullIntPtr converts an optional value for the nillable ent setters
+func nullIntPtr(n null.Int) *int {
+	if !n.Valid {
+		return nil
 	}
-	return
+	v := int(n.Int64)
+	return &v
 }
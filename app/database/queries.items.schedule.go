@@ -0,0 +1,86 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+)
+
+// PublishDueItems enables all items whose publish time has passed and
+// clears the publish time. It returns the published items so callers can
+// run follow-up tasks exactly once, even with several running instances.
+func (db *Database) PublishDueItems(now time.Time) (published []Item, err error) {
+	ctx := context.Background()
+	due, err := db.EntClient.Item.Query().
+		Where(
+			entitem.ArchivedEQ(false),
+			entitem.PublishAtLTE(now),
+			entitem.Or(entitem.UnpublishAtIsNil(), entitem.UnpublishAtGT(now)),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error("PublishDueItems: ", err)
+		return nil, err
+	}
+	for _, e := range due {
+		// Only the instance whose update matched the row publishes the item
+		n, err := db.EntClient.Item.Update().
+			Where(entitem.ID(e.ID), entitem.PublishAtLTE(now)).
+			SetDisabled(false).
+			ClearPublishAt().
+			Save(ctx)
+		if err != nil {
+			log.Error("PublishDueItems: ", err)
+			return published, err
+		}
+		if n == 0 {
+			continue
+		}
+		item, err := db.GetItemIncludingDisabled(e.ID)
+		if err != nil {
+			return published, err
+		}
+		log.Infof("PublishDueItems: published item %d %s", item.ID, item.Name)
+		published = append(published, item)
+	}
+	return published, nil
+}
+
+// UnpublishDueItems disables all items whose unpublish time has passed and
+// clears the unpublish time. A publish time which passed as well is cleared
+// so the item is not published again. Run it before PublishDueItems.
+func (db *Database) UnpublishDueItems(now time.Time) (unpublished []Item, err error) {
+	ctx := context.Background()
+	due, err := db.EntClient.Item.Query().
+		Where(entitem.ArchivedEQ(false), entitem.UnpublishAtLTE(now)).
+		All(ctx)
+	if err != nil {
+		log.Error("UnpublishDueItems: ", err)
+		return nil, err
+	}
+	for _, e := range due {
+		update := db.EntClient.Item.Update().
+			Where(entitem.ID(e.ID), entitem.UnpublishAtLTE(now)).
+			SetDisabled(true).
+			ClearUnpublishAt()
+		if e.PublishAt != nil && !e.PublishAt.After(now) {
+			update = update.ClearPublishAt()
+		}
+		n, err := update.Save(ctx)
+		if err != nil {
+			log.Error("UnpublishDueItems: ", err)
+			return unpublished, err
+		}
+		if n == 0 {
+			continue
+		}
+		item, err := db.GetItemIncludingDisabled(e.ID)
+		if err != nil {
+			return unpublished, err
+		}
+		log.Infof("UnpublishDueItems: unpublished item %d %s", item.ID, item.Name)
+		unpublished = append(unpublished, item)
+	}
+	return unpublished, nil
+}
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestItemIsVisible(t *testing.T) {
+	now := time.Now()
+	require.True(t, Item{}.IsVisible(now))
+	require.False(t, Item{Disabled: true}.IsVisible(now))
+	require.False(t, Item{PublishAt: null.TimeFrom(now.Add(time.Hour))}.IsVisible(now))
+	require.True(t, Item{Disabled: true, PublishAt: null.TimeFrom(now.Add(-time.Hour))}.IsVisible(now))
+	require.False(t, Item{UnpublishAt: null.TimeFrom(now)}.IsVisible(now))
+	require.True(t, Item{UnpublishAt: null.TimeFrom(now.Add(time.Hour))}.IsVisible(now))
+}
+
+// TestPublishDueItems checks the shop visibility of scheduled items and
+// that the scheduler publishes and unpublishes them exactly once
+func TestPublishDueItems(t *testing.T) {
+	Db.InitEmptyTestDb()
+	now := time.Now()
+
+	futureID, err := Db.CreateItem(Item{Name: "future issue", Description: "d", Price: 300, Disabled: true, PublishAt: null.TimeFrom(now.Add(time.Hour))})
+	require.NoError(t, err)
+	dueID, err := Db.CreateItem(Item{Name: "due issue", Description: "d", Price: 300, Disabled: true, PublishAt: null.TimeFrom(now.Add(-time.Minute))})
+	require.NoError(t, err)
+	expiredID, err := Db.CreateItem(Item{Name: "expired issue", Description: "d", Price: 300, UnpublishAt: null.TimeFrom(now.Add(-time.Minute))})
+	require.NoError(t, err)
+
+	shopIDs := func() []int {
+		items, err := Db.ListItemsShop()
+		require.NoError(t, err)
+		ids := []int{}
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+	require.Contains(t, shopIDs(), dueID)
+	require.NotContains(t, shopIDs(), futureID)
+	require.NotContains(t, shopIDs(), expiredID)
+
+	_, err = Db.GetItem(futureID)
+	require.Error(t, err)
+	_, err = Db.GetItem(dueID)
+	require.NoError(t, err)
+
+	unpublished, err := Db.UnpublishDueItems(now)
+	require.NoError(t, err)
+	require.Len(t, unpublished, 1)
+	require.Equal(t, expiredID, unpublished[0].ID)
+	require.True(t, unpublished[0].Disabled)
+
+	published, err := Db.PublishDueItems(now)
+	require.NoError(t, err)
+	require.Len(t, published, 1)
+	require.Equal(t, dueID, published[0].ID)
+	require.False(t, published[0].Disabled)
+	require.False(t, published[0].PublishAt.Valid)
+
+	// A second run finds nothing to do
+	published, err = Db.PublishDueItems(now)
+	require.NoError(t, err)
+	require.Empty(t, published)
+	require.Contains(t, shopIDs(), dueID)
+	require.NotContains(t, shopIDs(), expiredID)
+}
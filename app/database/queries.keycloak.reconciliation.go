@@ -0,0 +1,596 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+	entvendor "github.com/augustin-wien/augustina-backend/ent/vendor"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+
+	"github.com/Nerzal/gocloak/v13"
+)
+
+// KeycloakDirectory is the part of Keycloak that is reconciled with the
+// database. Extracted as an interface so it can be replaced with a mock in
+// tests.
+type KeycloakDirectory interface {
+	GetUserByID(id string) (*gocloak.User, error)
+	GetUserByEmail(email string) (*gocloak.User, error)
+	GetUserLicenseGroups(userID string) ([]string, error)
+	GetGroupMembers(path string) ([]*gocloak.User, error)
+	GetOrCreateUser(email string) (userID string, newUser bool, err error)
+	GetOrCreateVendor(email string) (userID string, err error)
+	AssignGroup(userID string, groupName string) error
+	UnassignGroup(userID string, groupName string) error
+	AssignDigitalLicenseGroup(userID string, licenseGroup string) error
+	UnassignDigitalLicenseGroup(userID string, licenseGroup string) error
+	LicenseGroupPath(licenseGroup string) string
+	GetCustomerGroup() string
+	GetVendorGroup() string
+}
+
+// ReconcileDirection tells which side a reconciliation repairs
+type ReconcileDirection string
+
+const (
+	// ReconcileReport only reports mismatches
+	ReconcileReport ReconcileDirection = ""
+	// ReconcileToKeycloak repairs Keycloak to match the database
+	ReconcileToKeycloak ReconcileDirection = "keycloak"
+	// ReconcileToDatabase repairs the database to match Keycloak
+	ReconcileToDatabase ReconcileDirection = "database"
+)
+
+// ErrInvalidReconcileDirection is returned for unknown directions
+var ErrInvalidReconcileDirection = errors.New("direction must be empty, keycloak or database")
+
+// ParseReconcileDirection parses the direction of a reconciliation
+func ParseReconcileDirection(s string) (ReconcileDirection, error) {
+	direction := ReconcileDirection(strings.ToLower(strings.TrimSpace(s)))
+	switch direction {
+	case ReconcileReport, ReconcileToKeycloak, ReconcileToDatabase:
+		return direction, nil
+	}
+	return "", ErrInvalidReconcileDirection
+}
+
+// Kinds of mismatches between the database and Keycloak
+const (
+	// MismatchMissingUser is a customer or vendor without Keycloak user
+	MismatchMissingUser = "missing_user"
+	// MismatchUnlinkedUser is a customer or vendor whose Keycloak ID is
+	// empty or stale while a Keycloak user with their email exists
+	MismatchUnlinkedUser = "unlinked_user"
+	// MismatchMissingVendorGroup is a vendor whose user is not in the
+	// vendor group
+	MismatchMissingVendorGroup = "missing_vendor_group"
+	// MismatchMissingLicenseGroup is a license group of a customer in the
+	// database the Keycloak user is not a member of
+	MismatchMissingLicenseGroup = "missing_license_group"
+	// MismatchExtraLicenseGroup is a license group of a Keycloak user the
+	// customer does not have in the database
+	MismatchExtraLicenseGroup = "extra_license_group"
+	// MismatchOrphanedUser is a member of the customer, vendor or a license
+	// group without customer or vendor in the database
+	MismatchOrphanedUser = "orphaned_user"
+)
+
+// KeycloakMismatch is a difference between the database and Keycloak
+type KeycloakMismatch struct {
+	Kind string `json:"kind"`
+	// EntityType is customer, vendor or keycloak_user for orphans
+	EntityType   string `json:"entity_type"`
+	EntityID     int    `json:"entity_id,omitempty"`
+	Email        string `json:"email,omitempty"`
+	KeycloakID   string `json:"keycloak_id,omitempty"`
+	Group        string `json:"group,omitempty"`
+	LicenseGroup string `json:"license_group,omitempty"`
+	// Repair describes how the mismatch is repaired in the direction of the
+	// reconciliation, it is empty if it can not be repaired that way
+	Repair   string `json:"repair,omitempty"`
+	Repaired bool   `json:"repaired"`
+	// Skipped is set for removals beyond the limit of the reconciler
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// KeycloakReconciliation is the report of a reconciliation
+type KeycloakReconciliation struct {
+	Direction  ReconcileDirection `json:"direction"`
+	DryRun     bool               `json:"dry_run"`
+	Customers  int                `json:"customers"`
+	Vendors    int                `json:"vendors"`
+	Mismatches []KeycloakMismatch `json:"mismatches"`
+	Repaired   int                `json:"repaired"`
+	Failed     int                `json:"failed"`
+	Skipped    int                `json:"skipped"`
+}
+
+// KeycloakReconciler compares customers and vendors with their Keycloak
+// users and groups. The customer license groups and the Keycloak group
+// memberships are updated independently and failures are only logged, so
+// they drift apart.
+type KeycloakReconciler struct {
+	db        *Database
+	directory KeycloakDirectory
+	// MaxRemovals limits the group memberships removed in Keycloak per run,
+	// 0 means no limit. Further removals are reported as skipped.
+	MaxRemovals int
+}
+
+// NewKeycloakReconciler creates a reconciler backed by the global Keycloak
+// client
+func NewKeycloakReconciler(db *Database) *KeycloakReconciler {
+	return &KeycloakReconciler{db: db, directory: &keycloak.KeycloakClient}
+}
+
+// reconciliation is the state of a running reconciliation
+type reconciliation struct {
+	*KeycloakReconciler
+	report KeycloakReconciliation
+	// Keycloak IDs and emails of customers and vendors
+	customerIDs, customerEmails map[string]bool
+	vendorIDs, vendorEmails     map[string]bool
+	licenseGroups               map[string]bool
+	removals                    int
+}
+
+// Reconcile compares every customer and vendor with Keycloak and reports the
+// mismatches. With a direction other than ReconcileReport the mismatches are
+// repaired on that side, unless dryRun is set. Creating missing Keycloak
+// users sends them a password reset email like for new customers and
+// vendors.
+func (kr *KeycloakReconciler) Reconcile(ctx context.Context, direction ReconcileDirection, dryRun bool) (KeycloakReconciliation, error) {
+	rec := &reconciliation{
+		KeycloakReconciler: kr,
+		report:             KeycloakReconciliation{Direction: direction, DryRun: dryRun, Mismatches: []KeycloakMismatch{}},
+		customerIDs:        map[string]bool{},
+		customerEmails:     map[string]bool{},
+		vendorIDs:          map[string]bool{},
+		vendorEmails:       map[string]bool{},
+		licenseGroups:      map[string]bool{},
+	}
+
+	customers, err := kr.db.ListCustomers()
+	if err != nil {
+		return rec.report, err
+	}
+	for _, customer := range customers {
+		if err := ctx.Err(); err != nil {
+			return rec.report, err
+		}
+		if err := rec.reconcileCustomer(customer); err != nil {
+			return rec.report, err
+		}
+	}
+	rec.report.Customers = len(customers)
+
+	vendors, err := kr.db.listReconcilableVendors()
+	if err != nil {
+		return rec.report, err
+	}
+	vendorMembers, err := kr.directory.GetGroupMembers("/" + kr.directory.GetVendorGroup())
+	if err != nil {
+		return rec.report, fmt.Errorf("Reconcile: members of the vendor group: %w", err)
+	}
+	inVendorGroup := map[string]bool{}
+	for _, member := range vendorMembers {
+		inVendorGroup[gocloak.PString(member.ID)] = true
+	}
+	for _, vendor := range vendors {
+		if err := ctx.Err(); err != nil {
+			return rec.report, err
+		}
+		if err := rec.reconcileVendor(vendor, inVendorGroup); err != nil {
+			return rec.report, err
+		}
+	}
+	rec.report.Vendors = len(vendors)
+
+	if err := rec.reconcileOrphans(ctx, vendorMembers); err != nil {
+		return rec.report, err
+	}
+
+	for _, mismatch := range rec.report.Mismatches {
+		if mismatch.Repaired {
+			rec.report.Repaired++
+		} else if mismatch.Error != "" {
+			rec.report.Failed++
+		} else if mismatch.Skipped {
+			rec.report.Skipped++
+		}
+	}
+	return rec.report, nil
+}
+
+// add records a mismatch and repairs it with repair if the direction of the
+// reconciliation is one of repairable and it is no dry run
+func (rec *reconciliation) add(mismatch KeycloakMismatch, repairs map[ReconcileDirection]string, repair func() error) {
+	mismatch.Repair = repairs[rec.report.Direction]
+	if mismatch.Repair != "" && rec.removesFromKeycloak(mismatch) {
+		if rec.MaxRemovals > 0 && rec.removals >= rec.MaxRemovals {
+			mismatch.Skipped = true
+			rec.report.Mismatches = append(rec.report.Mismatches, mismatch)
+			return
+		}
+		rec.removals++
+	}
+	if mismatch.Repair != "" && !rec.report.DryRun {
+		if err := repair(); err != nil {
+			log.Errorf("Reconcile: %s of %s %d %s: %v", mismatch.Kind, mismatch.EntityType, mismatch.EntityID, mismatch.Email, err)
+			mismatch.Error = err.Error()
+		} else {
+			mismatch.Repaired = true
+		}
+	}
+	rec.report.Mismatches = append(rec.report.Mismatches, mismatch)
+}
+
+// removesFromKeycloak reports whether repairing a mismatch removes a user
+// from a Keycloak group
+func (rec *reconciliation) removesFromKeycloak(mismatch KeycloakMismatch) bool {
+	if rec.report.Direction != ReconcileToKeycloak {
+		return false
+	}
+	return mismatch.Kind == MismatchExtraLicenseGroup || mismatch.Kind == MismatchOrphanedUser
+}
+
+// findUser returns the Keycloak user of a customer or vendor by its
+// Keycloak ID or else by its email. linked is false if the user was found
+// by email. A nil user is returned if there is none.
+func (rec *reconciliation) findUser(keycloakID, email string) (user *gocloak.User, linked bool, err error) {
+	if keycloakID != "" {
+		user, err = rec.directory.GetUserByID(keycloakID)
+		if err == nil {
+			return user, true, nil
+		}
+		if !keycloakNotFound(err) {
+			return nil, false, err
+		}
+	}
+	if email == "" {
+		return nil, false, nil
+	}
+	user, err = rec.directory.GetUserByEmail(email)
+	if err == nil {
+		return user, false, nil
+	}
+	if keycloakNotFound(err) {
+		return nil, false, nil
+	}
+	return nil, false, err
+}
+
+func (rec *reconciliation) reconcileCustomer(customer *Customer) error {
+	for _, g := range customer.LicenseGroups {
+		if g != "" {
+			rec.licenseGroups[g] = true
+		}
+	}
+	rec.customerEmails[strings.ToLower(customer.Email)] = true
+
+	user, linked, err := rec.findUser(customer.KeycloakID, customer.Email)
+	if err != nil {
+		return fmt.Errorf("Reconcile: Keycloak user of customer %d: %w", customer.ID, err)
+	}
+	mismatch := KeycloakMismatch{EntityType: "customer", EntityID: customer.ID, Email: customer.Email, KeycloakID: customer.KeycloakID}
+	keycloakID := ""
+	switch {
+	case user != nil && !linked:
+		keycloakID = gocloak.PString(user.ID)
+		mismatch.Kind = MismatchUnlinkedUser
+		mismatch.KeycloakID = keycloakID
+		rec.add(mismatch, map[ReconcileDirection]string{
+			ReconcileToKeycloak: "link Keycloak user",
+			ReconcileToDatabase: "link Keycloak user",
+		}, func() error {
+			customer.KeycloakID = keycloakID
+			_, err := rec.db.UpdateCustomer(customer)
+			return err
+		})
+	case user == nil:
+		mismatch.Kind = MismatchMissingUser
+		rec.add(mismatch, map[ReconcileDirection]string{
+			ReconcileToKeycloak: "create Keycloak user",
+		}, func() error {
+			userID, _, err := rec.directory.GetOrCreateUser(customer.Email)
+			if err != nil {
+				return err
+			}
+			customer.KeycloakID = userID
+			keycloakID = userID
+			_, err = rec.db.UpdateCustomer(customer)
+			return err
+		})
+	default:
+		keycloakID = gocloak.PString(user.ID)
+	}
+	if keycloakID != "" {
+		rec.customerIDs[keycloakID] = true
+	}
+
+	if keycloakID == "" {
+		// The license groups are assigned once the user exists
+		return nil
+	}
+	keycloakGroups, err := rec.directory.GetUserLicenseGroups(keycloakID)
+	if err != nil {
+		return fmt.Errorf("Reconcile: license groups of customer %d: %w", customer.ID, err)
+	}
+	missing, extra := compareLicenseGroups(customer.LicenseGroups, keycloakGroups)
+	if len(missing) == 0 && len(extra) == 0 {
+		return nil
+	}
+
+	// In the database the license groups are applied at once, as manual
+	// entitlements of the groups only Keycloak has and revoked entitlements
+	// of those it lacks
+	var applied error
+	appliedOnce := false
+	applyToDatabase := func() error {
+		if !appliedOnce {
+			appliedOnce = true
+			applied = rec.db.ApplyManualLicenseGroups(customer.ID, customer.LicenseGroups, keycloakGroups)
+			if applied == nil {
+				customer.LicenseGroups = keycloakGroups
+				_, applied = rec.db.UpdateCustomer(customer)
+			}
+		}
+		return applied
+	}
+	mismatch.KeycloakID = keycloakID
+	for _, g := range missing {
+		m := mismatch
+		m.Kind = MismatchMissingLicenseGroup
+		m.LicenseGroup = g
+		rec.add(m, map[ReconcileDirection]string{
+			ReconcileToKeycloak: "assign license group in Keycloak",
+			ReconcileToDatabase: "revoke license group in the database",
+		}, func() error {
+			if rec.report.Direction == ReconcileToDatabase {
+				return applyToDatabase()
+			}
+			return rec.directory.AssignDigitalLicenseGroup(keycloakID, g)
+		})
+	}
+	for _, g := range extra {
+		m := mismatch
+		m.Kind = MismatchExtraLicenseGroup
+		m.LicenseGroup = g
+		rec.add(m, map[ReconcileDirection]string{
+			ReconcileToKeycloak: "unassign license group in Keycloak",
+			ReconcileToDatabase: "grant license group in the database",
+		}, func() error {
+			if rec.report.Direction == ReconcileToDatabase {
+				return applyToDatabase()
+			}
+			return rec.directory.UnassignDigitalLicenseGroup(keycloakID, g)
+		})
+	}
+	return nil
+}
+
+func (rec *reconciliation) reconcileVendor(vendor Vendor, inVendorGroup map[string]bool) error {
+	if vendor.Email != "" {
+		rec.vendorEmails[strings.ToLower(vendor.Email)] = true
+	}
+	user, linked, err := rec.findUser(vendor.KeycloakID, vendor.Email)
+	if err != nil {
+		return fmt.Errorf("Reconcile: Keycloak user of vendor %d: %w", vendor.ID, err)
+	}
+	mismatch := KeycloakMismatch{EntityType: "vendor", EntityID: vendor.ID, Email: vendor.Email, KeycloakID: vendor.KeycloakID}
+	keycloakID := ""
+	switch {
+	case user != nil && !linked:
+		keycloakID = gocloak.PString(user.ID)
+		mismatch.Kind = MismatchUnlinkedUser
+		mismatch.KeycloakID = keycloakID
+		rec.add(mismatch, map[ReconcileDirection]string{
+			ReconcileToKeycloak: "link Keycloak user",
+			ReconcileToDatabase: "link Keycloak user",
+		}, func() error {
+			return rec.db.setVendorKeycloakID(vendor.ID, keycloakID)
+		})
+	case user == nil:
+		mismatch.Kind = MismatchMissingUser
+		repairs := map[ReconcileDirection]string{}
+		if vendor.Email != "" {
+			repairs[ReconcileToKeycloak] = "create Keycloak user"
+		}
+		rec.add(mismatch, repairs, func() error {
+			userID, err := rec.directory.GetOrCreateVendor(vendor.Email)
+			if err != nil {
+				return err
+			}
+			keycloakID = userID
+			return rec.db.setVendorKeycloakID(vendor.ID, userID)
+		})
+		if keycloakID == "" {
+			return nil
+		}
+	default:
+		keycloakID = gocloak.PString(user.ID)
+	}
+	rec.vendorIDs[keycloakID] = true
+
+	if inVendorGroup[keycloakID] {
+		return nil
+	}
+	mismatch.Kind = MismatchMissingVendorGroup
+	mismatch.KeycloakID = keycloakID
+	mismatch.Group = rec.directory.GetVendorGroup()
+	rec.add(mismatch, map[ReconcileDirection]string{
+		ReconcileToKeycloak: "assign vendor group in Keycloak",
+	}, func() error {
+		return rec.directory.AssignGroup(keycloakID, rec.directory.GetVendorGroup())
+	})
+	return nil
+}
+
+// reconcileOrphans reports members of the customer group, the license groups
+// and the vendor group without customer or vendor in the database
+func (rec *reconciliation) reconcileOrphans(ctx context.Context, vendorMembers []*gocloak.User) error {
+	itemGroups, err := rec.db.EntClient.Item.Query().
+		Where(entitem.LicenseGroupNEQ("")).
+		Unique(true).
+		Select(entitem.FieldLicenseGroup).
+		Strings(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, g := range itemGroups {
+		rec.licenseGroups[g] = true
+	}
+
+	licenseGroups := make([]string, 0, len(rec.licenseGroups))
+	for g := range rec.licenseGroups {
+		licenseGroups = append(licenseGroups, g)
+	}
+	slices.Sort(licenseGroups)
+	// The customer group comes first, its license group is empty
+	groups := []struct{ path, licenseGroup string }{{path: "/" + rec.directory.GetCustomerGroup()}}
+	for _, g := range licenseGroups {
+		groups = append(groups, struct{ path, licenseGroup string }{rec.directory.LicenseGroupPath(g), g})
+	}
+
+	for _, g := range groups {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		group := g.path
+		members, err := rec.directory.GetGroupMembers(group)
+		if err != nil {
+			if g.licenseGroup != "" {
+				// License groups are only created in Keycloak when they are
+				// assigned the first time
+				continue
+			}
+			return fmt.Errorf("Reconcile: members of %s: %w", group, err)
+		}
+		for _, member := range members {
+			keycloakID := gocloak.PString(member.ID)
+			email := strings.ToLower(gocloak.PString(member.Email))
+			if rec.customerIDs[keycloakID] || (email != "" && rec.customerEmails[email]) {
+				continue
+			}
+			mismatch := KeycloakMismatch{
+				Kind:         MismatchOrphanedUser,
+				EntityType:   "keycloak_user",
+				Email:        email,
+				KeycloakID:   keycloakID,
+				Group:        group,
+				LicenseGroup: g.licenseGroup,
+			}
+			rec.add(mismatch, map[ReconcileDirection]string{
+				ReconcileToKeycloak: "remove from group in Keycloak",
+				ReconcileToDatabase: "create customer",
+			}, func() error {
+				if rec.report.Direction == ReconcileToKeycloak {
+					return rec.directory.UnassignGroup(keycloakID, group)
+				}
+				if email == "" {
+					return errors.New("Keycloak user has no email")
+				}
+				customer, err := rec.db.GetOrCreateCustomerByEmail(email, keycloakID)
+				if err != nil {
+					return err
+				}
+				// The license groups of the new customer are taken from
+				// Keycloak as well
+				return rec.reconcileCustomer(customer)
+			})
+			if rec.report.Direction == ReconcileToDatabase && !rec.report.DryRun {
+				// Report the user once, not for each of their groups
+				rec.customerIDs[keycloakID] = true
+			}
+		}
+	}
+
+	for _, member := range vendorMembers {
+		keycloakID := gocloak.PString(member.ID)
+		email := strings.ToLower(gocloak.PString(member.Email))
+		if rec.vendorIDs[keycloakID] || (email != "" && rec.vendorEmails[email]) {
+			continue
+		}
+		group := "/" + rec.directory.GetVendorGroup()
+		rec.add(KeycloakMismatch{
+			Kind:       MismatchOrphanedUser,
+			EntityType: "keycloak_user",
+			Email:      email,
+			KeycloakID: keycloakID,
+			Group:      group,
+		}, map[ReconcileDirection]string{
+			// Vendors can not be created without their license
+			ReconcileToKeycloak: "remove from group in Keycloak",
+		}, func() error {
+			return rec.directory.UnassignGroup(keycloakID, group)
+		})
+	}
+	return nil
+}
+
+// compareLicenseGroups returns the sorted license groups only in the
+// database and only in Keycloak
+func compareLicenseGroups(dbGroups, keycloakGroups []string) (missing, extra []string) {
+	for _, g := range dbGroups {
+		if g != "" && !slices.Contains(keycloakGroups, g) && !slices.Contains(missing, g) {
+			missing = append(missing, g)
+		}
+	}
+	for _, g := range keycloakGroups {
+		if g != "" && !slices.Contains(dbGroups, g) && !slices.Contains(extra, g) {
+			extra = append(extra, g)
+		}
+	}
+	slices.Sort(missing)
+	slices.Sort(extra)
+	return missing, extra
+}
+
+// keycloakNotFound reports whether Keycloak answered an error with 404
+func keycloakNotFound(err error) bool {
+	var apiErr gocloak.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusNotFound
+	}
+	var apiErrPtr *gocloak.APIError
+	if errors.As(err, &apiErrPtr) {
+		return apiErrPtr.Code == http.StatusNotFound
+	}
+	return false
+}
+
+// listReconcilableVendors returns the vendors that are not deleted, their
+// Keycloak users are deleted with them
+func (db *Database) listReconcilableVendors() ([]Vendor, error) {
+	vendors, err := db.EntClient.Vendor.Query().
+		Where(entvendor.Isdeleted(false)).
+		Order(ent.Asc(entvendor.FieldID)).
+		All(context.Background())
+	if err != nil {
+		log.Error("listReconcilableVendors: ", err)
+		return nil, err
+	}
+	result := make([]Vendor, 0, len(vendors))
+	for _, v := range vendors {
+		result = append(result, db.VendorEntIntoVendor(*v))
+	}
+	return result, nil
+}
+
+// setVendorKeycloakID links a vendor to a Keycloak user
+func (db *Database) setVendorKeycloakID(vendorID int, keycloakID string) error {
+	err := db.EntClient.Vendor.UpdateOneID(vendorID).
+		SetKeycloakid(keycloakID).
+		Exec(context.Background())
+	if err != nil {
+		log.Error("setVendorKeycloakID: ", err)
+	}
+	return err
+}
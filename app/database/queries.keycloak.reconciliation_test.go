@@ -0,0 +1,236 @@
+package database
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+// mockDirectory is an in-memory Keycloak with users and their group paths
+type mockDirectory struct {
+	users  map[string]*gocloak.User
+	groups map[string][]string
+}
+
+func newMockDirectory() *mockDirectory {
+	return &mockDirectory{users: map[string]*gocloak.User{}, groups: map[string][]string{}}
+}
+
+func (m *mockDirectory) addUser(id, email string, groups ...string) {
+	m.users[id] = &gocloak.User{ID: gocloak.StringP(id), Email: gocloak.StringP(email)}
+	m.groups[id] = groups
+}
+
+func (m *mockDirectory) GetUserByID(id string) (*gocloak.User, error) {
+	if user, ok := m.users[id]; ok {
+		return user, nil
+	}
+	return nil, &gocloak.APIError{Code: 404}
+}
+
+func (m *mockDirectory) GetUserByEmail(email string) (*gocloak.User, error) {
+	for _, user := range m.users {
+		if *user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, gocloak.APIError{Code: 404}
+}
+
+func (m *mockDirectory) GetUserLicenseGroups(userID string) ([]string, error) {
+	groups := []string{}
+	for _, path := range m.groups[userID] {
+		if g, ok := strings.CutPrefix(path, m.LicenseGroupPath("")); ok {
+			groups = append(groups, g)
+		}
+	}
+	return groups, nil
+}
+
+func (m *mockDirectory) GetGroupMembers(path string) ([]*gocloak.User, error) {
+	members := []*gocloak.User{}
+	for id, groups := range m.groups {
+		if slices.Contains(groups, path) {
+			members = append(members, m.users[id])
+		}
+	}
+	return members, nil
+}
+
+func (m *mockDirectory) GetOrCreateUser(email string) (string, bool, error) {
+	if user, err := m.GetUserByEmail(email); err == nil {
+		return *user.ID, false, nil
+	}
+	id := fmt.Sprintf("created-%d", len(m.users))
+	m.addUser(id, email)
+	return id, true, nil
+}
+
+func (m *mockDirectory) GetOrCreateVendor(email string) (string, error) {
+	id, _, err := m.GetOrCreateUser(email)
+	return id, err
+}
+
+func (m *mockDirectory) AssignGroup(userID string, groupName string) error {
+	m.groups[userID] = append(m.groups[userID], "/"+groupName)
+	return nil
+}
+
+func (m *mockDirectory) UnassignGroup(userID string, groupName string) error {
+	m.groups[userID] = slices.DeleteFunc(m.groups[userID], func(g string) bool { return g == groupName })
+	return nil
+}
+
+func (m *mockDirectory) AssignDigitalLicenseGroup(userID string, licenseGroup string) error {
+	m.groups[userID] = append(m.groups[userID], m.LicenseGroupPath(licenseGroup))
+	return nil
+}
+
+func (m *mockDirectory) UnassignDigitalLicenseGroup(userID string, licenseGroup string) error {
+	return m.UnassignGroup(userID, m.LicenseGroupPath(licenseGroup))
+}
+
+func (m *mockDirectory) LicenseGroupPath(licenseGroup string) string {
+	return "/customer/newspapers/" + licenseGroup
+}
+
+func (m *mockDirectory) GetCustomerGroup() string { return "customer" }
+
+func (m *mockDirectory) GetVendorGroup() string { return "vendors" }
+
+func mismatchKinds(report KeycloakReconciliation) []string {
+	kinds := []string{}
+	for _, m := range report.Mismatches {
+		kinds = append(kinds, m.Kind+":"+m.Email+":"+m.LicenseGroup)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+func TestCompareLicenseGroups(t *testing.T) {
+	missing, extra := compareLicenseGroups([]string{"digital", "print", "", "print"}, []string{"archive", "digital"})
+	require.Equal(t, []string{"print"}, missing)
+	require.Equal(t, []string{"archive"}, extra)
+
+	missing, extra = compareLicenseGroups(nil, nil)
+	require.Empty(t, missing)
+	require.Empty(t, extra)
+}
+
+func TestParseReconcileDirection(t *testing.T) {
+	for _, s := range []string{"", "keycloak", " Database "} {
+		_, err := ParseReconcileDirection(s)
+		require.NoError(t, err, s)
+	}
+	_, err := ParseReconcileDirection("both")
+	require.ErrorIs(t, err, ErrInvalidReconcileDirection)
+}
+
+func TestKeycloakNotFound(t *testing.T) {
+	require.True(t, keycloakNotFound(gocloak.APIError{Code: 404}))
+	require.True(t, keycloakNotFound(fmt.Errorf("get user: %w", &gocloak.APIError{Code: 404})))
+	require.False(t, keycloakNotFound(&gocloak.APIError{Code: 500}))
+	require.False(t, keycloakNotFound(fmt.Errorf("connection refused")))
+}
+
+func TestKeycloakReconciliation(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+
+	directory := newMockDirectory()
+	directory.addUser("kc-anna", "anna@example.com", "/customer", "/customer/newspapers/digital", "/customer/newspapers/archive")
+	directory.addUser("kc-ben", "ben@example.com")
+	directory.addUser("kc-orphan", "orphan@example.com", "/customer/newspapers/digital")
+	directory.addUser("kc-vendor", "vendor@example.com")
+
+	anna, err := Db.CreateCustomer(&Customer{KeycloakID: "kc-anna", Email: "anna@example.com", LicenseGroups: []string{"digital", "print"}})
+	require.NoError(t, err)
+	// Ben's Keycloak ID is stale, the user is found by email
+	ben, err := Db.CreateCustomer(&Customer{KeycloakID: "kc-gone", Email: "ben@example.com", LicenseGroups: []string{}})
+	require.NoError(t, err)
+	_, err = Db.CreateCustomer(&Customer{Email: "carl@example.com", LicenseGroups: []string{"digital"}})
+	require.NoError(t, err)
+	vendorID, err := Db.CreateVendor(Vendor{LicenseID: null.StringFrom("reconcile"), Email: "vendor@example.com", KeycloakID: "kc-vendor"})
+	require.NoError(t, err)
+
+	reconciler := &KeycloakReconciler{db: &Db, directory: directory}
+
+	// Only report
+	report, err := reconciler.Reconcile(t.Context(), ReconcileReport, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"extra_license_group:anna@example.com:archive",
+		"missing_license_group:anna@example.com:print",
+		"missing_user:carl@example.com:",
+		"missing_vendor_group:vendor@example.com:",
+		"orphaned_user:orphan@example.com:digital",
+		"unlinked_user:ben@example.com:",
+	}, mismatchKinds(report))
+	require.Zero(t, report.Repaired)
+	for _, m := range report.Mismatches {
+		require.Empty(t, m.Repair)
+	}
+
+	// A dry run plans the repairs without applying them
+	report, err = reconciler.Reconcile(t.Context(), ReconcileToKeycloak, true)
+	require.NoError(t, err)
+	require.Len(t, report.Mismatches, 6)
+	require.Zero(t, report.Repaired)
+	groups, _ := directory.GetUserLicenseGroups("kc-anna")
+	require.ElementsMatch(t, []string{"digital", "archive"}, groups)
+
+	// Removals beyond the limit are skipped
+	reconciler.MaxRemovals = 1
+	report, err = reconciler.Reconcile(t.Context(), ReconcileToKeycloak, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, report.Skipped)
+	groups, _ = directory.GetUserLicenseGroups("kc-anna")
+	require.NotContains(t, groups, "archive")
+	require.Contains(t, directory.groups["kc-orphan"], "/customer/newspapers/digital")
+
+	// Repair Keycloak
+	reconciler.MaxRemovals = 0
+	report, err = reconciler.Reconcile(t.Context(), ReconcileToKeycloak, false)
+	require.NoError(t, err)
+	require.Zero(t, report.Failed)
+	groups, _ = directory.GetUserLicenseGroups("kc-anna")
+	require.ElementsMatch(t, []string{"digital", "print"}, groups)
+	require.NotContains(t, directory.groups["kc-orphan"], "/customer/newspapers/digital")
+	require.Contains(t, directory.groups["kc-vendor"], "/vendors")
+	updatedBen, err := Db.GetCustomerByID(ben.ID)
+	require.NoError(t, err)
+	require.Equal(t, "kc-ben", updatedBen.KeycloakID)
+	carl, err := Db.GetCustomerByEmail("carl@example.com")
+	require.NoError(t, err)
+	require.NotEmpty(t, carl.KeycloakID)
+	groups, _ = directory.GetUserLicenseGroups(carl.KeycloakID)
+	require.Equal(t, []string{"digital"}, groups)
+	vendor, err := Db.GetVendor(vendorID)
+	require.NoError(t, err)
+	require.Equal(t, "kc-vendor", vendor.KeycloakID)
+
+	report, err = reconciler.Reconcile(t.Context(), ReconcileReport, false)
+	require.NoError(t, err)
+	require.Empty(t, report.Mismatches)
+
+	// Repair the database from Keycloak
+	directory.groups["kc-anna"] = []string{"/customer/newspapers/archive"}
+	directory.addUser("kc-dora", "dora@example.com", "/customer", "/customer/newspapers/digital")
+	report, err = reconciler.Reconcile(t.Context(), ReconcileToDatabase, false)
+	require.NoError(t, err)
+	require.Zero(t, report.Failed)
+	updatedAnna, err := Db.GetCustomerByID(anna.ID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"archive"}, updatedAnna.LicenseGroups)
+	dora, err := Db.GetCustomerByKeycloakID("kc-dora")
+	require.NoError(t, err)
+	require.Equal(t, []string{"digital"}, dora.LicenseGroups)
+
+	report, err = reconciler.Reconcile(t.Context(), ReconcileReport, false)
+	require.NoError(t, err)
+	require.Empty(t, report.Mismatches)
+}
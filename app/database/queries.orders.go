@@ -214,6 +214,18 @@ func (db *Database) CreateOrder(o Order) (orderID int, err error) {
 	if o.CustomerEmail.Valid {
 		tCreate.SetCustomerEmail(o.CustomerEmail.String)
 	}
+	if o.Gift {
+		tCreate.SetGift(true).
+			SetNillableGiftRecipientEmail(o.GiftRecipientEmail.Ptr()).
+			SetNillableGiftSendAt(o.GiftSendAt.Ptr()).
+			SetGiftMessage(o.GiftMessage)
+	}
+	if o.BillingAddress != nil {
+		tCreate.SetBillingAddress(o.BillingAddress)
+	}
+	if o.AutoRenew {
+		tCreate.SetAutoRenew(true)
+	}
 
 	oRes, err := tCreate.Save(context.Background())
 	if err != nil {
@@ -259,6 +271,20 @@ func createOrderEntryTx(tx *ent.Tx, orderID int, entry OrderEntry) (OrderEntry,
 	}
 	entry.Price = int(math.Round(itemRes.Price))
 
+	// Record the taxes at the time of purchase
+	defaultRate, err := defaultVATRateTx(tx)
+	if err != nil {
+		log.Error("createOrderEntryTx: query default VAT rate ", err)
+		return entry, err
+	}
+	// Discounts have no rate of their own, the caller sets the rate of the
+	// entries they discount, see DiscountCode.DiscountShares
+	if itemRes.Type != "discount" {
+		entry.VATRate = itemVATRate(Item{Type: itemRes.Type, VATRate: null.FloatFromPtr(itemRes.VATRate)}, defaultRate)
+	}
+	entry.Gross = entry.Price * entry.Quantity
+	entry.Net, entry.Tax = splitVAT(entry.Gross, entry.VATRate)
+
 	// Create order entry
 	oeRes, err := tx.OrderEntry.Create().
 		SetItemID(entry.Item).
@@ -268,6 +294,10 @@ func createOrderEntryTx(tx *ent.Tx, orderID int, entry OrderEntry) (OrderEntry,
 		SetSenderID(entry.Sender).
 		SetReceiverID(entry.Receiver).
 		SetIsSale(entry.IsSale).
+		SetVatRate(entry.VATRate).
+		SetNet(entry.Net).
+		SetTax(entry.Tax).
+		SetGross(entry.Gross).
 		Save(context.Background())
 
 	if err != nil {
@@ -313,18 +343,43 @@ func createPaymentForOrderEntryTx(tx *ent.Tx, orderID int, entry OrderEntry, err
 
 // SetOrderTransactionID sets the transaction ID for an order
 func (db *Database) SetOrderTransactionID(orderID int, transactionID string) (err error) {
-	err = db.EntClient.Order.UpdateOneID(orderID).
-		SetTransactionID(transactionID).
-		Exec(context.Background())
-	if err != nil {
-		log.Error("SetOrderTransactionID: ", err)
-	}
-	return
+	return db.inTx(func(txDb *Database) error {
+		var before string
+		if txDb.auditing() {
+			before, _ = txDb.EntClient.Order.Query().
+				Where(order.ID(orderID)).
+				Select(order.FieldTransactionID).
+				String(context.Background())
+		}
+		err := txDb.EntClient.Order.UpdateOneID(orderID).
+			SetTransactionID(transactionID).
+			Exec(context.Background())
+		if err != nil {
+			log.Error("SetOrderTransactionID: ", err)
+			return err
+		}
+		return txDb.audit(AuditOrderTransactionID, "order", orderID,
+			map[string]any{"transaction_id": before},
+			map[string]any{"transaction_id": transactionID})
+	})
 }
 
 // VerifyOrderAndCreatePayments sets payment order to verified and creates a payment for each order entry if it doesn't already exist
 // This means if some payments have already been created with CreatePayedOrderEntries before verifying the order, they will be skipped
 func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID int) (err error) {
+	return db.verifyOrder(orderID, transactionTypeID, true)
+}
+
+// VerifyRenewalOrder verifies an order billing the renewal of an abonement
+// and creates its payments. The items are not granted again, the caller
+// extends the abonement instead.
+func (db *Database) VerifyRenewalOrder(orderID int) error {
+	return db.verifyOrder(orderID, 0, false)
+}
+
+// verifyOrder verifies an order, grants its items to the customer if fulfil
+// is set and creates the payments
+func (db *Database) verifyOrder(orderID int, transactionTypeID int, fulfil bool) (err error) {
 	// Acquire per-order lock to serialize concurrent verification attempts
 	// and prevent duplicate payment creation.
 	unlock := lockOrder(orderID)
@@ -349,7 +404,6 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 		log.Error("VerifyOrderAndCreatePayments: read payment order verified flag", orderID, err)
 		// continue — we'll still try to update and proceed
 	}
-
 	// Verify payment order
 	err = tx.Order.UpdateOneID(orderID).
 		SetVerified(true).
@@ -360,6 +414,12 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 	if err != nil {
 		log.Error("VerifyOrderAndCreatePayments: update payment order", orderID, err)
 	}
+	if !alreadyVerified {
+		if err = reclaimDiscountCodesTx(context.Background(), tx, orderID); err != nil {
+			log.Error("VerifyOrderAndCreatePayments: reclaim discount codes", orderID, err)
+			return err
+		}
+	}
 
 	// Get Paymentorder (including payments)
 	o, err := db.GetOrderByIDTx(tx, orderID)
@@ -368,13 +428,18 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 		return err
 	}
 
-	if !alreadyVerified && o.CustomerEmail.Valid && o.CustomerEmail.String != "" {
+	// Abonements and entitlements are written in the transaction of the
+	// order and projected to Keycloak after the commit
+	txDb := db.withTx(tx)
+	entitledCustomer := 0
+
+	if fulfil && !alreadyVerified && o.CustomerEmail.Valid && o.CustomerEmail.String != "" {
 
 		// We may have multiple order entries for the same order. To avoid
-		// assigning groups and sending the same email multiple times for the
-		// same customer/order, collect unique license groups and perform
-		// assignments and sends once per relevant unit.
-		processedLicenseGroups := make(map[string]bool)
+		// sending the same email multiple times for the same customer/order,
+		// sends happen once per relevant unit. Entitlements are granted per
+		// item and projected to Keycloak once after the commit.
+		entitled := false
 		var customerID string
 		var newUser bool
 		var dbCustomer *Customer
@@ -382,6 +447,7 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 		customerAssigned := false
 		sentDigitalLicenceEmail := false
 		inviteURL := ""
+		giftCodes := []map[string]interface{}{}
 
 		// Load settings once to read WordPress invite config.
 		wpSettings, wpSettingsErr := db.GetSettings()
@@ -397,7 +463,10 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 
 			if item.LicenseItem.Valid || item.Type == "abonement" {
 
-				if !item.IsPDFItem {
+				if !item.IsPDFItem && o.Gift {
+					// Gifts are granted to whoever redeems their codes
+					giftCodes = append(giftCodes, db.createGiftCodesForEntry(o, entry, item)...)
+				} else if !item.IsPDFItem {
 					// Ensure we only call GetOrCreateUser once per order/customer
 					if !gotCustomer {
 						customerID, newUser, err = keycloak.KeycloakClient.GetOrCreateUser(o.CustomerEmail.String)
@@ -434,34 +503,42 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 						customerAssigned = true
 					}
 
-					// Assign to license subgroup once per unique license group
+					// Entitle the customer to the item. Abonements are
+					// entitled through their abonement record below.
 					lg := item.LicenseGroup.String
-					if gotCustomer && lg != "" && !processedLicenseGroups[lg] {
-						err = keycloak.KeycloakClient.AssignDigitalLicenseGroup(customerID, lg)
+					if dbCustomer != nil && item.Type != "abonement" {
+						_, err = txDb.GrantEntitlement(Entitlement{
+							CustomerID:   dbCustomer.ID,
+							ItemID:       null.IntFrom(int64(item.ID)),
+							LicenseGroup: lg,
+							Source:       EntitlementSourceOrder,
+							OrderID:      null.IntFrom(int64(orderID)),
+							ValidFrom:    o.Timestamp,
+						})
 						if err != nil {
-							log.Error("VerifyOrderAndCreatePayments: failed to assign customer to license group: ", orderID, err)
+							log.Error("VerifyOrderAndCreatePayments: failed to grant entitlement: ", orderID, err)
 						}
-						if dbCustomer != nil {
-							_, err = db.AddLicenseGroupToCustomer(dbCustomer.ID, lg)
-							if err != nil {
-								log.Error("VerifyOrderAndCreatePayments: failed to add license group to customer db record: ", orderID, err)
-							}
-						}
-						processedLicenseGroups[lg] = true
+						entitled = true
 					}
 
 					// When an abonement is sold, create an Abonement DB record.
 					if item.Type == "abonement" && gotCustomer && dbCustomer != nil {
-						createdAbo, createAboErr := db.CreateAbonement(&Abonement{
+						createdAbo, createAboErr := txDb.CreateAbonement(&Abonement{
 							CustomerID: dbCustomer.ID,
 							ItemID:     item.ID,
 							FromDate:   o.Timestamp,
 							ToDate:     o.Timestamp.AddDate(1, 0, 0),
-							Status:     "active",
+							Status:     AbonementStatusActive,
+							OrderID:    orderID,
 						})
 						if createAboErr != nil {
 							log.Error("VerifyOrderAndCreatePayments: failed to create abonement record: ", orderID, createAboErr)
-						} else if dbCustomer.Email != "" {
+						} else if syncErr := txDb.SyncAbonementEntitlement(createdAbo); syncErr != nil {
+							log.Error("VerifyOrderAndCreatePayments: failed to grant abonement entitlement: ", orderID, syncErr)
+						} else {
+							entitled = true
+						}
+						if createAboErr == nil && dbCustomer.Email != "" {
 							aboTemplateData := map[string]interface{}{
 								"CustomerName": dbCustomer.FirstName + " " + dbCustomer.LastName,
 								"ItemName":     item.Name,
@@ -484,21 +561,9 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 						}
 						// Also grant access to the currently published online_issue for the
 						// same license group so the customer can read it immediately.
-						if lg != "" {
-							latestIssue, found, issueErr := db.GetLatestPublishedOnlineIssueByLicenseGroup(lg)
-							if issueErr != nil {
-								log.Error("VerifyOrderAndCreatePayments: failed to look up latest online_issue: ", orderID, issueErr)
-							} else if found && latestIssue.LicenseGroup.Valid && latestIssue.LicenseGroup.String != "" {
-								issueLg := latestIssue.LicenseGroup.String
-								if !processedLicenseGroups[issueLg] {
-									if assignErr := keycloak.KeycloakClient.AssignDigitalLicenseGroup(customerID, issueLg); assignErr != nil {
-										log.Error("VerifyOrderAndCreatePayments: failed to assign online_issue license group: ", orderID, assignErr)
-									}
-									if _, addErr := db.AddLicenseGroupToCustomer(dbCustomer.ID, issueLg); addErr != nil {
-										log.Error("VerifyOrderAndCreatePayments: failed to add online_issue license group to customer: ", orderID, addErr)
-									}
-									processedLicenseGroups[issueLg] = true
-								}
+						if lg != "" && createAboErr == nil {
+							if grantErr := txDb.grantLatestOnlineIssue(createdAbo, lg, null.IntFrom(int64(orderID)), o.Timestamp); grantErr != nil {
+								log.Error("VerifyOrderAndCreatePayments: failed to grant online_issue entitlement: ", orderID, grantErr)
 							}
 						}
 					}
@@ -580,7 +645,7 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 						}
 					}
 					if !pdfDownload.EmailSent {
-						url := config.Config.FrontendURL + "/pdf/" + pdfDownload.LinkID
+						url := PDFDownloadURL(pdfDownload.LinkID)
 						templateData := struct {
 							URL   string
 							EMAIL string
@@ -615,6 +680,14 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 
 			}
 		}
+
+		if len(giftCodes) > 0 {
+			db.sendGiftPurchasedMail(o, giftCodes)
+		}
+
+		if entitled {
+			entitledCustomer = dbCustomer.ID
+		}
 	}
 	log.Info("VerifyOrderAndCreatePayments: Creating payments for order ", orderID)
 	// Create payments
@@ -626,7 +699,28 @@ func (db *Database) VerifyOrderAndCreatePayments(orderID int, transactionTypeID
 		}
 	}
 
-	return tx.Commit()
+	if !alreadyVerified {
+		err = txDb.audit(AuditOrderVerify, "order", orderID,
+			map[string]any{"verified": false},
+			map[string]any{"verified": true, "transaction_type_id": transactionTypeID})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	// Keycloak license groups are a projection of the entitlements
+	if entitledCustomer > 0 {
+		if err := NewAbonementService(db).ProjectEntitlements(entitledCustomer, time.Now()); err != nil {
+			log.Error("VerifyOrderAndCreatePayments: failed to project entitlements to keycloak: ", orderID, err)
+		}
+	}
+	if !alreadyVerified && o.CustomerEmail.Valid && o.CustomerEmail.String != "" {
+		db.issueOrderInvoice(orderID)
+	}
+	return nil
 }
 
 // CreatePayedOrderEntries creates entries with a payment for an order
@@ -670,6 +764,10 @@ func convertOrderEntry(e *ent.OrderEntry) OrderEntry {
 		Sender:   e.SenderID,
 		Receiver: e.ReceiverID,
 		IsSale:   e.IsSale,
+		VATRate:  e.VatRate,
+		Net:      e.Net,
+		Tax:      e.Tax,
+		Gross:    e.Gross,
 	}
 	if e.Edges.Sender != nil {
 		oe.SenderName = e.Edges.Sender.Name
@@ -682,12 +780,18 @@ func convertOrderEntry(e *ent.OrderEntry) OrderEntry {
 
 func convertOrder(e *ent.Order) Order {
 	o := Order{
-		ID:                e.ID,
-		TransactionID:     e.TransactionID,
-		Verified:          e.Verified,
-		TransactionTypeID: e.TransactionTypeID,
-		Timestamp:         e.Timestamp,
-		Vendor:            e.VendorID,
+		ID:                 e.ID,
+		TransactionID:      e.TransactionID,
+		Verified:           e.Verified,
+		TransactionTypeID:  e.TransactionTypeID,
+		Timestamp:          e.Timestamp,
+		Vendor:             e.VendorID,
+		Gift:               e.Gift,
+		GiftRecipientEmail: null.StringFromPtr(e.GiftRecipientEmail),
+		GiftSendAt:         null.TimeFromPtr(e.GiftSendAt),
+		GiftMessage:        e.GiftMessage,
+		BillingAddress:     e.BillingAddress,
+		AutoRenew:          e.AutoRenew,
 	}
 	if e.OrderCode != nil {
 		o.OrderCode = null.StringFrom(*e.OrderCode)
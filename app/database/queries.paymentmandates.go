@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entabonement "github.com/augustin-wien/augustina-backend/ent/abonement"
+	entpaymentmandate "github.com/augustin-wien/augustina-backend/ent/paymentmandate"
+	"gopkg.in/guregu/null.v4"
+)
+
+// PaymentProviderVivaWallet identifies mandates charged through VivaWallet
+const PaymentProviderVivaWallet = "vivawallet"
+
+// PaymentMandate allows charging a customer again through the payment
+// provider that processed their first purchase. For VivaWallet the token is
+// the ID of the initial transaction.
+type PaymentMandate struct {
+	ID         int       `json:"id"`
+	CustomerID int       `json:"customer_id"`
+	Provider   string    `json:"provider"`
+	Token      string    `json:"-"`
+	CardNumber string    `json:"card_number"`
+	OrderID    null.Int  `json:"order_id" swaggertype:"integer"`
+	CreatedAt  time.Time `json:"created_at"`
+	RevokedAt  null.Time `json:"revoked_at" swaggertype:"string" format:"date-time"`
+}
+
+// PaymentMandateEntIntoPaymentMandate converts an ent.PaymentMandate to
+// PaymentMandate struct
+func PaymentMandateEntIntoPaymentMandate(m *ent.PaymentMandate) PaymentMandate {
+	return PaymentMandate{
+		ID:         m.ID,
+		CustomerID: m.CustomerID,
+		Provider:   m.Provider,
+		Token:      m.Token,
+		CardNumber: m.CardNumber,
+		OrderID:    null.IntFromPtr(intPtrToInt64Ptr(m.OrderID)),
+		CreatedAt:  m.CreatedAt,
+		RevokedAt:  null.TimeFromPtr(m.RevokedAt),
+	}
+}
+
+// GetPaymentMandate retrieves a payment mandate by ID
+func (db *Database) GetPaymentMandate(id int) (PaymentMandate, error) {
+	m, err := db.EntClient.PaymentMandate.Get(context.Background(), id)
+	if err != nil {
+		return PaymentMandate{}, err
+	}
+	return PaymentMandateEntIntoPaymentMandate(m), nil
+}
+
+// AttachPaymentMandateToOrder stores mandate for the abonements bought with
+// an order and turns on their automatic renewal. Orders without abonements
+// or whose buyer did not agree to automatic renewal get no mandate and nil
+// is returned. Attaching the same token again reuses
+// the stored mandate, so payment webhooks can safely be retried.
+func (db *Database) AttachPaymentMandateToOrder(orderID int, mandate PaymentMandate) (*PaymentMandate, error) {
+	ctx := context.Background()
+	order, err := db.EntClient.Order.Get(ctx, orderID)
+	if err != nil {
+		log.Error("AttachPaymentMandateToOrder: ", err)
+		return nil, err
+	}
+	if !order.AutoRenew {
+		return nil, nil
+	}
+	abonements, err := db.EntClient.Abonement.Query().
+		Where(entabonement.OrderID(orderID)).
+		All(ctx)
+	if err != nil {
+		log.Error("AttachPaymentMandateToOrder: ", err)
+		return nil, err
+	}
+	if len(abonements) == 0 {
+		return nil, nil
+	}
+
+	m, err := db.EntClient.PaymentMandate.Query().
+		Where(
+			entpaymentmandate.Provider(mandate.Provider),
+			entpaymentmandate.Token(mandate.Token),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		m, err = db.EntClient.PaymentMandate.Create().
+			SetCustomerID(abonements[0].CustomerID).
+			SetProvider(mandate.Provider).
+			SetToken(mandate.Token).
+			SetCardNumber(mandate.CardNumber).
+			SetOrderID(orderID).
+			SetCreatedAt(time.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		log.Error("AttachPaymentMandateToOrder: ", err)
+		return nil, err
+	}
+
+	_, err = db.EntClient.Abonement.Update().
+		Where(entabonement.OrderID(orderID), entabonement.PaymentMandateIDIsNil()).
+		SetPaymentMandateID(m.ID).
+		SetAutoRenew(true).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		log.Error("AttachPaymentMandateToOrder: ", err)
+		return nil, err
+	}
+	result := PaymentMandateEntIntoPaymentMandate(m)
+	return &result, nil
+}
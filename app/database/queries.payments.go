@@ -432,20 +432,28 @@ func (db *Database) CreatePaymentPayout(vendor Vendor, vendorAccountID int, auth
 		}
 	}
 
-	err = tx.Commit()
+	// Update last payout date and record the payout within the transaction
+	txDb := db.withTx(tx)
+	vendor.LastPayout = null.NewTime(time.Now(), true)
+	err = txDb.UpdateVendor(vendor.ID, vendor)
 	if err != nil {
+		log.Error("CreatePaymentPayout: ", err)
 		return 0, err
 	}
-
-	// Update last payout date - outside transaction as UpdateVendor likely uses separate connection
-	// Ideally should be inside, but for now strict replacement.
-	vendor.LastPayout = null.NewTime(time.Now(), true)
-	err = db.UpdateVendor(vendor.ID, vendor)
+	err = txDb.audit(AuditPaymentPayout, "payment", paymentID, nil, map[string]any{
+		"vendor":        vendor.LicenseID.String,
+		"amount":        amount,
+		"payments":      len(payments),
+		"authorized_by": authorizedBy,
+	})
 	if err != nil {
-		log.Error("CreatePaymentPayout: ", err)
-		return
+		return 0, err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
 	return
 }
 
@@ -0,0 +1,463 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/ent"
+	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+	entorder "github.com/augustin-wien/augustina-backend/ent/order"
+	entpdfdownload "github.com/augustin-wien/augustina-backend/ent/pdfdownload"
+	entpdfdownloadaccess "github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/notifications"
+	"github.com/google/uuid"
+	"gopkg.in/guregu/null.v4"
+)
+
+var (
+	ErrPDFDownloadExpired      = errors.New("pdf is expired")
+	ErrPDFDownloadLimitReached = errors.New("download limit reached")
+	ErrPDFDownloadSuspended    = errors.New("download link is suspended")
+)
+
+// Reasons for suspending a download link
+const (
+	PDFDownloadSuspendTooManyIPs = "too many distinct IP addresses"
+	PDFDownloadSuspendReissued   = "reissued"
+)
+
+// notifyPDFDownloadSuspended informs the admins about an automatic
+// suspension. Tests can override it.
+var notifyPDFDownloadSuspended = func(subject, message string) {
+	if notifications.NotificationsClient.Client == nil {
+		return
+	}
+	go notifications.NotificationsClient.SendNotification(subject, message)
+}
+
+// PDFDownloadPolicy holds the limits that apply to a download link.
+// A value of 0 disables the respective limit.
+type PDFDownloadPolicy struct {
+	Limit      int `json:"limit"`       // Maximum number of downloads
+	ExpiryDays int `json:"expiry_days"` // Days the link is valid after its creation
+	MaxIPs     int `json:"max_ips"`     // Distinct IP addresses before the link is suspended
+}
+
+// Check returns why the download is not allowed at the given time, if so
+func (p PDFDownloadPolicy) Check(download PDFDownload, now time.Time) error {
+	if download.Suspended {
+		return ErrPDFDownloadSuspended
+	}
+	if p.ExpiryDays > 0 && now.After(download.Timestamp.AddDate(0, 0, p.ExpiryDays)) {
+		return ErrPDFDownloadExpired
+	}
+	if p.Limit > 0 && download.DownloadCount >= p.Limit {
+		return ErrPDFDownloadLimitReached
+	}
+	return nil
+}
+
+// ExpiresAt returns when the download expires, zero if it never does
+func (p PDFDownloadPolicy) ExpiresAt(download PDFDownload) time.Time {
+	if p.ExpiryDays <= 0 {
+		return time.Time{}
+	}
+	return download.Timestamp.AddDate(0, 0, p.ExpiryDays)
+}
+
+// PDFDownloadAccess is a logged request to a download link
+type PDFDownloadAccess struct {
+	ID        int       `json:"id"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"user_agent"`
+	Allowed   bool      `json:"allowed"`
+	Reason    string    `json:"reason,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PDFDownloadAccessEntIntoPDFDownloadAccess converts an ent.PDFDownloadAccess to PDFDownloadAccess struct
+func PDFDownloadAccessEntIntoPDFDownloadAccess(a *ent.PDFDownloadAccess) PDFDownloadAccess {
+	return PDFDownloadAccess{
+		ID:        a.ID,
+		IP:        a.IP,
+		UserAgent: a.UserAgent,
+		Allowed:   a.Allowed,
+		Reason:    a.Reason,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+// PDFDownloadDetails is the admin view of a download link
+type PDFDownloadDetails struct {
+	Download    PDFDownload         `json:"download"`
+	Policy      PDFDownloadPolicy   `json:"policy"`
+	ExpiresAt   null.Time           `json:"expires_at" swaggertype:"string"`
+	DistinctIPs int                 `json:"distinct_ips"`
+	Accesses    []PDFDownloadAccess `json:"accesses"`
+}
+
+// pdfDownloadPolicyTx returns the settings, overridden by the item if set
+func pdfDownloadPolicyTx(tx *ent.Tx, itemID null.Int) (policy PDFDownloadPolicy, err error) {
+	ctx := context.Background()
+	settings, err := tx.Settings.Query().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return policy, err
+	}
+	if settings != nil {
+		policy = PDFDownloadPolicy{
+			Limit:      settings.PDFDownloadLimit,
+			ExpiryDays: settings.PDFDownloadExpiryDays,
+			MaxIPs:     settings.PDFDownloadMaxIPs,
+		}
+	}
+	if !itemID.Valid {
+		return policy, nil
+	}
+	item, err := tx.Item.Query().Where(entitem.ID(int(itemID.Int64))).Only(ctx)
+	if ent.IsNotFound(err) {
+		return policy, nil
+	}
+	if err != nil {
+		return policy, err
+	}
+	if item.PDFDownloadLimit != nil {
+		policy.Limit = *item.PDFDownloadLimit
+	}
+	if item.PDFDownloadExpiryDays != nil {
+		policy.ExpiryDays = *item.PDFDownloadExpiryDays
+	}
+	return policy, nil
+}
+
+// GetPDFDownloadPolicy returns the limits that apply to downloads of an item
+func (db *Database) GetPDFDownloadPolicy(itemID null.Int) (PDFDownloadPolicy, error) {
+	tx, err := db.EntClient.Tx(context.Background())
+	if err != nil {
+		log.Error("GetPDFDownloadPolicy: ", err)
+		return PDFDownloadPolicy{}, err
+	}
+	defer tx.Rollback()
+	policy, err := pdfDownloadPolicyTx(tx, itemID)
+	if err != nil {
+		log.Error("GetPDFDownloadPolicy: ", err)
+	}
+	return policy, err
+}
+
+// ValidatePDFDownload checks a link without counting a download
+func (db *Database) ValidatePDFDownload(linkID string) (PDFDownload, error) {
+	download, err := db.GetPDFDownload(linkID)
+	if err != nil {
+		return download, err
+	}
+	policy, err := db.GetPDFDownloadPolicy(download.ItemID)
+	if err != nil {
+		return download, err
+	}
+	return download, policy.Check(download, time.Now())
+}
+
+// RegisterPDFDownload counts a download of the link if it is allowed and
+// logs the request. A link that is used from more distinct IP addresses
+// than allowed is suspended. Policy violations are returned as
+// ErrPDFDownloadExpired, ErrPDFDownloadLimitReached or
+// ErrPDFDownloadSuspended, the request is logged in any case.
+func (db *Database) RegisterPDFDownload(linkID, ip, userAgent string) (download PDFDownload, err error) {
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("RegisterPDFDownload: ", err)
+		return download, err
+	}
+	defer tx.Rollback()
+
+	entDownload, err := tx.PDFDownload.Query().Where(entpdfdownload.LinkID(linkID)).Only(ctx)
+	if err != nil {
+		return download, err
+	}
+	download = db.PDFDownloadEntIntoPDFDownload(entDownload)
+	policy, err := pdfDownloadPolicyTx(tx, download.ItemID)
+	if err != nil {
+		log.Error("RegisterPDFDownload: ", err)
+		return download, err
+	}
+
+	now := time.Now()
+	denied := policy.Check(download, now)
+	suspended := false
+	if denied == nil && policy.MaxIPs > 0 && ip != "" {
+		ips, err := tx.PDFDownloadAccess.Query().
+			Where(
+				entpdfdownloadaccess.PdfDownloadID(download.ID),
+				entpdfdownloadaccess.Allowed(true),
+			).
+			Unique(true).
+			Select(entpdfdownloadaccess.FieldIP).
+			Strings(ctx)
+		if err != nil {
+			log.Error("RegisterPDFDownload: ", err)
+			return download, err
+		}
+		known := false
+		for _, knownIP := range ips {
+			if knownIP == ip {
+				known = true
+				break
+			}
+		}
+		if !known && len(ips) >= policy.MaxIPs {
+			n, err := tx.PDFDownload.Update().
+				Where(entpdfdownload.ID(download.ID), entpdfdownload.Suspended(false)).
+				SetSuspended(true).
+				SetSuspendedAt(now).
+				SetSuspendReason(PDFDownloadSuspendTooManyIPs).
+				Save(ctx)
+			if err != nil {
+				log.Error("RegisterPDFDownload: ", err)
+				return download, err
+			}
+			suspended = n > 0
+			download.Suspended = true
+			download.SuspendedAt = null.TimeFrom(now)
+			download.SuspendReason = PDFDownloadSuspendTooManyIPs
+			denied = ErrPDFDownloadSuspended
+		}
+	}
+
+	if denied == nil {
+		// The conditions guard against concurrent downloads of the same link
+		update := tx.PDFDownload.Update().
+			Where(entpdfdownload.ID(download.ID), entpdfdownload.Suspended(false))
+		if policy.Limit > 0 {
+			update = update.Where(entpdfdownload.DownloadCountLT(policy.Limit))
+		}
+		n, err := update.AddDownloadCount(1).SetLastDownload(now).Save(ctx)
+		if err != nil {
+			log.Error("RegisterPDFDownload: ", err)
+			return download, err
+		}
+		if n == 0 {
+			denied = ErrPDFDownloadLimitReached
+		} else {
+			download.DownloadCount++
+			download.LastDownload = now
+		}
+	}
+
+	reason := ""
+	if denied != nil {
+		reason = denied.Error()
+	}
+	_, err = tx.PDFDownloadAccess.Create().
+		SetPdfDownloadID(download.ID).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetAllowed(denied == nil).
+		SetReason(reason).
+		SetCreatedAt(now).
+		Save(ctx)
+	if err != nil {
+		log.Error("RegisterPDFDownload: ", err)
+		return download, err
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("RegisterPDFDownload: ", err)
+		return download, err
+	}
+
+	if suspended {
+		notifyPDFDownloadSuspended(
+			"PDF download link suspended",
+			fmt.Sprintf("The download link %s was used from more than %d distinct IP addresses and has been suspended.", download.LinkID, policy.MaxIPs),
+		)
+	}
+	return download, denied
+}
+
+// ListPDFDownloads returns download links filtered by order and item,
+// newest first. Zero values disable the filters.
+func (db *Database) ListPDFDownloads(orderID, itemID int, suspendedOnly bool) ([]PDFDownload, error) {
+	query := db.EntClient.PDFDownload.Query()
+	if orderID > 0 {
+		query = query.Where(entpdfdownload.OrderID(orderID))
+	}
+	if itemID > 0 {
+		query = query.Where(entpdfdownload.ItemID(itemID))
+	}
+	if suspendedOnly {
+		query = query.Where(entpdfdownload.Suspended(true))
+	}
+	res, err := query.Order(ent.Desc(entpdfdownload.FieldID)).All(context.Background())
+	if err != nil {
+		log.Error("ListPDFDownloads: ", err)
+		return nil, err
+	}
+	downloads := make([]PDFDownload, 0, len(res))
+	for _, d := range res {
+		downloads = append(downloads, db.PDFDownloadEntIntoPDFDownload(d))
+	}
+	return downloads, nil
+}
+
+// GetPDFDownloadDetails returns a download link with its limits and access log
+func (db *Database) GetPDFDownloadDetails(linkID string) (details PDFDownloadDetails, err error) {
+	ctx := context.Background()
+	entDownload, err := db.EntClient.PDFDownload.Query().Where(entpdfdownload.LinkID(linkID)).Only(ctx)
+	if err != nil {
+		return details, err
+	}
+	details.Download = db.PDFDownloadEntIntoPDFDownload(entDownload)
+	details.Policy, err = db.GetPDFDownloadPolicy(details.Download.ItemID)
+	if err != nil {
+		return details, err
+	}
+	if expires := details.Policy.ExpiresAt(details.Download); !expires.IsZero() {
+		details.ExpiresAt = null.TimeFrom(expires)
+	}
+	accesses, err := db.EntClient.PDFDownloadAccess.Query().
+		Where(entpdfdownloadaccess.PdfDownloadID(entDownload.ID)).
+		Order(ent.Desc(entpdfdownloadaccess.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		log.Error("GetPDFDownloadDetails: ", err)
+		return details, err
+	}
+	ips := map[string]bool{}
+	details.Accesses = make([]PDFDownloadAccess, 0, len(accesses))
+	for _, a := range accesses {
+		details.Accesses = append(details.Accesses, PDFDownloadAccessEntIntoPDFDownloadAccess(a))
+		if a.Allowed {
+			ips[a.IP] = true
+		}
+	}
+	details.DistinctIPs = len(ips)
+	return details, nil
+}
+
+// ReissuePDFDownload suspends a link and creates a new one for the same
+// order and item. The new link starts with a fresh download count and
+// expiry window.
+func (db *Database) ReissuePDFDownload(linkID string) (reissued PDFDownload, err error) {
+	ctx := context.Background()
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		log.Error("ReissuePDFDownload: ", err)
+		return reissued, err
+	}
+	defer tx.Rollback()
+
+	old, err := tx.PDFDownload.Query().Where(entpdfdownload.LinkID(linkID)).Only(ctx)
+	if err != nil {
+		return reissued, err
+	}
+	update := tx.PDFDownload.UpdateOne(old)
+	if !old.Suspended {
+		update = update.SetSuspended(true).SetSuspendedAt(time.Now()).SetSuspendReason(PDFDownloadSuspendReissued)
+	}
+	if err = update.Exec(ctx); err != nil {
+		log.Error("ReissuePDFDownload: ", err)
+		return reissued, err
+	}
+	created, err := tx.PDFDownload.Create().
+		SetLinkID(uuid.New().String()).
+		SetPdfID(old.PdfID).
+		SetTimestamp(time.Now()).
+		SetEmailSent(false).
+		SetDownloadCount(0).
+		SetNillableOrderID(old.OrderID).
+		SetNillableItemID(old.ItemID).
+		Save(ctx)
+	if err != nil {
+		log.Error("ReissuePDFDownload: ", err)
+		return reissued, err
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("ReissuePDFDownload: ", err)
+		return reissued, err
+	}
+	return db.PDFDownloadEntIntoPDFDownload(created), nil
+}
+
+// PDFDownloadURL returns the public URL of a download link
+func PDFDownloadURL(linkID string) string {
+	return config.Config.FrontendURL + "/pdf/" + linkID
+}
+
+// SendPDFDownloadMail sends the download link to the customer and marks
+// the link as sent
+func (db *Database) SendPDFDownloadMail(download PDFDownload, email string) error {
+	templateData := struct {
+		URL   string
+		EMAIL string
+	}{
+		URL:   PDFDownloadURL(download.LinkID),
+		EMAIL: email,
+	}
+	mail, err := BuildEmailRequestFromTemplate("PDFLicenceItemTemplate.html", []string{email}, templateData)
+	if err != nil {
+		log.Error("SendPDFDownloadMail: failed to create mail: ", download.LinkID, err)
+		return err
+	}
+	if mail != nil {
+		go func(m *mailer.EmailRequest) {
+			success, err := m.SendEmail()
+			if err != nil || !success {
+				log.Error("SendPDFDownloadMail: failed to send mail: ", download.LinkID, err)
+			}
+		}(mail)
+	}
+	_, err = db.EntClient.PDFDownload.UpdateOneID(download.ID).SetEmailSent(true).Save(context.Background())
+	if err != nil {
+		log.Error("SendPDFDownloadMail: ", err)
+	}
+	return err
+}
+
+// ResendPDFDownloadLinks sends the usable download links of a verified
+// order again if the email matches the order. It returns the number of
+// links sent, which is 0 if order code and email do not match.
+func (db *Database) ResendPDFDownloadLinks(orderCode, email string) (sent int, err error) {
+	email = strings.TrimSpace(email)
+	if orderCode == "" || email == "" {
+		return 0, nil
+	}
+	ctx := context.Background()
+	order, err := db.EntClient.Order.Query().
+		Where(
+			entorder.OrderCode(orderCode),
+			entorder.Verified(true),
+			entorder.CustomerEmailEqualFold(email),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		log.Error("ResendPDFDownloadLinks: ", err)
+		return 0, err
+	}
+	downloads, err := db.GetPDFDownloadByOrderId(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	for _, download := range downloads {
+		policy, err := db.GetPDFDownloadPolicy(download.ItemID)
+		if err != nil {
+			return sent, err
+		}
+		if policy.Check(download, now) != nil {
+			continue
+		}
+		if err := db.SendPDFDownloadMail(download, *order.CustomerEmail); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
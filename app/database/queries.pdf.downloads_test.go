@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestPDFDownloadPolicyCheck(t *testing.T) {
+	now := time.Now()
+	policy := PDFDownloadPolicy{Limit: 3, ExpiryDays: 7}
+	download := PDFDownload{Timestamp: now.AddDate(0, 0, -6), DownloadCount: 2}
+	require.NoError(t, policy.Check(download, now))
+
+	download.DownloadCount = 3
+	require.ErrorIs(t, policy.Check(download, now), ErrPDFDownloadLimitReached)
+	require.NoError(t, PDFDownloadPolicy{}.Check(download, now))
+
+	download.Timestamp = now.AddDate(0, 0, -8)
+	require.ErrorIs(t, policy.Check(download, now), ErrPDFDownloadExpired)
+	require.True(t, PDFDownloadPolicy{}.ExpiresAt(download).IsZero())
+
+	download.Suspended = true
+	require.ErrorIs(t, PDFDownloadPolicy{}.Check(download, now), ErrPDFDownloadSuspended)
+}
+
+// TestPDFDownloadLimits downloads a link until the item limit is reached,
+// suspends a link that spreads to too many IPs and reissues it
+func TestPDFDownloadLimits(t *testing.T) {
+	Db.InitEmptyTestDb()
+	ctx := context.Background()
+	_, err := Db.EntClient.Settings.UpdateOneID(1).SetPDFDownloadMaxIPs(2).SetPDFDownloadLimit(0).Save(ctx)
+	require.NoError(t, err)
+
+	pdfID, err := Db.CreatePDF(PDF{Path: "pdf/test.pdf", Timestamp: time.Now()})
+	require.NoError(t, err)
+	itemID, err := Db.CreateItem(Item{
+		Name:             "Digital issue",
+		Description:      "Online issue",
+		Price:            300,
+		Type:             "online_issue",
+		PDF:              null.IntFrom(pdfID),
+		PDFDownloadLimit: null.IntFrom(2),
+	})
+	require.NoError(t, err)
+	item, err := Db.GetItem(itemID)
+	require.NoError(t, err)
+	require.Equal(t, null.IntFrom(2), item.PDFDownloadLimit)
+
+	createDownload := func() PDFDownload {
+		tx, err := Db.EntClient.Tx(ctx)
+		require.NoError(t, err)
+		download, err := Db.CreatePDFDownload(tx, PDF{ID: int(pdfID)}, 0, itemID)
+		require.NoError(t, err)
+		require.NoError(t, tx.Commit())
+		return download
+	}
+
+	// Item limit
+	download := createDownload()
+	for i := 0; i < 2; i++ {
+		_, err = Db.RegisterPDFDownload(download.LinkID, "10.0.0.1", "test")
+		require.NoError(t, err)
+	}
+	_, err = Db.RegisterPDFDownload(download.LinkID, "10.0.0.1", "test")
+	require.ErrorIs(t, err, ErrPDFDownloadLimitReached)
+	_, err = Db.ValidatePDFDownload(download.LinkID)
+	require.ErrorIs(t, err, ErrPDFDownloadLimitReached)
+
+	details, err := Db.GetPDFDownloadDetails(download.LinkID)
+	require.NoError(t, err)
+	require.Equal(t, 2, details.Download.DownloadCount)
+	require.Equal(t, 2, details.Policy.Limit)
+	require.Equal(t, 42, details.Policy.ExpiryDays)
+	require.Len(t, details.Accesses, 3)
+	require.False(t, details.Accesses[0].Allowed)
+	require.Equal(t, 1, details.DistinctIPs)
+
+	// Spread to too many IPs
+	item.PDFDownloadLimit = null.Int{}
+	require.NoError(t, Db.UpdateItem(itemID, item))
+	download = createDownload()
+	for _, ip := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.1"} {
+		_, err = Db.RegisterPDFDownload(download.LinkID, ip, "test")
+		require.NoError(t, err)
+	}
+	suspended, err := Db.RegisterPDFDownload(download.LinkID, "10.0.0.3", "test")
+	require.ErrorIs(t, err, ErrPDFDownloadSuspended)
+	require.Equal(t, PDFDownloadSuspendTooManyIPs, suspended.SuspendReason)
+	_, err = Db.RegisterPDFDownload(download.LinkID, "10.0.0.1", "test")
+	require.ErrorIs(t, err, ErrPDFDownloadSuspended)
+
+	list, err := Db.ListPDFDownloads(0, itemID, true)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, download.LinkID, list[0].LinkID)
+
+	// Reissue
+	reissued, err := Db.ReissuePDFDownload(download.LinkID)
+	require.NoError(t, err)
+	require.NotEqual(t, download.LinkID, reissued.LinkID)
+	require.Equal(t, 0, reissued.DownloadCount)
+	require.Equal(t, null.IntFrom(int64(itemID)), reissued.ItemID)
+	_, err = Db.RegisterPDFDownload(reissued.LinkID, "10.0.0.3", "test")
+	require.NoError(t, err)
+}
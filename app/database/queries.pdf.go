@@ -5,7 +5,6 @@ import (
 	"errors"
 	"time"
 
-	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/ent"
 	entpdf "github.com/augustin-wien/augustina-backend/ent/pdf"
 	entpdfdownload "github.com/augustin-wien/augustina-backend/ent/pdfdownload"
@@ -13,19 +12,10 @@ import (
 	"gopkg.in/guregu/null.v4"
 )
 
-// DeletePDF removes pdfs if their creation date is older than 6 weeks
+// DeletePDF purges PDFs older than IntervalToDeletePDFsInWeeks that are no
+// longer referenced, see PurgePDFs
 func (db *Database) DeletePDF() (err error) {
-	deleteInterval := config.Config.IntervalToDeletePDFsInWeeks
-	log.Info("DeletePDF entered: ", deleteInterval)
-
-	cutoff := time.Now().AddDate(0, 0, -deleteInterval*7)
-	// We assume timestamp is stored in a format compatible with string comparison or implicit cast
-	// Since schema says String but DB likely Timestamp, providing RFC3339 string should work for Postgres implicit cast
-	_, err = db.EntClient.PDF.Delete().Where(entpdf.TimestampLT(cutoff.Format(time.RFC3339))).Exec(context.Background())
-	if err != nil {
-		log.Error("DeletePDF: ", err)
-		return err
-	}
+	_, err = db.PurgePDFs(false)
 	return err
 }
 
@@ -35,7 +25,7 @@ func (db *Database) CreatePDF(pdf PDF) (pdfId int64, err error) {
 	created, err := db.EntClient.PDF.
 		Create().
 		SetPath(pdf.Path).
-		SetTimestamp(pdf.Timestamp.Format(time.RFC3339)).
+		SetTimestamp(pdf.Timestamp).
 		Save(context.Background())
 
 	if err != nil {
@@ -55,12 +45,7 @@ func (db *Database) GetPDF() (pdf PDF, err error) {
 		return pdf, err
 	}
 
-	ts, _ := time.Parse(time.RFC3339, result.Timestamp)
-	return PDF{
-		ID:        result.ID,
-		Path:      result.Path,
-		Timestamp: ts,
-	}, nil
+	return PDFEntIntoPDF(result), nil
 }
 
 // GetPDFByID returns the PDF with the given ID
@@ -71,12 +56,16 @@ func (db *Database) GetPDFByID(id int64) (pdf PDF, err error) {
 		return pdf, err
 	}
 
-	ts, _ := time.Parse(time.RFC3339, result.Timestamp)
+	return PDFEntIntoPDF(result), nil
+}
+
+// PDFEntIntoPDF converts an ent.PDF to PDF struct
+func PDFEntIntoPDF(p *ent.PDF) PDF {
 	return PDF{
-		ID:        result.ID,
-		Path:      result.Path,
-		Timestamp: ts,
-	}, nil
+		ID:        p.ID,
+		Path:      p.Path,
+		Timestamp: p.Timestamp,
+	}
 }
 
 // CreatePDFDownload creates an instance of the PDFDownload with given linkID and timestamp into the database
@@ -112,6 +101,11 @@ func (db *Database) PDFDownloadEntIntoPDFDownload(p *ent.PDFDownload) PDFDownloa
 		Timestamp:     p.Timestamp,
 		EmailSent:     p.EmailSent,
 		DownloadCount: p.DownloadCount,
+		Suspended:     p.Suspended,
+		SuspendReason: p.SuspendReason,
+	}
+	if p.SuspendedAt != nil {
+		pd.SuspendedAt = null.TimeFrom(*p.SuspendedAt)
 	}
 	if p.LastDownload != nil {
 		pd.LastDownload = *p.LastDownload
@@ -130,15 +124,6 @@ func (db *Database) GetPDFDownload(linkID string) (pdfDownload PDFDownload, err
 	if len(linkID) == 0 {
 		return pdfDownload, errors.New("linkID is empty")
 	}
-	deleteInterval := config.Config.IntervalToDeletePDFsInWeeks
-	cutoff := time.Now().AddDate(0, 0, -deleteInterval*7)
-
-	_, err = db.EntClient.PDFDownload.Delete().Where(entpdfdownload.TimestampLT(cutoff)).Exec(context.Background())
-	if err != nil {
-		log.Error("DeletePDFDownload: ", err)
-		return pdfDownload, err
-	}
-
 	download, err := db.EntClient.PDFDownload.Query().Where(entpdfdownload.LinkID(linkID)).Only(context.Background())
 	if err != nil {
 		return pdfDownload, err
@@ -0,0 +1,280 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/ent"
+	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+	entpdf "github.com/augustin-wien/augustina-backend/ent/pdf"
+	entpdfdownload "github.com/augustin-wien/augustina-backend/ent/pdfdownload"
+	entpdfdownloadaccess "github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+	"github.com/augustin-wien/augustina-backend/storage"
+	"gopkg.in/guregu/null.v4"
+)
+
+// ErrPDFRetentionDisabled is returned if no retention interval is configured
+var ErrPDFRetentionDisabled = errors.New("pdf retention is disabled, set INTERVAL_TO_DELETE_PDFS_IN_WEEKS")
+
+// Reasons for keeping a PDF past the retention interval
+const (
+	PDFKeptActiveItem = "referenced by an active item"
+	PDFKeptActiveLink = "referenced by an unexpired download link"
+)
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+// PDFStampedCopyKey returns the storage key of the personalized copy of a
+// PDF for a download link
+func PDFStampedCopyKey(linkID string, pdfID int) string {
+	name := fmt.Sprintf("%s_%d.pdf", unsafeFileChars.ReplaceAllString(linkID, "_"), pdfID)
+	return path.Join(config.Config.PDFWatermarkCacheDir, name)
+}
+
+// PurgedPDF is a PDF removed by the retention
+type PurgedPDF struct {
+	ID           int       `json:"id"`
+	Path         string    `json:"path"`
+	Timestamp    time.Time `json:"timestamp"`
+	LinksRemoved int       `json:"links_removed"`
+	ItemsCleared []int     `json:"items_cleared"` // Archived items that referenced the PDF
+	FilesRemoved []string  `json:"files_removed"`
+	FileErrors   []string  `json:"file_errors,omitempty"`
+	BytesFreed   int64     `json:"bytes_freed"`
+}
+
+// KeptPDF is a PDF past the retention interval that is still in use
+type KeptPDF struct {
+	ID           int       `json:"id"`
+	Path         string    `json:"path"`
+	Timestamp    time.Time `json:"timestamp"`
+	Reason       string    `json:"reason"`
+	ItemIDs      []int     `json:"item_ids,omitempty"`
+	ActiveLinks  int       `json:"active_links"`
+	LinksRemoved int       `json:"links_removed"` // Expired links of the PDF that were removed
+}
+
+// PDFRetentionReport describes what a retention run purged or, for a dry
+// run, would purge
+type PDFRetentionReport struct {
+	DryRun       bool        `json:"dry_run"`
+	Cutoff       time.Time   `json:"cutoff"`
+	Purged       []PurgedPDF `json:"purged"`
+	Kept         []KeptPDF   `json:"kept"`
+	LinksRemoved int         `json:"links_removed"`
+	BytesFreed   int64       `json:"bytes_freed"`
+	Errors       []string    `json:"errors,omitempty"`
+}
+
+// pdfLinkExpired reports whether a download link can no longer be used.
+// Links without an expiry in their policy expire with the retention cutoff.
+func pdfLinkExpired(download PDFDownload, policy PDFDownloadPolicy, cutoff, now time.Time) bool {
+	if download.Suspended {
+		return true
+	}
+	if policy.ExpiryDays > 0 {
+		return now.After(policy.ExpiresAt(download))
+	}
+	return download.Timestamp.Before(cutoff)
+}
+
+// PurgePDFs deletes PDFs older than IntervalToDeletePDFsInWeeks together
+// with their download links and files. PDFs referenced by an item that is
+// not archived or by a download link that has not expired are kept; expired
+// links of kept PDFs are removed. With dryRun nothing is changed.
+func (db *Database) PurgePDFs(dryRun bool) (report PDFRetentionReport, err error) {
+	ctx := context.Background()
+	report = PDFRetentionReport{DryRun: dryRun, Purged: []PurgedPDF{}, Kept: []KeptPDF{}}
+	weeks := config.Config.IntervalToDeletePDFsInWeeks
+	if weeks <= 0 {
+		return report, ErrPDFRetentionDisabled
+	}
+	now := time.Now()
+	report.Cutoff = now.AddDate(0, 0, -weeks*7)
+
+	candidates, err := db.EntClient.PDF.Query().
+		Where(entpdf.TimestampLT(report.Cutoff)).
+		Order(ent.Asc(entpdf.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error("PurgePDFs: ", err)
+		return report, err
+	}
+	for _, candidate := range candidates {
+		purged, kept, err := db.purgePDF(ctx, PDFEntIntoPDF(candidate), report.Cutoff, now, dryRun)
+		if err != nil {
+			log.Error("PurgePDFs: pdf ", candidate.ID, ": ", err)
+			report.Errors = append(report.Errors, fmt.Sprintf("pdf %d: %v", candidate.ID, err))
+			continue
+		}
+		if purged != nil {
+			report.Purged = append(report.Purged, *purged)
+			report.LinksRemoved += purged.LinksRemoved
+			report.BytesFreed += purged.BytesFreed
+		}
+		if kept != nil {
+			report.Kept = append(report.Kept, *kept)
+			report.LinksRemoved += kept.LinksRemoved
+		}
+	}
+	log.Info("PurgePDFs: purged ", len(report.Purged), " pdfs and ", report.LinksRemoved, " links, kept ", len(report.Kept), " pdfs, dry run ", dryRun)
+	return report, nil
+}
+
+// purgePDF checks the references of a single PDF and deletes it in its own
+// transaction. Files are removed after the commit so that a failed
+// transaction never leaves rows pointing to missing files.
+func (db *Database) purgePDF(ctx context.Context, pdf PDF, cutoff, now time.Time, dryRun bool) (purged *PurgedPDF, kept *KeptPDF, err error) {
+	tx, err := db.EntClient.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	// The delete triggers of pdf and pdf_download only let this
+	// transaction through, see migration 054
+	if _, err = tx.ExecContext(ctx, "SET LOCAL augustina.purge_pdfs = 'on'"); err != nil {
+		return nil, nil, err
+	}
+
+	items, err := tx.Item.Query().Where(entitem.HasPDFWith(entpdf.ID(pdf.ID))).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var activeItems, archivedItems []int
+	for _, item := range items {
+		if item.Archived {
+			archivedItems = append(archivedItems, item.ID)
+		} else {
+			activeItems = append(activeItems, item.ID)
+		}
+	}
+
+	downloads, err := tx.PDFDownload.Query().Where(entpdfdownload.PdfID(pdf.ID)).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	policies := map[null.Int]PDFDownloadPolicy{}
+	var expired []PDFDownload
+	activeLinks := 0
+	for _, d := range downloads {
+		download := db.PDFDownloadEntIntoPDFDownload(d)
+		policy, ok := policies[download.ItemID]
+		if !ok {
+			if policy, err = pdfDownloadPolicyTx(tx, download.ItemID); err != nil {
+				return nil, nil, err
+			}
+			policies[download.ItemID] = policy
+		}
+		if pdfLinkExpired(download, policy, cutoff, now) {
+			expired = append(expired, download)
+		} else {
+			activeLinks++
+		}
+	}
+
+	if len(activeItems) > 0 || activeLinks > 0 {
+		kept = &KeptPDF{
+			ID:           pdf.ID,
+			Path:         pdf.Path,
+			Timestamp:    pdf.Timestamp,
+			Reason:       PDFKeptActiveLink,
+			ItemIDs:      activeItems,
+			ActiveLinks:  activeLinks,
+			LinksRemoved: len(expired),
+		}
+		if len(activeItems) > 0 {
+			kept.Reason = PDFKeptActiveItem
+		}
+		if dryRun || len(expired) == 0 {
+			return nil, kept, nil
+		}
+		if err = deletePDFDownloadsTx(ctx, tx, expired); err != nil {
+			return nil, nil, err
+		}
+		if err = tx.Commit(); err != nil {
+			return nil, nil, err
+		}
+		for _, download := range expired {
+			if err := storage.Client.Delete(ctx, PDFStampedCopyKey(download.LinkID, pdf.ID)); err != nil {
+				log.Error("purgePDF: failed to remove stamped copy of ", download.LinkID, ": ", err)
+			}
+		}
+		return nil, kept, nil
+	}
+
+	purged = &PurgedPDF{
+		ID:           pdf.ID,
+		Path:         pdf.Path,
+		Timestamp:    pdf.Timestamp,
+		LinksRemoved: len(expired),
+		ItemsCleared: archivedItems,
+		FilesRemoved: []string{},
+	}
+	keys := []string{pdf.Path}
+	for _, download := range expired {
+		keys = append(keys, PDFStampedCopyKey(download.LinkID, pdf.ID))
+	}
+	if dryRun {
+		for _, key := range keys {
+			if info, err := storage.Client.Stat(ctx, key); err == nil {
+				purged.FilesRemoved = append(purged.FilesRemoved, key)
+				purged.BytesFreed += info.Size
+			}
+		}
+		return purged, nil, nil
+	}
+
+	if len(archivedItems) > 0 {
+		if err = tx.Item.Update().Where(entitem.IDIn(archivedItems...)).ClearPDF().Exec(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err = deletePDFDownloadsTx(ctx, tx, expired); err != nil {
+		return nil, nil, err
+	}
+	if err = tx.PDF.DeleteOneID(pdf.ID).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	for _, key := range keys {
+		info, err := storage.Client.Stat(ctx, key)
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+			continue
+		}
+		if err == nil {
+			err = storage.Client.Delete(ctx, key)
+		}
+		if err != nil {
+			log.Error("purgePDF: failed to remove ", key, ": ", err)
+			purged.FileErrors = append(purged.FileErrors, fmt.Sprintf("%s: %v", key, err))
+			continue
+		}
+		purged.FilesRemoved = append(purged.FilesRemoved, key)
+		purged.BytesFreed += info.Size
+	}
+	return purged, nil, nil
+}
+
+// deletePDFDownloadsTx deletes download links and their access log
+func deletePDFDownloadsTx(ctx context.Context, tx *ent.Tx, downloads []PDFDownload) error {
+	if len(downloads) == 0 {
+		return nil
+	}
+	ids := make([]int, len(downloads))
+	for i, download := range downloads {
+		ids[i] = download.ID
+	}
+	if _, err := tx.PDFDownloadAccess.Delete().Where(entpdfdownloadaccess.PdfDownloadIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.PDFDownload.Delete().Where(entpdfdownload.IDIn(ids...)).Exec(ctx)
+	return err
+}
@@ -1,12 +1,15 @@
 package database
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/storage"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 // TestCreateAndGetPDF verifies PDF creation and retrieval
@@ -29,11 +32,7 @@ func TestCreateAndGetPDF(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, id, int64(latest.ID))
 	require.Equal(t, pdf.Path, latest.Path)
-	// Compare timestamp: if stored as string, format might matter.
-	// If stored as time, truncation matters.
-	// Since Ent schema says String, implementation will format it.
-	// Test should account for possible serialization.
-	// We check closer enough or same string representation.
+	// Postgres stores microseconds, so compare with a tolerance
 	require.WithinDuration(t, ts, latest.Timestamp, time.Second)
 
 	// Get by ID
@@ -78,3 +77,105 @@ func TestDeletePDF(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, int64(found.ID), int64(found.ID))
 }
+
+func TestPDFLinkExpired(t *testing.T) {
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -7)
+	download := PDFDownload{Timestamp: now.AddDate(0, 0, -10)}
+	require.True(t, pdfLinkExpired(download, PDFDownloadPolicy{}, cutoff, now))
+	require.False(t, pdfLinkExpired(download, PDFDownloadPolicy{ExpiryDays: 30}, cutoff, now))
+
+	download.Timestamp = now.AddDate(0, 0, -3)
+	require.False(t, pdfLinkExpired(download, PDFDownloadPolicy{}, cutoff, now))
+	require.True(t, pdfLinkExpired(download, PDFDownloadPolicy{ExpiryDays: 2}, cutoff, now))
+
+	download.Suspended = true
+	require.True(t, pdfLinkExpired(download, PDFDownloadPolicy{ExpiryDays: 30}, cutoff, now))
+}
+
+// TestPurgePDFs keeps PDFs of active items and unexpired links and purges
+// the rest together with their links and files
+func TestPurgePDFs(t *testing.T) {
+	Db.InitEmptyTestDb()
+	ctx := context.Background()
+	config.Config.IntervalToDeletePDFsInWeeks = 1
+	previous := storage.Client
+	storage.Client = storage.NewLocal(t.TempDir(), "", []byte("secret"))
+	defer func() { storage.Client = previous }()
+	_, err := Db.EntClient.Settings.UpdateOneID(1).SetPDFDownloadExpiryDays(0).Save(ctx)
+	require.NoError(t, err)
+
+	old := time.Now().AddDate(0, 0, -30)
+	createPDF := func(path string) int64 {
+		require.NoError(t, storage.Client.Put(ctx, path, []byte("%PDF-1.4"), "application/pdf"))
+		id, err := Db.CreatePDF(PDF{Path: path, Timestamp: old})
+		require.NoError(t, err)
+		return id
+	}
+	createLink := func(pdfID int64, itemID int, ts time.Time) PDFDownload {
+		tx, err := Db.EntClient.Tx(ctx)
+		require.NoError(t, err)
+		download, err := Db.CreatePDFDownload(tx, PDF{ID: int(pdfID)}, 0, itemID)
+		require.NoError(t, err)
+		require.NoError(t, tx.PDFDownload.UpdateOneID(download.ID).SetTimestamp(ts).Exec(ctx))
+		require.NoError(t, tx.Commit())
+		return download
+	}
+
+	activePDF := createPDF("pdf/active.pdf")
+	activeItem, err := Db.CreateItem(Item{Name: "Active issue", Description: "Online issue", Price: 300, Type: "online_issue", PDF: null.IntFrom(activePDF)})
+	require.NoError(t, err)
+	createLink(activePDF, activeItem, old)
+
+	linkedPDF := createPDF("pdf/linked.pdf")
+	createLink(linkedPDF, 0, time.Now())
+
+	archivedPDF := createPDF("pdf/archived.pdf")
+	archivedItem, err := Db.CreateItem(Item{Name: "Archived issue", Description: "Online issue", Price: 300, Type: "online_issue", PDF: null.IntFrom(archivedPDF)})
+	require.NoError(t, err)
+	require.NoError(t, Db.EntClient.Item.UpdateOneID(archivedItem).SetArchived(true).Exec(ctx))
+	oldLink := createLink(archivedPDF, archivedItem, old)
+	stamped := PDFStampedCopyKey(oldLink.LinkID, int(archivedPDF))
+	require.NoError(t, storage.Client.Put(ctx, stamped, []byte("%PDF-1.4 stamped"), "application/pdf"))
+
+	// A dry run reports without deleting
+	report, err := Db.PurgePDFs(true)
+	require.NoError(t, err)
+	require.Len(t, report.Purged, 1)
+	require.ElementsMatch(t, []string{"pdf/archived.pdf", stamped}, report.Purged[0].FilesRemoved)
+	_, err = Db.GetPDFByID(archivedPDF)
+	require.NoError(t, err)
+
+	report, err = Db.PurgePDFs(false)
+	require.NoError(t, err)
+	require.Len(t, report.Purged, 1)
+	require.Equal(t, int(archivedPDF), report.Purged[0].ID)
+	require.Equal(t, []int{archivedItem}, report.Purged[0].ItemsCleared)
+	require.Equal(t, 1, report.Purged[0].LinksRemoved)
+	require.Equal(t, int64(len("%PDF-1.4")+len("%PDF-1.4 stamped")), report.BytesFreed)
+
+	kept := map[int]KeptPDF{}
+	for _, k := range report.Kept {
+		kept[k.ID] = k
+	}
+	require.Equal(t, PDFKeptActiveItem, kept[int(activePDF)].Reason)
+	require.Equal(t, 1, kept[int(activePDF)].LinksRemoved)
+	require.Equal(t, PDFKeptActiveLink, kept[int(linkedPDF)].Reason)
+
+	_, err = Db.GetPDFByID(archivedPDF)
+	require.Error(t, err)
+	_, err = storage.Client.Stat(ctx, "pdf/archived.pdf")
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = storage.Client.Stat(ctx, stamped)
+	require.ErrorIs(t, err, storage.ErrNotFound)
+	_, err = Db.GetPDFDownload(oldLink.LinkID)
+	require.Error(t, err)
+	item, err := Db.GetItem(archivedItem)
+	require.NoError(t, err)
+	require.False(t, item.PDF.Valid)
+
+	_, err = storage.Client.Stat(ctx, "pdf/active.pdf")
+	require.NoError(t, err)
+	_, err = storage.Client.Stat(ctx, "pdf/linked.pdf")
+	require.NoError(t, err)
+}
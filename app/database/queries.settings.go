@@ -42,6 +42,10 @@ func (db *Database) GetSettings() (*ent.Settings, error) {
 
 // UpdateSettings updates the settings in the database
 func (db *Database) UpdateSettings(settings *ent.Settings) (err error) {
+	var before *ent.Settings
+	if db.auditing() {
+		before, _ = db.GetSettings()
+	}
 
 	tx, err := db.EntClient.Tx(context.Background())
 	if err != nil {
@@ -87,7 +91,17 @@ func (db *Database) UpdateSettings(settings *ent.Settings) (err error) {
 		SetPOSEnabled(settings.POSEnabled).
 		SetWordPressInviteURL(settings.WordPressInviteURL).
 		SetWordPressInviteAPIKey(settings.WordPressInviteAPIKey).
-		SetWordPressInviteTTL(settings.WordPressInviteTTL)
+		SetWordPressInviteTTL(settings.WordPressInviteTTL).
+		SetPDFDownloadLimit(settings.PDFDownloadLimit).
+		SetPDFDownloadExpiryDays(settings.PDFDownloadExpiryDays).
+		SetPDFDownloadMaxIPs(settings.PDFDownloadMaxIPs).
+		SetImageVariants(settings.ImageVariants).
+		SetInvoicesEnabled(settings.InvoicesEnabled).
+		SetInvoiceIssuerName(settings.InvoiceIssuerName).
+		SetInvoiceIssuerAddress(settings.InvoiceIssuerAddress).
+		SetInvoiceIssuerVATID(settings.InvoiceIssuerVATID).
+		SetInvoiceFooter(settings.InvoiceFooter).
+		SetDefaultVATRate(settings.DefaultVATRate)
 
 	// Update main item if present
 	if settings.Edges.MainItem != nil {
@@ -100,9 +114,42 @@ func (db *Database) UpdateSettings(settings *ent.Settings) (err error) {
 		return err
 	}
 
+	if db.auditing() {
+		txDb := db.withTx(tx)
+		after, _ := txDb.GetSettings()
+		beforeState, afterState := settingsAuditState(before), settingsAuditState(after)
+		if before != nil && beforeState != nil && afterState != nil {
+			// Secrets are not recorded, not even as a hash, only whether
+			// they changed
+			if before.WordPressInviteAPIKey == after.WordPressInviteAPIKey {
+				beforeState["WordPressInviteAPIKey"] = "unchanged"
+				afterState["WordPressInviteAPIKey"] = "unchanged"
+			} else {
+				afterState["WordPressInviteAPIKey"] = "changed"
+			}
+		}
+		if err = txDb.audit(AuditSettingsUpdate, "settings", 1, beforeState, afterState); err != nil {
+			return err
+		}
+	}
 	return tx.Commit()
 }
 
+// settingsAuditState is the state of the settings recorded in the audit
+// log without secrets
+func settingsAuditState(settings *ent.Settings) map[string]any {
+	if settings == nil {
+		return nil
+	}
+	state := auditFields(settings)
+	delete(state, "edges")
+	delete(state, "WordPressInviteAPIKey")
+	if settings.Edges.MainItem != nil {
+		state["MainItem"] = settings.Edges.MainItem.ID
+	}
+	return state
+}
+
 // InitiateDBSettings creates default settings if they don't exist
 func (db *Database) InitiateDBSettings() (err error) {
 	exists, err := db.EntClient.DBSettings.Query().Where(entdbsettings.ID(1)).Exist(context.Background())
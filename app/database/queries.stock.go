@@ -0,0 +1,330 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entitem "github.com/augustin-wien/augustina-backend/ent/item"
+	entstockmovement "github.com/augustin-wien/augustina-backend/ent/stockmovement"
+	"github.com/augustin-wien/augustina-backend/notifications"
+)
+
+// Stock movement types
+const (
+	StockMovementDelivery   = "delivery"   // printed copies arrive at the office
+	StockMovementHandout    = "handout"    // copies are handed to a vendor
+	StockMovementReturn     = "return"     // a vendor brings copies back
+	StockMovementWriteOff   = "write_off"  // copies are damaged or lost
+	StockMovementSale       = "sale"       // copies are sold at the office (POS)
+	StockMovementCorrection = "correction" // manual correction after a stock count
+)
+
+// ErrStockTrackingDisabled is returned when a movement is booked for an item
+// without stock tracking
+var ErrStockTrackingDisabled = errors.New("stock tracking is disabled for this item")
+
+// ErrInsufficientStock is returned when a handout or write-off exceeds the
+// office stock
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// ErrStockVendorRequired is returned for handouts and returns without vendor
+var ErrStockVendorRequired = errors.New("vendor is required for handouts and returns")
+
+// notifyLowStock sends the low stock alert. Tests can override it.
+var notifyLowStock = func(subject, message string) {
+	if notifications.NotificationsClient.Client == nil {
+		return
+	}
+	go notifications.NotificationsClient.SendNotification(subject, message)
+}
+
+// StockMovement is a change of the office stock of an item
+type StockMovement struct {
+	ID         int       `json:"id"`
+	ItemID     int       `json:"item_id"`
+	VendorID   int       `json:"vendor_id,omitempty"`
+	Type       string    `json:"type"`
+	Quantity   int       `json:"quantity"` // Signed change of the stock
+	StockAfter int       `json:"stock_after"`
+	Note       string    `json:"note"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// StockMovementEntIntoStockMovement converts an ent.StockMovement to StockMovement struct
+func StockMovementEntIntoStockMovement(m *ent.StockMovement) StockMovement {
+	vendorID := 0
+	if m.VendorID != nil {
+		vendorID = *m.VendorID
+	}
+	return StockMovement{
+		ID:         m.ID,
+		ItemID:     m.ItemID,
+		VendorID:   vendorID,
+		Type:       m.Type,
+		Quantity:   m.Quantity,
+		StockAfter: m.StockAfter,
+		Note:       m.Note,
+		CreatedBy:  m.CreatedBy,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+// StockDelta returns the signed stock change of a movement. Quantities are
+// given as positive numbers except for corrections, which may be negative.
+func StockDelta(movementType string, quantity int) (int, error) {
+	if quantity == 0 {
+		return 0, errors.New("quantity must not be 0")
+	}
+	if quantity < 0 && movementType != StockMovementCorrection {
+		return 0, errors.New("quantity must be greater than 0")
+	}
+	switch movementType {
+	case StockMovementDelivery, StockMovementReturn, StockMovementCorrection:
+		return quantity, nil
+	case StockMovementHandout, StockMovementWriteOff, StockMovementSale:
+		return -quantity, nil
+	}
+	return 0, fmt.Errorf("unknown stock movement type %q", movementType)
+}
+
+// lowStockAlert describes an item whose stock fell to or below its threshold
+type lowStockAlert struct {
+	ItemName  string
+	Stock     int
+	Threshold int
+}
+
+// createStockMovementTx books a movement and updates the item stock. The
+// quantity of the movement is the unsigned amount as entered by staff. It
+// returns an alert if the movement made the stock cross the low stock
+// threshold.
+func createStockMovementTx(tx *ent.Tx, movement StockMovement) (created StockMovement, alert *lowStockAlert, err error) {
+	ctx := context.Background()
+	delta, err := StockDelta(movement.Type, movement.Quantity)
+	if err != nil {
+		return
+	}
+	if (movement.Type == StockMovementHandout || movement.Type == StockMovementReturn) && movement.VendorID == 0 {
+		err = ErrStockVendorRequired
+		return
+	}
+
+	// Incrementing in SQL locks the row for the rest of the transaction so
+	// concurrent movements can not lose updates
+	item, err := tx.Item.UpdateOneID(movement.ItemID).AddStock(delta).Save(ctx)
+	if err != nil {
+		log.Error("createStockMovementTx: update stock ", err)
+		return
+	}
+	if !item.TrackStock {
+		err = ErrStockTrackingDisabled
+		return
+	}
+	if item.Stock < 0 && (movement.Type == StockMovementHandout || movement.Type == StockMovementWriteOff) {
+		err = ErrInsufficientStock
+		return
+	}
+
+	create := tx.StockMovement.Create().
+		SetItemID(movement.ItemID).
+		SetType(movement.Type).
+		SetQuantity(delta).
+		SetStockAfter(item.Stock).
+		SetNote(movement.Note).
+		SetCreatedBy(movement.CreatedBy).
+		SetCreatedAt(time.Now())
+	if movement.VendorID != 0 {
+		create.SetVendorID(movement.VendorID)
+	}
+	entMovement, err := create.Save(ctx)
+	if err != nil {
+		log.Error("createStockMovementTx: insert ", err)
+		return
+	}
+
+	previousStock := item.Stock - delta
+	if item.Stock <= item.LowStockThreshold && previousStock > item.LowStockThreshold {
+		alert = &lowStockAlert{ItemName: item.Name, Stock: item.Stock, Threshold: item.LowStockThreshold}
+	}
+	return StockMovementEntIntoStockMovement(entMovement), alert, nil
+}
+
+// sendLowStockAlerts notifies the office about items running low
+func sendLowStockAlerts(alerts []*lowStockAlert) {
+	for _, alert := range alerts {
+		if alert == nil {
+			continue
+		}
+		log.Infof("Low stock of %s: %d (threshold %d)", alert.ItemName, alert.Stock, alert.Threshold)
+		notifyLowStock("Low stock: "+alert.ItemName, fmt.Sprintf("Only %d copies of %s are left in stock (threshold %d).", alert.Stock, alert.ItemName, alert.Threshold))
+	}
+}
+
+// CreateStockMovement books a stock movement and updates the item stock
+func (db *Database) CreateStockMovement(movement StockMovement) (*StockMovement, error) {
+	tx, err := db.EntClient.Tx(context.Background())
+	if err != nil {
+		log.Error("CreateStockMovement: ", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, alert, err := createStockMovementTx(tx, movement)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		log.Error("CreateStockMovement: commit ", err)
+		return nil, err
+	}
+	sendLowStockAlerts([]*lowStockAlert{alert})
+	return &created, nil
+}
+
+// CreatePaymentsWithStockMovements creates payments and stock movements in
+// one transaction, e.g. for a POS order that sells printed copies
+func (db *Database) CreatePaymentsWithStockMovements(payments []Payment, movements []StockMovement) (err error) {
+	tx, err := db.EntClient.Tx(context.Background())
+	if err != nil {
+		log.Error("CreatePaymentsWithStockMovements: ", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, payment := range payments {
+		_, err = createPaymentTx(tx, payment)
+		if err != nil {
+			log.Error("CreatePaymentsWithStockMovements: ", err)
+			return err
+		}
+	}
+	alerts := make([]*lowStockAlert, 0, len(movements))
+	for _, movement := range movements {
+		_, alert, err := createStockMovementTx(tx, movement)
+		if err != nil {
+			log.Error("CreatePaymentsWithStockMovements: ", err)
+			return err
+		}
+		alerts = append(alerts, alert)
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	sendLowStockAlerts(alerts)
+	return nil
+}
+
+// ListStockMovements returns the movements of an item, newest first.
+// vendorID 0 and zero times disable the respective filter.
+func (db *Database) ListStockMovements(itemID int, vendorID int, minDate, maxDate time.Time) ([]*StockMovement, error) {
+	query := db.EntClient.StockMovement.Query()
+	if itemID != 0 {
+		query = query.Where(entstockmovement.ItemID(itemID))
+	}
+	if vendorID != 0 {
+		query = query.Where(entstockmovement.VendorID(vendorID))
+	}
+	if !minDate.IsZero() {
+		query = query.Where(entstockmovement.CreatedAtGTE(minDate))
+	}
+	if !maxDate.IsZero() {
+		query = query.Where(entstockmovement.CreatedAtLTE(maxDate))
+	}
+	movements, err := query.Order(ent.Desc(entstockmovement.FieldCreatedAt), ent.Desc(entstockmovement.FieldID)).All(context.Background())
+	if err != nil {
+		log.Error("ListStockMovements: ", err)
+		return nil, err
+	}
+	result := make([]*StockMovement, len(movements))
+	for i, m := range movements {
+		movement := StockMovementEntIntoStockMovement(m)
+		result[i] = &movement
+	}
+	return result, nil
+}
+
+// UpdateItemStockSettings enables or disables stock tracking of an item and
+// sets its low stock threshold
+func (db *Database) UpdateItemStockSettings(itemID int, trackStock bool, lowStockThreshold int) error {
+	return db.inTx(func(txDb *Database) error {
+		var before *ent.Item
+		if txDb.auditing() {
+			before, _ = txDb.EntClient.Item.Get(context.Background(), itemID)
+		}
+		err := txDb.EntClient.Item.UpdateOneID(itemID).
+			SetTrackStock(trackStock).
+			SetLowStockThreshold(lowStockThreshold).
+			Exec(context.Background())
+		if err != nil {
+			log.Error("UpdateItemStockSettings: ", err)
+			return err
+		}
+		if before == nil {
+			return nil
+		}
+		return txDb.audit(AuditItemStockSettings, "item", itemID,
+			map[string]any{"track_stock": before.TrackStock, "low_stock_threshold": before.LowStockThreshold},
+			map[string]any{"track_stock": trackStock, "low_stock_threshold": lowStockThreshold})
+	})
+}
+
+// StockReportEntry summarizes the stock of an item and its movements in a
+// period, grouped by movement type
+type StockReportEntry struct {
+	ItemID            int            `json:"item_id"`
+	ItemName          string         `json:"item_name"`
+	Stock             int            `json:"stock"`
+	LowStockThreshold int            `json:"low_stock_threshold"`
+	LowStock          bool           `json:"low_stock"`
+	Movements         map[string]int `json:"movements"` // Unsigned quantities per movement type
+}
+
+// GetStockReport returns the stock of all tracked items and the sum of
+// their movements between minDate and maxDate (zero means unbounded)
+func (db *Database) GetStockReport(minDate, maxDate time.Time) ([]StockReportEntry, error) {
+	ctx := context.Background()
+	items, err := db.EntClient.Item.Query().
+		Where(entitem.TrackStock(true), entitem.ArchivedEQ(false)).
+		Order(ent.Desc(entitem.FieldItemOrder), ent.Asc(entitem.FieldID)).
+		All(ctx)
+	if err != nil {
+		log.Error("GetStockReport: ", err)
+		return nil, err
+	}
+	report := make([]StockReportEntry, 0, len(items))
+	byItem := make(map[int]*StockReportEntry, len(items))
+	for _, item := range items {
+		report = append(report, StockReportEntry{
+			ItemID:            item.ID,
+			ItemName:          item.Name,
+			Stock:             item.Stock,
+			LowStockThreshold: item.LowStockThreshold,
+			LowStock:          item.Stock <= item.LowStockThreshold,
+			Movements:         map[string]int{},
+		})
+	}
+	for i := range report {
+		byItem[report[i].ItemID] = &report[i]
+	}
+
+	movements, err := db.ListStockMovements(0, 0, minDate, maxDate)
+	if err != nil {
+		return nil, err
+	}
+	for _, movement := range movements {
+		entry, ok := byItem[movement.ItemID]
+		if !ok {
+			continue
+		}
+		quantity := movement.Quantity
+		if quantity < 0 && movement.Type != StockMovementCorrection {
+			quantity = -quantity
+		}
+		entry.Movements[movement.Type] += quantity
+	}
+	return report, nil
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestStockDelta(t *testing.T) {
+	delta, err := StockDelta(StockMovementDelivery, 10)
+	require.NoError(t, err)
+	require.Equal(t, 10, delta)
+	delta, err = StockDelta(StockMovementHandout, 3)
+	require.NoError(t, err)
+	require.Equal(t, -3, delta)
+	delta, err = StockDelta(StockMovementCorrection, -2)
+	require.NoError(t, err)
+	require.Equal(t, -2, delta)
+	_, err = StockDelta(StockMovementWriteOff, -1)
+	require.Error(t, err)
+	_, err = StockDelta(StockMovementReturn, 0)
+	require.Error(t, err)
+	_, err = StockDelta("gift", 1)
+	require.Error(t, err)
+}
+
+// TestStockMovements books movements for a tracked item and checks the
+// stock, the low stock alert and the report
+func TestStockMovements(t *testing.T) {
+	Db.InitEmptyTestDb()
+
+	var alerts []string
+	notifyLowStock = func(subject, message string) { alerts = append(alerts, subject) }
+
+	vendorID, err := Db.CreateVendor(Vendor{
+		FirstName: "Stock",
+		LastName:  "Vendor",
+		Email:     "stock-vendor@vendor.com",
+		LicenseID: null.StringFrom("stock-001"),
+	})
+	require.NoError(t, err)
+	itemID, err := Db.CreateItem(Item{Name: "Stock issue", Description: "Printed issue", Price: 300, Type: "issue"})
+	require.NoError(t, err)
+
+	// Movements are rejected until tracking is enabled
+	_, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, Type: StockMovementDelivery, Quantity: 10})
+	require.ErrorIs(t, err, ErrStockTrackingDisabled)
+
+	require.NoError(t, Db.UpdateItemStockSettings(itemID, true, 5))
+
+	movement, err := Db.CreateStockMovement(StockMovement{ItemID: itemID, Type: StockMovementDelivery, Quantity: 10, CreatedBy: "test"})
+	require.NoError(t, err)
+	require.Equal(t, 10, movement.StockAfter)
+
+	_, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, Type: StockMovementHandout, Quantity: 4})
+	require.ErrorIs(t, err, ErrStockVendorRequired)
+
+	movement, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, VendorID: vendorID, Type: StockMovementHandout, Quantity: 4})
+	require.NoError(t, err)
+	require.Equal(t, -4, movement.Quantity)
+	require.Equal(t, 6, movement.StockAfter)
+	require.Empty(t, alerts)
+
+	// Handing out more than is in stock fails and leaves the stock untouched
+	_, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, VendorID: vendorID, Type: StockMovementHandout, Quantity: 7})
+	require.ErrorIs(t, err, ErrInsufficientStock)
+
+	_, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, Type: StockMovementWriteOff, Quantity: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Low stock: Stock issue"}, alerts)
+
+	_, err = Db.CreateStockMovement(StockMovement{ItemID: itemID, VendorID: vendorID, Type: StockMovementReturn, Quantity: 1})
+	require.NoError(t, err)
+
+	item, err := Db.GetItem(itemID)
+	require.NoError(t, err)
+	require.True(t, item.TrackStock)
+	require.Equal(t, 5, item.Stock)
+
+	movements, err := Db.ListStockMovements(itemID, vendorID, time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, movements, 2)
+
+	report, err := Db.GetStockReport(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	require.Equal(t, 5, report[0].Stock)
+	require.True(t, report[0].LowStock)
+	require.Equal(t, map[string]int{
+		StockMovementDelivery: 10,
+		StockMovementHandout:  4,
+		StockMovementWriteOff: 2,
+		StockMovementReturn:   1,
+	}, report[0].Movements)
+}
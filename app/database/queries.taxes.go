@@ -0,0 +1,127 @@
+package database
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent"
+	entorder "github.com/augustin-wien/augustina-backend/ent/order"
+	entorderentry "github.com/augustin-wien/augustina-backend/ent/orderentry"
+	entsettings "github.com/augustin-wien/augustina-backend/ent/settings"
+)
+
+// splitVAT splits a gross amount into net amount and VAT
+func splitVAT(gross int, rate float64) (net, tax int) {
+	net = int(math.Round(float64(gross) * 100 / (100 + rate)))
+	return net, gross - net
+}
+
+// itemVATRate returns the VAT rate of an item in percent. Items without a
+// rate of their own use the default rate, except for donations which are
+// not taxed.
+func itemVATRate(item Item, defaultRate float64) float64 {
+	if item.VATRate.Valid {
+		return item.VATRate.Float64
+	}
+	if item.Type == "donation" {
+		return 0
+	}
+	return defaultRate
+}
+
+// defaultVATRateTx returns the default VAT rate of the settings
+func defaultVATRateTx(tx *ent.Tx) (float64, error) {
+	rate, err := tx.Settings.Query().
+		Select(entsettings.FieldDefaultVATRate).
+		Float64(context.Background())
+	if ent.IsNotFound(err) {
+		return entsettings.DefaultDefaultVATRate, nil
+	}
+	return rate, err
+}
+
+// entryTaxes returns the VAT rate, net amount, VAT and gross amount of an
+// order entry. Entries from before VAT was recorded are taxed with the
+// current rate of their item.
+func entryTaxes(entry OrderEntry, itemRate func(itemID int) float64) (rate float64, net, tax, gross int) {
+	if entry.Net != 0 || entry.Tax != 0 || entry.Gross != 0 {
+		return entry.VATRate, entry.Net, entry.Tax, entry.Gross
+	}
+	gross = entry.Price * entry.Quantity
+	if gross == 0 {
+		return 0, 0, 0, 0
+	}
+	rate = itemRate(entry.Item)
+	net, tax = splitVAT(gross, rate)
+	return rate, net, tax, gross
+}
+
+// TaxReport sums up the sales of verified orders in a period per VAT rate.
+// Amounts are in cents.
+type TaxReport struct {
+	From  time.Time     `json:"from"`
+	To    time.Time     `json:"to"`
+	Rates []VATSubtotal `json:"rates"`
+	Net   int           `json:"net"`
+	Tax   int           `json:"tax"`
+	Gross int           `json:"gross"`
+}
+
+// GetTaxReport sums up the sales of the verified orders placed in
+// [from, to) per VAT rate
+func (db *Database) GetTaxReport(from, to time.Time) (TaxReport, error) {
+	report := TaxReport{From: from, To: to, Rates: []VATSubtotal{}}
+	ctx := context.Background()
+	entries, err := db.EntClient.OrderEntry.Query().
+		Where(
+			entorderentry.IsSale(true),
+			entorderentry.HasOrderWith(
+				entorder.Verified(true),
+				entorder.TimestampGTE(from),
+				entorder.TimestampLT(to),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		log.Error("GetTaxReport: ", err)
+		return report, err
+	}
+	settings, err := db.GetSettings()
+	if err != nil {
+		return report, err
+	}
+	rates := map[int]float64{}
+	itemRate := func(id int) float64 {
+		if _, ok := rates[id]; !ok {
+			item, err := db.GetItem(id)
+			if err != nil {
+				log.Error("GetTaxReport: get item: ", err)
+			}
+			rates[id] = itemVATRate(item, settings.DefaultVATRate)
+		}
+		return rates[id]
+	}
+
+	subtotals := map[float64]*VATSubtotal{}
+	for _, e := range entries {
+		rate, net, tax, gross := entryTaxes(convertOrderEntry(e), itemRate)
+		subtotal, ok := subtotals[rate]
+		if !ok {
+			subtotal = &VATSubtotal{Rate: rate}
+			subtotals[rate] = subtotal
+		}
+		subtotal.Net += net
+		subtotal.Tax += tax
+		subtotal.Gross += gross
+		report.Net += net
+		report.Tax += tax
+		report.Gross += gross
+	}
+	for _, subtotal := range subtotals {
+		report.Rates = append(report.Rates, *subtotal)
+	}
+	sort.Slice(report.Rates, func(i, j int) bool { return report.Rates[i].Rate < report.Rates[j].Rate })
+	return report, nil
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestEntryTaxes(t *testing.T) {
+	require.Equal(t, 20.0, itemVATRate(Item{Type: "normal_item", VATRate: null.FloatFrom(20)}, 10))
+	require.Equal(t, 0.0, itemVATRate(Item{Type: "donation"}, 10))
+	require.Equal(t, 10.0, itemVATRate(Item{Type: "issue"}, 10))
+
+	itemRate := func(int) float64 { return 20 }
+	// Recorded at purchase
+	rate, net, tax, gross := entryTaxes(OrderEntry{Item: 1, Quantity: 2, Price: 550, VATRate: 10, Net: 1000, Tax: 100, Gross: 1100}, itemRate)
+	require.Equal(t, []any{10.0, 1000, 100, 1100}, []any{rate, net, tax, gross})
+	// Recorded before VAT was, taxed with the current rate of the item
+	rate, net, tax, gross = entryTaxes(OrderEntry{Item: 1, Quantity: 1, Price: 1200}, itemRate)
+	require.Equal(t, []any{20.0, 1000, 200, 1200}, []any{rate, net, tax, gross})
+}
+
+func TestDiscountShares(t *testing.T) {
+	items := map[int]Item{
+		1: {ID: 1, Type: "issue"},
+		2: {ID: 2, Type: "normal_item", VATRate: null.FloatFrom(20)},
+		3: {ID: 3, Type: "donation"},
+	}
+	entries := []OrderEntry{
+		{Item: 1, Quantity: 2, Price: 550, IsSale: true},
+		{Item: 2, Quantity: 1, Price: 1200, IsSale: true},
+		{Item: 3, Quantity: 500, Price: 1, IsSale: true},
+	}
+	code := DiscountCode{Kind: DiscountKindPercentage, Value: 10}
+	amount := code.Discount(entries, items)
+	require.Equal(t, 230, amount)
+	require.Equal(t, []DiscountShare{{VATRate: 10, Amount: 110}, {VATRate: 20, Amount: 120}}, code.DiscountShares(amount, entries, items, 10))
+
+	// Rounded shares add up to the discount
+	fixed := DiscountCode{Kind: DiscountKindFixed, Value: 100}
+	shares := fixed.DiscountShares(100, entries, items, 10)
+	require.Equal(t, 100, shares[0].Amount+shares[1].Amount)
+
+	// Discounts of a single item only reduce the VAT of its rate
+	fixed.ItemID = null.IntFrom(1)
+	require.Equal(t, []DiscountShare{{VATRate: 10, Amount: 100}}, fixed.DiscountShares(100, entries, items, 10))
+}
+
+func TestTaxReport(t *testing.T) {
+	require.NoError(t, Db.InitEmptyTestDb())
+	ctx := context.Background()
+	require.NoError(t, Db.EntClient.Settings.Update().SetDefaultVATRate(10).Exec(ctx))
+	newspaperID, err := Db.CreateItem(Item{Name: "Issue 800", Description: "Newspaper", Price: 550, Type: "issue"})
+	require.NoError(t, err)
+	bagID, err := Db.CreateItem(Item{Name: "Bag", Description: "Cotton bag", Price: 1200, Type: "normal_item", VATRate: null.FloatFrom(20)})
+	require.NoError(t, err)
+	item, err := Db.GetItem(bagID)
+	require.NoError(t, err)
+	require.Equal(t, null.FloatFrom(20), item.VATRate)
+
+	discountItem, err := Db.GetOrCreateDiscountItem()
+	require.NoError(t, err)
+
+	// A discount of 10% reduces the VAT of both rates
+	orderID, err := Db.CreateOrder(Order{
+		OrderCode: null.StringFrom("tax-1"),
+		Entries: []OrderEntry{
+			{Item: newspaperID, Quantity: 2, IsSale: true},
+			{Item: bagID, Quantity: 1, IsSale: true},
+			{Item: discountItem.ID, Quantity: -110, IsSale: true, VATRate: 10},
+			{Item: discountItem.ID, Quantity: -120, IsSale: true, VATRate: 20},
+		},
+	})
+	require.NoError(t, err)
+	order, err := Db.GetOrderByID(orderID)
+	require.NoError(t, err)
+	require.Len(t, order.Entries, 4)
+	for _, entry := range order.Entries {
+		if entry.Item == bagID {
+			require.Equal(t, []any{20.0, 1000, 200, 1200}, []any{entry.VATRate, entry.Net, entry.Tax, entry.Gross})
+		}
+		if entry.Item == discountItem.ID && entry.Quantity == -110 {
+			require.Equal(t, []any{10.0, -100, -10, -110}, []any{entry.VATRate, entry.Net, entry.Tax, entry.Gross})
+		}
+	}
+
+	from := time.Now().Add(-time.Hour)
+	to := time.Now().Add(time.Hour)
+	report, err := Db.GetTaxReport(from, to)
+	require.NoError(t, err)
+	require.Empty(t, report.Rates, "unverified orders are not reported")
+
+	require.NoError(t, Db.EntClient.Order.UpdateOneID(orderID).SetVerified(true).Exec(ctx))
+	report, err = Db.GetTaxReport(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []VATSubtotal{
+		{Rate: 10, Net: 900, Tax: 90, Gross: 990},
+		{Rate: 20, Net: 900, Tax: 180, Gross: 1080},
+	}, report.Rates)
+	require.Equal(t, 270, report.Tax)
+	require.Equal(t, 2070, report.Gross)
+}
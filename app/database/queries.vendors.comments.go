@@ -21,25 +21,56 @@ func (db *Database) GetVendorComments(vendorId int) (comments []*ent.Comment, er
 }
 
 func (db *Database) CreateVendorComment(vendorID int, comment ent.Comment) (err error) {
-	_, err = db.EntClient.Comment.Create().SetVendorID(vendorID).SetComment(comment.Comment).SetCreatedAt(comment.CreatedAt).SetResolvedAt(comment.ResolvedAt).SetWarning(comment.Warning).Save(context.Background())
-	if err != nil {
-		log.Error("CreateVendorComment", err)
-	}
-	return err
+	return db.inTx(func(txDb *Database) error {
+		created, err := txDb.EntClient.Comment.Create().SetVendorID(vendorID).SetComment(comment.Comment).SetCreatedAt(comment.CreatedAt).SetResolvedAt(comment.ResolvedAt).SetWarning(comment.Warning).Save(context.Background())
+		if err != nil {
+			log.Error("CreateVendorComment", err)
+			return err
+		}
+		return txDb.audit(AuditCommentCreate, "comment", created.ID, nil, vendorCommentAuditState(vendorID, created))
+	})
 }
 
 func (db *Database) UpdateVendorComment(vendorID int, comment ent.Comment) (err error) {
-	_, err = db.EntClient.Comment.UpdateOneID(comment.ID).SetComment(comment.Comment).SetResolvedAt(comment.ResolvedAt).SetWarning(comment.Warning).Save(context.Background())
-	if err != nil {
-		log.Error("UpdateVendorComment", err)
-	}
-	return err
+	return db.inTx(func(txDb *Database) error {
+		var before *ent.Comment
+		if txDb.auditing() {
+			before, _ = txDb.EntClient.Comment.Get(context.Background(), comment.ID)
+		}
+		updated, err := txDb.EntClient.Comment.UpdateOneID(comment.ID).SetComment(comment.Comment).SetResolvedAt(comment.ResolvedAt).SetWarning(comment.Warning).Save(context.Background())
+		if err != nil {
+			log.Error("UpdateVendorComment", err)
+			return err
+		}
+		return txDb.audit(AuditCommentUpdate, "comment", comment.ID, vendorCommentAuditState(vendorID, before), vendorCommentAuditState(vendorID, updated))
+	})
 }
 
 func (db *Database) DeleteVendorComment(vendorID int, commentID int) (err error) {
-	err = db.EntClient.Comment.DeleteOneID(commentID).Exec(context.Background())
-	if err != nil {
-		log.Error("DeleteVendorComment", err)
+	return db.inTx(func(txDb *Database) error {
+		var before *ent.Comment
+		if txDb.auditing() {
+			before, _ = txDb.EntClient.Comment.Get(context.Background(), commentID)
+		}
+		err := txDb.EntClient.Comment.DeleteOneID(commentID).Exec(context.Background())
+		if err != nil {
+			log.Error("DeleteVendorComment", err)
+			return err
+		}
+		return txDb.audit(AuditCommentDelete, "comment", commentID, vendorCommentAuditState(vendorID, before), nil)
+	})
+}
+
+// vendorCommentAuditState is the state of a comment recorded in the audit
+// log
+func vendorCommentAuditState(vendorID int, comment *ent.Comment) map[string]any {
+	if comment == nil {
+		return nil
+	}
+	return map[string]any{
+		"vendor":      vendorID,
+		"comment":     comment.Comment,
+		"warning":     comment.Warning,
+		"resolved_at": comment.ResolvedAt,
 	}
-	return err
 }
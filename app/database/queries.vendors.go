@@ -198,47 +198,53 @@ func (db *Database) GetVendorWithBalanceUpdate(vendorID int) (vendor Vendor, err
 // CreateVendor creates a vendor and an associated account in the database
 func (db *Database) CreateVendor(vendor Vendor) (vendorID int, err error) {
 	vendor.Email = utils.ToLower(vendor.Email)
-	// Create vendor
-	v, err := db.EntClient.Vendor.Create().
-		SetAccountproofurl(vendor.AccountProofUrl.String).
-		SetEmail(vendor.Email).
-		SetFirstname(vendor.FirstName).
-		SetHasbankaccount(vendor.HasBankAccount).
-		SetHassmartphone(vendor.HasSmartphone).
-		SetIsdeleted(vendor.IsDeleted).
-		SetIsdisabled(vendor.IsDisabled).
-		SetKeycloakid(vendor.KeycloakID).
-		SetLanguage(vendor.Language).
-		SetLastname(vendor.LastName).
-		SetLastpayout(vendor.LastPayout.Time).
-		SetLicenseid(vendor.LicenseID.String).
-		SetOnlinemap(vendor.OnlineMap).
-		SetRegistrationdate(vendor.RegistrationDate).
-		SetTelephone(vendor.Telephone).
-		SetVendorsince(vendor.VendorSince).
-		SetUrlid(vendor.UrlID).
-		SetDebt(vendor.Debt).
-		Save(context.Background())
-	if err != nil {
-		log.Errorf("CreateVendor: create vendor %s %+v", vendor.Email, err)
-		return
-	}
-	vendorID = v.ID
-	log.Info("CreateVendor: created vendor %v", vendorID)
-
-	// Create vendor account
-	_, err = db.EntClient.Account.Create().
-		SetName(vendor.LicenseID.String).
-		SetBalance(0).
-		SetType("Vendor").
-		SetVendorID(vendorID).
-		Save(context.Background())
+	err = db.inTx(func(txDb *Database) error {
+		// Create vendor
+		v, err := txDb.EntClient.Vendor.Create().
+			SetAccountproofurl(vendor.AccountProofUrl.String).
+			SetEmail(vendor.Email).
+			SetFirstname(vendor.FirstName).
+			SetHasbankaccount(vendor.HasBankAccount).
+			SetHassmartphone(vendor.HasSmartphone).
+			SetIsdeleted(vendor.IsDeleted).
+			SetIsdisabled(vendor.IsDisabled).
+			SetKeycloakid(vendor.KeycloakID).
+			SetLanguage(vendor.Language).
+			SetLastname(vendor.LastName).
+			SetLastpayout(vendor.LastPayout.Time).
+			SetLicenseid(vendor.LicenseID.String).
+			SetOnlinemap(vendor.OnlineMap).
+			SetRegistrationdate(vendor.RegistrationDate).
+			SetTelephone(vendor.Telephone).
+			SetVendorsince(vendor.VendorSince).
+			SetUrlid(vendor.UrlID).
+			SetDebt(vendor.Debt).
+			Save(context.Background())
+		if err != nil {
+			log.Errorf("CreateVendor: create vendor %s %+v", vendor.Email, err)
+			return err
+		}
+		vendorID = v.ID
+		log.Info("CreateVendor: created vendor %v", vendorID)
+
+		// Create vendor account
+		_, err = txDb.EntClient.Account.Create().
+			SetName(vendor.LicenseID.String).
+			SetBalance(0).
+			SetType("Vendor").
+			SetVendorID(vendorID).
+			Save(context.Background())
+		if err != nil {
+			log.Errorf("CreateVendor: create vendor account %s %+v", vendor.Email, err)
+			return err
+		}
+		log.Infof("CreateVendor: created vendor %s", vendor.Email)
+		vendor.ID = vendorID
+		return txDb.audit(AuditVendorCreate, "vendor", vendorID, nil, vendor)
+	})
 	if err != nil {
-		log.Errorf("CreateVendor: create vendor account %s %+v", vendor.Email, err)
-		return
+		return 0, err
 	}
-	log.Infof("CreateVendor: created vendor %s", vendor.Email)
-
 	return
 }
 
@@ -246,10 +252,22 @@ func (db *Database) CreateVendor(vendor Vendor) (vendorID int, err error) {
 func (db *Database) UpdateVendor(id int, vendor Vendor) (err error) {
 	vendor.Email = utils.ToLower(vendor.Email)
 	ctx := context.Background()
-	v := db.VendorIntoVendorEnt(vendor)
-	_, err = db.EntClient.Vendor.UpdateOneID(id).SetAccountproofurl(v.Accountproofurl).SetEmail(v.Email).SetFirstname(v.Firstname).SetHasbankaccount(v.Hasbankaccount).SetHassmartphone(v.Hassmartphone).SetIsdeleted(v.Isdeleted).SetIsdisabled(v.Isdisabled).SetKeycloakid(v.Keycloakid).SetLanguage(v.Language).SetLastname(v.Lastname).SetLastpayout(v.Lastpayout).SetLicenseid(v.Licenseid).SetOnlinemap(v.Onlinemap).SetRegistrationdate(v.Registrationdate).SetTelephone(v.Telephone).SetUrlid(v.Urlid).SetDebt(v.Debt).Save(ctx)
-
-	return err
+	return db.inTx(func(txDb *Database) error {
+		var before Vendor
+		if txDb.auditing() {
+			before, _ = txDb.GetVendor(id)
+		}
+		v := txDb.VendorIntoVendorEnt(vendor)
+		_, err := txDb.EntClient.Vendor.UpdateOneID(id).SetAccountproofurl(v.Accountproofurl).SetEmail(v.Email).SetFirstname(v.Firstname).SetHasbankaccount(v.Hasbankaccount).SetHassmartphone(v.Hassmartphone).SetIsdeleted(v.Isdeleted).SetIsdisabled(v.Isdisabled).SetKeycloakid(v.Keycloakid).SetLanguage(v.Language).SetLastname(v.Lastname).SetLastpayout(v.Lastpayout).SetLicenseid(v.Licenseid).SetOnlinemap(v.Onlinemap).SetRegistrationdate(v.Registrationdate).SetTelephone(v.Telephone).SetUrlid(v.Urlid).SetDebt(v.Debt).Save(ctx)
+		if err != nil {
+			return err
+		}
+		if !txDb.auditing() {
+			return nil
+		}
+		after, _ := txDb.GetVendor(id)
+		return txDb.audit(AuditVendorUpdate, "vendor", id, before, after)
+	})
 }
 
 // DeleteVendor deletes a user in the database and the associated account
@@ -263,16 +281,20 @@ func (db *Database) DeleteVendor(vendorID int) (err error) {
 	}
 
 	name := utils.RandomString(10)
-	_, err = db.EntClient.Vendor.Update().
-		Where(entvendor.ID(vendorID)).
-		SetIsdeleted(true). // Update the isdeleted field
-		SetLicenseid("del_" + v.Licenseid + "_" + name).
-		Save(ctx)
-	if err != nil {
-		log.Error("DeleteVendor: ", err)
-	}
-
-	return
+	return db.inTx(func(txDb *Database) error {
+		_, err := txDb.EntClient.Vendor.Update().
+			Where(entvendor.ID(vendorID)).
+			SetIsdeleted(true). // Update the isdeleted field
+			SetLicenseid("del_" + v.Licenseid + "_" + name).
+			Save(ctx)
+		if err != nil {
+			log.Error("DeleteVendor: ", err)
+			return err
+		}
+		return txDb.audit(AuditVendorDelete, "vendor", vendorID,
+			map[string]any{"licenseid": v.Licenseid, "isdeleted": v.Isdeleted},
+			map[string]any{"licenseid": "del_" + v.Licenseid + "_" + name, "isdeleted": true})
+	})
 }
 
 func (db *Database) GetAdditionalVendorData(vendor Vendor) (Vendor, error) {
@@ -2,7 +2,9 @@ package database
 
 import (
 	"context"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/augustin-wien/augustina-backend/ent"
 	entlocation "github.com/augustin-wien/augustina-backend/ent/location"
 	entvendor "github.com/augustin-wien/augustina-backend/ent/vendor"
@@ -19,9 +21,18 @@ func (db *Database) GetLocationsByVendorID(vendorID int) (locations []*ent.Locat
 	return locations, nil
 }
 
+// GetLocationByID fetches a single location.
+func (db *Database) GetLocationByID(locationID int) (location *ent.Location, err error) {
+	location, err = db.EntClient.Location.Get(context.Background(), locationID)
+	if err != nil {
+		log.Error("GetLocationByID", err)
+	}
+	return location, err
+}
+
 // CreateLocation creates a new location for a given vendor.
 func (db *Database) CreateLocation(vendorID int, location ent.Location) (err error) {
-	_, err = db.EntClient.Location.Create().SetVendorID(vendorID).SetName(location.Name).SetAddress(location.Address).SetLongitude(location.Longitude).SetLatitude(location.Latitude).SetZip(location.Zip).SetWorkingTime(location.WorkingTime).Save(context.Background())
+	_, err = db.EntClient.Location.Create().SetVendorID(vendorID).SetName(location.Name).SetAddress(location.Address).SetLongitude(location.Longitude).SetLatitude(location.Latitude).SetZip(location.Zip).SetGeocodingConfidence(location.GeocodingConfidence).SetNillableGeocodeAttemptedAt(location.GeocodeAttemptedAt).SetWorkingTime(location.WorkingTime).Save(context.Background())
 	if err != nil {
 		log.Error("CreateLocation", err)
 	}
@@ -30,13 +41,58 @@ func (db *Database) CreateLocation(vendorID int, location ent.Location) (err err
 
 // UpdateLocation updates a location.
 func (db *Database) UpdateLocation(location ent.Location) (err error) {
-	_, err = db.EntClient.Location.UpdateOneID(location.ID).SetName(location.Name).SetAddress(location.Address).SetLongitude(location.Longitude).SetLatitude(location.Latitude).SetZip(location.Zip).SetWorkingTime(location.WorkingTime).Save(context.Background())
+	update := db.EntClient.Location.UpdateOneID(location.ID).SetName(location.Name).SetAddress(location.Address).SetLongitude(location.Longitude).SetLatitude(location.Latitude).SetZip(location.Zip).SetGeocodingConfidence(location.GeocodingConfidence).SetWorkingTime(location.WorkingTime)
+	if location.GeocodeAttemptedAt == nil {
+		update = update.ClearGeocodeAttemptedAt()
+	} else {
+		update = update.SetGeocodeAttemptedAt(*location.GeocodeAttemptedAt)
+	}
+	_, err = update.Save(context.Background())
 	if err != nil {
 		log.Error("UpdateLocation", err)
 	}
 	return err
 }
 
+// UpdateLocationCoordinates stores geocoded coordinates of a location.
+func (db *Database) UpdateLocationCoordinates(locationID int, latitude, longitude, confidence float64) (err error) {
+	err = db.EntClient.Location.UpdateOneID(locationID).SetLatitude(latitude).SetLongitude(longitude).SetGeocodingConfidence(confidence).ClearGeocodeAttemptedAt().Exec(context.Background())
+	if err != nil {
+		log.Error("UpdateLocationCoordinates", err)
+	}
+	return err
+}
+
+// RecordLocationGeocodeFailure stores when geocoding a location failed.
+func (db *Database) RecordLocationGeocodeFailure(locationID int, at time.Time) (err error) {
+	err = db.EntClient.Location.UpdateOneID(locationID).SetGeocodeAttemptedAt(at).Exec(context.Background())
+	if err != nil {
+		log.Error("RecordLocationGeocodeFailure", err)
+	}
+	return err
+}
+
+// ListLocationsWithDefaultCoordinates returns all locations whose
+// coordinates were never entered, i.e. are still 0 or the default 0.1.
+// Locations that were never geocoded come first, failed ones by the time of
+// their last attempt, so they do not block the others.
+func (db *Database) ListLocationsWithDefaultCoordinates() (locations []*ent.Location, err error) {
+	locations, err = db.EntClient.Location.Query().
+		Where(
+			entlocation.LatitudeIn(0, 0.1),
+			entlocation.LongitudeIn(0, 0.1),
+		).
+		Order(
+			entlocation.ByGeocodeAttemptedAt(sql.OrderNullsFirst()),
+			entlocation.ByID(),
+		).
+		All(context.Background())
+	if err != nil {
+		log.Error("ListLocationsWithDefaultCoordinates", err)
+	}
+	return locations, err
+}
+
 // DeleteLocation deletes a location.
 func (db *Database) DeleteLocation(locationID int) (err error) {
 	err = db.EntClient.Location.DeleteOneID(locationID).Exec(context.Background())
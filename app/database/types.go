@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -67,22 +68,32 @@ type Account struct {
 
 // Item is a struct that is used for the item table
 type Item struct {
-	ID            int
-	Archived      bool
-	Disabled      bool
-	ItemColor     null.String // Color of the item in the webshop
-	ItemTextColor null.String // Text color of the item in the webshop
-	Description   string
-	Name          string
-	Image         string
-	IsLicenseItem bool
-	IsPDFItem     bool
-	ItemOrder     int // Order in the webshop
-	LicenseGroup  null.String
-	LicenseItem   null.Int // License has to be bought before item
-	PDF           null.Int
-	Price         int    // Price in cents
-	Type          string // Type of item: normal_item, license_item, issue, online_issue, donation, transaction_costs, abonement
+	ID                int
+	Archived          bool
+	Disabled          bool
+	ItemColor         null.String // Color of the item in the webshop
+	ItemTextColor     null.String // Text color of the item in the webshop
+	Description       string
+	Name              string
+	Image             string
+	ImageVariants     map[string]string // Resized and WebP versions of Image by variant name
+	IsLicenseItem     bool
+	IsPDFItem         bool
+	ItemOrder         int // Order in the webshop
+	LicenseGroup      null.String
+	LicenseItem       null.Int // License has to be bought before item
+	PDF               null.Int
+	Price             int       // Price in cents
+	Type              string    // Type of item: normal_item, license_item, issue, online_issue, donation, transaction_costs, abonement, discount
+	PublishAt         null.Time `swaggertype:"string"` // Item becomes visible at this time
+	UnpublishAt       null.Time `swaggertype:"string"` // Item is hidden from this time on
+	TrackStock        bool      // Office stock of printed copies is tracked
+	Stock             int       // Copies in stock at the office
+	LowStockThreshold int       // Alert when the stock falls to this level
+	// Overrides of the download settings for PDF items, empty uses the settings
+	PDFDownloadLimit      null.Int
+	PDFDownloadExpiryDays null.Int
+	VATRate               null.Float // VAT rate in percent, empty uses the default rate of the settings
 }
 
 // Order is a struct that is used for the order table
@@ -98,6 +109,15 @@ type Order struct {
 	Vendor            int
 	Entries           []OrderEntry
 	CustomerEmail     null.String `db:"customeremail"`
+	// Gift orders grant their items through gift codes, see GiftCode
+	Gift               bool
+	GiftRecipientEmail null.String
+	GiftSendAt         null.Time
+	GiftMessage        string
+	// Entered at checkout for the invoice, see Invoice
+	BillingAddress *schema.BillingAddress
+	// The buyer agreed to renew the abonements automatically, see PaymentMandate
+	AutoRenew bool
 }
 
 // OrderEntry is a struct that is used for the order_entry table
@@ -111,6 +131,11 @@ type OrderEntry struct {
 	SenderName   string
 	ReceiverName string
 	IsSale       bool // Whether to include this item in sales payment
+	// Taxes at the time of purchase in cents for the whole entry
+	VATRate float64
+	Net     int
+	Tax     int
+	Gross   int
 }
 
 // Payment is a struct that is used for the payment table
@@ -185,4 +210,7 @@ type PDFDownload struct {
 	LastDownload  time.Time
 	DownloadCount int
 	ItemID        null.Int
+	Suspended     bool
+	SuspendedAt   null.Time `swaggertype:"string"`
+	SuspendReason string
 }
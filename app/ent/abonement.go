@@ -29,6 +29,20 @@ type Abonement struct {
 	ToDate time.Time `json:"to_date,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// RemindedAt holds the value of the "reminded_at" field.
+	RemindedAt *time.Time `json:"reminded_at,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID *int `json:"order_id,omitempty"`
+	// AutoRenew holds the value of the "auto_renew" field.
+	AutoRenew bool `json:"auto_renew,omitempty"`
+	// PaymentMandateID holds the value of the "payment_mandate_id" field.
+	PaymentMandateID *int `json:"payment_mandate_id,omitempty"`
+	// RenewalAttempts holds the value of the "renewal_attempts" field.
+	RenewalAttempts int `json:"renewal_attempts,omitempty"`
+	// NextRenewalAt holds the value of the "next_renewal_at" field.
+	NextRenewalAt *time.Time `json:"next_renewal_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -77,11 +91,13 @@ func (*Abonement) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case abonement.FieldID, abonement.FieldCustomerID, abonement.FieldItemID:
+		case abonement.FieldAutoRenew:
+			values[i] = new(sql.NullBool)
+		case abonement.FieldID, abonement.FieldCustomerID, abonement.FieldItemID, abonement.FieldOrderID, abonement.FieldPaymentMandateID, abonement.FieldRenewalAttempts:
 			values[i] = new(sql.NullInt64)
 		case abonement.FieldStatus:
 			values[i] = new(sql.NullString)
-		case abonement.FieldFromDate, abonement.FieldToDate, abonement.FieldCreatedAt, abonement.FieldUpdatedAt:
+		case abonement.FieldFromDate, abonement.FieldToDate, abonement.FieldCancelledAt, abonement.FieldRemindedAt, abonement.FieldNextRenewalAt, abonement.FieldCreatedAt, abonement.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +151,53 @@ func (_m *Abonement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case abonement.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				_m.CancelledAt = new(time.Time)
+				*_m.CancelledAt = value.Time
+			}
+		case abonement.FieldRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminded_at", values[i])
+			} else if value.Valid {
+				_m.RemindedAt = new(time.Time)
+				*_m.RemindedAt = value.Time
+			}
+		case abonement.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = new(int)
+				*_m.OrderID = int(value.Int64)
+			}
+		case abonement.FieldAutoRenew:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_renew", values[i])
+			} else if value.Valid {
+				_m.AutoRenew = value.Bool
+			}
+		case abonement.FieldPaymentMandateID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_mandate_id", values[i])
+			} else if value.Valid {
+				_m.PaymentMandateID = new(int)
+				*_m.PaymentMandateID = int(value.Int64)
+			}
+		case abonement.FieldRenewalAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field renewal_attempts", values[i])
+			} else if value.Valid {
+				_m.RenewalAttempts = int(value.Int64)
+			}
+		case abonement.FieldNextRenewalAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_renewal_at", values[i])
+			} else if value.Valid {
+				_m.NextRenewalAt = new(time.Time)
+				*_m.NextRenewalAt = value.Time
+			}
 		case abonement.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -212,6 +275,37 @@ func (_m *Abonement) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
+	if v := _m.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RemindedAt; v != nil {
+		builder.WriteString("reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.OrderID; v != nil {
+		builder.WriteString("order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_renew=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoRenew))
+	builder.WriteString(", ")
+	if v := _m.PaymentMandateID; v != nil {
+		builder.WriteString("payment_mandate_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("renewal_attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RenewalAttempts))
+	builder.WriteString(", ")
+	if v := _m.NextRenewalAt; v != nil {
+		builder.WriteString("next_renewal_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
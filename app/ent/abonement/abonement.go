@@ -22,6 +22,20 @@ const (
 	FieldToDate = "to_date"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldCancelledAt holds the string denoting the cancelled_at field in the database.
+	FieldCancelledAt = "cancelled_at"
+	// FieldRemindedAt holds the string denoting the reminded_at field in the database.
+	FieldRemindedAt = "reminded_at"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "paymentorder"
+	// FieldAutoRenew holds the string denoting the auto_renew field in the database.
+	FieldAutoRenew = "auto_renew"
+	// FieldPaymentMandateID holds the string denoting the payment_mandate_id field in the database.
+	FieldPaymentMandateID = "payment_mandate"
+	// FieldRenewalAttempts holds the string denoting the renewal_attempts field in the database.
+	FieldRenewalAttempts = "renewal_attempts"
+	// FieldNextRenewalAt holds the string denoting the next_renewal_at field in the database.
+	FieldNextRenewalAt = "next_renewal_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -56,6 +70,13 @@ var Columns = []string{
 	FieldFromDate,
 	FieldToDate,
 	FieldStatus,
+	FieldCancelledAt,
+	FieldRemindedAt,
+	FieldOrderID,
+	FieldAutoRenew,
+	FieldPaymentMandateID,
+	FieldRenewalAttempts,
+	FieldNextRenewalAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -73,6 +94,10 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultAutoRenew holds the default value on creation for the "auto_renew" field.
+	DefaultAutoRenew bool
+	// DefaultRenewalAttempts holds the default value on creation for the "renewal_attempts" field.
+	DefaultRenewalAttempts int
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -110,6 +135,41 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByCancelledAt orders the results by the cancelled_at field.
+func ByCancelledAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelledAt, opts...).ToFunc()
+}
+
+// ByRemindedAt orders the results by the reminded_at field.
+func ByRemindedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemindedAt, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByAutoRenew orders the results by the auto_renew field.
+func ByAutoRenew(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoRenew, opts...).ToFunc()
+}
+
+// ByPaymentMandateID orders the results by the payment_mandate_id field.
+func ByPaymentMandateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMandateID, opts...).ToFunc()
+}
+
+// ByRenewalAttempts orders the results by the renewal_attempts field.
+func ByRenewalAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRenewalAttempts, opts...).ToFunc()
+}
+
+// ByNextRenewalAt orders the results by the next_renewal_at field.
+func ByNextRenewalAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextRenewalAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -80,6 +80,41 @@ func Status(v string) predicate.Abonement {
 	return predicate.Abonement(sql.FieldEQ(FieldStatus, v))
 }
 
+// CancelledAt applies equality check predicate on the "cancelled_at" field. It's identical to CancelledAtEQ.
+func CancelledAt(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// RemindedAt applies equality check predicate on the "reminded_at" field. It's identical to RemindedAtEQ.
+func RemindedAt(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldOrderID, v))
+}
+
+// AutoRenew applies equality check predicate on the "auto_renew" field. It's identical to AutoRenewEQ.
+func AutoRenew(v bool) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldAutoRenew, v))
+}
+
+// PaymentMandateID applies equality check predicate on the "payment_mandate_id" field. It's identical to PaymentMandateIDEQ.
+func PaymentMandateID(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldPaymentMandateID, v))
+}
+
+// RenewalAttempts applies equality check predicate on the "renewal_attempts" field. It's identical to RenewalAttemptsEQ.
+func RenewalAttempts(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldRenewalAttempts, v))
+}
+
+// NextRenewalAt applies equality check predicate on the "next_renewal_at" field. It's identical to NextRenewalAtEQ.
+func NextRenewalAt(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldNextRenewalAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Abonement {
 	return predicate.Abonement(sql.FieldEQ(FieldCreatedAt, v))
@@ -285,6 +320,306 @@ func StatusContainsFold(v string) predicate.Abonement {
 	return predicate.Abonement(sql.FieldContainsFold(FieldStatus, v))
 }
 
+// CancelledAtEQ applies the EQ predicate on the "cancelled_at" field.
+func CancelledAtEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtNEQ applies the NEQ predicate on the "cancelled_at" field.
+func CancelledAtNEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldCancelledAt, v))
+}
+
+// CancelledAtIn applies the In predicate on the "cancelled_at" field.
+func CancelledAtIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtNotIn applies the NotIn predicate on the "cancelled_at" field.
+func CancelledAtNotIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldCancelledAt, vs...))
+}
+
+// CancelledAtGT applies the GT predicate on the "cancelled_at" field.
+func CancelledAtGT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldCancelledAt, v))
+}
+
+// CancelledAtGTE applies the GTE predicate on the "cancelled_at" field.
+func CancelledAtGTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldCancelledAt, v))
+}
+
+// CancelledAtLT applies the LT predicate on the "cancelled_at" field.
+func CancelledAtLT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldCancelledAt, v))
+}
+
+// CancelledAtLTE applies the LTE predicate on the "cancelled_at" field.
+func CancelledAtLTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldCancelledAt, v))
+}
+
+// CancelledAtIsNil applies the IsNil predicate on the "cancelled_at" field.
+func CancelledAtIsNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldIsNull(FieldCancelledAt))
+}
+
+// CancelledAtNotNil applies the NotNil predicate on the "cancelled_at" field.
+func CancelledAtNotNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotNull(FieldCancelledAt))
+}
+
+// RemindedAtEQ applies the EQ predicate on the "reminded_at" field.
+func RemindedAtEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtNEQ applies the NEQ predicate on the "reminded_at" field.
+func RemindedAtNEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldRemindedAt, v))
+}
+
+// RemindedAtIn applies the In predicate on the "reminded_at" field.
+func RemindedAtIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtNotIn applies the NotIn predicate on the "reminded_at" field.
+func RemindedAtNotIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldRemindedAt, vs...))
+}
+
+// RemindedAtGT applies the GT predicate on the "reminded_at" field.
+func RemindedAtGT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldRemindedAt, v))
+}
+
+// RemindedAtGTE applies the GTE predicate on the "reminded_at" field.
+func RemindedAtGTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldRemindedAt, v))
+}
+
+// RemindedAtLT applies the LT predicate on the "reminded_at" field.
+func RemindedAtLT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldRemindedAt, v))
+}
+
+// RemindedAtLTE applies the LTE predicate on the "reminded_at" field.
+func RemindedAtLTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldRemindedAt, v))
+}
+
+// RemindedAtIsNil applies the IsNil predicate on the "reminded_at" field.
+func RemindedAtIsNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldIsNull(FieldRemindedAt))
+}
+
+// RemindedAtNotNil applies the NotNil predicate on the "reminded_at" field.
+func RemindedAtNotNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotNull(FieldRemindedAt))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotNull(FieldOrderID))
+}
+
+// AutoRenewEQ applies the EQ predicate on the "auto_renew" field.
+func AutoRenewEQ(v bool) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldAutoRenew, v))
+}
+
+// AutoRenewNEQ applies the NEQ predicate on the "auto_renew" field.
+func AutoRenewNEQ(v bool) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldAutoRenew, v))
+}
+
+// PaymentMandateIDEQ applies the EQ predicate on the "payment_mandate_id" field.
+func PaymentMandateIDEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDNEQ applies the NEQ predicate on the "payment_mandate_id" field.
+func PaymentMandateIDNEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDIn applies the In predicate on the "payment_mandate_id" field.
+func PaymentMandateIDIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldPaymentMandateID, vs...))
+}
+
+// PaymentMandateIDNotIn applies the NotIn predicate on the "payment_mandate_id" field.
+func PaymentMandateIDNotIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldPaymentMandateID, vs...))
+}
+
+// PaymentMandateIDGT applies the GT predicate on the "payment_mandate_id" field.
+func PaymentMandateIDGT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDGTE applies the GTE predicate on the "payment_mandate_id" field.
+func PaymentMandateIDGTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDLT applies the LT predicate on the "payment_mandate_id" field.
+func PaymentMandateIDLT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDLTE applies the LTE predicate on the "payment_mandate_id" field.
+func PaymentMandateIDLTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldPaymentMandateID, v))
+}
+
+// PaymentMandateIDIsNil applies the IsNil predicate on the "payment_mandate_id" field.
+func PaymentMandateIDIsNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldIsNull(FieldPaymentMandateID))
+}
+
+// PaymentMandateIDNotNil applies the NotNil predicate on the "payment_mandate_id" field.
+func PaymentMandateIDNotNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotNull(FieldPaymentMandateID))
+}
+
+// RenewalAttemptsEQ applies the EQ predicate on the "renewal_attempts" field.
+func RenewalAttemptsEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldRenewalAttempts, v))
+}
+
+// RenewalAttemptsNEQ applies the NEQ predicate on the "renewal_attempts" field.
+func RenewalAttemptsNEQ(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldRenewalAttempts, v))
+}
+
+// RenewalAttemptsIn applies the In predicate on the "renewal_attempts" field.
+func RenewalAttemptsIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldRenewalAttempts, vs...))
+}
+
+// RenewalAttemptsNotIn applies the NotIn predicate on the "renewal_attempts" field.
+func RenewalAttemptsNotIn(vs ...int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldRenewalAttempts, vs...))
+}
+
+// RenewalAttemptsGT applies the GT predicate on the "renewal_attempts" field.
+func RenewalAttemptsGT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldRenewalAttempts, v))
+}
+
+// RenewalAttemptsGTE applies the GTE predicate on the "renewal_attempts" field.
+func RenewalAttemptsGTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldRenewalAttempts, v))
+}
+
+// RenewalAttemptsLT applies the LT predicate on the "renewal_attempts" field.
+func RenewalAttemptsLT(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldRenewalAttempts, v))
+}
+
+// RenewalAttemptsLTE applies the LTE predicate on the "renewal_attempts" field.
+func RenewalAttemptsLTE(v int) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldRenewalAttempts, v))
+}
+
+// NextRenewalAtEQ applies the EQ predicate on the "next_renewal_at" field.
+func NextRenewalAtEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldEQ(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtNEQ applies the NEQ predicate on the "next_renewal_at" field.
+func NextRenewalAtNEQ(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNEQ(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtIn applies the In predicate on the "next_renewal_at" field.
+func NextRenewalAtIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldIn(FieldNextRenewalAt, vs...))
+}
+
+// NextRenewalAtNotIn applies the NotIn predicate on the "next_renewal_at" field.
+func NextRenewalAtNotIn(vs ...time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotIn(FieldNextRenewalAt, vs...))
+}
+
+// NextRenewalAtGT applies the GT predicate on the "next_renewal_at" field.
+func NextRenewalAtGT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGT(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtGTE applies the GTE predicate on the "next_renewal_at" field.
+func NextRenewalAtGTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldGTE(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtLT applies the LT predicate on the "next_renewal_at" field.
+func NextRenewalAtLT(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLT(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtLTE applies the LTE predicate on the "next_renewal_at" field.
+func NextRenewalAtLTE(v time.Time) predicate.Abonement {
+	return predicate.Abonement(sql.FieldLTE(FieldNextRenewalAt, v))
+}
+
+// NextRenewalAtIsNil applies the IsNil predicate on the "next_renewal_at" field.
+func NextRenewalAtIsNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldIsNull(FieldNextRenewalAt))
+}
+
+// NextRenewalAtNotNil applies the NotNil predicate on the "next_renewal_at" field.
+func NextRenewalAtNotNil() predicate.Abonement {
+	return predicate.Abonement(sql.FieldNotNull(FieldNextRenewalAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Abonement {
 	return predicate.Abonement(sql.FieldEQ(FieldCreatedAt, v))
@@ -68,6 +68,104 @@ func (_c *AbonementCreate) SetNillableStatus(v *string) *AbonementCreate {
 	return _c
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_c *AbonementCreate) SetCancelledAt(v time.Time) *AbonementCreate {
+	_c.mutation.SetCancelledAt(v)
+	return _c
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableCancelledAt(v *time.Time) *AbonementCreate {
+	if v != nil {
+		_c.SetCancelledAt(*v)
+	}
+	return _c
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_c *AbonementCreate) SetRemindedAt(v time.Time) *AbonementCreate {
+	_c.mutation.SetRemindedAt(v)
+	return _c
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableRemindedAt(v *time.Time) *AbonementCreate {
+	if v != nil {
+		_c.SetRemindedAt(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *AbonementCreate) SetOrderID(v int) *AbonementCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableOrderID(v *int) *AbonementCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_c *AbonementCreate) SetAutoRenew(v bool) *AbonementCreate {
+	_c.mutation.SetAutoRenew(v)
+	return _c
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableAutoRenew(v *bool) *AbonementCreate {
+	if v != nil {
+		_c.SetAutoRenew(*v)
+	}
+	return _c
+}
+
+// SetPaymentMandateID sets the "payment_mandate_id" field.
+func (_c *AbonementCreate) SetPaymentMandateID(v int) *AbonementCreate {
+	_c.mutation.SetPaymentMandateID(v)
+	return _c
+}
+
+// SetNillablePaymentMandateID sets the "payment_mandate_id" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillablePaymentMandateID(v *int) *AbonementCreate {
+	if v != nil {
+		_c.SetPaymentMandateID(*v)
+	}
+	return _c
+}
+
+// SetRenewalAttempts sets the "renewal_attempts" field.
+func (_c *AbonementCreate) SetRenewalAttempts(v int) *AbonementCreate {
+	_c.mutation.SetRenewalAttempts(v)
+	return _c
+}
+
+// SetNillableRenewalAttempts sets the "renewal_attempts" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableRenewalAttempts(v *int) *AbonementCreate {
+	if v != nil {
+		_c.SetRenewalAttempts(*v)
+	}
+	return _c
+}
+
+// SetNextRenewalAt sets the "next_renewal_at" field.
+func (_c *AbonementCreate) SetNextRenewalAt(v time.Time) *AbonementCreate {
+	_c.mutation.SetNextRenewalAt(v)
+	return _c
+}
+
+// SetNillableNextRenewalAt sets the "next_renewal_at" field if the given value is not nil.
+func (_c *AbonementCreate) SetNillableNextRenewalAt(v *time.Time) *AbonementCreate {
+	if v != nil {
+		_c.SetNextRenewalAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AbonementCreate) SetCreatedAt(v time.Time) *AbonementCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -151,6 +249,14 @@ func (_c *AbonementCreate) defaults() {
 		v := abonement.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.AutoRenew(); !ok {
+		v := abonement.DefaultAutoRenew
+		_c.mutation.SetAutoRenew(v)
+	}
+	if _, ok := _c.mutation.RenewalAttempts(); !ok {
+		v := abonement.DefaultRenewalAttempts
+		_c.mutation.SetRenewalAttempts(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -167,6 +273,12 @@ func (_c *AbonementCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Abonement.status"`)}
 	}
+	if _, ok := _c.mutation.AutoRenew(); !ok {
+		return &ValidationError{Name: "auto_renew", err: errors.New(`ent: missing required field "Abonement.auto_renew"`)}
+	}
+	if _, ok := _c.mutation.RenewalAttempts(); !ok {
+		return &ValidationError{Name: "renewal_attempts", err: errors.New(`ent: missing required field "Abonement.renewal_attempts"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := abonement.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Abonement.id": %w`, err)}
@@ -219,6 +331,34 @@ func (_c *AbonementCreate) createSpec() (*Abonement, *sqlgraph.CreateSpec) {
 		_spec.SetField(abonement.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.CancelledAt(); ok {
+		_spec.SetField(abonement.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := _c.mutation.RemindedAt(); ok {
+		_spec.SetField(abonement.FieldRemindedAt, field.TypeTime, value)
+		_node.RemindedAt = &value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(abonement.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.AutoRenew(); ok {
+		_spec.SetField(abonement.FieldAutoRenew, field.TypeBool, value)
+		_node.AutoRenew = value
+	}
+	if value, ok := _c.mutation.PaymentMandateID(); ok {
+		_spec.SetField(abonement.FieldPaymentMandateID, field.TypeInt, value)
+		_node.PaymentMandateID = &value
+	}
+	if value, ok := _c.mutation.RenewalAttempts(); ok {
+		_spec.SetField(abonement.FieldRenewalAttempts, field.TypeInt, value)
+		_node.RenewalAttempts = value
+	}
+	if value, ok := _c.mutation.NextRenewalAt(); ok {
+		_spec.SetField(abonement.FieldNextRenewalAt, field.TypeTime, value)
+		_node.NextRenewalAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(abonement.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
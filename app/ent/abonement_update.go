@@ -106,6 +106,155 @@ func (_u *AbonementUpdate) SetNillableStatus(v *string) *AbonementUpdate {
 	return _u
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AbonementUpdate) SetCancelledAt(v time.Time) *AbonementUpdate {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableCancelledAt(v *time.Time) *AbonementUpdate {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AbonementUpdate) ClearCancelledAt() *AbonementUpdate {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_u *AbonementUpdate) SetRemindedAt(v time.Time) *AbonementUpdate {
+	_u.mutation.SetRemindedAt(v)
+	return _u
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableRemindedAt(v *time.Time) *AbonementUpdate {
+	if v != nil {
+		_u.SetRemindedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (_u *AbonementUpdate) ClearRemindedAt() *AbonementUpdate {
+	_u.mutation.ClearRemindedAt()
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *AbonementUpdate) SetOrderID(v int) *AbonementUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableOrderID(v *int) *AbonementUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *AbonementUpdate) AddOrderID(v int) *AbonementUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *AbonementUpdate) ClearOrderID() *AbonementUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_u *AbonementUpdate) SetAutoRenew(v bool) *AbonementUpdate {
+	_u.mutation.SetAutoRenew(v)
+	return _u
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableAutoRenew(v *bool) *AbonementUpdate {
+	if v != nil {
+		_u.SetAutoRenew(*v)
+	}
+	return _u
+}
+
+// SetPaymentMandateID sets the "payment_mandate_id" field.
+func (_u *AbonementUpdate) SetPaymentMandateID(v int) *AbonementUpdate {
+	_u.mutation.ResetPaymentMandateID()
+	_u.mutation.SetPaymentMandateID(v)
+	return _u
+}
+
+// SetNillablePaymentMandateID sets the "payment_mandate_id" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillablePaymentMandateID(v *int) *AbonementUpdate {
+	if v != nil {
+		_u.SetPaymentMandateID(*v)
+	}
+	return _u
+}
+
+// AddPaymentMandateID adds value to the "payment_mandate_id" field.
+func (_u *AbonementUpdate) AddPaymentMandateID(v int) *AbonementUpdate {
+	_u.mutation.AddPaymentMandateID(v)
+	return _u
+}
+
+// ClearPaymentMandateID clears the value of the "payment_mandate_id" field.
+func (_u *AbonementUpdate) ClearPaymentMandateID() *AbonementUpdate {
+	_u.mutation.ClearPaymentMandateID()
+	return _u
+}
+
+// SetRenewalAttempts sets the "renewal_attempts" field.
+func (_u *AbonementUpdate) SetRenewalAttempts(v int) *AbonementUpdate {
+	_u.mutation.ResetRenewalAttempts()
+	_u.mutation.SetRenewalAttempts(v)
+	return _u
+}
+
+// SetNillableRenewalAttempts sets the "renewal_attempts" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableRenewalAttempts(v *int) *AbonementUpdate {
+	if v != nil {
+		_u.SetRenewalAttempts(*v)
+	}
+	return _u
+}
+
+// AddRenewalAttempts adds value to the "renewal_attempts" field.
+func (_u *AbonementUpdate) AddRenewalAttempts(v int) *AbonementUpdate {
+	_u.mutation.AddRenewalAttempts(v)
+	return _u
+}
+
+// SetNextRenewalAt sets the "next_renewal_at" field.
+func (_u *AbonementUpdate) SetNextRenewalAt(v time.Time) *AbonementUpdate {
+	_u.mutation.SetNextRenewalAt(v)
+	return _u
+}
+
+// SetNillableNextRenewalAt sets the "next_renewal_at" field if the given value is not nil.
+func (_u *AbonementUpdate) SetNillableNextRenewalAt(v *time.Time) *AbonementUpdate {
+	if v != nil {
+		_u.SetNextRenewalAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRenewalAt clears the value of the "next_renewal_at" field.
+func (_u *AbonementUpdate) ClearNextRenewalAt() *AbonementUpdate {
+	_u.mutation.ClearNextRenewalAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AbonementUpdate) SetCreatedAt(v time.Time) *AbonementUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -229,6 +378,51 @@ func (_u *AbonementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(abonement.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(abonement.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(abonement.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemindedAt(); ok {
+		_spec.SetField(abonement.FieldRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemindedAtCleared() {
+		_spec.ClearField(abonement.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(abonement.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(abonement.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(abonement.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AutoRenew(); ok {
+		_spec.SetField(abonement.FieldAutoRenew, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PaymentMandateID(); ok {
+		_spec.SetField(abonement.FieldPaymentMandateID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentMandateID(); ok {
+		_spec.AddField(abonement.FieldPaymentMandateID, field.TypeInt, value)
+	}
+	if _u.mutation.PaymentMandateIDCleared() {
+		_spec.ClearField(abonement.FieldPaymentMandateID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RenewalAttempts(); ok {
+		_spec.SetField(abonement.FieldRenewalAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenewalAttempts(); ok {
+		_spec.AddField(abonement.FieldRenewalAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRenewalAt(); ok {
+		_spec.SetField(abonement.FieldNextRenewalAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRenewalAtCleared() {
+		_spec.ClearField(abonement.FieldNextRenewalAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(abonement.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -395,6 +589,155 @@ func (_u *AbonementUpdateOne) SetNillableStatus(v *string) *AbonementUpdateOne {
 	return _u
 }
 
+// SetCancelledAt sets the "cancelled_at" field.
+func (_u *AbonementUpdateOne) SetCancelledAt(v time.Time) *AbonementUpdateOne {
+	_u.mutation.SetCancelledAt(v)
+	return _u
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableCancelledAt(v *time.Time) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetCancelledAt(*v)
+	}
+	return _u
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (_u *AbonementUpdateOne) ClearCancelledAt() *AbonementUpdateOne {
+	_u.mutation.ClearCancelledAt()
+	return _u
+}
+
+// SetRemindedAt sets the "reminded_at" field.
+func (_u *AbonementUpdateOne) SetRemindedAt(v time.Time) *AbonementUpdateOne {
+	_u.mutation.SetRemindedAt(v)
+	return _u
+}
+
+// SetNillableRemindedAt sets the "reminded_at" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableRemindedAt(v *time.Time) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetRemindedAt(*v)
+	}
+	return _u
+}
+
+// ClearRemindedAt clears the value of the "reminded_at" field.
+func (_u *AbonementUpdateOne) ClearRemindedAt() *AbonementUpdateOne {
+	_u.mutation.ClearRemindedAt()
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *AbonementUpdateOne) SetOrderID(v int) *AbonementUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableOrderID(v *int) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *AbonementUpdateOne) AddOrderID(v int) *AbonementUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *AbonementUpdateOne) ClearOrderID() *AbonementUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_u *AbonementUpdateOne) SetAutoRenew(v bool) *AbonementUpdateOne {
+	_u.mutation.SetAutoRenew(v)
+	return _u
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableAutoRenew(v *bool) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetAutoRenew(*v)
+	}
+	return _u
+}
+
+// SetPaymentMandateID sets the "payment_mandate_id" field.
+func (_u *AbonementUpdateOne) SetPaymentMandateID(v int) *AbonementUpdateOne {
+	_u.mutation.ResetPaymentMandateID()
+	_u.mutation.SetPaymentMandateID(v)
+	return _u
+}
+
+// SetNillablePaymentMandateID sets the "payment_mandate_id" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillablePaymentMandateID(v *int) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetPaymentMandateID(*v)
+	}
+	return _u
+}
+
+// AddPaymentMandateID adds value to the "payment_mandate_id" field.
+func (_u *AbonementUpdateOne) AddPaymentMandateID(v int) *AbonementUpdateOne {
+	_u.mutation.AddPaymentMandateID(v)
+	return _u
+}
+
+// ClearPaymentMandateID clears the value of the "payment_mandate_id" field.
+func (_u *AbonementUpdateOne) ClearPaymentMandateID() *AbonementUpdateOne {
+	_u.mutation.ClearPaymentMandateID()
+	return _u
+}
+
+// SetRenewalAttempts sets the "renewal_attempts" field.
+func (_u *AbonementUpdateOne) SetRenewalAttempts(v int) *AbonementUpdateOne {
+	_u.mutation.ResetRenewalAttempts()
+	_u.mutation.SetRenewalAttempts(v)
+	return _u
+}
+
+// SetNillableRenewalAttempts sets the "renewal_attempts" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableRenewalAttempts(v *int) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetRenewalAttempts(*v)
+	}
+	return _u
+}
+
+// AddRenewalAttempts adds value to the "renewal_attempts" field.
+func (_u *AbonementUpdateOne) AddRenewalAttempts(v int) *AbonementUpdateOne {
+	_u.mutation.AddRenewalAttempts(v)
+	return _u
+}
+
+// SetNextRenewalAt sets the "next_renewal_at" field.
+func (_u *AbonementUpdateOne) SetNextRenewalAt(v time.Time) *AbonementUpdateOne {
+	_u.mutation.SetNextRenewalAt(v)
+	return _u
+}
+
+// SetNillableNextRenewalAt sets the "next_renewal_at" field if the given value is not nil.
+func (_u *AbonementUpdateOne) SetNillableNextRenewalAt(v *time.Time) *AbonementUpdateOne {
+	if v != nil {
+		_u.SetNextRenewalAt(*v)
+	}
+	return _u
+}
+
+// ClearNextRenewalAt clears the value of the "next_renewal_at" field.
+func (_u *AbonementUpdateOne) ClearNextRenewalAt() *AbonementUpdateOne {
+	_u.mutation.ClearNextRenewalAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *AbonementUpdateOne) SetCreatedAt(v time.Time) *AbonementUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -548,6 +891,51 @@ func (_u *AbonementUpdateOne) sqlSave(ctx context.Context) (_node *Abonement, er
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(abonement.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.CancelledAt(); ok {
+		_spec.SetField(abonement.FieldCancelledAt, field.TypeTime, value)
+	}
+	if _u.mutation.CancelledAtCleared() {
+		_spec.ClearField(abonement.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RemindedAt(); ok {
+		_spec.SetField(abonement.FieldRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RemindedAtCleared() {
+		_spec.ClearField(abonement.FieldRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(abonement.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(abonement.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(abonement.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AutoRenew(); ok {
+		_spec.SetField(abonement.FieldAutoRenew, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PaymentMandateID(); ok {
+		_spec.SetField(abonement.FieldPaymentMandateID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPaymentMandateID(); ok {
+		_spec.AddField(abonement.FieldPaymentMandateID, field.TypeInt, value)
+	}
+	if _u.mutation.PaymentMandateIDCleared() {
+		_spec.ClearField(abonement.FieldPaymentMandateID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RenewalAttempts(); ok {
+		_spec.SetField(abonement.FieldRenewalAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRenewalAttempts(); ok {
+		_spec.AddField(abonement.FieldRenewalAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.NextRenewalAt(); ok {
+		_spec.SetField(abonement.FieldNextRenewalAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextRenewalAtCleared() {
+		_spec.ClearField(abonement.FieldNextRenewalAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(abonement.FieldCreatedAt, field.TypeTime, value)
 	}
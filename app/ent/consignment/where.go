@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package consignment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldID, id))
+}
+
+// HandedOut applies equality check predicate on the "handed_out" field. It's identical to HandedOutEQ.
+func HandedOut(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldHandedOut, v))
+}
+
+// Returned applies equality check predicate on the "returned" field. It's identical to ReturnedEQ.
+func Returned(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldReturned, v))
+}
+
+// Settled applies equality check predicate on the "settled" field. It's identical to SettledEQ.
+func Settled(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldSettled, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldPrice, v))
+}
+
+// SettledAt applies equality check predicate on the "settled_at" field. It's identical to SettledAtEQ.
+func SettledAt(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// VendorID applies equality check predicate on the "vendor_id" field. It's identical to VendorIDEQ.
+func VendorID(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldVendorID, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldItemID, v))
+}
+
+// HandedOutEQ applies the EQ predicate on the "handed_out" field.
+func HandedOutEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldHandedOut, v))
+}
+
+// HandedOutNEQ applies the NEQ predicate on the "handed_out" field.
+func HandedOutNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldHandedOut, v))
+}
+
+// HandedOutIn applies the In predicate on the "handed_out" field.
+func HandedOutIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldHandedOut, vs...))
+}
+
+// HandedOutNotIn applies the NotIn predicate on the "handed_out" field.
+func HandedOutNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldHandedOut, vs...))
+}
+
+// HandedOutGT applies the GT predicate on the "handed_out" field.
+func HandedOutGT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldHandedOut, v))
+}
+
+// HandedOutGTE applies the GTE predicate on the "handed_out" field.
+func HandedOutGTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldHandedOut, v))
+}
+
+// HandedOutLT applies the LT predicate on the "handed_out" field.
+func HandedOutLT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldHandedOut, v))
+}
+
+// HandedOutLTE applies the LTE predicate on the "handed_out" field.
+func HandedOutLTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldHandedOut, v))
+}
+
+// ReturnedEQ applies the EQ predicate on the "returned" field.
+func ReturnedEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldReturned, v))
+}
+
+// ReturnedNEQ applies the NEQ predicate on the "returned" field.
+func ReturnedNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldReturned, v))
+}
+
+// ReturnedIn applies the In predicate on the "returned" field.
+func ReturnedIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldReturned, vs...))
+}
+
+// ReturnedNotIn applies the NotIn predicate on the "returned" field.
+func ReturnedNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldReturned, vs...))
+}
+
+// ReturnedGT applies the GT predicate on the "returned" field.
+func ReturnedGT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldReturned, v))
+}
+
+// ReturnedGTE applies the GTE predicate on the "returned" field.
+func ReturnedGTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldReturned, v))
+}
+
+// ReturnedLT applies the LT predicate on the "returned" field.
+func ReturnedLT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldReturned, v))
+}
+
+// ReturnedLTE applies the LTE predicate on the "returned" field.
+func ReturnedLTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldReturned, v))
+}
+
+// SettledEQ applies the EQ predicate on the "settled" field.
+func SettledEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldSettled, v))
+}
+
+// SettledNEQ applies the NEQ predicate on the "settled" field.
+func SettledNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldSettled, v))
+}
+
+// SettledIn applies the In predicate on the "settled" field.
+func SettledIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldSettled, vs...))
+}
+
+// SettledNotIn applies the NotIn predicate on the "settled" field.
+func SettledNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldSettled, vs...))
+}
+
+// SettledGT applies the GT predicate on the "settled" field.
+func SettledGT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldSettled, v))
+}
+
+// SettledGTE applies the GTE predicate on the "settled" field.
+func SettledGTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldSettled, v))
+}
+
+// SettledLT applies the LT predicate on the "settled" field.
+func SettledLT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldSettled, v))
+}
+
+// SettledLTE applies the LTE predicate on the "settled" field.
+func SettledLTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldSettled, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldPrice, v))
+}
+
+// SettledAtEQ applies the EQ predicate on the "settled_at" field.
+func SettledAtEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldSettledAt, v))
+}
+
+// SettledAtNEQ applies the NEQ predicate on the "settled_at" field.
+func SettledAtNEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldSettledAt, v))
+}
+
+// SettledAtIn applies the In predicate on the "settled_at" field.
+func SettledAtIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldSettledAt, vs...))
+}
+
+// SettledAtNotIn applies the NotIn predicate on the "settled_at" field.
+func SettledAtNotIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldSettledAt, vs...))
+}
+
+// SettledAtGT applies the GT predicate on the "settled_at" field.
+func SettledAtGT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldSettledAt, v))
+}
+
+// SettledAtGTE applies the GTE predicate on the "settled_at" field.
+func SettledAtGTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldSettledAt, v))
+}
+
+// SettledAtLT applies the LT predicate on the "settled_at" field.
+func SettledAtLT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldSettledAt, v))
+}
+
+// SettledAtLTE applies the LTE predicate on the "settled_at" field.
+func SettledAtLTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldSettledAt, v))
+}
+
+// SettledAtIsNil applies the IsNil predicate on the "settled_at" field.
+func SettledAtIsNil() predicate.Consignment {
+	return predicate.Consignment(sql.FieldIsNull(FieldSettledAt))
+}
+
+// SettledAtNotNil applies the NotNil predicate on the "settled_at" field.
+func SettledAtNotNil() predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotNull(FieldSettledAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Consignment {
+	return predicate.Consignment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// VendorIDEQ applies the EQ predicate on the "vendor_id" field.
+func VendorIDEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldVendorID, v))
+}
+
+// VendorIDNEQ applies the NEQ predicate on the "vendor_id" field.
+func VendorIDNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldVendorID, v))
+}
+
+// VendorIDIn applies the In predicate on the "vendor_id" field.
+func VendorIDIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldVendorID, vs...))
+}
+
+// VendorIDNotIn applies the NotIn predicate on the "vendor_id" field.
+func VendorIDNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldVendorID, vs...))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.Consignment {
+	return predicate.Consignment(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// HasVendor applies the HasEdge predicate on the "vendor" edge.
+func HasVendor() predicate.Consignment {
+	return predicate.Consignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, VendorTable, VendorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVendorWith applies the HasEdge predicate on the "vendor" edge with a given conditions (other predicates).
+func HasVendorWith(preds ...predicate.Vendor) predicate.Consignment {
+	return predicate.Consignment(func(s *sql.Selector) {
+		step := newVendorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.Consignment {
+	return predicate.Consignment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.Consignment {
+	return predicate.Consignment(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Consignment) predicate.Consignment {
+	return predicate.Consignment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Consignment) predicate.Consignment {
+	return predicate.Consignment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Consignment) predicate.Consignment {
+	return predicate.Consignment(sql.NotPredicates(p))
+}
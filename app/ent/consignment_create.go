@@ -0,0 +1,396 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/consignment"
+	"github.com/augustin-wien/augustina-backend/ent/item"
+	"github.com/augustin-wien/augustina-backend/ent/vendor"
+)
+
+// ConsignmentCreate is the builder for creating a Consignment entity.
+type ConsignmentCreate struct {
+	config
+	mutation *ConsignmentMutation
+	hooks    []Hook
+}
+
+// SetHandedOut sets the "handed_out" field.
+func (_c *ConsignmentCreate) SetHandedOut(v int) *ConsignmentCreate {
+	_c.mutation.SetHandedOut(v)
+	return _c
+}
+
+// SetNillableHandedOut sets the "handed_out" field if the given value is not nil.
+func (_c *ConsignmentCreate) SetNillableHandedOut(v *int) *ConsignmentCreate {
+	if v != nil {
+		_c.SetHandedOut(*v)
+	}
+	return _c
+}
+
+// SetReturned sets the "returned" field.
+func (_c *ConsignmentCreate) SetReturned(v int) *ConsignmentCreate {
+	_c.mutation.SetReturned(v)
+	return _c
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_c *ConsignmentCreate) SetNillableReturned(v *int) *ConsignmentCreate {
+	if v != nil {
+		_c.SetReturned(*v)
+	}
+	return _c
+}
+
+// SetSettled sets the "settled" field.
+func (_c *ConsignmentCreate) SetSettled(v int) *ConsignmentCreate {
+	_c.mutation.SetSettled(v)
+	return _c
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_c *ConsignmentCreate) SetNillableSettled(v *int) *ConsignmentCreate {
+	if v != nil {
+		_c.SetSettled(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *ConsignmentCreate) SetPrice(v int) *ConsignmentCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_c *ConsignmentCreate) SetSettledAt(v time.Time) *ConsignmentCreate {
+	_c.mutation.SetSettledAt(v)
+	return _c
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_c *ConsignmentCreate) SetNillableSettledAt(v *time.Time) *ConsignmentCreate {
+	if v != nil {
+		_c.SetSettledAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConsignmentCreate) SetCreatedAt(v time.Time) *ConsignmentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConsignmentCreate) SetUpdatedAt(v time.Time) *ConsignmentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *ConsignmentCreate) SetVendorID(v int) *ConsignmentCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ConsignmentCreate) SetItemID(v int) *ConsignmentCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConsignmentCreate) SetID(v int) *ConsignmentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_c *ConsignmentCreate) SetVendor(v *Vendor) *ConsignmentCreate {
+	return _c.SetVendorID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ConsignmentCreate) SetItem(v *Item) *ConsignmentCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the ConsignmentMutation object of the builder.
+func (_c *ConsignmentCreate) Mutation() *ConsignmentMutation {
+	return _c.mutation
+}
+
+// Save creates the Consignment in the database.
+func (_c *ConsignmentCreate) Save(ctx context.Context) (*Consignment, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConsignmentCreate) SaveX(ctx context.Context) *Consignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsignmentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsignmentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConsignmentCreate) defaults() {
+	if _, ok := _c.mutation.HandedOut(); !ok {
+		v := consignment.DefaultHandedOut
+		_c.mutation.SetHandedOut(v)
+	}
+	if _, ok := _c.mutation.Returned(); !ok {
+		v := consignment.DefaultReturned
+		_c.mutation.SetReturned(v)
+	}
+	if _, ok := _c.mutation.Settled(); !ok {
+		v := consignment.DefaultSettled
+		_c.mutation.SetSettled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConsignmentCreate) check() error {
+	if _, ok := _c.mutation.HandedOut(); !ok {
+		return &ValidationError{Name: "handed_out", err: errors.New(`ent: missing required field "Consignment.handed_out"`)}
+	}
+	if _, ok := _c.mutation.Returned(); !ok {
+		return &ValidationError{Name: "returned", err: errors.New(`ent: missing required field "Consignment.returned"`)}
+	}
+	if _, ok := _c.mutation.Settled(); !ok {
+		return &ValidationError{Name: "settled", err: errors.New(`ent: missing required field "Consignment.settled"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Consignment.price"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Consignment.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Consignment.updated_at"`)}
+	}
+	if _, ok := _c.mutation.VendorID(); !ok {
+		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "Consignment.vendor_id"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "Consignment.item_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := consignment.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Consignment.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.VendorIDs()) == 0 {
+		return &ValidationError{Name: "vendor", err: errors.New(`ent: missing required edge "Consignment.vendor"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Consignment.item"`)}
+	}
+	return nil
+}
+
+func (_c *ConsignmentCreate) sqlSave(ctx context.Context) (*Consignment, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConsignmentCreate) createSpec() (*Consignment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Consignment{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(consignment.Table, sqlgraph.NewFieldSpec(consignment.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.HandedOut(); ok {
+		_spec.SetField(consignment.FieldHandedOut, field.TypeInt, value)
+		_node.HandedOut = value
+	}
+	if value, ok := _c.mutation.Returned(); ok {
+		_spec.SetField(consignment.FieldReturned, field.TypeInt, value)
+		_node.Returned = value
+	}
+	if value, ok := _c.mutation.Settled(); ok {
+		_spec.SetField(consignment.FieldSettled, field.TypeInt, value)
+		_node.Settled = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(consignment.FieldPrice, field.TypeInt, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.SettledAt(); ok {
+		_spec.SetField(consignment.FieldSettledAt, field.TypeTime, value)
+		_node.SettledAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(consignment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(consignment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.VendorTable,
+			Columns: []string{consignment.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VendorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.ItemTable,
+			Columns: []string{consignment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConsignmentCreateBulk is the builder for creating many Consignment entities in bulk.
+type ConsignmentCreateBulk struct {
+	config
+	err      error
+	builders []*ConsignmentCreate
+}
+
+// Save creates the Consignment entities in the database.
+func (_c *ConsignmentCreateBulk) Save(ctx context.Context) ([]*Consignment, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Consignment, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConsignmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConsignmentCreateBulk) SaveX(ctx context.Context) []*Consignment {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConsignmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConsignmentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
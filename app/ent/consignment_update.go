@@ -0,0 +1,758 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/consignment"
+	"github.com/augustin-wien/augustina-backend/ent/item"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"github.com/augustin-wien/augustina-backend/ent/vendor"
+)
+
+// ConsignmentUpdate is the builder for updating Consignment entities.
+type ConsignmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConsignmentMutation
+}
+
+// Where appends a list predicates to the ConsignmentUpdate builder.
+func (_u *ConsignmentUpdate) Where(ps ...predicate.Consignment) *ConsignmentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetHandedOut sets the "handed_out" field.
+func (_u *ConsignmentUpdate) SetHandedOut(v int) *ConsignmentUpdate {
+	_u.mutation.ResetHandedOut()
+	_u.mutation.SetHandedOut(v)
+	return _u
+}
+
+// SetNillableHandedOut sets the "handed_out" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableHandedOut(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetHandedOut(*v)
+	}
+	return _u
+}
+
+// AddHandedOut adds value to the "handed_out" field.
+func (_u *ConsignmentUpdate) AddHandedOut(v int) *ConsignmentUpdate {
+	_u.mutation.AddHandedOut(v)
+	return _u
+}
+
+// SetReturned sets the "returned" field.
+func (_u *ConsignmentUpdate) SetReturned(v int) *ConsignmentUpdate {
+	_u.mutation.ResetReturned()
+	_u.mutation.SetReturned(v)
+	return _u
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableReturned(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetReturned(*v)
+	}
+	return _u
+}
+
+// AddReturned adds value to the "returned" field.
+func (_u *ConsignmentUpdate) AddReturned(v int) *ConsignmentUpdate {
+	_u.mutation.AddReturned(v)
+	return _u
+}
+
+// SetSettled sets the "settled" field.
+func (_u *ConsignmentUpdate) SetSettled(v int) *ConsignmentUpdate {
+	_u.mutation.ResetSettled()
+	_u.mutation.SetSettled(v)
+	return _u
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableSettled(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetSettled(*v)
+	}
+	return _u
+}
+
+// AddSettled adds value to the "settled" field.
+func (_u *ConsignmentUpdate) AddSettled(v int) *ConsignmentUpdate {
+	_u.mutation.AddSettled(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *ConsignmentUpdate) SetPrice(v int) *ConsignmentUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillablePrice(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *ConsignmentUpdate) AddPrice(v int) *ConsignmentUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *ConsignmentUpdate) SetSettledAt(v time.Time) *ConsignmentUpdate {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableSettledAt(v *time.Time) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *ConsignmentUpdate) ClearSettledAt() *ConsignmentUpdate {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ConsignmentUpdate) SetCreatedAt(v time.Time) *ConsignmentUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableCreatedAt(v *time.Time) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConsignmentUpdate) SetUpdatedAt(v time.Time) *ConsignmentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableUpdatedAt(v *time.Time) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *ConsignmentUpdate) SetVendorID(v int) *ConsignmentUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableVendorID(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ConsignmentUpdate) SetItemID(v int) *ConsignmentUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ConsignmentUpdate) SetNillableItemID(v *int) *ConsignmentUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *ConsignmentUpdate) SetVendor(v *Vendor) *ConsignmentUpdate {
+	return _u.SetVendorID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ConsignmentUpdate) SetItem(v *Item) *ConsignmentUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ConsignmentMutation object of the builder.
+func (_u *ConsignmentUpdate) Mutation() *ConsignmentMutation {
+	return _u.mutation
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *ConsignmentUpdate) ClearVendor() *ConsignmentUpdate {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ConsignmentUpdate) ClearItem() *ConsignmentUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConsignmentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConsignmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConsignmentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConsignmentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConsignmentUpdate) check() error {
+	if _u.mutation.VendorCleared() && len(_u.mutation.VendorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consignment.vendor"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consignment.item"`)
+	}
+	return nil
+}
+
+func (_u *ConsignmentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consignment.Table, consignment.Columns, sqlgraph.NewFieldSpec(consignment.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HandedOut(); ok {
+		_spec.SetField(consignment.FieldHandedOut, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandedOut(); ok {
+		_spec.AddField(consignment.FieldHandedOut, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Returned(); ok {
+		_spec.SetField(consignment.FieldReturned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReturned(); ok {
+		_spec.AddField(consignment.FieldReturned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Settled(); ok {
+		_spec.SetField(consignment.FieldSettled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettled(); ok {
+		_spec.AddField(consignment.FieldSettled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(consignment.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(consignment.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(consignment.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(consignment.FieldSettledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(consignment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(consignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.VendorTable,
+			Columns: []string{consignment.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.VendorTable,
+			Columns: []string{consignment.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.ItemTable,
+			Columns: []string{consignment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.ItemTable,
+			Columns: []string{consignment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConsignmentUpdateOne is the builder for updating a single Consignment entity.
+type ConsignmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConsignmentMutation
+}
+
+// SetHandedOut sets the "handed_out" field.
+func (_u *ConsignmentUpdateOne) SetHandedOut(v int) *ConsignmentUpdateOne {
+	_u.mutation.ResetHandedOut()
+	_u.mutation.SetHandedOut(v)
+	return _u
+}
+
+// SetNillableHandedOut sets the "handed_out" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableHandedOut(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetHandedOut(*v)
+	}
+	return _u
+}
+
+// AddHandedOut adds value to the "handed_out" field.
+func (_u *ConsignmentUpdateOne) AddHandedOut(v int) *ConsignmentUpdateOne {
+	_u.mutation.AddHandedOut(v)
+	return _u
+}
+
+// SetReturned sets the "returned" field.
+func (_u *ConsignmentUpdateOne) SetReturned(v int) *ConsignmentUpdateOne {
+	_u.mutation.ResetReturned()
+	_u.mutation.SetReturned(v)
+	return _u
+}
+
+// SetNillableReturned sets the "returned" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableReturned(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetReturned(*v)
+	}
+	return _u
+}
+
+// AddReturned adds value to the "returned" field.
+func (_u *ConsignmentUpdateOne) AddReturned(v int) *ConsignmentUpdateOne {
+	_u.mutation.AddReturned(v)
+	return _u
+}
+
+// SetSettled sets the "settled" field.
+func (_u *ConsignmentUpdateOne) SetSettled(v int) *ConsignmentUpdateOne {
+	_u.mutation.ResetSettled()
+	_u.mutation.SetSettled(v)
+	return _u
+}
+
+// SetNillableSettled sets the "settled" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableSettled(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetSettled(*v)
+	}
+	return _u
+}
+
+// AddSettled adds value to the "settled" field.
+func (_u *ConsignmentUpdateOne) AddSettled(v int) *ConsignmentUpdateOne {
+	_u.mutation.AddSettled(v)
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *ConsignmentUpdateOne) SetPrice(v int) *ConsignmentUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillablePrice(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *ConsignmentUpdateOne) AddPrice(v int) *ConsignmentUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// SetSettledAt sets the "settled_at" field.
+func (_u *ConsignmentUpdateOne) SetSettledAt(v time.Time) *ConsignmentUpdateOne {
+	_u.mutation.SetSettledAt(v)
+	return _u
+}
+
+// SetNillableSettledAt sets the "settled_at" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableSettledAt(v *time.Time) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetSettledAt(*v)
+	}
+	return _u
+}
+
+// ClearSettledAt clears the value of the "settled_at" field.
+func (_u *ConsignmentUpdateOne) ClearSettledAt() *ConsignmentUpdateOne {
+	_u.mutation.ClearSettledAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ConsignmentUpdateOne) SetCreatedAt(v time.Time) *ConsignmentUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableCreatedAt(v *time.Time) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConsignmentUpdateOne) SetUpdatedAt(v time.Time) *ConsignmentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableUpdatedAt(v *time.Time) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *ConsignmentUpdateOne) SetVendorID(v int) *ConsignmentUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableVendorID(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ConsignmentUpdateOne) SetItemID(v int) *ConsignmentUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ConsignmentUpdateOne) SetNillableItemID(v *int) *ConsignmentUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *ConsignmentUpdateOne) SetVendor(v *Vendor) *ConsignmentUpdateOne {
+	return _u.SetVendorID(v.ID)
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ConsignmentUpdateOne) SetItem(v *Item) *ConsignmentUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ConsignmentMutation object of the builder.
+func (_u *ConsignmentUpdateOne) Mutation() *ConsignmentMutation {
+	return _u.mutation
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *ConsignmentUpdateOne) ClearVendor() *ConsignmentUpdateOne {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ConsignmentUpdateOne) ClearItem() *ConsignmentUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the ConsignmentUpdate builder.
+func (_u *ConsignmentUpdateOne) Where(ps ...predicate.Consignment) *ConsignmentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConsignmentUpdateOne) Select(field string, fields ...string) *ConsignmentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Consignment entity.
+func (_u *ConsignmentUpdateOne) Save(ctx context.Context) (*Consignment, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConsignmentUpdateOne) SaveX(ctx context.Context) *Consignment {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConsignmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConsignmentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConsignmentUpdateOne) check() error {
+	if _u.mutation.VendorCleared() && len(_u.mutation.VendorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consignment.vendor"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Consignment.item"`)
+	}
+	return nil
+}
+
+func (_u *ConsignmentUpdateOne) sqlSave(ctx context.Context) (_node *Consignment, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(consignment.Table, consignment.Columns, sqlgraph.NewFieldSpec(consignment.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Consignment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, consignment.FieldID)
+		for _, f := range fields {
+			if !consignment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != consignment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.HandedOut(); ok {
+		_spec.SetField(consignment.FieldHandedOut, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHandedOut(); ok {
+		_spec.AddField(consignment.FieldHandedOut, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Returned(); ok {
+		_spec.SetField(consignment.FieldReturned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReturned(); ok {
+		_spec.AddField(consignment.FieldReturned, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Settled(); ok {
+		_spec.SetField(consignment.FieldSettled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSettled(); ok {
+		_spec.AddField(consignment.FieldSettled, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(consignment.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(consignment.FieldPrice, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SettledAt(); ok {
+		_spec.SetField(consignment.FieldSettledAt, field.TypeTime, value)
+	}
+	if _u.mutation.SettledAtCleared() {
+		_spec.ClearField(consignment.FieldSettledAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(consignment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(consignment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.VendorTable,
+			Columns: []string{consignment.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.VendorTable,
+			Columns: []string{consignment.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.ItemTable,
+			Columns: []string{consignment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   consignment.ItemTable,
+			Columns: []string{consignment.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Consignment{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{consignment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,12 +21,24 @@ type Customer struct {
 	Keycloakid string `json:"keycloakid,omitempty"`
 	// Email holds the value of the "email" field.
 	Email string `json:"email,omitempty"`
+	// PendingEmail holds the value of the "pending_email" field.
+	PendingEmail string `json:"pending_email,omitempty"`
 	// Firstname holds the value of the "firstname" field.
 	Firstname string `json:"firstname,omitempty"`
 	// Lastname holds the value of the "lastname" field.
 	Lastname string `json:"lastname,omitempty"`
 	// Licensegroups holds the value of the "licensegroups" field.
 	Licensegroups string `json:"licensegroups,omitempty"`
+	// Newsletter holds the value of the "newsletter" field.
+	Newsletter bool `json:"newsletter,omitempty"`
+	// NotifyNewIssues holds the value of the "notify_new_issues" field.
+	NotifyNewIssues bool `json:"notify_new_issues,omitempty"`
+	// NotifyAbonementReminders holds the value of the "notify_abonement_reminders" field.
+	NotifyAbonementReminders bool `json:"notify_abonement_reminders,omitempty"`
+	// DeletionRequestedAt holds the value of the "deletion_requested_at" field.
+	DeletionRequestedAt *time.Time `json:"deletion_requested_at,omitempty"`
+	// DeletionReason holds the value of the "deletion_reason" field.
+	DeletionReason string `json:"deletion_reason,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -60,11 +72,13 @@ func (*Customer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case customer.FieldNewsletter, customer.FieldNotifyNewIssues, customer.FieldNotifyAbonementReminders:
+			values[i] = new(sql.NullBool)
 		case customer.FieldID:
 			values[i] = new(sql.NullInt64)
-		case customer.FieldKeycloakid, customer.FieldEmail, customer.FieldFirstname, customer.FieldLastname, customer.FieldLicensegroups:
+		case customer.FieldKeycloakid, customer.FieldEmail, customer.FieldPendingEmail, customer.FieldFirstname, customer.FieldLastname, customer.FieldLicensegroups, customer.FieldDeletionReason:
 			values[i] = new(sql.NullString)
-		case customer.FieldCreatedAt, customer.FieldUpdatedAt:
+		case customer.FieldDeletionRequestedAt, customer.FieldCreatedAt, customer.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -99,6 +113,12 @@ func (_m *Customer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Email = value.String
 			}
+		case customer.FieldPendingEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_email", values[i])
+			} else if value.Valid {
+				_m.PendingEmail = value.String
+			}
 		case customer.FieldFirstname:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field firstname", values[i])
@@ -117,6 +137,37 @@ func (_m *Customer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Licensegroups = value.String
 			}
+		case customer.FieldNewsletter:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field newsletter", values[i])
+			} else if value.Valid {
+				_m.Newsletter = value.Bool
+			}
+		case customer.FieldNotifyNewIssues:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_new_issues", values[i])
+			} else if value.Valid {
+				_m.NotifyNewIssues = value.Bool
+			}
+		case customer.FieldNotifyAbonementReminders:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field notify_abonement_reminders", values[i])
+			} else if value.Valid {
+				_m.NotifyAbonementReminders = value.Bool
+			}
+		case customer.FieldDeletionRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_requested_at", values[i])
+			} else if value.Valid {
+				_m.DeletionRequestedAt = new(time.Time)
+				*_m.DeletionRequestedAt = value.Time
+			}
+		case customer.FieldDeletionReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deletion_reason", values[i])
+			} else if value.Valid {
+				_m.DeletionReason = value.String
+			}
 		case customer.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -178,6 +229,9 @@ func (_m *Customer) String() string {
 	builder.WriteString("email=")
 	builder.WriteString(_m.Email)
 	builder.WriteString(", ")
+	builder.WriteString("pending_email=")
+	builder.WriteString(_m.PendingEmail)
+	builder.WriteString(", ")
 	builder.WriteString("firstname=")
 	builder.WriteString(_m.Firstname)
 	builder.WriteString(", ")
@@ -187,6 +241,23 @@ func (_m *Customer) String() string {
 	builder.WriteString("licensegroups=")
 	builder.WriteString(_m.Licensegroups)
 	builder.WriteString(", ")
+	builder.WriteString("newsletter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Newsletter))
+	builder.WriteString(", ")
+	builder.WriteString("notify_new_issues=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyNewIssues))
+	builder.WriteString(", ")
+	builder.WriteString("notify_abonement_reminders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifyAbonementReminders))
+	builder.WriteString(", ")
+	if v := _m.DeletionRequestedAt; v != nil {
+		builder.WriteString("deletion_requested_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deletion_reason=")
+	builder.WriteString(_m.DeletionReason)
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -16,12 +16,24 @@ const (
 	FieldKeycloakid = "keycloakid"
 	// FieldEmail holds the string denoting the email field in the database.
 	FieldEmail = "email"
+	// FieldPendingEmail holds the string denoting the pending_email field in the database.
+	FieldPendingEmail = "pending_email"
 	// FieldFirstname holds the string denoting the firstname field in the database.
 	FieldFirstname = "firstname"
 	// FieldLastname holds the string denoting the lastname field in the database.
 	FieldLastname = "lastname"
 	// FieldLicensegroups holds the string denoting the licensegroups field in the database.
 	FieldLicensegroups = "licensegroups"
+	// FieldNewsletter holds the string denoting the newsletter field in the database.
+	FieldNewsletter = "newsletter"
+	// FieldNotifyNewIssues holds the string denoting the notify_new_issues field in the database.
+	FieldNotifyNewIssues = "notify_new_issues"
+	// FieldNotifyAbonementReminders holds the string denoting the notify_abonement_reminders field in the database.
+	FieldNotifyAbonementReminders = "notify_abonement_reminders"
+	// FieldDeletionRequestedAt holds the string denoting the deletion_requested_at field in the database.
+	FieldDeletionRequestedAt = "deletion_requested_at"
+	// FieldDeletionReason holds the string denoting the deletion_reason field in the database.
+	FieldDeletionReason = "deletion_reason"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -44,9 +56,15 @@ var Columns = []string{
 	FieldID,
 	FieldKeycloakid,
 	FieldEmail,
+	FieldPendingEmail,
 	FieldFirstname,
 	FieldLastname,
 	FieldLicensegroups,
+	FieldNewsletter,
+	FieldNotifyNewIssues,
+	FieldNotifyAbonementReminders,
+	FieldDeletionRequestedAt,
+	FieldDeletionReason,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -64,12 +82,22 @@ func ValidColumn(column string) bool {
 var (
 	// DefaultEmail holds the default value on creation for the "email" field.
 	DefaultEmail string
+	// DefaultPendingEmail holds the default value on creation for the "pending_email" field.
+	DefaultPendingEmail string
 	// DefaultFirstname holds the default value on creation for the "firstname" field.
 	DefaultFirstname string
 	// DefaultLastname holds the default value on creation for the "lastname" field.
 	DefaultLastname string
 	// DefaultLicensegroups holds the default value on creation for the "licensegroups" field.
 	DefaultLicensegroups string
+	// DefaultNewsletter holds the default value on creation for the "newsletter" field.
+	DefaultNewsletter bool
+	// DefaultNotifyNewIssues holds the default value on creation for the "notify_new_issues" field.
+	DefaultNotifyNewIssues bool
+	// DefaultNotifyAbonementReminders holds the default value on creation for the "notify_abonement_reminders" field.
+	DefaultNotifyAbonementReminders bool
+	// DefaultDeletionReason holds the default value on creation for the "deletion_reason" field.
+	DefaultDeletionReason string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -92,6 +120,11 @@ func ByEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEmail, opts...).ToFunc()
 }
 
+// ByPendingEmail orders the results by the pending_email field.
+func ByPendingEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingEmail, opts...).ToFunc()
+}
+
 // ByFirstname orders the results by the firstname field.
 func ByFirstname(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFirstname, opts...).ToFunc()
@@ -107,6 +140,31 @@ func ByLicensegroups(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLicensegroups, opts...).ToFunc()
 }
 
+// ByNewsletter orders the results by the newsletter field.
+func ByNewsletter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNewsletter, opts...).ToFunc()
+}
+
+// ByNotifyNewIssues orders the results by the notify_new_issues field.
+func ByNotifyNewIssues(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyNewIssues, opts...).ToFunc()
+}
+
+// ByNotifyAbonementReminders orders the results by the notify_abonement_reminders field.
+func ByNotifyAbonementReminders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifyAbonementReminders, opts...).ToFunc()
+}
+
+// ByDeletionRequestedAt orders the results by the deletion_requested_at field.
+func ByDeletionRequestedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionRequestedAt, opts...).ToFunc()
+}
+
+// ByDeletionReason orders the results by the deletion_reason field.
+func ByDeletionReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletionReason, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
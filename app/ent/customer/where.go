@@ -65,6 +65,11 @@ func Email(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldEmail, v))
 }
 
+// PendingEmail applies equality check predicate on the "pending_email" field. It's identical to PendingEmailEQ.
+func PendingEmail(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPendingEmail, v))
+}
+
 // Firstname applies equality check predicate on the "firstname" field. It's identical to FirstnameEQ.
 func Firstname(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldFirstname, v))
@@ -80,6 +85,31 @@ func Licensegroups(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldLicensegroups, v))
 }
 
+// Newsletter applies equality check predicate on the "newsletter" field. It's identical to NewsletterEQ.
+func Newsletter(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNewsletter, v))
+}
+
+// NotifyNewIssues applies equality check predicate on the "notify_new_issues" field. It's identical to NotifyNewIssuesEQ.
+func NotifyNewIssues(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNotifyNewIssues, v))
+}
+
+// NotifyAbonementReminders applies equality check predicate on the "notify_abonement_reminders" field. It's identical to NotifyAbonementRemindersEQ.
+func NotifyAbonementReminders(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNotifyAbonementReminders, v))
+}
+
+// DeletionRequestedAt applies equality check predicate on the "deletion_requested_at" field. It's identical to DeletionRequestedAtEQ.
+func DeletionRequestedAt(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionReason applies equality check predicate on the "deletion_reason" field. It's identical to DeletionReasonEQ.
+func DeletionReason(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDeletionReason, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldCreatedAt, v))
@@ -220,6 +250,71 @@ func EmailContainsFold(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// PendingEmailEQ applies the EQ predicate on the "pending_email" field.
+func PendingEmailEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailNEQ applies the NEQ predicate on the "pending_email" field.
+func PendingEmailNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldPendingEmail, v))
+}
+
+// PendingEmailIn applies the In predicate on the "pending_email" field.
+func PendingEmailIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailNotIn applies the NotIn predicate on the "pending_email" field.
+func PendingEmailNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldPendingEmail, vs...))
+}
+
+// PendingEmailGT applies the GT predicate on the "pending_email" field.
+func PendingEmailGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldPendingEmail, v))
+}
+
+// PendingEmailGTE applies the GTE predicate on the "pending_email" field.
+func PendingEmailGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldPendingEmail, v))
+}
+
+// PendingEmailLT applies the LT predicate on the "pending_email" field.
+func PendingEmailLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldPendingEmail, v))
+}
+
+// PendingEmailLTE applies the LTE predicate on the "pending_email" field.
+func PendingEmailLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldPendingEmail, v))
+}
+
+// PendingEmailContains applies the Contains predicate on the "pending_email" field.
+func PendingEmailContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldPendingEmail, v))
+}
+
+// PendingEmailHasPrefix applies the HasPrefix predicate on the "pending_email" field.
+func PendingEmailHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldPendingEmail, v))
+}
+
+// PendingEmailHasSuffix applies the HasSuffix predicate on the "pending_email" field.
+func PendingEmailHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldPendingEmail, v))
+}
+
+// PendingEmailEqualFold applies the EqualFold predicate on the "pending_email" field.
+func PendingEmailEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldPendingEmail, v))
+}
+
+// PendingEmailContainsFold applies the ContainsFold predicate on the "pending_email" field.
+func PendingEmailContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldPendingEmail, v))
+}
+
 // FirstnameEQ applies the EQ predicate on the "firstname" field.
 func FirstnameEQ(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldFirstname, v))
@@ -415,6 +510,151 @@ func LicensegroupsContainsFold(v string) predicate.Customer {
 	return predicate.Customer(sql.FieldContainsFold(FieldLicensegroups, v))
 }
 
+// NewsletterEQ applies the EQ predicate on the "newsletter" field.
+func NewsletterEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNewsletter, v))
+}
+
+// NewsletterNEQ applies the NEQ predicate on the "newsletter" field.
+func NewsletterNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldNewsletter, v))
+}
+
+// NotifyNewIssuesEQ applies the EQ predicate on the "notify_new_issues" field.
+func NotifyNewIssuesEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNotifyNewIssues, v))
+}
+
+// NotifyNewIssuesNEQ applies the NEQ predicate on the "notify_new_issues" field.
+func NotifyNewIssuesNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldNotifyNewIssues, v))
+}
+
+// NotifyAbonementRemindersEQ applies the EQ predicate on the "notify_abonement_reminders" field.
+func NotifyAbonementRemindersEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldNotifyAbonementReminders, v))
+}
+
+// NotifyAbonementRemindersNEQ applies the NEQ predicate on the "notify_abonement_reminders" field.
+func NotifyAbonementRemindersNEQ(v bool) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldNotifyAbonementReminders, v))
+}
+
+// DeletionRequestedAtEQ applies the EQ predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtNEQ applies the NEQ predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNEQ(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtIn applies the In predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldDeletionRequestedAt, vs...))
+}
+
+// DeletionRequestedAtNotIn applies the NotIn predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNotIn(vs ...time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldDeletionRequestedAt, vs...))
+}
+
+// DeletionRequestedAtGT applies the GT predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtGT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtGTE applies the GTE predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtGTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtLT applies the LT predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtLT(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtLTE applies the LTE predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtLTE(v time.Time) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldDeletionRequestedAt, v))
+}
+
+// DeletionRequestedAtIsNil applies the IsNil predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtIsNil() predicate.Customer {
+	return predicate.Customer(sql.FieldIsNull(FieldDeletionRequestedAt))
+}
+
+// DeletionRequestedAtNotNil applies the NotNil predicate on the "deletion_requested_at" field.
+func DeletionRequestedAtNotNil() predicate.Customer {
+	return predicate.Customer(sql.FieldNotNull(FieldDeletionRequestedAt))
+}
+
+// DeletionReasonEQ applies the EQ predicate on the "deletion_reason" field.
+func DeletionReasonEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEQ(FieldDeletionReason, v))
+}
+
+// DeletionReasonNEQ applies the NEQ predicate on the "deletion_reason" field.
+func DeletionReasonNEQ(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldNEQ(FieldDeletionReason, v))
+}
+
+// DeletionReasonIn applies the In predicate on the "deletion_reason" field.
+func DeletionReasonIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldIn(FieldDeletionReason, vs...))
+}
+
+// DeletionReasonNotIn applies the NotIn predicate on the "deletion_reason" field.
+func DeletionReasonNotIn(vs ...string) predicate.Customer {
+	return predicate.Customer(sql.FieldNotIn(FieldDeletionReason, vs...))
+}
+
+// DeletionReasonGT applies the GT predicate on the "deletion_reason" field.
+func DeletionReasonGT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGT(FieldDeletionReason, v))
+}
+
+// DeletionReasonGTE applies the GTE predicate on the "deletion_reason" field.
+func DeletionReasonGTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldGTE(FieldDeletionReason, v))
+}
+
+// DeletionReasonLT applies the LT predicate on the "deletion_reason" field.
+func DeletionReasonLT(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLT(FieldDeletionReason, v))
+}
+
+// DeletionReasonLTE applies the LTE predicate on the "deletion_reason" field.
+func DeletionReasonLTE(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldLTE(FieldDeletionReason, v))
+}
+
+// DeletionReasonContains applies the Contains predicate on the "deletion_reason" field.
+func DeletionReasonContains(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContains(FieldDeletionReason, v))
+}
+
+// DeletionReasonHasPrefix applies the HasPrefix predicate on the "deletion_reason" field.
+func DeletionReasonHasPrefix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasPrefix(FieldDeletionReason, v))
+}
+
+// DeletionReasonHasSuffix applies the HasSuffix predicate on the "deletion_reason" field.
+func DeletionReasonHasSuffix(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldHasSuffix(FieldDeletionReason, v))
+}
+
+// DeletionReasonEqualFold applies the EqualFold predicate on the "deletion_reason" field.
+func DeletionReasonEqualFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldEqualFold(FieldDeletionReason, v))
+}
+
+// DeletionReasonContainsFold applies the ContainsFold predicate on the "deletion_reason" field.
+func DeletionReasonContainsFold(v string) predicate.Customer {
+	return predicate.Customer(sql.FieldContainsFold(FieldDeletionReason, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Customer {
 	return predicate.Customer(sql.FieldEQ(FieldCreatedAt, v))
@@ -41,6 +41,20 @@ func (_c *CustomerCreate) SetNillableEmail(v *string) *CustomerCreate {
 	return _c
 }
 
+// SetPendingEmail sets the "pending_email" field.
+func (_c *CustomerCreate) SetPendingEmail(v string) *CustomerCreate {
+	_c.mutation.SetPendingEmail(v)
+	return _c
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillablePendingEmail(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetPendingEmail(*v)
+	}
+	return _c
+}
+
 // SetFirstname sets the "firstname" field.
 func (_c *CustomerCreate) SetFirstname(v string) *CustomerCreate {
 	_c.mutation.SetFirstname(v)
@@ -83,6 +97,76 @@ func (_c *CustomerCreate) SetNillableLicensegroups(v *string) *CustomerCreate {
 	return _c
 }
 
+// SetNewsletter sets the "newsletter" field.
+func (_c *CustomerCreate) SetNewsletter(v bool) *CustomerCreate {
+	_c.mutation.SetNewsletter(v)
+	return _c
+}
+
+// SetNillableNewsletter sets the "newsletter" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableNewsletter(v *bool) *CustomerCreate {
+	if v != nil {
+		_c.SetNewsletter(*v)
+	}
+	return _c
+}
+
+// SetNotifyNewIssues sets the "notify_new_issues" field.
+func (_c *CustomerCreate) SetNotifyNewIssues(v bool) *CustomerCreate {
+	_c.mutation.SetNotifyNewIssues(v)
+	return _c
+}
+
+// SetNillableNotifyNewIssues sets the "notify_new_issues" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableNotifyNewIssues(v *bool) *CustomerCreate {
+	if v != nil {
+		_c.SetNotifyNewIssues(*v)
+	}
+	return _c
+}
+
+// SetNotifyAbonementReminders sets the "notify_abonement_reminders" field.
+func (_c *CustomerCreate) SetNotifyAbonementReminders(v bool) *CustomerCreate {
+	_c.mutation.SetNotifyAbonementReminders(v)
+	return _c
+}
+
+// SetNillableNotifyAbonementReminders sets the "notify_abonement_reminders" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableNotifyAbonementReminders(v *bool) *CustomerCreate {
+	if v != nil {
+		_c.SetNotifyAbonementReminders(*v)
+	}
+	return _c
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (_c *CustomerCreate) SetDeletionRequestedAt(v time.Time) *CustomerCreate {
+	_c.mutation.SetDeletionRequestedAt(v)
+	return _c
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableDeletionRequestedAt(v *time.Time) *CustomerCreate {
+	if v != nil {
+		_c.SetDeletionRequestedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (_c *CustomerCreate) SetDeletionReason(v string) *CustomerCreate {
+	_c.mutation.SetDeletionReason(v)
+	return _c
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (_c *CustomerCreate) SetNillableDeletionReason(v *string) *CustomerCreate {
+	if v != nil {
+		_c.SetDeletionReason(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *CustomerCreate) SetCreatedAt(v time.Time) *CustomerCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -171,6 +255,10 @@ func (_c *CustomerCreate) defaults() {
 		v := customer.DefaultEmail
 		_c.mutation.SetEmail(v)
 	}
+	if _, ok := _c.mutation.PendingEmail(); !ok {
+		v := customer.DefaultPendingEmail
+		_c.mutation.SetPendingEmail(v)
+	}
 	if _, ok := _c.mutation.Firstname(); !ok {
 		v := customer.DefaultFirstname
 		_c.mutation.SetFirstname(v)
@@ -183,6 +271,22 @@ func (_c *CustomerCreate) defaults() {
 		v := customer.DefaultLicensegroups
 		_c.mutation.SetLicensegroups(v)
 	}
+	if _, ok := _c.mutation.Newsletter(); !ok {
+		v := customer.DefaultNewsletter
+		_c.mutation.SetNewsletter(v)
+	}
+	if _, ok := _c.mutation.NotifyNewIssues(); !ok {
+		v := customer.DefaultNotifyNewIssues
+		_c.mutation.SetNotifyNewIssues(v)
+	}
+	if _, ok := _c.mutation.NotifyAbonementReminders(); !ok {
+		v := customer.DefaultNotifyAbonementReminders
+		_c.mutation.SetNotifyAbonementReminders(v)
+	}
+	if _, ok := _c.mutation.DeletionReason(); !ok {
+		v := customer.DefaultDeletionReason
+		_c.mutation.SetDeletionReason(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -193,6 +297,9 @@ func (_c *CustomerCreate) check() error {
 	if _, ok := _c.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Customer.email"`)}
 	}
+	if _, ok := _c.mutation.PendingEmail(); !ok {
+		return &ValidationError{Name: "pending_email", err: errors.New(`ent: missing required field "Customer.pending_email"`)}
+	}
 	if _, ok := _c.mutation.Firstname(); !ok {
 		return &ValidationError{Name: "firstname", err: errors.New(`ent: missing required field "Customer.firstname"`)}
 	}
@@ -202,6 +309,18 @@ func (_c *CustomerCreate) check() error {
 	if _, ok := _c.mutation.Licensegroups(); !ok {
 		return &ValidationError{Name: "licensegroups", err: errors.New(`ent: missing required field "Customer.licensegroups"`)}
 	}
+	if _, ok := _c.mutation.Newsletter(); !ok {
+		return &ValidationError{Name: "newsletter", err: errors.New(`ent: missing required field "Customer.newsletter"`)}
+	}
+	if _, ok := _c.mutation.NotifyNewIssues(); !ok {
+		return &ValidationError{Name: "notify_new_issues", err: errors.New(`ent: missing required field "Customer.notify_new_issues"`)}
+	}
+	if _, ok := _c.mutation.NotifyAbonementReminders(); !ok {
+		return &ValidationError{Name: "notify_abonement_reminders", err: errors.New(`ent: missing required field "Customer.notify_abonement_reminders"`)}
+	}
+	if _, ok := _c.mutation.DeletionReason(); !ok {
+		return &ValidationError{Name: "deletion_reason", err: errors.New(`ent: missing required field "Customer.deletion_reason"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := customer.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Customer.id": %w`, err)}
@@ -247,6 +366,10 @@ func (_c *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
 		_spec.SetField(customer.FieldEmail, field.TypeString, value)
 		_node.Email = value
 	}
+	if value, ok := _c.mutation.PendingEmail(); ok {
+		_spec.SetField(customer.FieldPendingEmail, field.TypeString, value)
+		_node.PendingEmail = value
+	}
 	if value, ok := _c.mutation.Firstname(); ok {
 		_spec.SetField(customer.FieldFirstname, field.TypeString, value)
 		_node.Firstname = value
@@ -259,6 +382,26 @@ func (_c *CustomerCreate) createSpec() (*Customer, *sqlgraph.CreateSpec) {
 		_spec.SetField(customer.FieldLicensegroups, field.TypeString, value)
 		_node.Licensegroups = value
 	}
+	if value, ok := _c.mutation.Newsletter(); ok {
+		_spec.SetField(customer.FieldNewsletter, field.TypeBool, value)
+		_node.Newsletter = value
+	}
+	if value, ok := _c.mutation.NotifyNewIssues(); ok {
+		_spec.SetField(customer.FieldNotifyNewIssues, field.TypeBool, value)
+		_node.NotifyNewIssues = value
+	}
+	if value, ok := _c.mutation.NotifyAbonementReminders(); ok {
+		_spec.SetField(customer.FieldNotifyAbonementReminders, field.TypeBool, value)
+		_node.NotifyAbonementReminders = value
+	}
+	if value, ok := _c.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(customer.FieldDeletionRequestedAt, field.TypeTime, value)
+		_node.DeletionRequestedAt = &value
+	}
+	if value, ok := _c.mutation.DeletionReason(); ok {
+		_spec.SetField(customer.FieldDeletionReason, field.TypeString, value)
+		_node.DeletionReason = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(customer.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -57,6 +57,20 @@ func (_u *CustomerUpdate) SetNillableEmail(v *string) *CustomerUpdate {
 	return _u
 }
 
+// SetPendingEmail sets the "pending_email" field.
+func (_u *CustomerUpdate) SetPendingEmail(v string) *CustomerUpdate {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillablePendingEmail(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
 // SetFirstname sets the "firstname" field.
 func (_u *CustomerUpdate) SetFirstname(v string) *CustomerUpdate {
 	_u.mutation.SetFirstname(v)
@@ -99,6 +113,82 @@ func (_u *CustomerUpdate) SetNillableLicensegroups(v *string) *CustomerUpdate {
 	return _u
 }
 
+// SetNewsletter sets the "newsletter" field.
+func (_u *CustomerUpdate) SetNewsletter(v bool) *CustomerUpdate {
+	_u.mutation.SetNewsletter(v)
+	return _u
+}
+
+// SetNillableNewsletter sets the "newsletter" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableNewsletter(v *bool) *CustomerUpdate {
+	if v != nil {
+		_u.SetNewsletter(*v)
+	}
+	return _u
+}
+
+// SetNotifyNewIssues sets the "notify_new_issues" field.
+func (_u *CustomerUpdate) SetNotifyNewIssues(v bool) *CustomerUpdate {
+	_u.mutation.SetNotifyNewIssues(v)
+	return _u
+}
+
+// SetNillableNotifyNewIssues sets the "notify_new_issues" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableNotifyNewIssues(v *bool) *CustomerUpdate {
+	if v != nil {
+		_u.SetNotifyNewIssues(*v)
+	}
+	return _u
+}
+
+// SetNotifyAbonementReminders sets the "notify_abonement_reminders" field.
+func (_u *CustomerUpdate) SetNotifyAbonementReminders(v bool) *CustomerUpdate {
+	_u.mutation.SetNotifyAbonementReminders(v)
+	return _u
+}
+
+// SetNillableNotifyAbonementReminders sets the "notify_abonement_reminders" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableNotifyAbonementReminders(v *bool) *CustomerUpdate {
+	if v != nil {
+		_u.SetNotifyAbonementReminders(*v)
+	}
+	return _u
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (_u *CustomerUpdate) SetDeletionRequestedAt(v time.Time) *CustomerUpdate {
+	_u.mutation.SetDeletionRequestedAt(v)
+	return _u
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableDeletionRequestedAt(v *time.Time) *CustomerUpdate {
+	if v != nil {
+		_u.SetDeletionRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (_u *CustomerUpdate) ClearDeletionRequestedAt() *CustomerUpdate {
+	_u.mutation.ClearDeletionRequestedAt()
+	return _u
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (_u *CustomerUpdate) SetDeletionReason(v string) *CustomerUpdate {
+	_u.mutation.SetDeletionReason(v)
+	return _u
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (_u *CustomerUpdate) SetNillableDeletionReason(v *string) *CustomerUpdate {
+	if v != nil {
+		_u.SetDeletionReason(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *CustomerUpdate) SetCreatedAt(v time.Time) *CustomerUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -222,6 +312,9 @@ func (_u *CustomerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(customer.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(customer.FieldPendingEmail, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Firstname(); ok {
 		_spec.SetField(customer.FieldFirstname, field.TypeString, value)
 	}
@@ -231,6 +324,24 @@ func (_u *CustomerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Licensegroups(); ok {
 		_spec.SetField(customer.FieldLicensegroups, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Newsletter(); ok {
+		_spec.SetField(customer.FieldNewsletter, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyNewIssues(); ok {
+		_spec.SetField(customer.FieldNotifyNewIssues, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyAbonementReminders(); ok {
+		_spec.SetField(customer.FieldNotifyAbonementReminders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(customer.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(customer.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionReason(); ok {
+		_spec.SetField(customer.FieldDeletionReason, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(customer.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -336,6 +447,20 @@ func (_u *CustomerUpdateOne) SetNillableEmail(v *string) *CustomerUpdateOne {
 	return _u
 }
 
+// SetPendingEmail sets the "pending_email" field.
+func (_u *CustomerUpdateOne) SetPendingEmail(v string) *CustomerUpdateOne {
+	_u.mutation.SetPendingEmail(v)
+	return _u
+}
+
+// SetNillablePendingEmail sets the "pending_email" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillablePendingEmail(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetPendingEmail(*v)
+	}
+	return _u
+}
+
 // SetFirstname sets the "firstname" field.
 func (_u *CustomerUpdateOne) SetFirstname(v string) *CustomerUpdateOne {
 	_u.mutation.SetFirstname(v)
@@ -378,6 +503,82 @@ func (_u *CustomerUpdateOne) SetNillableLicensegroups(v *string) *CustomerUpdate
 	return _u
 }
 
+// SetNewsletter sets the "newsletter" field.
+func (_u *CustomerUpdateOne) SetNewsletter(v bool) *CustomerUpdateOne {
+	_u.mutation.SetNewsletter(v)
+	return _u
+}
+
+// SetNillableNewsletter sets the "newsletter" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableNewsletter(v *bool) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetNewsletter(*v)
+	}
+	return _u
+}
+
+// SetNotifyNewIssues sets the "notify_new_issues" field.
+func (_u *CustomerUpdateOne) SetNotifyNewIssues(v bool) *CustomerUpdateOne {
+	_u.mutation.SetNotifyNewIssues(v)
+	return _u
+}
+
+// SetNillableNotifyNewIssues sets the "notify_new_issues" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableNotifyNewIssues(v *bool) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetNotifyNewIssues(*v)
+	}
+	return _u
+}
+
+// SetNotifyAbonementReminders sets the "notify_abonement_reminders" field.
+func (_u *CustomerUpdateOne) SetNotifyAbonementReminders(v bool) *CustomerUpdateOne {
+	_u.mutation.SetNotifyAbonementReminders(v)
+	return _u
+}
+
+// SetNillableNotifyAbonementReminders sets the "notify_abonement_reminders" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableNotifyAbonementReminders(v *bool) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetNotifyAbonementReminders(*v)
+	}
+	return _u
+}
+
+// SetDeletionRequestedAt sets the "deletion_requested_at" field.
+func (_u *CustomerUpdateOne) SetDeletionRequestedAt(v time.Time) *CustomerUpdateOne {
+	_u.mutation.SetDeletionRequestedAt(v)
+	return _u
+}
+
+// SetNillableDeletionRequestedAt sets the "deletion_requested_at" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableDeletionRequestedAt(v *time.Time) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetDeletionRequestedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletionRequestedAt clears the value of the "deletion_requested_at" field.
+func (_u *CustomerUpdateOne) ClearDeletionRequestedAt() *CustomerUpdateOne {
+	_u.mutation.ClearDeletionRequestedAt()
+	return _u
+}
+
+// SetDeletionReason sets the "deletion_reason" field.
+func (_u *CustomerUpdateOne) SetDeletionReason(v string) *CustomerUpdateOne {
+	_u.mutation.SetDeletionReason(v)
+	return _u
+}
+
+// SetNillableDeletionReason sets the "deletion_reason" field if the given value is not nil.
+func (_u *CustomerUpdateOne) SetNillableDeletionReason(v *string) *CustomerUpdateOne {
+	if v != nil {
+		_u.SetDeletionReason(*v)
+	}
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *CustomerUpdateOne) SetCreatedAt(v time.Time) *CustomerUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -531,6 +732,9 @@ func (_u *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err
 	if value, ok := _u.mutation.Email(); ok {
 		_spec.SetField(customer.FieldEmail, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.PendingEmail(); ok {
+		_spec.SetField(customer.FieldPendingEmail, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Firstname(); ok {
 		_spec.SetField(customer.FieldFirstname, field.TypeString, value)
 	}
@@ -540,6 +744,24 @@ func (_u *CustomerUpdateOne) sqlSave(ctx context.Context) (_node *Customer, err
 	if value, ok := _u.mutation.Licensegroups(); ok {
 		_spec.SetField(customer.FieldLicensegroups, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Newsletter(); ok {
+		_spec.SetField(customer.FieldNewsletter, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyNewIssues(); ok {
+		_spec.SetField(customer.FieldNotifyNewIssues, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NotifyAbonementReminders(); ok {
+		_spec.SetField(customer.FieldNotifyAbonementReminders, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DeletionRequestedAt(); ok {
+		_spec.SetField(customer.FieldDeletionRequestedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletionRequestedAtCleared() {
+		_spec.ClearField(customer.FieldDeletionRequestedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletionReason(); ok {
+		_spec.SetField(customer.FieldDeletionReason, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(customer.FieldCreatedAt, field.TypeTime, value)
 	}
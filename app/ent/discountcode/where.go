@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discountcode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldDescription, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldKind, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValue, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldItemID, v))
+}
+
+// LicenseGroup applies equality check predicate on the "license_group" field. It's identical to LicenseGroupEQ.
+func LicenseGroup(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldLicenseGroup, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValidTo, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// Uses applies equality check predicate on the "uses" field. It's identical to UsesEQ.
+func Uses(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldUses, v))
+}
+
+// Disabled applies equality check predicate on the "disabled" field. It's identical to DisabledEQ.
+func Disabled(v bool) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldDisabled, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldValue, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldItemID, v))
+}
+
+// ItemIDIsNil applies the IsNil predicate on the "item_id" field.
+func ItemIDIsNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIsNull(FieldItemID))
+}
+
+// ItemIDNotNil applies the NotNil predicate on the "item_id" field.
+func ItemIDNotNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotNull(FieldItemID))
+}
+
+// LicenseGroupEQ applies the EQ predicate on the "license_group" field.
+func LicenseGroupEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldLicenseGroup, v))
+}
+
+// LicenseGroupNEQ applies the NEQ predicate on the "license_group" field.
+func LicenseGroupNEQ(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldLicenseGroup, v))
+}
+
+// LicenseGroupIn applies the In predicate on the "license_group" field.
+func LicenseGroupIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldLicenseGroup, vs...))
+}
+
+// LicenseGroupNotIn applies the NotIn predicate on the "license_group" field.
+func LicenseGroupNotIn(vs ...string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldLicenseGroup, vs...))
+}
+
+// LicenseGroupGT applies the GT predicate on the "license_group" field.
+func LicenseGroupGT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldLicenseGroup, v))
+}
+
+// LicenseGroupGTE applies the GTE predicate on the "license_group" field.
+func LicenseGroupGTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldLicenseGroup, v))
+}
+
+// LicenseGroupLT applies the LT predicate on the "license_group" field.
+func LicenseGroupLT(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldLicenseGroup, v))
+}
+
+// LicenseGroupLTE applies the LTE predicate on the "license_group" field.
+func LicenseGroupLTE(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldLicenseGroup, v))
+}
+
+// LicenseGroupContains applies the Contains predicate on the "license_group" field.
+func LicenseGroupContains(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContains(FieldLicenseGroup, v))
+}
+
+// LicenseGroupHasPrefix applies the HasPrefix predicate on the "license_group" field.
+func LicenseGroupHasPrefix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasPrefix(FieldLicenseGroup, v))
+}
+
+// LicenseGroupHasSuffix applies the HasSuffix predicate on the "license_group" field.
+func LicenseGroupHasSuffix(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldHasSuffix(FieldLicenseGroup, v))
+}
+
+// LicenseGroupEqualFold applies the EqualFold predicate on the "license_group" field.
+func LicenseGroupEqualFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEqualFold(FieldLicenseGroup, v))
+}
+
+// LicenseGroupContainsFold applies the ContainsFold predicate on the "license_group" field.
+func LicenseGroupContainsFold(v string) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldContainsFold(FieldLicenseGroup, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidFromIsNil applies the IsNil predicate on the "valid_from" field.
+func ValidFromIsNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIsNull(FieldValidFrom))
+}
+
+// ValidFromNotNil applies the NotNil predicate on the "valid_from" field.
+func ValidFromNotNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotNull(FieldValidFrom))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotNull(FieldValidTo))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// UsesEQ applies the EQ predicate on the "uses" field.
+func UsesEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldUses, v))
+}
+
+// UsesNEQ applies the NEQ predicate on the "uses" field.
+func UsesNEQ(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldUses, v))
+}
+
+// UsesIn applies the In predicate on the "uses" field.
+func UsesIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldUses, vs...))
+}
+
+// UsesNotIn applies the NotIn predicate on the "uses" field.
+func UsesNotIn(vs ...int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldUses, vs...))
+}
+
+// UsesGT applies the GT predicate on the "uses" field.
+func UsesGT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldUses, v))
+}
+
+// UsesGTE applies the GTE predicate on the "uses" field.
+func UsesGTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldUses, v))
+}
+
+// UsesLT applies the LT predicate on the "uses" field.
+func UsesLT(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldUses, v))
+}
+
+// UsesLTE applies the LTE predicate on the "uses" field.
+func UsesLTE(v int) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldUses, v))
+}
+
+// DisabledEQ applies the EQ predicate on the "disabled" field.
+func DisabledEQ(v bool) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldDisabled, v))
+}
+
+// DisabledNEQ applies the NEQ predicate on the "disabled" field.
+func DisabledNEQ(v bool) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldDisabled, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscountCode) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscountCode) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscountCode) predicate.DiscountCode {
+	return predicate.DiscountCode(sql.NotPredicates(p))
+}
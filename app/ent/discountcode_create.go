@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/discountcode"
+)
+
+// DiscountCodeCreate is the builder for creating a DiscountCode entity.
+type DiscountCodeCreate struct {
+	config
+	mutation *DiscountCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *DiscountCodeCreate) SetCode(v string) *DiscountCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *DiscountCodeCreate) SetDescription(v string) *DiscountCodeCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableDescription(v *string) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *DiscountCodeCreate) SetKind(v string) *DiscountCodeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DiscountCodeCreate) SetValue(v int) *DiscountCodeCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *DiscountCodeCreate) SetItemID(v int) *DiscountCodeCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableItemID(v *int) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_c *DiscountCodeCreate) SetLicenseGroup(v string) *DiscountCodeCreate {
+	_c.mutation.SetLicenseGroup(v)
+	return _c
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableLicenseGroup(v *string) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetLicenseGroup(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *DiscountCodeCreate) SetValidFrom(v time.Time) *DiscountCodeCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableValidFrom(v *time.Time) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetValidFrom(*v)
+	}
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *DiscountCodeCreate) SetValidTo(v time.Time) *DiscountCodeCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableValidTo(v *time.Time) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *DiscountCodeCreate) SetMaxUses(v int) *DiscountCodeCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableMaxUses(v *int) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *DiscountCodeCreate) SetUses(v int) *DiscountCodeCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableUses(v *int) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetDisabled sets the "disabled" field.
+func (_c *DiscountCodeCreate) SetDisabled(v bool) *DiscountCodeCreate {
+	_c.mutation.SetDisabled(v)
+	return _c
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_c *DiscountCodeCreate) SetNillableDisabled(v *bool) *DiscountCodeCreate {
+	if v != nil {
+		_c.SetDisabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DiscountCodeCreate) SetCreatedAt(v time.Time) *DiscountCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscountCodeCreate) SetID(v int) *DiscountCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DiscountCodeMutation object of the builder.
+func (_c *DiscountCodeCreate) Mutation() *DiscountCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscountCode in the database.
+func (_c *DiscountCodeCreate) Save(ctx context.Context) (*DiscountCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscountCodeCreate) SaveX(ctx context.Context) *DiscountCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscountCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscountCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscountCodeCreate) defaults() {
+	if _, ok := _c.mutation.Description(); !ok {
+		v := discountcode.DefaultDescription
+		_c.mutation.SetDescription(v)
+	}
+	if _, ok := _c.mutation.LicenseGroup(); !ok {
+		v := discountcode.DefaultLicenseGroup
+		_c.mutation.SetLicenseGroup(v)
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		v := discountcode.DefaultMaxUses
+		_c.mutation.SetMaxUses(v)
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := discountcode.DefaultUses
+		_c.mutation.SetUses(v)
+	}
+	if _, ok := _c.mutation.Disabled(); !ok {
+		v := discountcode.DefaultDisabled
+		_c.mutation.SetDisabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscountCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "DiscountCode.code"`)}
+	}
+	if _, ok := _c.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "DiscountCode.description"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DiscountCode.kind"`)}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "DiscountCode.value"`)}
+	}
+	if _, ok := _c.mutation.LicenseGroup(); !ok {
+		return &ValidationError{Name: "license_group", err: errors.New(`ent: missing required field "DiscountCode.license_group"`)}
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "DiscountCode.max_uses"`)}
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "DiscountCode.uses"`)}
+	}
+	if _, ok := _c.mutation.Disabled(); !ok {
+		return &ValidationError{Name: "disabled", err: errors.New(`ent: missing required field "DiscountCode.disabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiscountCode.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discountcode.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscountCode.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscountCodeCreate) sqlSave(ctx context.Context) (*DiscountCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscountCodeCreate) createSpec() (*DiscountCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscountCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discountcode.Table, sqlgraph.NewFieldSpec(discountcode.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(discountcode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(discountcode.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(discountcode.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(discountcode.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(discountcode.FieldItemID, field.TypeInt, value)
+		_node.ItemID = &value
+	}
+	if value, ok := _c.mutation.LicenseGroup(); ok {
+		_spec.SetField(discountcode.FieldLicenseGroup, field.TypeString, value)
+		_node.LicenseGroup = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(discountcode.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = &value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(discountcode.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(discountcode.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(discountcode.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.Disabled(); ok {
+		_spec.SetField(discountcode.FieldDisabled, field.TypeBool, value)
+		_node.Disabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(discountcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// DiscountCodeCreateBulk is the builder for creating many DiscountCode entities in bulk.
+type DiscountCodeCreateBulk struct {
+	config
+	err      error
+	builders []*DiscountCodeCreate
+}
+
+// Save creates the DiscountCode entities in the database.
+func (_c *DiscountCodeCreateBulk) Save(ctx context.Context) ([]*DiscountCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscountCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscountCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscountCodeCreateBulk) SaveX(ctx context.Context) []*DiscountCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscountCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscountCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/discountcode"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// DiscountCodeUpdate is the builder for updating DiscountCode entities.
+type DiscountCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscountCodeMutation
+}
+
+// Where appends a list predicates to the DiscountCodeUpdate builder.
+func (_u *DiscountCodeUpdate) Where(ps ...predicate.DiscountCode) *DiscountCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *DiscountCodeUpdate) SetCode(v string) *DiscountCodeUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableCode(v *string) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DiscountCodeUpdate) SetDescription(v string) *DiscountCodeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableDescription(v *string) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *DiscountCodeUpdate) SetKind(v string) *DiscountCodeUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableKind(v *string) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *DiscountCodeUpdate) SetValue(v int) *DiscountCodeUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableValue(v *int) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *DiscountCodeUpdate) AddValue(v int) *DiscountCodeUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *DiscountCodeUpdate) SetItemID(v int) *DiscountCodeUpdate {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableItemID(v *int) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *DiscountCodeUpdate) AddItemID(v int) *DiscountCodeUpdate {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *DiscountCodeUpdate) ClearItemID() *DiscountCodeUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_u *DiscountCodeUpdate) SetLicenseGroup(v string) *DiscountCodeUpdate {
+	_u.mutation.SetLicenseGroup(v)
+	return _u
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableLicenseGroup(v *string) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetLicenseGroup(*v)
+	}
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *DiscountCodeUpdate) SetValidFrom(v time.Time) *DiscountCodeUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableValidFrom(v *time.Time) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *DiscountCodeUpdate) ClearValidFrom() *DiscountCodeUpdate {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *DiscountCodeUpdate) SetValidTo(v time.Time) *DiscountCodeUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableValidTo(v *time.Time) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *DiscountCodeUpdate) ClearValidTo() *DiscountCodeUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *DiscountCodeUpdate) SetMaxUses(v int) *DiscountCodeUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableMaxUses(v *int) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *DiscountCodeUpdate) AddMaxUses(v int) *DiscountCodeUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *DiscountCodeUpdate) SetUses(v int) *DiscountCodeUpdate {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableUses(v *int) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *DiscountCodeUpdate) AddUses(v int) *DiscountCodeUpdate {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *DiscountCodeUpdate) SetDisabled(v bool) *DiscountCodeUpdate {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableDisabled(v *bool) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DiscountCodeUpdate) SetCreatedAt(v time.Time) *DiscountCodeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DiscountCodeUpdate) SetNillableCreatedAt(v *time.Time) *DiscountCodeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscountCodeMutation object of the builder.
+func (_u *DiscountCodeUpdate) Mutation() *DiscountCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscountCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscountCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscountCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscountCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscountCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discountcode.Table, discountcode.Columns, sqlgraph.NewFieldSpec(discountcode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(discountcode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(discountcode.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(discountcode.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(discountcode.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(discountcode.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(discountcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(discountcode.FieldItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(discountcode.FieldItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LicenseGroup(); ok {
+		_spec.SetField(discountcode.FieldLicenseGroup, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(discountcode.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(discountcode.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(discountcode.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(discountcode.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(discountcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(discountcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(discountcode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(discountcode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(discountcode.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(discountcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discountcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscountCodeUpdateOne is the builder for updating a single DiscountCode entity.
+type DiscountCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscountCodeMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *DiscountCodeUpdateOne) SetCode(v string) *DiscountCodeUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableCode(v *string) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DiscountCodeUpdateOne) SetDescription(v string) *DiscountCodeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableDescription(v *string) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *DiscountCodeUpdateOne) SetKind(v string) *DiscountCodeUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableKind(v *string) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *DiscountCodeUpdateOne) SetValue(v int) *DiscountCodeUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableValue(v *int) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *DiscountCodeUpdateOne) AddValue(v int) *DiscountCodeUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *DiscountCodeUpdateOne) SetItemID(v int) *DiscountCodeUpdateOne {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableItemID(v *int) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *DiscountCodeUpdateOne) AddItemID(v int) *DiscountCodeUpdateOne {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *DiscountCodeUpdateOne) ClearItemID() *DiscountCodeUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_u *DiscountCodeUpdateOne) SetLicenseGroup(v string) *DiscountCodeUpdateOne {
+	_u.mutation.SetLicenseGroup(v)
+	return _u
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableLicenseGroup(v *string) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetLicenseGroup(*v)
+	}
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *DiscountCodeUpdateOne) SetValidFrom(v time.Time) *DiscountCodeUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableValidFrom(v *time.Time) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// ClearValidFrom clears the value of the "valid_from" field.
+func (_u *DiscountCodeUpdateOne) ClearValidFrom() *DiscountCodeUpdateOne {
+	_u.mutation.ClearValidFrom()
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *DiscountCodeUpdateOne) SetValidTo(v time.Time) *DiscountCodeUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableValidTo(v *time.Time) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *DiscountCodeUpdateOne) ClearValidTo() *DiscountCodeUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *DiscountCodeUpdateOne) SetMaxUses(v int) *DiscountCodeUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableMaxUses(v *int) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *DiscountCodeUpdateOne) AddMaxUses(v int) *DiscountCodeUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *DiscountCodeUpdateOne) SetUses(v int) *DiscountCodeUpdateOne {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableUses(v *int) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *DiscountCodeUpdateOne) AddUses(v int) *DiscountCodeUpdateOne {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetDisabled sets the "disabled" field.
+func (_u *DiscountCodeUpdateOne) SetDisabled(v bool) *DiscountCodeUpdateOne {
+	_u.mutation.SetDisabled(v)
+	return _u
+}
+
+// SetNillableDisabled sets the "disabled" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableDisabled(v *bool) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetDisabled(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DiscountCodeUpdateOne) SetCreatedAt(v time.Time) *DiscountCodeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DiscountCodeUpdateOne) SetNillableCreatedAt(v *time.Time) *DiscountCodeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscountCodeMutation object of the builder.
+func (_u *DiscountCodeUpdateOne) Mutation() *DiscountCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscountCodeUpdate builder.
+func (_u *DiscountCodeUpdateOne) Where(ps ...predicate.DiscountCode) *DiscountCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscountCodeUpdateOne) Select(field string, fields ...string) *DiscountCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscountCode entity.
+func (_u *DiscountCodeUpdateOne) Save(ctx context.Context) (*DiscountCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscountCodeUpdateOne) SaveX(ctx context.Context) *DiscountCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscountCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscountCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscountCodeUpdateOne) sqlSave(ctx context.Context) (_node *DiscountCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discountcode.Table, discountcode.Columns, sqlgraph.NewFieldSpec(discountcode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscountCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discountcode.FieldID)
+		for _, f := range fields {
+			if !discountcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discountcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(discountcode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(discountcode.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(discountcode.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(discountcode.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(discountcode.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(discountcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(discountcode.FieldItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(discountcode.FieldItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LicenseGroup(); ok {
+		_spec.SetField(discountcode.FieldLicenseGroup, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(discountcode.FieldValidFrom, field.TypeTime, value)
+	}
+	if _u.mutation.ValidFromCleared() {
+		_spec.ClearField(discountcode.FieldValidFrom, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(discountcode.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(discountcode.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(discountcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(discountcode.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(discountcode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(discountcode.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Disabled(); ok {
+		_spec.SetField(discountcode.FieldDisabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(discountcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &DiscountCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discountcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
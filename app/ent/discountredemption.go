@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+)
+
+// DiscountRedemption is the model entity for the DiscountRedemption schema.
+type DiscountRedemption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DiscountCodeID holds the value of the "discount_code_id" field.
+	DiscountCodeID int `json:"discount_code_id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID int `json:"order_id,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int `json:"amount,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt   *time.Time `json:"released_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscountRedemption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discountredemption.FieldID, discountredemption.FieldDiscountCodeID, discountredemption.FieldOrderID, discountredemption.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case discountredemption.FieldCreatedAt, discountredemption.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscountRedemption fields.
+func (_m *DiscountRedemption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discountredemption.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case discountredemption.FieldDiscountCodeID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_code_id", values[i])
+			} else if value.Valid {
+				_m.DiscountCodeID = int(value.Int64)
+			}
+		case discountredemption.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = int(value.Int64)
+			}
+		case discountredemption.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = int(value.Int64)
+			}
+		case discountredemption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case discountredemption.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscountRedemption.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscountRedemption) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DiscountRedemption.
+// Note that you need to call DiscountRedemption.Unwrap() before calling this method if this DiscountRedemption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscountRedemption) Update() *DiscountRedemptionUpdateOne {
+	return NewDiscountRedemptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscountRedemption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscountRedemption) Unwrap() *DiscountRedemption {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscountRedemption is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscountRedemption) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscountRedemption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("discount_code_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DiscountCodeID))
+	builder.WriteString(", ")
+	builder.WriteString("order_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderID))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscountRedemptions is a parsable slice of DiscountRedemption.
+type DiscountRedemptions []*DiscountRedemption
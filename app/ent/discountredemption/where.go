@@ -0,0 +1,305 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discountredemption
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldID, id))
+}
+
+// DiscountCodeID applies equality check predicate on the "discount_code_id" field. It's identical to DiscountCodeIDEQ.
+func DiscountCodeID(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldDiscountCodeID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldOrderID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldAmount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// DiscountCodeIDEQ applies the EQ predicate on the "discount_code_id" field.
+func DiscountCodeIDEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldDiscountCodeID, v))
+}
+
+// DiscountCodeIDNEQ applies the NEQ predicate on the "discount_code_id" field.
+func DiscountCodeIDNEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldDiscountCodeID, v))
+}
+
+// DiscountCodeIDIn applies the In predicate on the "discount_code_id" field.
+func DiscountCodeIDIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldDiscountCodeID, vs...))
+}
+
+// DiscountCodeIDNotIn applies the NotIn predicate on the "discount_code_id" field.
+func DiscountCodeIDNotIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldDiscountCodeID, vs...))
+}
+
+// DiscountCodeIDGT applies the GT predicate on the "discount_code_id" field.
+func DiscountCodeIDGT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldDiscountCodeID, v))
+}
+
+// DiscountCodeIDGTE applies the GTE predicate on the "discount_code_id" field.
+func DiscountCodeIDGTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldDiscountCodeID, v))
+}
+
+// DiscountCodeIDLT applies the LT predicate on the "discount_code_id" field.
+func DiscountCodeIDLT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldDiscountCodeID, v))
+}
+
+// DiscountCodeIDLTE applies the LTE predicate on the "discount_code_id" field.
+func DiscountCodeIDLTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldDiscountCodeID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldOrderID, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldAmount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscountRedemption) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscountRedemption) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscountRedemption) predicate.DiscountRedemption {
+	return predicate.DiscountRedemption(sql.NotPredicates(p))
+}
@@ -0,0 +1,258 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+)
+
+// DiscountRedemptionCreate is the builder for creating a DiscountRedemption entity.
+type DiscountRedemptionCreate struct {
+	config
+	mutation *DiscountRedemptionMutation
+	hooks    []Hook
+}
+
+// SetDiscountCodeID sets the "discount_code_id" field.
+func (_c *DiscountRedemptionCreate) SetDiscountCodeID(v int) *DiscountRedemptionCreate {
+	_c.mutation.SetDiscountCodeID(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *DiscountRedemptionCreate) SetOrderID(v int) *DiscountRedemptionCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *DiscountRedemptionCreate) SetAmount(v int) *DiscountRedemptionCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DiscountRedemptionCreate) SetCreatedAt(v time.Time) *DiscountRedemptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *DiscountRedemptionCreate) SetReleasedAt(v time.Time) *DiscountRedemptionCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *DiscountRedemptionCreate) SetNillableReleasedAt(v *time.Time) *DiscountRedemptionCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscountRedemptionCreate) SetID(v int) *DiscountRedemptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DiscountRedemptionMutation object of the builder.
+func (_c *DiscountRedemptionCreate) Mutation() *DiscountRedemptionMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscountRedemption in the database.
+func (_c *DiscountRedemptionCreate) Save(ctx context.Context) (*DiscountRedemption, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscountRedemptionCreate) SaveX(ctx context.Context) *DiscountRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscountRedemptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscountRedemptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscountRedemptionCreate) check() error {
+	if _, ok := _c.mutation.DiscountCodeID(); !ok {
+		return &ValidationError{Name: "discount_code_id", err: errors.New(`ent: missing required field "DiscountRedemption.discount_code_id"`)}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "DiscountRedemption.order_id"`)}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "DiscountRedemption.amount"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiscountRedemption.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discountredemption.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscountRedemption.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscountRedemptionCreate) sqlSave(ctx context.Context) (*DiscountRedemption, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscountRedemptionCreate) createSpec() (*DiscountRedemption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscountRedemption{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discountredemption.Table, sqlgraph.NewFieldSpec(discountredemption.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DiscountCodeID(); ok {
+		_spec.SetField(discountredemption.FieldDiscountCodeID, field.TypeInt, value)
+		_node.DiscountCodeID = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(discountredemption.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(discountredemption.FieldAmount, field.TypeInt, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(discountredemption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(discountredemption.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	return _node, _spec
+}
+
+// DiscountRedemptionCreateBulk is the builder for creating many DiscountRedemption entities in bulk.
+type DiscountRedemptionCreateBulk struct {
+	config
+	err      error
+	builders []*DiscountRedemptionCreate
+}
+
+// Save creates the DiscountRedemption entities in the database.
+func (_c *DiscountRedemptionCreateBulk) Save(ctx context.Context) ([]*DiscountRedemption, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscountRedemption, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscountRedemptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscountRedemptionCreateBulk) SaveX(ctx context.Context) []*DiscountRedemption {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscountRedemptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscountRedemptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
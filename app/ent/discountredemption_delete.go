@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// DiscountRedemptionDelete is the builder for deleting a DiscountRedemption entity.
+type DiscountRedemptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DiscountRedemptionMutation
+}
+
+// Where appends a list predicates to the DiscountRedemptionDelete builder.
+func (_d *DiscountRedemptionDelete) Where(ps ...predicate.DiscountRedemption) *DiscountRedemptionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DiscountRedemptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscountRedemptionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DiscountRedemptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(discountredemption.Table, sqlgraph.NewFieldSpec(discountredemption.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DiscountRedemptionDeleteOne is the builder for deleting a single DiscountRedemption entity.
+type DiscountRedemptionDeleteOne struct {
+	_d *DiscountRedemptionDelete
+}
+
+// Where appends a list predicates to the DiscountRedemptionDelete builder.
+func (_d *DiscountRedemptionDeleteOne) Where(ps ...predicate.DiscountRedemption) *DiscountRedemptionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DiscountRedemptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{discountredemption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscountRedemptionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// DiscountRedemptionUpdate is the builder for updating DiscountRedemption entities.
+type DiscountRedemptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscountRedemptionMutation
+}
+
+// Where appends a list predicates to the DiscountRedemptionUpdate builder.
+func (_u *DiscountRedemptionUpdate) Where(ps ...predicate.DiscountRedemption) *DiscountRedemptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDiscountCodeID sets the "discount_code_id" field.
+func (_u *DiscountRedemptionUpdate) SetDiscountCodeID(v int) *DiscountRedemptionUpdate {
+	_u.mutation.ResetDiscountCodeID()
+	_u.mutation.SetDiscountCodeID(v)
+	return _u
+}
+
+// SetNillableDiscountCodeID sets the "discount_code_id" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdate) SetNillableDiscountCodeID(v *int) *DiscountRedemptionUpdate {
+	if v != nil {
+		_u.SetDiscountCodeID(*v)
+	}
+	return _u
+}
+
+// AddDiscountCodeID adds value to the "discount_code_id" field.
+func (_u *DiscountRedemptionUpdate) AddDiscountCodeID(v int) *DiscountRedemptionUpdate {
+	_u.mutation.AddDiscountCodeID(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *DiscountRedemptionUpdate) SetOrderID(v int) *DiscountRedemptionUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdate) SetNillableOrderID(v *int) *DiscountRedemptionUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *DiscountRedemptionUpdate) AddOrderID(v int) *DiscountRedemptionUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *DiscountRedemptionUpdate) SetAmount(v int) *DiscountRedemptionUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdate) SetNillableAmount(v *int) *DiscountRedemptionUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *DiscountRedemptionUpdate) AddAmount(v int) *DiscountRedemptionUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DiscountRedemptionUpdate) SetCreatedAt(v time.Time) *DiscountRedemptionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdate) SetNillableCreatedAt(v *time.Time) *DiscountRedemptionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *DiscountRedemptionUpdate) SetReleasedAt(v time.Time) *DiscountRedemptionUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdate) SetNillableReleasedAt(v *time.Time) *DiscountRedemptionUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *DiscountRedemptionUpdate) ClearReleasedAt() *DiscountRedemptionUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// Mutation returns the DiscountRedemptionMutation object of the builder.
+func (_u *DiscountRedemptionUpdate) Mutation() *DiscountRedemptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscountRedemptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscountRedemptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscountRedemptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscountRedemptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscountRedemptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discountredemption.Table, discountredemption.Columns, sqlgraph.NewFieldSpec(discountredemption.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DiscountCodeID(); ok {
+		_spec.SetField(discountredemption.FieldDiscountCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountCodeID(); ok {
+		_spec.AddField(discountredemption.FieldDiscountCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(discountredemption.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(discountredemption.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(discountredemption.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(discountredemption.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(discountredemption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(discountredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(discountredemption.FieldReleasedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discountredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscountRedemptionUpdateOne is the builder for updating a single DiscountRedemption entity.
+type DiscountRedemptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscountRedemptionMutation
+}
+
+// SetDiscountCodeID sets the "discount_code_id" field.
+func (_u *DiscountRedemptionUpdateOne) SetDiscountCodeID(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.ResetDiscountCodeID()
+	_u.mutation.SetDiscountCodeID(v)
+	return _u
+}
+
+// SetNillableDiscountCodeID sets the "discount_code_id" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdateOne) SetNillableDiscountCodeID(v *int) *DiscountRedemptionUpdateOne {
+	if v != nil {
+		_u.SetDiscountCodeID(*v)
+	}
+	return _u
+}
+
+// AddDiscountCodeID adds value to the "discount_code_id" field.
+func (_u *DiscountRedemptionUpdateOne) AddDiscountCodeID(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.AddDiscountCodeID(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *DiscountRedemptionUpdateOne) SetOrderID(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdateOne) SetNillableOrderID(v *int) *DiscountRedemptionUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *DiscountRedemptionUpdateOne) AddOrderID(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *DiscountRedemptionUpdateOne) SetAmount(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdateOne) SetNillableAmount(v *int) *DiscountRedemptionUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *DiscountRedemptionUpdateOne) AddAmount(v int) *DiscountRedemptionUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DiscountRedemptionUpdateOne) SetCreatedAt(v time.Time) *DiscountRedemptionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdateOne) SetNillableCreatedAt(v *time.Time) *DiscountRedemptionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *DiscountRedemptionUpdateOne) SetReleasedAt(v time.Time) *DiscountRedemptionUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *DiscountRedemptionUpdateOne) SetNillableReleasedAt(v *time.Time) *DiscountRedemptionUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *DiscountRedemptionUpdateOne) ClearReleasedAt() *DiscountRedemptionUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// Mutation returns the DiscountRedemptionMutation object of the builder.
+func (_u *DiscountRedemptionUpdateOne) Mutation() *DiscountRedemptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscountRedemptionUpdate builder.
+func (_u *DiscountRedemptionUpdateOne) Where(ps ...predicate.DiscountRedemption) *DiscountRedemptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscountRedemptionUpdateOne) Select(field string, fields ...string) *DiscountRedemptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscountRedemption entity.
+func (_u *DiscountRedemptionUpdateOne) Save(ctx context.Context) (*DiscountRedemption, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscountRedemptionUpdateOne) SaveX(ctx context.Context) *DiscountRedemption {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscountRedemptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscountRedemptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscountRedemptionUpdateOne) sqlSave(ctx context.Context) (_node *DiscountRedemption, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discountredemption.Table, discountredemption.Columns, sqlgraph.NewFieldSpec(discountredemption.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscountRedemption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discountredemption.FieldID)
+		for _, f := range fields {
+			if !discountredemption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discountredemption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.DiscountCodeID(); ok {
+		_spec.SetField(discountredemption.FieldDiscountCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDiscountCodeID(); ok {
+		_spec.AddField(discountredemption.FieldDiscountCodeID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(discountredemption.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(discountredemption.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(discountredemption.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(discountredemption.FieldAmount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(discountredemption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(discountredemption.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(discountredemption.FieldReleasedAt, field.TypeTime)
+	}
+	_node = &DiscountRedemption{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discountredemption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
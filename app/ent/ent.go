@@ -14,19 +14,31 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/augustin-wien/augustina-backend/ent/abonement"
 	"github.com/augustin-wien/augustina-backend/ent/account"
+	"github.com/augustin-wien/augustina-backend/ent/apikey"
+	"github.com/augustin-wien/augustina-backend/ent/auditlog"
 	"github.com/augustin-wien/augustina-backend/ent/blockedip"
 	"github.com/augustin-wien/augustina-backend/ent/comment"
+	"github.com/augustin-wien/augustina-backend/ent/consignment"
 	"github.com/augustin-wien/augustina-backend/ent/customer"
 	"github.com/augustin-wien/augustina-backend/ent/dbsettings"
+	"github.com/augustin-wien/augustina-backend/ent/discountcode"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+	"github.com/augustin-wien/augustina-backend/ent/entitlement"
+	"github.com/augustin-wien/augustina-backend/ent/giftcode"
+	"github.com/augustin-wien/augustina-backend/ent/invoice"
+	"github.com/augustin-wien/augustina-backend/ent/invoicesequence"
 	"github.com/augustin-wien/augustina-backend/ent/item"
 	"github.com/augustin-wien/augustina-backend/ent/location"
 	"github.com/augustin-wien/augustina-backend/ent/mailtemplate"
 	"github.com/augustin-wien/augustina-backend/ent/order"
 	"github.com/augustin-wien/augustina-backend/ent/orderentry"
 	"github.com/augustin-wien/augustina-backend/ent/payment"
+	"github.com/augustin-wien/augustina-backend/ent/paymentmandate"
 	"github.com/augustin-wien/augustina-backend/ent/pdf"
 	"github.com/augustin-wien/augustina-backend/ent/pdfdownload"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
 	"github.com/augustin-wien/augustina-backend/ent/settings"
+	"github.com/augustin-wien/augustina-backend/ent/stockmovement"
 	"github.com/augustin-wien/augustina-backend/ent/vendor"
 )
 
@@ -88,22 +100,34 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			abonement.Table:    abonement.ValidColumn,
-			account.Table:      account.ValidColumn,
-			blockedip.Table:    blockedip.ValidColumn,
-			comment.Table:      comment.ValidColumn,
-			customer.Table:     customer.ValidColumn,
-			dbsettings.Table:   dbsettings.ValidColumn,
-			item.Table:         item.ValidColumn,
-			location.Table:     location.ValidColumn,
-			mailtemplate.Table: mailtemplate.ValidColumn,
-			order.Table:        order.ValidColumn,
-			orderentry.Table:   orderentry.ValidColumn,
-			pdf.Table:          pdf.ValidColumn,
-			pdfdownload.Table:  pdfdownload.ValidColumn,
-			payment.Table:      payment.ValidColumn,
-			settings.Table:     settings.ValidColumn,
-			vendor.Table:       vendor.ValidColumn,
+			apikey.Table:             apikey.ValidColumn,
+			abonement.Table:          abonement.ValidColumn,
+			account.Table:            account.ValidColumn,
+			auditlog.Table:           auditlog.ValidColumn,
+			blockedip.Table:          blockedip.ValidColumn,
+			comment.Table:            comment.ValidColumn,
+			consignment.Table:        consignment.ValidColumn,
+			customer.Table:           customer.ValidColumn,
+			dbsettings.Table:         dbsettings.ValidColumn,
+			discountcode.Table:       discountcode.ValidColumn,
+			discountredemption.Table: discountredemption.ValidColumn,
+			entitlement.Table:        entitlement.ValidColumn,
+			giftcode.Table:           giftcode.ValidColumn,
+			invoice.Table:            invoice.ValidColumn,
+			invoicesequence.Table:    invoicesequence.ValidColumn,
+			item.Table:               item.ValidColumn,
+			location.Table:           location.ValidColumn,
+			mailtemplate.Table:       mailtemplate.ValidColumn,
+			order.Table:              order.ValidColumn,
+			orderentry.Table:         orderentry.ValidColumn,
+			pdf.Table:                pdf.ValidColumn,
+			pdfdownload.Table:        pdfdownload.ValidColumn,
+			pdfdownloadaccess.Table:  pdfdownloadaccess.ValidColumn,
+			payment.Table:            payment.ValidColumn,
+			paymentmandate.Table:     paymentmandate.ValidColumn,
+			settings.Table:           settings.ValidColumn,
+			stockmovement.Table:      stockmovement.ValidColumn,
+			vendor.Table:             vendor.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
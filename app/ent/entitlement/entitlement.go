@@ -0,0 +1,126 @@
+// Code generated by ent, DO NOT EDIT.
+
+package entitlement
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the entitlement type in the database.
+	Label = "entitlement"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item"
+	// FieldLicenseGroup holds the string denoting the license_group field in the database.
+	FieldLicenseGroup = "license_group"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "paymentorder"
+	// FieldAbonementID holds the string denoting the abonement_id field in the database.
+	FieldAbonementID = "abonement"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldRevokedAt holds the string denoting the revoked_at field in the database.
+	FieldRevokedAt = "revoked_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the entitlement in the database.
+	Table = "entitlement"
+)
+
+// Columns holds all SQL columns for entitlement fields.
+var Columns = []string{
+	FieldID,
+	FieldCustomerID,
+	FieldItemID,
+	FieldLicenseGroup,
+	FieldSource,
+	FieldOrderID,
+	FieldAbonementID,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldRevokedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultLicenseGroup holds the default value on creation for the "license_group" field.
+	DefaultLicenseGroup string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the Entitlement queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByLicenseGroup orders the results by the license_group field.
+func ByLicenseGroup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLicenseGroup, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByAbonementID orders the results by the abonement_id field.
+func ByAbonementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbonementID, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByRevokedAt orders the results by the revoked_at field.
+func ByRevokedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevokedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
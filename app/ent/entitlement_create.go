@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/entitlement"
+)
+
+// EntitlementCreate is the builder for creating a Entitlement entity.
+type EntitlementCreate struct {
+	config
+	mutation *EntitlementMutation
+	hooks    []Hook
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *EntitlementCreate) SetCustomerID(v int) *EntitlementCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *EntitlementCreate) SetItemID(v int) *EntitlementCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableItemID(v *int) *EntitlementCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_c *EntitlementCreate) SetLicenseGroup(v string) *EntitlementCreate {
+	_c.mutation.SetLicenseGroup(v)
+	return _c
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableLicenseGroup(v *string) *EntitlementCreate {
+	if v != nil {
+		_c.SetLicenseGroup(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *EntitlementCreate) SetSource(v string) *EntitlementCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *EntitlementCreate) SetOrderID(v int) *EntitlementCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableOrderID(v *int) *EntitlementCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_c *EntitlementCreate) SetAbonementID(v int) *EntitlementCreate {
+	_c.mutation.SetAbonementID(v)
+	return _c
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableAbonementID(v *int) *EntitlementCreate {
+	if v != nil {
+		_c.SetAbonementID(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *EntitlementCreate) SetValidFrom(v time.Time) *EntitlementCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *EntitlementCreate) SetValidTo(v time.Time) *EntitlementCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableValidTo(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *EntitlementCreate) SetRevokedAt(v time.Time) *EntitlementCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *EntitlementCreate) SetNillableRevokedAt(v *time.Time) *EntitlementCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EntitlementCreate) SetCreatedAt(v time.Time) *EntitlementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EntitlementCreate) SetID(v int) *EntitlementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_c *EntitlementCreate) Mutation() *EntitlementMutation {
+	return _c.mutation
+}
+
+// Save creates the Entitlement in the database.
+func (_c *EntitlementCreate) Save(ctx context.Context) (*Entitlement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EntitlementCreate) SaveX(ctx context.Context) *Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EntitlementCreate) defaults() {
+	if _, ok := _c.mutation.LicenseGroup(); !ok {
+		v := entitlement.DefaultLicenseGroup
+		_c.mutation.SetLicenseGroup(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EntitlementCreate) check() error {
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "Entitlement.customer_id"`)}
+	}
+	if _, ok := _c.mutation.LicenseGroup(); !ok {
+		return &ValidationError{Name: "license_group", err: errors.New(`ent: missing required field "Entitlement.license_group"`)}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Entitlement.source"`)}
+	}
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "Entitlement.valid_from"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Entitlement.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := entitlement.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Entitlement.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EntitlementCreate) sqlSave(ctx context.Context) (*Entitlement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EntitlementCreate) createSpec() (*Entitlement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Entitlement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(entitlement.Table, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(entitlement.FieldCustomerID, field.TypeInt, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(entitlement.FieldItemID, field.TypeInt, value)
+		_node.ItemID = &value
+	}
+	if value, ok := _c.mutation.LicenseGroup(); ok {
+		_spec.SetField(entitlement.FieldLicenseGroup, field.TypeString, value)
+		_node.LicenseGroup = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(entitlement.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(entitlement.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.AbonementID(); ok {
+		_spec.SetField(entitlement.FieldAbonementID, field.TypeInt, value)
+		_node.AbonementID = &value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(entitlement.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(entitlement.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(entitlement.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(entitlement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EntitlementCreateBulk is the builder for creating many Entitlement entities in bulk.
+type EntitlementCreateBulk struct {
+	config
+	err      error
+	builders []*EntitlementCreate
+}
+
+// Save creates the Entitlement entities in the database.
+func (_c *EntitlementCreateBulk) Save(ctx context.Context) ([]*Entitlement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Entitlement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EntitlementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) SaveX(ctx context.Context) []*Entitlement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EntitlementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EntitlementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,686 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/entitlement"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// EntitlementUpdate is the builder for updating Entitlement entities.
+type EntitlementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EntitlementMutation
+}
+
+// Where appends a list predicates to the EntitlementUpdate builder.
+func (_u *EntitlementUpdate) Where(ps ...predicate.Entitlement) *EntitlementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *EntitlementUpdate) SetCustomerID(v int) *EntitlementUpdate {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableCustomerID(v *int) *EntitlementUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *EntitlementUpdate) AddCustomerID(v int) *EntitlementUpdate {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *EntitlementUpdate) SetItemID(v int) *EntitlementUpdate {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableItemID(v *int) *EntitlementUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *EntitlementUpdate) AddItemID(v int) *EntitlementUpdate {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *EntitlementUpdate) ClearItemID() *EntitlementUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_u *EntitlementUpdate) SetLicenseGroup(v string) *EntitlementUpdate {
+	_u.mutation.SetLicenseGroup(v)
+	return _u
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableLicenseGroup(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetLicenseGroup(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *EntitlementUpdate) SetSource(v string) *EntitlementUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableSource(v *string) *EntitlementUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *EntitlementUpdate) SetOrderID(v int) *EntitlementUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableOrderID(v *int) *EntitlementUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *EntitlementUpdate) AddOrderID(v int) *EntitlementUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *EntitlementUpdate) ClearOrderID() *EntitlementUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_u *EntitlementUpdate) SetAbonementID(v int) *EntitlementUpdate {
+	_u.mutation.ResetAbonementID()
+	_u.mutation.SetAbonementID(v)
+	return _u
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableAbonementID(v *int) *EntitlementUpdate {
+	if v != nil {
+		_u.SetAbonementID(*v)
+	}
+	return _u
+}
+
+// AddAbonementID adds value to the "abonement_id" field.
+func (_u *EntitlementUpdate) AddAbonementID(v int) *EntitlementUpdate {
+	_u.mutation.AddAbonementID(v)
+	return _u
+}
+
+// ClearAbonementID clears the value of the "abonement_id" field.
+func (_u *EntitlementUpdate) ClearAbonementID() *EntitlementUpdate {
+	_u.mutation.ClearAbonementID()
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *EntitlementUpdate) SetValidFrom(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableValidFrom(v *time.Time) *EntitlementUpdate {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *EntitlementUpdate) SetValidTo(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableValidTo(v *time.Time) *EntitlementUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *EntitlementUpdate) ClearValidTo() *EntitlementUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *EntitlementUpdate) SetRevokedAt(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableRevokedAt(v *time.Time) *EntitlementUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *EntitlementUpdate) ClearRevokedAt() *EntitlementUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EntitlementUpdate) SetCreatedAt(v time.Time) *EntitlementUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EntitlementUpdate) SetNillableCreatedAt(v *time.Time) *EntitlementUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_u *EntitlementUpdate) Mutation() *EntitlementMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EntitlementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntitlementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EntitlementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntitlementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EntitlementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(entitlement.Table, entitlement.Columns, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(entitlement.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(entitlement.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(entitlement.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(entitlement.FieldItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(entitlement.FieldItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LicenseGroup(); ok {
+		_spec.SetField(entitlement.FieldLicenseGroup, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(entitlement.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(entitlement.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(entitlement.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(entitlement.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AbonementID(); ok {
+		_spec.SetField(entitlement.FieldAbonementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAbonementID(); ok {
+		_spec.AddField(entitlement.FieldAbonementID, field.TypeInt, value)
+	}
+	if _u.mutation.AbonementIDCleared() {
+		_spec.ClearField(entitlement.FieldAbonementID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(entitlement.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(entitlement.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(entitlement.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(entitlement.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(entitlement.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(entitlement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EntitlementUpdateOne is the builder for updating a single Entitlement entity.
+type EntitlementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EntitlementMutation
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *EntitlementUpdateOne) SetCustomerID(v int) *EntitlementUpdateOne {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableCustomerID(v *int) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *EntitlementUpdateOne) AddCustomerID(v int) *EntitlementUpdateOne {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *EntitlementUpdateOne) SetItemID(v int) *EntitlementUpdateOne {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableItemID(v *int) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *EntitlementUpdateOne) AddItemID(v int) *EntitlementUpdateOne {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// ClearItemID clears the value of the "item_id" field.
+func (_u *EntitlementUpdateOne) ClearItemID() *EntitlementUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
+// SetLicenseGroup sets the "license_group" field.
+func (_u *EntitlementUpdateOne) SetLicenseGroup(v string) *EntitlementUpdateOne {
+	_u.mutation.SetLicenseGroup(v)
+	return _u
+}
+
+// SetNillableLicenseGroup sets the "license_group" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableLicenseGroup(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetLicenseGroup(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *EntitlementUpdateOne) SetSource(v string) *EntitlementUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableSource(v *string) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *EntitlementUpdateOne) SetOrderID(v int) *EntitlementUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableOrderID(v *int) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *EntitlementUpdateOne) AddOrderID(v int) *EntitlementUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *EntitlementUpdateOne) ClearOrderID() *EntitlementUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_u *EntitlementUpdateOne) SetAbonementID(v int) *EntitlementUpdateOne {
+	_u.mutation.ResetAbonementID()
+	_u.mutation.SetAbonementID(v)
+	return _u
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableAbonementID(v *int) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetAbonementID(*v)
+	}
+	return _u
+}
+
+// AddAbonementID adds value to the "abonement_id" field.
+func (_u *EntitlementUpdateOne) AddAbonementID(v int) *EntitlementUpdateOne {
+	_u.mutation.AddAbonementID(v)
+	return _u
+}
+
+// ClearAbonementID clears the value of the "abonement_id" field.
+func (_u *EntitlementUpdateOne) ClearAbonementID() *EntitlementUpdateOne {
+	_u.mutation.ClearAbonementID()
+	return _u
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_u *EntitlementUpdateOne) SetValidFrom(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetValidFrom(v)
+	return _u
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableValidFrom(v *time.Time) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetValidFrom(*v)
+	}
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *EntitlementUpdateOne) SetValidTo(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableValidTo(v *time.Time) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *EntitlementUpdateOne) ClearValidTo() *EntitlementUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *EntitlementUpdateOne) SetRevokedAt(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableRevokedAt(v *time.Time) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *EntitlementUpdateOne) ClearRevokedAt() *EntitlementUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EntitlementUpdateOne) SetCreatedAt(v time.Time) *EntitlementUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EntitlementUpdateOne) SetNillableCreatedAt(v *time.Time) *EntitlementUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EntitlementMutation object of the builder.
+func (_u *EntitlementUpdateOne) Mutation() *EntitlementMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EntitlementUpdate builder.
+func (_u *EntitlementUpdateOne) Where(ps ...predicate.Entitlement) *EntitlementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EntitlementUpdateOne) Select(field string, fields ...string) *EntitlementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Entitlement entity.
+func (_u *EntitlementUpdateOne) Save(ctx context.Context) (*Entitlement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EntitlementUpdateOne) SaveX(ctx context.Context) *Entitlement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EntitlementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EntitlementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EntitlementUpdateOne) sqlSave(ctx context.Context) (_node *Entitlement, err error) {
+	_spec := sqlgraph.NewUpdateSpec(entitlement.Table, entitlement.Columns, sqlgraph.NewFieldSpec(entitlement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Entitlement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, entitlement.FieldID)
+		for _, f := range fields {
+			if !entitlement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != entitlement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(entitlement.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(entitlement.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(entitlement.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(entitlement.FieldItemID, field.TypeInt, value)
+	}
+	if _u.mutation.ItemIDCleared() {
+		_spec.ClearField(entitlement.FieldItemID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LicenseGroup(); ok {
+		_spec.SetField(entitlement.FieldLicenseGroup, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(entitlement.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(entitlement.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(entitlement.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(entitlement.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AbonementID(); ok {
+		_spec.SetField(entitlement.FieldAbonementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAbonementID(); ok {
+		_spec.AddField(entitlement.FieldAbonementID, field.TypeInt, value)
+	}
+	if _u.mutation.AbonementIDCleared() {
+		_spec.ClearField(entitlement.FieldAbonementID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ValidFrom(); ok {
+		_spec.SetField(entitlement.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(entitlement.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(entitlement.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(entitlement.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(entitlement.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(entitlement.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &Entitlement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{entitlement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/giftcode"
+)
+
+// GiftCode is the model entity for the GiftCode schema.
+type GiftCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int `json:"item_id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID *int `json:"order_id,omitempty"`
+	// BuyerEmail holds the value of the "buyer_email" field.
+	BuyerEmail string `json:"buyer_email,omitempty"`
+	// RecipientEmail holds the value of the "recipient_email" field.
+	RecipientEmail *string `json:"recipient_email,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// SendAt holds the value of the "send_at" field.
+	SendAt *time.Time `json:"send_at,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt *time.Time `json:"sent_at,omitempty"`
+	// RedeemedAt holds the value of the "redeemed_at" field.
+	RedeemedAt *time.Time `json:"redeemed_at,omitempty"`
+	// CustomerID holds the value of the "customer_id" field.
+	CustomerID *int `json:"customer_id,omitempty"`
+	// AbonementID holds the value of the "abonement_id" field.
+	AbonementID *int `json:"abonement_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*GiftCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case giftcode.FieldID, giftcode.FieldItemID, giftcode.FieldOrderID, giftcode.FieldCustomerID, giftcode.FieldAbonementID:
+			values[i] = new(sql.NullInt64)
+		case giftcode.FieldCode, giftcode.FieldBuyerEmail, giftcode.FieldRecipientEmail, giftcode.FieldMessage:
+			values[i] = new(sql.NullString)
+		case giftcode.FieldSendAt, giftcode.FieldSentAt, giftcode.FieldRedeemedAt, giftcode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the GiftCode fields.
+func (_m *GiftCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case giftcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case giftcode.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case giftcode.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = int(value.Int64)
+			}
+		case giftcode.FieldOrderID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				_m.OrderID = new(int)
+				*_m.OrderID = int(value.Int64)
+			}
+		case giftcode.FieldBuyerEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field buyer_email", values[i])
+			} else if value.Valid {
+				_m.BuyerEmail = value.String
+			}
+		case giftcode.FieldRecipientEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_email", values[i])
+			} else if value.Valid {
+				_m.RecipientEmail = new(string)
+				*_m.RecipientEmail = value.String
+			}
+		case giftcode.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case giftcode.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				_m.SendAt = new(time.Time)
+				*_m.SendAt = value.Time
+			}
+		case giftcode.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		case giftcode.FieldRedeemedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field redeemed_at", values[i])
+			} else if value.Valid {
+				_m.RedeemedAt = new(time.Time)
+				*_m.RedeemedAt = value.Time
+			}
+		case giftcode.FieldCustomerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field customer_id", values[i])
+			} else if value.Valid {
+				_m.CustomerID = new(int)
+				*_m.CustomerID = int(value.Int64)
+			}
+		case giftcode.FieldAbonementID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field abonement_id", values[i])
+			} else if value.Valid {
+				_m.AbonementID = new(int)
+				*_m.AbonementID = int(value.Int64)
+			}
+		case giftcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the GiftCode.
+// This includes values selected through modifiers, order, etc.
+func (_m *GiftCode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this GiftCode.
+// Note that you need to call GiftCode.Unwrap() before calling this method if this GiftCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *GiftCode) Update() *GiftCodeUpdateOne {
+	return NewGiftCodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the GiftCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *GiftCode) Unwrap() *GiftCode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GiftCode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *GiftCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("GiftCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	if v := _m.OrderID; v != nil {
+		builder.WriteString("order_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("buyer_email=")
+	builder.WriteString(_m.BuyerEmail)
+	builder.WriteString(", ")
+	if v := _m.RecipientEmail; v != nil {
+		builder.WriteString("recipient_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	if v := _m.SendAt; v != nil {
+		builder.WriteString("send_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RedeemedAt; v != nil {
+		builder.WriteString("redeemed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CustomerID; v != nil {
+		builder.WriteString("customer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AbonementID; v != nil {
+		builder.WriteString("abonement_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// GiftCodes is a parsable slice of GiftCode.
+type GiftCodes []*GiftCode
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package giftcode
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the giftcode type in the database.
+	Label = "gift_code"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "paymentorder"
+	// FieldBuyerEmail holds the string denoting the buyer_email field in the database.
+	FieldBuyerEmail = "buyer_email"
+	// FieldRecipientEmail holds the string denoting the recipient_email field in the database.
+	FieldRecipientEmail = "recipient_email"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldSendAt holds the string denoting the send_at field in the database.
+	FieldSendAt = "send_at"
+	// FieldSentAt holds the string denoting the sent_at field in the database.
+	FieldSentAt = "sent_at"
+	// FieldRedeemedAt holds the string denoting the redeemed_at field in the database.
+	FieldRedeemedAt = "redeemed_at"
+	// FieldCustomerID holds the string denoting the customer_id field in the database.
+	FieldCustomerID = "customer"
+	// FieldAbonementID holds the string denoting the abonement_id field in the database.
+	FieldAbonementID = "abonement"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the giftcode in the database.
+	Table = "gift_code"
+)
+
+// Columns holds all SQL columns for giftcode fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldItemID,
+	FieldOrderID,
+	FieldBuyerEmail,
+	FieldRecipientEmail,
+	FieldMessage,
+	FieldSendAt,
+	FieldSentAt,
+	FieldRedeemedAt,
+	FieldCustomerID,
+	FieldAbonementID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMessage holds the default value on creation for the "message" field.
+	DefaultMessage string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the GiftCode queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByBuyerEmail orders the results by the buyer_email field.
+func ByBuyerEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBuyerEmail, opts...).ToFunc()
+}
+
+// ByRecipientEmail orders the results by the recipient_email field.
+func ByRecipientEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecipientEmail, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// BySendAt orders the results by the send_at field.
+func BySendAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSendAt, opts...).ToFunc()
+}
+
+// BySentAt orders the results by the sent_at field.
+func BySentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSentAt, opts...).ToFunc()
+}
+
+// ByRedeemedAt orders the results by the redeemed_at field.
+func ByRedeemedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRedeemedAt, opts...).ToFunc()
+}
+
+// ByCustomerID orders the results by the customer_id field.
+func ByCustomerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCustomerID, opts...).ToFunc()
+}
+
+// ByAbonementID orders the results by the abonement_id field.
+func ByAbonementID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbonementID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
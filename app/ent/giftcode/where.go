@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package giftcode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCode, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldItemID, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldOrderID, v))
+}
+
+// BuyerEmail applies equality check predicate on the "buyer_email" field. It's identical to BuyerEmailEQ.
+func BuyerEmail(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// RecipientEmail applies equality check predicate on the "recipient_email" field. It's identical to RecipientEmailEQ.
+func RecipientEmail(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldRecipientEmail, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldMessage, v))
+}
+
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldSentAt, v))
+}
+
+// RedeemedAt applies equality check predicate on the "redeemed_at" field. It's identical to RedeemedAtEQ.
+func RedeemedAt(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// AbonementID applies equality check predicate on the "abonement_id" field. It's identical to AbonementIDEQ.
+func AbonementID(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldAbonementID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContainsFold(FieldCode, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// ItemIDGT applies the GT predicate on the "item_id" field.
+func ItemIDGT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldItemID, v))
+}
+
+// ItemIDGTE applies the GTE predicate on the "item_id" field.
+func ItemIDGTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldItemID, v))
+}
+
+// ItemIDLT applies the LT predicate on the "item_id" field.
+func ItemIDLT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldItemID, v))
+}
+
+// ItemIDLTE applies the LTE predicate on the "item_id" field.
+func ItemIDLTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldItemID, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldOrderID))
+}
+
+// BuyerEmailEQ applies the EQ predicate on the "buyer_email" field.
+func BuyerEmailEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailNEQ applies the NEQ predicate on the "buyer_email" field.
+func BuyerEmailNEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldBuyerEmail, v))
+}
+
+// BuyerEmailIn applies the In predicate on the "buyer_email" field.
+func BuyerEmailIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailNotIn applies the NotIn predicate on the "buyer_email" field.
+func BuyerEmailNotIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldBuyerEmail, vs...))
+}
+
+// BuyerEmailGT applies the GT predicate on the "buyer_email" field.
+func BuyerEmailGT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailGTE applies the GTE predicate on the "buyer_email" field.
+func BuyerEmailGTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLT applies the LT predicate on the "buyer_email" field.
+func BuyerEmailLT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldBuyerEmail, v))
+}
+
+// BuyerEmailLTE applies the LTE predicate on the "buyer_email" field.
+func BuyerEmailLTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContains applies the Contains predicate on the "buyer_email" field.
+func BuyerEmailContains(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContains(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasPrefix applies the HasPrefix predicate on the "buyer_email" field.
+func BuyerEmailHasPrefix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasPrefix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailHasSuffix applies the HasSuffix predicate on the "buyer_email" field.
+func BuyerEmailHasSuffix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasSuffix(FieldBuyerEmail, v))
+}
+
+// BuyerEmailEqualFold applies the EqualFold predicate on the "buyer_email" field.
+func BuyerEmailEqualFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEqualFold(FieldBuyerEmail, v))
+}
+
+// BuyerEmailContainsFold applies the ContainsFold predicate on the "buyer_email" field.
+func BuyerEmailContainsFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContainsFold(FieldBuyerEmail, v))
+}
+
+// RecipientEmailEQ applies the EQ predicate on the "recipient_email" field.
+func RecipientEmailEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldRecipientEmail, v))
+}
+
+// RecipientEmailNEQ applies the NEQ predicate on the "recipient_email" field.
+func RecipientEmailNEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldRecipientEmail, v))
+}
+
+// RecipientEmailIn applies the In predicate on the "recipient_email" field.
+func RecipientEmailIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldRecipientEmail, vs...))
+}
+
+// RecipientEmailNotIn applies the NotIn predicate on the "recipient_email" field.
+func RecipientEmailNotIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldRecipientEmail, vs...))
+}
+
+// RecipientEmailGT applies the GT predicate on the "recipient_email" field.
+func RecipientEmailGT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldRecipientEmail, v))
+}
+
+// RecipientEmailGTE applies the GTE predicate on the "recipient_email" field.
+func RecipientEmailGTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldRecipientEmail, v))
+}
+
+// RecipientEmailLT applies the LT predicate on the "recipient_email" field.
+func RecipientEmailLT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldRecipientEmail, v))
+}
+
+// RecipientEmailLTE applies the LTE predicate on the "recipient_email" field.
+func RecipientEmailLTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldRecipientEmail, v))
+}
+
+// RecipientEmailContains applies the Contains predicate on the "recipient_email" field.
+func RecipientEmailContains(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContains(FieldRecipientEmail, v))
+}
+
+// RecipientEmailHasPrefix applies the HasPrefix predicate on the "recipient_email" field.
+func RecipientEmailHasPrefix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasPrefix(FieldRecipientEmail, v))
+}
+
+// RecipientEmailHasSuffix applies the HasSuffix predicate on the "recipient_email" field.
+func RecipientEmailHasSuffix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasSuffix(FieldRecipientEmail, v))
+}
+
+// RecipientEmailIsNil applies the IsNil predicate on the "recipient_email" field.
+func RecipientEmailIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldRecipientEmail))
+}
+
+// RecipientEmailNotNil applies the NotNil predicate on the "recipient_email" field.
+func RecipientEmailNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldRecipientEmail))
+}
+
+// RecipientEmailEqualFold applies the EqualFold predicate on the "recipient_email" field.
+func RecipientEmailEqualFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEqualFold(FieldRecipientEmail, v))
+}
+
+// RecipientEmailContainsFold applies the ContainsFold predicate on the "recipient_email" field.
+func RecipientEmailContainsFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContainsFold(FieldRecipientEmail, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldSendAt, v))
+}
+
+// SendAtIsNil applies the IsNil predicate on the "send_at" field.
+func SendAtIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldSendAt))
+}
+
+// SendAtNotNil applies the NotNil predicate on the "send_at" field.
+func SendAtNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldSendAt))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldSentAt))
+}
+
+// RedeemedAtEQ applies the EQ predicate on the "redeemed_at" field.
+func RedeemedAtEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtNEQ applies the NEQ predicate on the "redeemed_at" field.
+func RedeemedAtNEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIn applies the In predicate on the "redeemed_at" field.
+func RedeemedAtIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtNotIn applies the NotIn predicate on the "redeemed_at" field.
+func RedeemedAtNotIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldRedeemedAt, vs...))
+}
+
+// RedeemedAtGT applies the GT predicate on the "redeemed_at" field.
+func RedeemedAtGT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtGTE applies the GTE predicate on the "redeemed_at" field.
+func RedeemedAtGTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLT applies the LT predicate on the "redeemed_at" field.
+func RedeemedAtLT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldRedeemedAt, v))
+}
+
+// RedeemedAtLTE applies the LTE predicate on the "redeemed_at" field.
+func RedeemedAtLTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldRedeemedAt, v))
+}
+
+// RedeemedAtIsNil applies the IsNil predicate on the "redeemed_at" field.
+func RedeemedAtIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldRedeemedAt))
+}
+
+// RedeemedAtNotNil applies the NotNil predicate on the "redeemed_at" field.
+func RedeemedAtNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldRedeemedAt))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// CustomerIDIsNil applies the IsNil predicate on the "customer_id" field.
+func CustomerIDIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldCustomerID))
+}
+
+// CustomerIDNotNil applies the NotNil predicate on the "customer_id" field.
+func CustomerIDNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldCustomerID))
+}
+
+// AbonementIDEQ applies the EQ predicate on the "abonement_id" field.
+func AbonementIDEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldAbonementID, v))
+}
+
+// AbonementIDNEQ applies the NEQ predicate on the "abonement_id" field.
+func AbonementIDNEQ(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldAbonementID, v))
+}
+
+// AbonementIDIn applies the In predicate on the "abonement_id" field.
+func AbonementIDIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldAbonementID, vs...))
+}
+
+// AbonementIDNotIn applies the NotIn predicate on the "abonement_id" field.
+func AbonementIDNotIn(vs ...int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldAbonementID, vs...))
+}
+
+// AbonementIDGT applies the GT predicate on the "abonement_id" field.
+func AbonementIDGT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldAbonementID, v))
+}
+
+// AbonementIDGTE applies the GTE predicate on the "abonement_id" field.
+func AbonementIDGTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldAbonementID, v))
+}
+
+// AbonementIDLT applies the LT predicate on the "abonement_id" field.
+func AbonementIDLT(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldAbonementID, v))
+}
+
+// AbonementIDLTE applies the LTE predicate on the "abonement_id" field.
+func AbonementIDLTE(v int) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldAbonementID, v))
+}
+
+// AbonementIDIsNil applies the IsNil predicate on the "abonement_id" field.
+func AbonementIDIsNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIsNull(FieldAbonementID))
+}
+
+// AbonementIDNotNil applies the NotNil predicate on the "abonement_id" field.
+func AbonementIDNotNil() predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotNull(FieldAbonementID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.GiftCode {
+	return predicate.GiftCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.GiftCode) predicate.GiftCode {
+	return predicate.GiftCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.GiftCode) predicate.GiftCode {
+	return predicate.GiftCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.GiftCode) predicate.GiftCode {
+	return predicate.GiftCode(sql.NotPredicates(p))
+}
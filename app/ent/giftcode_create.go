@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/giftcode"
+)
+
+// GiftCodeCreate is the builder for creating a GiftCode entity.
+type GiftCodeCreate struct {
+	config
+	mutation *GiftCodeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *GiftCodeCreate) SetCode(v string) *GiftCodeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *GiftCodeCreate) SetItemID(v int) *GiftCodeCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *GiftCodeCreate) SetOrderID(v int) *GiftCodeCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableOrderID(v *int) *GiftCodeCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_c *GiftCodeCreate) SetBuyerEmail(v string) *GiftCodeCreate {
+	_c.mutation.SetBuyerEmail(v)
+	return _c
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_c *GiftCodeCreate) SetRecipientEmail(v string) *GiftCodeCreate {
+	_c.mutation.SetRecipientEmail(v)
+	return _c
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableRecipientEmail(v *string) *GiftCodeCreate {
+	if v != nil {
+		_c.SetRecipientEmail(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *GiftCodeCreate) SetMessage(v string) *GiftCodeCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableMessage(v *string) *GiftCodeCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetSendAt sets the "send_at" field.
+func (_c *GiftCodeCreate) SetSendAt(v time.Time) *GiftCodeCreate {
+	_c.mutation.SetSendAt(v)
+	return _c
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableSendAt(v *time.Time) *GiftCodeCreate {
+	if v != nil {
+		_c.SetSendAt(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *GiftCodeCreate) SetSentAt(v time.Time) *GiftCodeCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableSentAt(v *time.Time) *GiftCodeCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_c *GiftCodeCreate) SetRedeemedAt(v time.Time) *GiftCodeCreate {
+	_c.mutation.SetRedeemedAt(v)
+	return _c
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableRedeemedAt(v *time.Time) *GiftCodeCreate {
+	if v != nil {
+		_c.SetRedeemedAt(*v)
+	}
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *GiftCodeCreate) SetCustomerID(v int) *GiftCodeCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableCustomerID(v *int) *GiftCodeCreate {
+	if v != nil {
+		_c.SetCustomerID(*v)
+	}
+	return _c
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_c *GiftCodeCreate) SetAbonementID(v int) *GiftCodeCreate {
+	_c.mutation.SetAbonementID(v)
+	return _c
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_c *GiftCodeCreate) SetNillableAbonementID(v *int) *GiftCodeCreate {
+	if v != nil {
+		_c.SetAbonementID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *GiftCodeCreate) SetCreatedAt(v time.Time) *GiftCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *GiftCodeCreate) SetID(v int) *GiftCodeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the GiftCodeMutation object of the builder.
+func (_c *GiftCodeCreate) Mutation() *GiftCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the GiftCode in the database.
+func (_c *GiftCodeCreate) Save(ctx context.Context) (*GiftCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *GiftCodeCreate) SaveX(ctx context.Context) *GiftCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GiftCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GiftCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *GiftCodeCreate) defaults() {
+	if _, ok := _c.mutation.Message(); !ok {
+		v := giftcode.DefaultMessage
+		_c.mutation.SetMessage(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *GiftCodeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "GiftCode.code"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "GiftCode.item_id"`)}
+	}
+	if _, ok := _c.mutation.BuyerEmail(); !ok {
+		return &ValidationError{Name: "buyer_email", err: errors.New(`ent: missing required field "GiftCode.buyer_email"`)}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "GiftCode.message"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "GiftCode.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := giftcode.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "GiftCode.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *GiftCodeCreate) sqlSave(ctx context.Context) (*GiftCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *GiftCodeCreate) createSpec() (*GiftCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &GiftCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(giftcode.Table, sqlgraph.NewFieldSpec(giftcode.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(giftcode.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.ItemID(); ok {
+		_spec.SetField(giftcode.FieldItemID, field.TypeInt, value)
+		_node.ItemID = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(giftcode.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.BuyerEmail(); ok {
+		_spec.SetField(giftcode.FieldBuyerEmail, field.TypeString, value)
+		_node.BuyerEmail = value
+	}
+	if value, ok := _c.mutation.RecipientEmail(); ok {
+		_spec.SetField(giftcode.FieldRecipientEmail, field.TypeString, value)
+		_node.RecipientEmail = &value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(giftcode.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.SendAt(); ok {
+		_spec.SetField(giftcode.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(giftcode.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	if value, ok := _c.mutation.RedeemedAt(); ok {
+		_spec.SetField(giftcode.FieldRedeemedAt, field.TypeTime, value)
+		_node.RedeemedAt = &value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(giftcode.FieldCustomerID, field.TypeInt, value)
+		_node.CustomerID = &value
+	}
+	if value, ok := _c.mutation.AbonementID(); ok {
+		_spec.SetField(giftcode.FieldAbonementID, field.TypeInt, value)
+		_node.AbonementID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(giftcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// GiftCodeCreateBulk is the builder for creating many GiftCode entities in bulk.
+type GiftCodeCreateBulk struct {
+	config
+	err      error
+	builders []*GiftCodeCreate
+}
+
+// Save creates the GiftCode entities in the database.
+func (_c *GiftCodeCreateBulk) Save(ctx context.Context) ([]*GiftCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*GiftCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GiftCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *GiftCodeCreateBulk) SaveX(ctx context.Context) []*GiftCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *GiftCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *GiftCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/giftcode"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// GiftCodeUpdate is the builder for updating GiftCode entities.
+type GiftCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *GiftCodeMutation
+}
+
+// Where appends a list predicates to the GiftCodeUpdate builder.
+func (_u *GiftCodeUpdate) Where(ps ...predicate.GiftCode) *GiftCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *GiftCodeUpdate) SetCode(v string) *GiftCodeUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableCode(v *string) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *GiftCodeUpdate) SetItemID(v int) *GiftCodeUpdate {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableItemID(v *int) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *GiftCodeUpdate) AddItemID(v int) *GiftCodeUpdate {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *GiftCodeUpdate) SetOrderID(v int) *GiftCodeUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableOrderID(v *int) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *GiftCodeUpdate) AddOrderID(v int) *GiftCodeUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *GiftCodeUpdate) ClearOrderID() *GiftCodeUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_u *GiftCodeUpdate) SetBuyerEmail(v string) *GiftCodeUpdate {
+	_u.mutation.SetBuyerEmail(v)
+	return _u
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableBuyerEmail(v *string) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetBuyerEmail(*v)
+	}
+	return _u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_u *GiftCodeUpdate) SetRecipientEmail(v string) *GiftCodeUpdate {
+	_u.mutation.SetRecipientEmail(v)
+	return _u
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableRecipientEmail(v *string) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetRecipientEmail(*v)
+	}
+	return _u
+}
+
+// ClearRecipientEmail clears the value of the "recipient_email" field.
+func (_u *GiftCodeUpdate) ClearRecipientEmail() *GiftCodeUpdate {
+	_u.mutation.ClearRecipientEmail()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *GiftCodeUpdate) SetMessage(v string) *GiftCodeUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableMessage(v *string) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *GiftCodeUpdate) SetSendAt(v time.Time) *GiftCodeUpdate {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableSendAt(v *time.Time) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (_u *GiftCodeUpdate) ClearSendAt() *GiftCodeUpdate {
+	_u.mutation.ClearSendAt()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *GiftCodeUpdate) SetSentAt(v time.Time) *GiftCodeUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableSentAt(v *time.Time) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *GiftCodeUpdate) ClearSentAt() *GiftCodeUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_u *GiftCodeUpdate) SetRedeemedAt(v time.Time) *GiftCodeUpdate {
+	_u.mutation.SetRedeemedAt(v)
+	return _u
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableRedeemedAt(v *time.Time) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetRedeemedAt(*v)
+	}
+	return _u
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (_u *GiftCodeUpdate) ClearRedeemedAt() *GiftCodeUpdate {
+	_u.mutation.ClearRedeemedAt()
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *GiftCodeUpdate) SetCustomerID(v int) *GiftCodeUpdate {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableCustomerID(v *int) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *GiftCodeUpdate) AddCustomerID(v int) *GiftCodeUpdate {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *GiftCodeUpdate) ClearCustomerID() *GiftCodeUpdate {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_u *GiftCodeUpdate) SetAbonementID(v int) *GiftCodeUpdate {
+	_u.mutation.ResetAbonementID()
+	_u.mutation.SetAbonementID(v)
+	return _u
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableAbonementID(v *int) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetAbonementID(*v)
+	}
+	return _u
+}
+
+// AddAbonementID adds value to the "abonement_id" field.
+func (_u *GiftCodeUpdate) AddAbonementID(v int) *GiftCodeUpdate {
+	_u.mutation.AddAbonementID(v)
+	return _u
+}
+
+// ClearAbonementID clears the value of the "abonement_id" field.
+func (_u *GiftCodeUpdate) ClearAbonementID() *GiftCodeUpdate {
+	_u.mutation.ClearAbonementID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GiftCodeUpdate) SetCreatedAt(v time.Time) *GiftCodeUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GiftCodeUpdate) SetNillableCreatedAt(v *time.Time) *GiftCodeUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GiftCodeMutation object of the builder.
+func (_u *GiftCodeUpdate) Mutation() *GiftCodeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *GiftCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GiftCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *GiftCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GiftCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GiftCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(giftcode.Table, giftcode.Columns, sqlgraph.NewFieldSpec(giftcode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(giftcode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(giftcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(giftcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(giftcode.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(giftcode.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(giftcode.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BuyerEmail(); ok {
+		_spec.SetField(giftcode.FieldBuyerEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecipientEmail(); ok {
+		_spec.SetField(giftcode.FieldRecipientEmail, field.TypeString, value)
+	}
+	if _u.mutation.RecipientEmailCleared() {
+		_spec.ClearField(giftcode.FieldRecipientEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(giftcode.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(giftcode.FieldSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.SendAtCleared() {
+		_spec.ClearField(giftcode.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(giftcode.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(giftcode.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RedeemedAt(); ok {
+		_spec.SetField(giftcode.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedeemedAtCleared() {
+		_spec.ClearField(giftcode.FieldRedeemedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(giftcode.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(giftcode.FieldCustomerID, field.TypeInt, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(giftcode.FieldCustomerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AbonementID(); ok {
+		_spec.SetField(giftcode.FieldAbonementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAbonementID(); ok {
+		_spec.AddField(giftcode.FieldAbonementID, field.TypeInt, value)
+	}
+	if _u.mutation.AbonementIDCleared() {
+		_spec.ClearField(giftcode.FieldAbonementID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(giftcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{giftcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// GiftCodeUpdateOne is the builder for updating a single GiftCode entity.
+type GiftCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GiftCodeMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *GiftCodeUpdateOne) SetCode(v string) *GiftCodeUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableCode(v *string) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *GiftCodeUpdateOne) SetItemID(v int) *GiftCodeUpdateOne {
+	_u.mutation.ResetItemID()
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableItemID(v *int) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// AddItemID adds value to the "item_id" field.
+func (_u *GiftCodeUpdateOne) AddItemID(v int) *GiftCodeUpdateOne {
+	_u.mutation.AddItemID(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *GiftCodeUpdateOne) SetOrderID(v int) *GiftCodeUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableOrderID(v *int) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *GiftCodeUpdateOne) AddOrderID(v int) *GiftCodeUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *GiftCodeUpdateOne) ClearOrderID() *GiftCodeUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (_u *GiftCodeUpdateOne) SetBuyerEmail(v string) *GiftCodeUpdateOne {
+	_u.mutation.SetBuyerEmail(v)
+	return _u
+}
+
+// SetNillableBuyerEmail sets the "buyer_email" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableBuyerEmail(v *string) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetBuyerEmail(*v)
+	}
+	return _u
+}
+
+// SetRecipientEmail sets the "recipient_email" field.
+func (_u *GiftCodeUpdateOne) SetRecipientEmail(v string) *GiftCodeUpdateOne {
+	_u.mutation.SetRecipientEmail(v)
+	return _u
+}
+
+// SetNillableRecipientEmail sets the "recipient_email" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableRecipientEmail(v *string) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetRecipientEmail(*v)
+	}
+	return _u
+}
+
+// ClearRecipientEmail clears the value of the "recipient_email" field.
+func (_u *GiftCodeUpdateOne) ClearRecipientEmail() *GiftCodeUpdateOne {
+	_u.mutation.ClearRecipientEmail()
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *GiftCodeUpdateOne) SetMessage(v string) *GiftCodeUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableMessage(v *string) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *GiftCodeUpdateOne) SetSendAt(v time.Time) *GiftCodeUpdateOne {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableSendAt(v *time.Time) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (_u *GiftCodeUpdateOne) ClearSendAt() *GiftCodeUpdateOne {
+	_u.mutation.ClearSendAt()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *GiftCodeUpdateOne) SetSentAt(v time.Time) *GiftCodeUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableSentAt(v *time.Time) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *GiftCodeUpdateOne) ClearSentAt() *GiftCodeUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// SetRedeemedAt sets the "redeemed_at" field.
+func (_u *GiftCodeUpdateOne) SetRedeemedAt(v time.Time) *GiftCodeUpdateOne {
+	_u.mutation.SetRedeemedAt(v)
+	return _u
+}
+
+// SetNillableRedeemedAt sets the "redeemed_at" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableRedeemedAt(v *time.Time) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetRedeemedAt(*v)
+	}
+	return _u
+}
+
+// ClearRedeemedAt clears the value of the "redeemed_at" field.
+func (_u *GiftCodeUpdateOne) ClearRedeemedAt() *GiftCodeUpdateOne {
+	_u.mutation.ClearRedeemedAt()
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *GiftCodeUpdateOne) SetCustomerID(v int) *GiftCodeUpdateOne {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableCustomerID(v *int) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *GiftCodeUpdateOne) AddCustomerID(v int) *GiftCodeUpdateOne {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *GiftCodeUpdateOne) ClearCustomerID() *GiftCodeUpdateOne {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetAbonementID sets the "abonement_id" field.
+func (_u *GiftCodeUpdateOne) SetAbonementID(v int) *GiftCodeUpdateOne {
+	_u.mutation.ResetAbonementID()
+	_u.mutation.SetAbonementID(v)
+	return _u
+}
+
+// SetNillableAbonementID sets the "abonement_id" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableAbonementID(v *int) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetAbonementID(*v)
+	}
+	return _u
+}
+
+// AddAbonementID adds value to the "abonement_id" field.
+func (_u *GiftCodeUpdateOne) AddAbonementID(v int) *GiftCodeUpdateOne {
+	_u.mutation.AddAbonementID(v)
+	return _u
+}
+
+// ClearAbonementID clears the value of the "abonement_id" field.
+func (_u *GiftCodeUpdateOne) ClearAbonementID() *GiftCodeUpdateOne {
+	_u.mutation.ClearAbonementID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *GiftCodeUpdateOne) SetCreatedAt(v time.Time) *GiftCodeUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *GiftCodeUpdateOne) SetNillableCreatedAt(v *time.Time) *GiftCodeUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the GiftCodeMutation object of the builder.
+func (_u *GiftCodeUpdateOne) Mutation() *GiftCodeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the GiftCodeUpdate builder.
+func (_u *GiftCodeUpdateOne) Where(ps ...predicate.GiftCode) *GiftCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *GiftCodeUpdateOne) Select(field string, fields ...string) *GiftCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated GiftCode entity.
+func (_u *GiftCodeUpdateOne) Save(ctx context.Context) (*GiftCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *GiftCodeUpdateOne) SaveX(ctx context.Context) *GiftCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *GiftCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *GiftCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *GiftCodeUpdateOne) sqlSave(ctx context.Context) (_node *GiftCode, err error) {
+	_spec := sqlgraph.NewUpdateSpec(giftcode.Table, giftcode.Columns, sqlgraph.NewFieldSpec(giftcode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "GiftCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, giftcode.FieldID)
+		for _, f := range fields {
+			if !giftcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != giftcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(giftcode.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ItemID(); ok {
+		_spec.SetField(giftcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItemID(); ok {
+		_spec.AddField(giftcode.FieldItemID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(giftcode.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(giftcode.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(giftcode.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.BuyerEmail(); ok {
+		_spec.SetField(giftcode.FieldBuyerEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RecipientEmail(); ok {
+		_spec.SetField(giftcode.FieldRecipientEmail, field.TypeString, value)
+	}
+	if _u.mutation.RecipientEmailCleared() {
+		_spec.ClearField(giftcode.FieldRecipientEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(giftcode.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(giftcode.FieldSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.SendAtCleared() {
+		_spec.ClearField(giftcode.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(giftcode.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(giftcode.FieldSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RedeemedAt(); ok {
+		_spec.SetField(giftcode.FieldRedeemedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RedeemedAtCleared() {
+		_spec.ClearField(giftcode.FieldRedeemedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(giftcode.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(giftcode.FieldCustomerID, field.TypeInt, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(giftcode.FieldCustomerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.AbonementID(); ok {
+		_spec.SetField(giftcode.FieldAbonementID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAbonementID(); ok {
+		_spec.AddField(giftcode.FieldAbonementID, field.TypeInt, value)
+	}
+	if _u.mutation.AbonementIDCleared() {
+		_spec.ClearField(giftcode.FieldAbonementID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(giftcode.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &GiftCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{giftcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
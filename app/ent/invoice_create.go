@@ -0,0 +1,378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/invoice"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *InvoiceCreate) SetNumber(v string) *InvoiceCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetYear sets the "year" field.
+func (_c *InvoiceCreate) SetYear(v int) *InvoiceCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetSequence sets the "sequence" field.
+func (_c *InvoiceCreate) SetSequence(v int) *InvoiceCreate {
+	_c.mutation.SetSequence(v)
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *InvoiceCreate) SetOrderID(v int) *InvoiceCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *InvoiceCreate) SetCustomerID(v int) *InvoiceCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableCustomerID(v *int) *InvoiceCreate {
+	if v != nil {
+		_c.SetCustomerID(*v)
+	}
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *InvoiceCreate) SetEmail(v string) *InvoiceCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_c *InvoiceCreate) SetBillingAddress(v *schema.BillingAddress) *InvoiceCreate {
+	_c.mutation.SetBillingAddress(v)
+	return _c
+}
+
+// SetIssuer sets the "issuer" field.
+func (_c *InvoiceCreate) SetIssuer(v schema.InvoiceIssuer) *InvoiceCreate {
+	_c.mutation.SetIssuer(v)
+	return _c
+}
+
+// SetLines sets the "lines" field.
+func (_c *InvoiceCreate) SetLines(v []schema.InvoiceLine) *InvoiceCreate {
+	_c.mutation.SetLines(v)
+	return _c
+}
+
+// SetNet sets the "net" field.
+func (_c *InvoiceCreate) SetNet(v int) *InvoiceCreate {
+	_c.mutation.SetNet(v)
+	return _c
+}
+
+// SetTax sets the "tax" field.
+func (_c *InvoiceCreate) SetTax(v int) *InvoiceCreate {
+	_c.mutation.SetTax(v)
+	return _c
+}
+
+// SetGross sets the "gross" field.
+func (_c *InvoiceCreate) SetGross(v int) *InvoiceCreate {
+	_c.mutation.SetGross(v)
+	return _c
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_c *InvoiceCreate) SetIssuedAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetIssuedAt(v)
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *InvoiceCreate) SetSentAt(v time.Time) *InvoiceCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *InvoiceCreate) SetNillableSentAt(v *time.Time) *InvoiceCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InvoiceCreate) SetID(v int) *InvoiceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_c *InvoiceCreate) Mutation() *InvoiceMutation {
+	return _c.mutation
+}
+
+// Save creates the Invoice in the database.
+func (_c *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InvoiceCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Invoice.number"`)}
+	}
+	if _, ok := _c.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "Invoice.year"`)}
+	}
+	if _, ok := _c.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "Invoice.sequence"`)}
+	}
+	if _, ok := _c.mutation.OrderID(); !ok {
+		return &ValidationError{Name: "order_id", err: errors.New(`ent: missing required field "Invoice.order_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "Invoice.email"`)}
+	}
+	if _, ok := _c.mutation.Issuer(); !ok {
+		return &ValidationError{Name: "issuer", err: errors.New(`ent: missing required field "Invoice.issuer"`)}
+	}
+	if _, ok := _c.mutation.Lines(); !ok {
+		return &ValidationError{Name: "lines", err: errors.New(`ent: missing required field "Invoice.lines"`)}
+	}
+	if _, ok := _c.mutation.Net(); !ok {
+		return &ValidationError{Name: "net", err: errors.New(`ent: missing required field "Invoice.net"`)}
+	}
+	if _, ok := _c.mutation.Tax(); !ok {
+		return &ValidationError{Name: "tax", err: errors.New(`ent: missing required field "Invoice.tax"`)}
+	}
+	if _, ok := _c.mutation.Gross(); !ok {
+		return &ValidationError{Name: "gross", err: errors.New(`ent: missing required field "Invoice.gross"`)}
+	}
+	if _, ok := _c.mutation.IssuedAt(); !ok {
+		return &ValidationError{Name: "issued_at", err: errors.New(`ent: missing required field "Invoice.issued_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := invoice.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Invoice.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Invoice{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(invoice.Table, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(invoice.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt, value)
+		_node.Sequence = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(invoice.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = value
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(invoice.FieldCustomerID, field.TypeInt, value)
+		_node.CustomerID = &value
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(invoice.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.BillingAddress(); ok {
+		_spec.SetField(invoice.FieldBillingAddress, field.TypeJSON, value)
+		_node.BillingAddress = value
+	}
+	if value, ok := _c.mutation.Issuer(); ok {
+		_spec.SetField(invoice.FieldIssuer, field.TypeJSON, value)
+		_node.Issuer = value
+	}
+	if value, ok := _c.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+		_node.Lines = value
+	}
+	if value, ok := _c.mutation.Net(); ok {
+		_spec.SetField(invoice.FieldNet, field.TypeInt, value)
+		_node.Net = value
+	}
+	if value, ok := _c.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt, value)
+		_node.Tax = value
+	}
+	if value, ok := _c.mutation.Gross(); ok {
+		_spec.SetField(invoice.FieldGross, field.TypeInt, value)
+		_node.Gross = value
+	}
+	if value, ok := _c.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+		_node.IssuedAt = value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(invoice.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	return _node, _spec
+}
+
+// InvoiceCreateBulk is the builder for creating many Invoice entities in bulk.
+type InvoiceCreateBulk struct {
+	config
+	err      error
+	builders []*InvoiceCreate
+}
+
+// Save creates the Invoice entities in the database.
+func (_c *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Invoice, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InvoiceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InvoiceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,838 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/invoice"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdate) SetNumber(v string) *InvoiceUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNumber(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *InvoiceUpdate) SetYear(v int) *InvoiceUpdate {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableYear(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *InvoiceUpdate) AddYear(v int) *InvoiceUpdate {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *InvoiceUpdate) SetSequence(v int) *InvoiceUpdate {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSequence(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *InvoiceUpdate) AddSequence(v int) *InvoiceUpdate {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *InvoiceUpdate) SetOrderID(v int) *InvoiceUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableOrderID(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *InvoiceUpdate) AddOrderID(v int) *InvoiceUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *InvoiceUpdate) SetCustomerID(v int) *InvoiceUpdate {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableCustomerID(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *InvoiceUpdate) AddCustomerID(v int) *InvoiceUpdate {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *InvoiceUpdate) ClearCustomerID() *InvoiceUpdate {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *InvoiceUpdate) SetEmail(v string) *InvoiceUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableEmail(v *string) *InvoiceUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_u *InvoiceUpdate) SetBillingAddress(v *schema.BillingAddress) *InvoiceUpdate {
+	_u.mutation.SetBillingAddress(v)
+	return _u
+}
+
+// ClearBillingAddress clears the value of the "billing_address" field.
+func (_u *InvoiceUpdate) ClearBillingAddress() *InvoiceUpdate {
+	_u.mutation.ClearBillingAddress()
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *InvoiceUpdate) SetIssuer(v schema.InvoiceIssuer) *InvoiceUpdate {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssuer(v *schema.InvoiceIssuer) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdate) SetLines(v []schema.InvoiceLine) *InvoiceUpdate {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdate) AppendLines(v []schema.InvoiceLine) *InvoiceUpdate {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *InvoiceUpdate) SetNet(v int) *InvoiceUpdate {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableNet(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *InvoiceUpdate) AddNet(v int) *InvoiceUpdate {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetTax sets the "tax" field.
+func (_u *InvoiceUpdate) SetTax(v int) *InvoiceUpdate {
+	_u.mutation.ResetTax()
+	_u.mutation.SetTax(v)
+	return _u
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableTax(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetTax(*v)
+	}
+	return _u
+}
+
+// AddTax adds value to the "tax" field.
+func (_u *InvoiceUpdate) AddTax(v int) *InvoiceUpdate {
+	_u.mutation.AddTax(v)
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *InvoiceUpdate) SetGross(v int) *InvoiceUpdate {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableGross(v *int) *InvoiceUpdate {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *InvoiceUpdate) AddGross(v int) *InvoiceUpdate {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdate) SetIssuedAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableIssuedAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *InvoiceUpdate) SetSentAt(v time.Time) *InvoiceUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *InvoiceUpdate) SetNillableSentAt(v *time.Time) *InvoiceUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *InvoiceUpdate) ClearSentAt() *InvoiceUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdate) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InvoiceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(invoice.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(invoice.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(invoice.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(invoice.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(invoice.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(invoice.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(invoice.FieldCustomerID, field.TypeInt, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(invoice.FieldCustomerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(invoice.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingAddress(); ok {
+		_spec.SetField(invoice.FieldBillingAddress, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingAddressCleared() {
+		_spec.ClearField(invoice.FieldBillingAddress, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(invoice.FieldIssuer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(invoice.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(invoice.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTax(); ok {
+		_spec.AddField(invoice.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(invoice.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(invoice.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(invoice.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(invoice.FieldSentAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *InvoiceMutation
+}
+
+// SetNumber sets the "number" field.
+func (_u *InvoiceUpdateOne) SetNumber(v string) *InvoiceUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNumber(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *InvoiceUpdateOne) SetYear(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableYear(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *InvoiceUpdateOne) AddYear(v int) *InvoiceUpdateOne {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// SetSequence sets the "sequence" field.
+func (_u *InvoiceUpdateOne) SetSequence(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetSequence()
+	_u.mutation.SetSequence(v)
+	return _u
+}
+
+// SetNillableSequence sets the "sequence" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSequence(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSequence(*v)
+	}
+	return _u
+}
+
+// AddSequence adds value to the "sequence" field.
+func (_u *InvoiceUpdateOne) AddSequence(v int) *InvoiceUpdateOne {
+	_u.mutation.AddSequence(v)
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *InvoiceUpdateOne) SetOrderID(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableOrderID(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *InvoiceUpdateOne) AddOrderID(v int) *InvoiceUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *InvoiceUpdateOne) SetCustomerID(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableCustomerID(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *InvoiceUpdateOne) AddCustomerID(v int) *InvoiceUpdateOne {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// ClearCustomerID clears the value of the "customer_id" field.
+func (_u *InvoiceUpdateOne) ClearCustomerID() *InvoiceUpdateOne {
+	_u.mutation.ClearCustomerID()
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *InvoiceUpdateOne) SetEmail(v string) *InvoiceUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableEmail(v *string) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_u *InvoiceUpdateOne) SetBillingAddress(v *schema.BillingAddress) *InvoiceUpdateOne {
+	_u.mutation.SetBillingAddress(v)
+	return _u
+}
+
+// ClearBillingAddress clears the value of the "billing_address" field.
+func (_u *InvoiceUpdateOne) ClearBillingAddress() *InvoiceUpdateOne {
+	_u.mutation.ClearBillingAddress()
+	return _u
+}
+
+// SetIssuer sets the "issuer" field.
+func (_u *InvoiceUpdateOne) SetIssuer(v schema.InvoiceIssuer) *InvoiceUpdateOne {
+	_u.mutation.SetIssuer(v)
+	return _u
+}
+
+// SetNillableIssuer sets the "issuer" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssuer(v *schema.InvoiceIssuer) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssuer(*v)
+	}
+	return _u
+}
+
+// SetLines sets the "lines" field.
+func (_u *InvoiceUpdateOne) SetLines(v []schema.InvoiceLine) *InvoiceUpdateOne {
+	_u.mutation.SetLines(v)
+	return _u
+}
+
+// AppendLines appends value to the "lines" field.
+func (_u *InvoiceUpdateOne) AppendLines(v []schema.InvoiceLine) *InvoiceUpdateOne {
+	_u.mutation.AppendLines(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *InvoiceUpdateOne) SetNet(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableNet(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *InvoiceUpdateOne) AddNet(v int) *InvoiceUpdateOne {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetTax sets the "tax" field.
+func (_u *InvoiceUpdateOne) SetTax(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetTax()
+	_u.mutation.SetTax(v)
+	return _u
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableTax(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetTax(*v)
+	}
+	return _u
+}
+
+// AddTax adds value to the "tax" field.
+func (_u *InvoiceUpdateOne) AddTax(v int) *InvoiceUpdateOne {
+	_u.mutation.AddTax(v)
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *InvoiceUpdateOne) SetGross(v int) *InvoiceUpdateOne {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableGross(v *int) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *InvoiceUpdateOne) AddGross(v int) *InvoiceUpdateOne {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
+// SetIssuedAt sets the "issued_at" field.
+func (_u *InvoiceUpdateOne) SetIssuedAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetIssuedAt(v)
+	return _u
+}
+
+// SetNillableIssuedAt sets the "issued_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableIssuedAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetIssuedAt(*v)
+	}
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *InvoiceUpdateOne) SetSentAt(v time.Time) *InvoiceUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *InvoiceUpdateOne) SetNillableSentAt(v *time.Time) *InvoiceUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *InvoiceUpdateOne) ClearSentAt() *InvoiceUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the InvoiceMutation object of the builder.
+func (_u *InvoiceUpdateOne) Mutation() *InvoiceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InvoiceUpdate builder.
+func (_u *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InvoiceUpdateOne) Select(field string, fields ...string) *InvoiceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Invoice entity.
+func (_u *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *InvoiceUpdateOne) sqlSave(ctx context.Context) (_node *Invoice, err error) {
+	_spec := sqlgraph.NewUpdateSpec(invoice.Table, invoice.Columns, sqlgraph.NewFieldSpec(invoice.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Invoice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for _, f := range fields {
+			if !invoice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(invoice.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(invoice.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(invoice.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Sequence(); ok {
+		_spec.SetField(invoice.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSequence(); ok {
+		_spec.AddField(invoice.FieldSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(invoice.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(invoice.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(invoice.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(invoice.FieldCustomerID, field.TypeInt, value)
+	}
+	if _u.mutation.CustomerIDCleared() {
+		_spec.ClearField(invoice.FieldCustomerID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(invoice.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingAddress(); ok {
+		_spec.SetField(invoice.FieldBillingAddress, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingAddressCleared() {
+		_spec.ClearField(invoice.FieldBillingAddress, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Issuer(); ok {
+		_spec.SetField(invoice.FieldIssuer, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Lines(); ok {
+		_spec.SetField(invoice.FieldLines, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLines(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, invoice.FieldLines, value)
+		})
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(invoice.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(invoice.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Tax(); ok {
+		_spec.SetField(invoice.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTax(); ok {
+		_spec.AddField(invoice.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(invoice.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(invoice.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IssuedAt(); ok {
+		_spec.SetField(invoice.FieldIssuedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(invoice.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(invoice.FieldSentAt, field.TypeTime)
+	}
+	_node = &Invoice{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -43,6 +45,24 @@ type Item struct {
 	ItemColor string `json:"ItemColor"`
 	// ItemTextColor holds the value of the "ItemTextColor" field.
 	ItemTextColor string `json:"ItemTextColor"`
+	// TrackStock holds the value of the "TrackStock" field.
+	TrackStock bool `json:"TrackStock"`
+	// Stock holds the value of the "Stock" field.
+	Stock int `json:"Stock"`
+	// LowStockThreshold holds the value of the "LowStockThreshold" field.
+	LowStockThreshold int `json:"LowStockThreshold"`
+	// PublishAt holds the value of the "PublishAt" field.
+	PublishAt *time.Time `json:"PublishAt"`
+	// UnpublishAt holds the value of the "UnpublishAt" field.
+	UnpublishAt *time.Time `json:"UnpublishAt"`
+	// PDFDownloadLimit holds the value of the "PDFDownloadLimit" field.
+	PDFDownloadLimit *int `json:"PDFDownloadLimit"`
+	// PDFDownloadExpiryDays holds the value of the "PDFDownloadExpiryDays" field.
+	PDFDownloadExpiryDays *int `json:"PDFDownloadExpiryDays"`
+	// VATRate holds the value of the "VATRate" field.
+	VATRate *float64 `json:"VATRate"`
+	// ImageVariants holds the value of the "ImageVariants" field.
+	ImageVariants map[string]string `json:"ImageVariants"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ItemQuery when eager-loading is set.
 	Edges        ItemEdges `json:"edges"`
@@ -89,14 +109,18 @@ func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case item.FieldArchived, item.FieldDisabled, item.FieldIsLicenseItem, item.FieldIsPDFItem:
+		case item.FieldImageVariants:
+			values[i] = new([]byte)
+		case item.FieldArchived, item.FieldDisabled, item.FieldIsLicenseItem, item.FieldIsPDFItem, item.FieldTrackStock:
 			values[i] = new(sql.NullBool)
-		case item.FieldPrice:
+		case item.FieldPrice, item.FieldVATRate:
 			values[i] = new(sql.NullFloat64)
-		case item.FieldID, item.FieldItemOrder:
+		case item.FieldID, item.FieldItemOrder, item.FieldStock, item.FieldLowStockThreshold, item.FieldPDFDownloadLimit, item.FieldPDFDownloadExpiryDays:
 			values[i] = new(sql.NullInt64)
 		case item.FieldName, item.FieldDescription, item.FieldImage, item.FieldLicenseGroup, item.FieldType, item.FieldItemColor, item.FieldItemTextColor:
 			values[i] = new(sql.NullString)
+		case item.FieldPublishAt, item.FieldUnpublishAt:
+			values[i] = new(sql.NullTime)
 		case item.ForeignKeys[0]: // licenseitem
 			values[i] = new(sql.NullInt64)
 		case item.ForeignKeys[1]: // pdf
@@ -200,6 +224,67 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ItemTextColor = value.String
 			}
+		case item.FieldTrackStock:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field TrackStock", values[i])
+			} else if value.Valid {
+				_m.TrackStock = value.Bool
+			}
+		case item.FieldStock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field Stock", values[i])
+			} else if value.Valid {
+				_m.Stock = int(value.Int64)
+			}
+		case item.FieldLowStockThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field LowStockThreshold", values[i])
+			} else if value.Valid {
+				_m.LowStockThreshold = int(value.Int64)
+			}
+		case item.FieldPublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field PublishAt", values[i])
+			} else if value.Valid {
+				_m.PublishAt = new(time.Time)
+				*_m.PublishAt = value.Time
+			}
+		case item.FieldUnpublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field UnpublishAt", values[i])
+			} else if value.Valid {
+				_m.UnpublishAt = new(time.Time)
+				*_m.UnpublishAt = value.Time
+			}
+		case item.FieldPDFDownloadLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field PDFDownloadLimit", values[i])
+			} else if value.Valid {
+				_m.PDFDownloadLimit = new(int)
+				*_m.PDFDownloadLimit = int(value.Int64)
+			}
+		case item.FieldPDFDownloadExpiryDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field PDFDownloadExpiryDays", values[i])
+			} else if value.Valid {
+				_m.PDFDownloadExpiryDays = new(int)
+				*_m.PDFDownloadExpiryDays = int(value.Int64)
+			}
+		case item.FieldVATRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field VATRate", values[i])
+			} else if value.Valid {
+				_m.VATRate = new(float64)
+				*_m.VATRate = value.Float64
+			}
+		case item.FieldImageVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ImageVariants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageVariants); err != nil {
+					return fmt.Errorf("unmarshal field ImageVariants: %w", err)
+				}
+			}
 		case item.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field licenseitem", value)
@@ -298,6 +383,43 @@ func (_m *Item) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("ItemTextColor=")
 	builder.WriteString(_m.ItemTextColor)
+	builder.WriteString(", ")
+	builder.WriteString("TrackStock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrackStock))
+	builder.WriteString(", ")
+	builder.WriteString("Stock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Stock))
+	builder.WriteString(", ")
+	builder.WriteString("LowStockThreshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LowStockThreshold))
+	builder.WriteString(", ")
+	if v := _m.PublishAt; v != nil {
+		builder.WriteString("PublishAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UnpublishAt; v != nil {
+		builder.WriteString("UnpublishAt=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PDFDownloadLimit; v != nil {
+		builder.WriteString("PDFDownloadLimit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.PDFDownloadExpiryDays; v != nil {
+		builder.WriteString("PDFDownloadExpiryDays=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.VATRate; v != nil {
+		builder.WriteString("VATRate=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("ImageVariants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageVariants))
 	builder.WriteByte(')')
 	return builder.String()
 }
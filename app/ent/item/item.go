@@ -38,6 +38,24 @@ const (
 	FieldItemColor = "itemcolor"
 	// FieldItemTextColor holds the string denoting the itemtextcolor field in the database.
 	FieldItemTextColor = "itemtextcolor"
+	// FieldTrackStock holds the string denoting the trackstock field in the database.
+	FieldTrackStock = "trackstock"
+	// FieldStock holds the string denoting the stock field in the database.
+	FieldStock = "stock"
+	// FieldLowStockThreshold holds the string denoting the lowstockthreshold field in the database.
+	FieldLowStockThreshold = "lowstockthreshold"
+	// FieldPublishAt holds the string denoting the publishat field in the database.
+	FieldPublishAt = "publishat"
+	// FieldUnpublishAt holds the string denoting the unpublishat field in the database.
+	FieldUnpublishAt = "unpublishat"
+	// FieldPDFDownloadLimit holds the string denoting the pdfdownloadlimit field in the database.
+	FieldPDFDownloadLimit = "pdfdownloadlimit"
+	// FieldPDFDownloadExpiryDays holds the string denoting the pdfdownloadexpirydays field in the database.
+	FieldPDFDownloadExpiryDays = "pdfdownloadexpirydays"
+	// FieldVATRate holds the string denoting the vatrate field in the database.
+	FieldVATRate = "vatrate"
+	// FieldImageVariants holds the string denoting the imagevariants field in the database.
+	FieldImageVariants = "imagevariants"
 	// EdgeLicenseItem holds the string denoting the licenseitem edge name in mutations.
 	EdgeLicenseItem = "LicenseItem"
 	// EdgePDF holds the string denoting the pdf edge name in mutations.
@@ -73,6 +91,15 @@ var Columns = []string{
 	FieldItemOrder,
 	FieldItemColor,
 	FieldItemTextColor,
+	FieldTrackStock,
+	FieldStock,
+	FieldLowStockThreshold,
+	FieldPublishAt,
+	FieldUnpublishAt,
+	FieldPDFDownloadLimit,
+	FieldPDFDownloadExpiryDays,
+	FieldVATRate,
+	FieldImageVariants,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "item"
@@ -122,6 +149,12 @@ var (
 	DefaultItemColor string
 	// DefaultItemTextColor holds the default value on creation for the "ItemTextColor" field.
 	DefaultItemTextColor string
+	// DefaultTrackStock holds the default value on creation for the "TrackStock" field.
+	DefaultTrackStock bool
+	// DefaultStock holds the default value on creation for the "Stock" field.
+	DefaultStock int
+	// DefaultLowStockThreshold holds the default value on creation for the "LowStockThreshold" field.
+	DefaultLowStockThreshold int
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -199,6 +232,46 @@ func ByItemTextColor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldItemTextColor, opts...).ToFunc()
 }
 
+// ByTrackStock orders the results by the TrackStock field.
+func ByTrackStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrackStock, opts...).ToFunc()
+}
+
+// ByStock orders the results by the Stock field.
+func ByStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStock, opts...).ToFunc()
+}
+
+// ByLowStockThreshold orders the results by the LowStockThreshold field.
+func ByLowStockThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLowStockThreshold, opts...).ToFunc()
+}
+
+// ByPublishAt orders the results by the PublishAt field.
+func ByPublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishAt, opts...).ToFunc()
+}
+
+// ByUnpublishAt orders the results by the UnpublishAt field.
+func ByUnpublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnpublishAt, opts...).ToFunc()
+}
+
+// ByPDFDownloadLimit orders the results by the PDFDownloadLimit field.
+func ByPDFDownloadLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPDFDownloadLimit, opts...).ToFunc()
+}
+
+// ByPDFDownloadExpiryDays orders the results by the PDFDownloadExpiryDays field.
+func ByPDFDownloadExpiryDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPDFDownloadExpiryDays, opts...).ToFunc()
+}
+
+// ByVATRate orders the results by the VATRate field.
+func ByVATRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVATRate, opts...).ToFunc()
+}
+
 // ByLicenseItemField orders the results by LicenseItem field.
 func ByLicenseItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -3,6 +3,8 @@
 package item
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/augustin-wien/augustina-backend/ent/predicate"
@@ -118,6 +120,46 @@ func ItemTextColor(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldItemTextColor, v))
 }
 
+// TrackStock applies equality check predicate on the "TrackStock" field. It's identical to TrackStockEQ.
+func TrackStock(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTrackStock, v))
+}
+
+// Stock applies equality check predicate on the "Stock" field. It's identical to StockEQ.
+func Stock(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldStock, v))
+}
+
+// LowStockThreshold applies equality check predicate on the "LowStockThreshold" field. It's identical to LowStockThresholdEQ.
+func LowStockThreshold(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLowStockThreshold, v))
+}
+
+// PublishAt applies equality check predicate on the "PublishAt" field. It's identical to PublishAtEQ.
+func PublishAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// UnpublishAt applies equality check predicate on the "UnpublishAt" field. It's identical to UnpublishAtEQ.
+func UnpublishAt(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
+// PDFDownloadLimit applies equality check predicate on the "PDFDownloadLimit" field. It's identical to PDFDownloadLimitEQ.
+func PDFDownloadLimit(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadExpiryDays applies equality check predicate on the "PDFDownloadExpiryDays" field. It's identical to PDFDownloadExpiryDaysEQ.
+func PDFDownloadExpiryDays(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// VATRate applies equality check predicate on the "VATRate" field. It's identical to VATRateEQ.
+func VATRate(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldVATRate, v))
+}
+
 // NameEQ applies the EQ predicate on the "Name" field.
 func NameEQ(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldName, v))
@@ -693,6 +735,356 @@ func ItemTextColorContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldItemTextColor, v))
 }
 
+// TrackStockEQ applies the EQ predicate on the "TrackStock" field.
+func TrackStockEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldTrackStock, v))
+}
+
+// TrackStockNEQ applies the NEQ predicate on the "TrackStock" field.
+func TrackStockNEQ(v bool) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldTrackStock, v))
+}
+
+// StockEQ applies the EQ predicate on the "Stock" field.
+func StockEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldStock, v))
+}
+
+// StockNEQ applies the NEQ predicate on the "Stock" field.
+func StockNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldStock, v))
+}
+
+// StockIn applies the In predicate on the "Stock" field.
+func StockIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldStock, vs...))
+}
+
+// StockNotIn applies the NotIn predicate on the "Stock" field.
+func StockNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldStock, vs...))
+}
+
+// StockGT applies the GT predicate on the "Stock" field.
+func StockGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldStock, v))
+}
+
+// StockGTE applies the GTE predicate on the "Stock" field.
+func StockGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldStock, v))
+}
+
+// StockLT applies the LT predicate on the "Stock" field.
+func StockLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldStock, v))
+}
+
+// StockLTE applies the LTE predicate on the "Stock" field.
+func StockLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldStock, v))
+}
+
+// LowStockThresholdEQ applies the EQ predicate on the "LowStockThreshold" field.
+func LowStockThresholdEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldLowStockThreshold, v))
+}
+
+// LowStockThresholdNEQ applies the NEQ predicate on the "LowStockThreshold" field.
+func LowStockThresholdNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldLowStockThreshold, v))
+}
+
+// LowStockThresholdIn applies the In predicate on the "LowStockThreshold" field.
+func LowStockThresholdIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldLowStockThreshold, vs...))
+}
+
+// LowStockThresholdNotIn applies the NotIn predicate on the "LowStockThreshold" field.
+func LowStockThresholdNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldLowStockThreshold, vs...))
+}
+
+// LowStockThresholdGT applies the GT predicate on the "LowStockThreshold" field.
+func LowStockThresholdGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldLowStockThreshold, v))
+}
+
+// LowStockThresholdGTE applies the GTE predicate on the "LowStockThreshold" field.
+func LowStockThresholdGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldLowStockThreshold, v))
+}
+
+// LowStockThresholdLT applies the LT predicate on the "LowStockThreshold" field.
+func LowStockThresholdLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldLowStockThreshold, v))
+}
+
+// LowStockThresholdLTE applies the LTE predicate on the "LowStockThreshold" field.
+func LowStockThresholdLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldLowStockThreshold, v))
+}
+
+// PublishAtEQ applies the EQ predicate on the "PublishAt" field.
+func PublishAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// PublishAtNEQ applies the NEQ predicate on the "PublishAt" field.
+func PublishAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPublishAt, v))
+}
+
+// PublishAtIn applies the In predicate on the "PublishAt" field.
+func PublishAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPublishAt, vs...))
+}
+
+// PublishAtNotIn applies the NotIn predicate on the "PublishAt" field.
+func PublishAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPublishAt, vs...))
+}
+
+// PublishAtGT applies the GT predicate on the "PublishAt" field.
+func PublishAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPublishAt, v))
+}
+
+// PublishAtGTE applies the GTE predicate on the "PublishAt" field.
+func PublishAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPublishAt, v))
+}
+
+// PublishAtLT applies the LT predicate on the "PublishAt" field.
+func PublishAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPublishAt, v))
+}
+
+// PublishAtLTE applies the LTE predicate on the "PublishAt" field.
+func PublishAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPublishAt, v))
+}
+
+// PublishAtIsNil applies the IsNil predicate on the "PublishAt" field.
+func PublishAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldPublishAt))
+}
+
+// PublishAtNotNil applies the NotNil predicate on the "PublishAt" field.
+func PublishAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldPublishAt))
+}
+
+// UnpublishAtEQ applies the EQ predicate on the "UnpublishAt" field.
+func UnpublishAtEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtNEQ applies the NEQ predicate on the "UnpublishAt" field.
+func UnpublishAtNEQ(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIn applies the In predicate on the "UnpublishAt" field.
+func UnpublishAtIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtNotIn applies the NotIn predicate on the "UnpublishAt" field.
+func UnpublishAtNotIn(vs ...time.Time) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldUnpublishAt, vs...))
+}
+
+// UnpublishAtGT applies the GT predicate on the "UnpublishAt" field.
+func UnpublishAtGT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtGTE applies the GTE predicate on the "UnpublishAt" field.
+func UnpublishAtGTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLT applies the LT predicate on the "UnpublishAt" field.
+func UnpublishAtLT(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldUnpublishAt, v))
+}
+
+// UnpublishAtLTE applies the LTE predicate on the "UnpublishAt" field.
+func UnpublishAtLTE(v time.Time) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldUnpublishAt, v))
+}
+
+// UnpublishAtIsNil applies the IsNil predicate on the "UnpublishAt" field.
+func UnpublishAtIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldUnpublishAt))
+}
+
+// UnpublishAtNotNil applies the NotNil predicate on the "UnpublishAt" field.
+func UnpublishAtNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldUnpublishAt))
+}
+
+// PDFDownloadLimitEQ applies the EQ predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitNEQ applies the NEQ predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitIn applies the In predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPDFDownloadLimit, vs...))
+}
+
+// PDFDownloadLimitNotIn applies the NotIn predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPDFDownloadLimit, vs...))
+}
+
+// PDFDownloadLimitGT applies the GT predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitGTE applies the GTE predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitLT applies the LT predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitLTE applies the LTE predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitIsNil applies the IsNil predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldPDFDownloadLimit))
+}
+
+// PDFDownloadLimitNotNil applies the NotNil predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldPDFDownloadLimit))
+}
+
+// PDFDownloadExpiryDaysEQ applies the EQ predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysNEQ applies the NEQ predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysNEQ(v int) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysIn applies the In predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldPDFDownloadExpiryDays, vs...))
+}
+
+// PDFDownloadExpiryDaysNotIn applies the NotIn predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysNotIn(vs ...int) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldPDFDownloadExpiryDays, vs...))
+}
+
+// PDFDownloadExpiryDaysGT applies the GT predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysGT(v int) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysGTE applies the GTE predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysGTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysLT applies the LT predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysLT(v int) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysLTE applies the LTE predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysLTE(v int) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysIsNil applies the IsNil predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldPDFDownloadExpiryDays))
+}
+
+// PDFDownloadExpiryDaysNotNil applies the NotNil predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldPDFDownloadExpiryDays))
+}
+
+// VATRateEQ applies the EQ predicate on the "VATRate" field.
+func VATRateEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldVATRate, v))
+}
+
+// VATRateNEQ applies the NEQ predicate on the "VATRate" field.
+func VATRateNEQ(v float64) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldVATRate, v))
+}
+
+// VATRateIn applies the In predicate on the "VATRate" field.
+func VATRateIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldVATRate, vs...))
+}
+
+// VATRateNotIn applies the NotIn predicate on the "VATRate" field.
+func VATRateNotIn(vs ...float64) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldVATRate, vs...))
+}
+
+// VATRateGT applies the GT predicate on the "VATRate" field.
+func VATRateGT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldVATRate, v))
+}
+
+// VATRateGTE applies the GTE predicate on the "VATRate" field.
+func VATRateGTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldVATRate, v))
+}
+
+// VATRateLT applies the LT predicate on the "VATRate" field.
+func VATRateLT(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldVATRate, v))
+}
+
+// VATRateLTE applies the LTE predicate on the "VATRate" field.
+func VATRateLTE(v float64) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldVATRate, v))
+}
+
+// VATRateIsNil applies the IsNil predicate on the "VATRate" field.
+func VATRateIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldVATRate))
+}
+
+// VATRateNotNil applies the NotNil predicate on the "VATRate" field.
+func VATRateNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldVATRate))
+}
+
+// ImageVariantsIsNil applies the IsNil predicate on the "ImageVariants" field.
+func ImageVariantsIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldImageVariants))
+}
+
+// ImageVariantsNotNil applies the NotNil predicate on the "ImageVariants" field.
+func ImageVariantsNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldImageVariants))
+}
+
 // HasLicenseItem applies the HasEdge predicate on the "LicenseItem" edge.
 func HasLicenseItem() predicate.Item {
 	return predicate.Item(func(s *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -170,6 +171,124 @@ func (_c *ItemCreate) SetNillableItemTextColor(v *string) *ItemCreate {
 	return _c
 }
 
+// SetTrackStock sets the "TrackStock" field.
+func (_c *ItemCreate) SetTrackStock(v bool) *ItemCreate {
+	_c.mutation.SetTrackStock(v)
+	return _c
+}
+
+// SetNillableTrackStock sets the "TrackStock" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableTrackStock(v *bool) *ItemCreate {
+	if v != nil {
+		_c.SetTrackStock(*v)
+	}
+	return _c
+}
+
+// SetStock sets the "Stock" field.
+func (_c *ItemCreate) SetStock(v int) *ItemCreate {
+	_c.mutation.SetStock(v)
+	return _c
+}
+
+// SetNillableStock sets the "Stock" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableStock(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetStock(*v)
+	}
+	return _c
+}
+
+// SetLowStockThreshold sets the "LowStockThreshold" field.
+func (_c *ItemCreate) SetLowStockThreshold(v int) *ItemCreate {
+	_c.mutation.SetLowStockThreshold(v)
+	return _c
+}
+
+// SetNillableLowStockThreshold sets the "LowStockThreshold" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableLowStockThreshold(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetLowStockThreshold(*v)
+	}
+	return _c
+}
+
+// SetPublishAt sets the "PublishAt" field.
+func (_c *ItemCreate) SetPublishAt(v time.Time) *ItemCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetNillablePublishAt sets the "PublishAt" field if the given value is not nil.
+func (_c *ItemCreate) SetNillablePublishAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetPublishAt(*v)
+	}
+	return _c
+}
+
+// SetUnpublishAt sets the "UnpublishAt" field.
+func (_c *ItemCreate) SetUnpublishAt(v time.Time) *ItemCreate {
+	_c.mutation.SetUnpublishAt(v)
+	return _c
+}
+
+// SetNillableUnpublishAt sets the "UnpublishAt" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableUnpublishAt(v *time.Time) *ItemCreate {
+	if v != nil {
+		_c.SetUnpublishAt(*v)
+	}
+	return _c
+}
+
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_c *ItemCreate) SetPDFDownloadLimit(v int) *ItemCreate {
+	_c.mutation.SetPDFDownloadLimit(v)
+	return _c
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_c *ItemCreate) SetNillablePDFDownloadLimit(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetPDFDownloadLimit(*v)
+	}
+	return _c
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_c *ItemCreate) SetPDFDownloadExpiryDays(v int) *ItemCreate {
+	_c.mutation.SetPDFDownloadExpiryDays(v)
+	return _c
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_c *ItemCreate) SetNillablePDFDownloadExpiryDays(v *int) *ItemCreate {
+	if v != nil {
+		_c.SetPDFDownloadExpiryDays(*v)
+	}
+	return _c
+}
+
+// SetVATRate sets the "VATRate" field.
+func (_c *ItemCreate) SetVATRate(v float64) *ItemCreate {
+	_c.mutation.SetVATRate(v)
+	return _c
+}
+
+// SetNillableVATRate sets the "VATRate" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableVATRate(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetVATRate(*v)
+	}
+	return _c
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_c *ItemCreate) SetImageVariants(v map[string]string) *ItemCreate {
+	_c.mutation.SetImageVariants(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ItemCreate) SetID(v int) *ItemCreate {
 	_c.mutation.SetID(v)
@@ -285,6 +404,18 @@ func (_c *ItemCreate) defaults() {
 		v := item.DefaultItemTextColor
 		_c.mutation.SetItemTextColor(v)
 	}
+	if _, ok := _c.mutation.TrackStock(); !ok {
+		v := item.DefaultTrackStock
+		_c.mutation.SetTrackStock(v)
+	}
+	if _, ok := _c.mutation.Stock(); !ok {
+		v := item.DefaultStock
+		_c.mutation.SetStock(v)
+	}
+	if _, ok := _c.mutation.LowStockThreshold(); !ok {
+		v := item.DefaultLowStockThreshold
+		_c.mutation.SetLowStockThreshold(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -343,6 +474,15 @@ func (_c *ItemCreate) check() error {
 	if _, ok := _c.mutation.ItemTextColor(); !ok {
 		return &ValidationError{Name: "ItemTextColor", err: errors.New(`ent: missing required field "Item.ItemTextColor"`)}
 	}
+	if _, ok := _c.mutation.TrackStock(); !ok {
+		return &ValidationError{Name: "TrackStock", err: errors.New(`ent: missing required field "Item.TrackStock"`)}
+	}
+	if _, ok := _c.mutation.Stock(); !ok {
+		return &ValidationError{Name: "Stock", err: errors.New(`ent: missing required field "Item.Stock"`)}
+	}
+	if _, ok := _c.mutation.LowStockThreshold(); !ok {
+		return &ValidationError{Name: "LowStockThreshold", err: errors.New(`ent: missing required field "Item.LowStockThreshold"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
@@ -432,6 +572,42 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldItemTextColor, field.TypeString, value)
 		_node.ItemTextColor = value
 	}
+	if value, ok := _c.mutation.TrackStock(); ok {
+		_spec.SetField(item.FieldTrackStock, field.TypeBool, value)
+		_node.TrackStock = value
+	}
+	if value, ok := _c.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+		_node.Stock = value
+	}
+	if value, ok := _c.mutation.LowStockThreshold(); ok {
+		_spec.SetField(item.FieldLowStockThreshold, field.TypeInt, value)
+		_node.LowStockThreshold = value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(item.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = &value
+	}
+	if value, ok := _c.mutation.UnpublishAt(); ok {
+		_spec.SetField(item.FieldUnpublishAt, field.TypeTime, value)
+		_node.UnpublishAt = &value
+	}
+	if value, ok := _c.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(item.FieldPDFDownloadLimit, field.TypeInt, value)
+		_node.PDFDownloadLimit = &value
+	}
+	if value, ok := _c.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(item.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+		_node.PDFDownloadExpiryDays = &value
+	}
+	if value, ok := _c.mutation.VATRate(); ok {
+		_spec.SetField(item.FieldVATRate, field.TypeFloat64, value)
+		_node.VATRate = &value
+	}
+	if value, ok := _c.mutation.ImageVariants(); ok {
+		_spec.SetField(item.FieldImageVariants, field.TypeJSON, value)
+		_node.ImageVariants = value
+	}
 	if nodes := _c.mutation.LicenseItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -224,6 +225,195 @@ func (_u *ItemUpdate) SetNillableItemTextColor(v *string) *ItemUpdate {
 	return _u
 }
 
+// SetTrackStock sets the "TrackStock" field.
+func (_u *ItemUpdate) SetTrackStock(v bool) *ItemUpdate {
+	_u.mutation.SetTrackStock(v)
+	return _u
+}
+
+// SetNillableTrackStock sets the "TrackStock" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableTrackStock(v *bool) *ItemUpdate {
+	if v != nil {
+		_u.SetTrackStock(*v)
+	}
+	return _u
+}
+
+// SetStock sets the "Stock" field.
+func (_u *ItemUpdate) SetStock(v int) *ItemUpdate {
+	_u.mutation.ResetStock()
+	_u.mutation.SetStock(v)
+	return _u
+}
+
+// SetNillableStock sets the "Stock" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableStock(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetStock(*v)
+	}
+	return _u
+}
+
+// AddStock adds value to the "Stock" field.
+func (_u *ItemUpdate) AddStock(v int) *ItemUpdate {
+	_u.mutation.AddStock(v)
+	return _u
+}
+
+// SetLowStockThreshold sets the "LowStockThreshold" field.
+func (_u *ItemUpdate) SetLowStockThreshold(v int) *ItemUpdate {
+	_u.mutation.ResetLowStockThreshold()
+	_u.mutation.SetLowStockThreshold(v)
+	return _u
+}
+
+// SetNillableLowStockThreshold sets the "LowStockThreshold" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableLowStockThreshold(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetLowStockThreshold(*v)
+	}
+	return _u
+}
+
+// AddLowStockThreshold adds value to the "LowStockThreshold" field.
+func (_u *ItemUpdate) AddLowStockThreshold(v int) *ItemUpdate {
+	_u.mutation.AddLowStockThreshold(v)
+	return _u
+}
+
+// SetPublishAt sets the "PublishAt" field.
+func (_u *ItemUpdate) SetPublishAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "PublishAt" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillablePublishAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "PublishAt" field.
+func (_u *ItemUpdate) ClearPublishAt() *ItemUpdate {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "UnpublishAt" field.
+func (_u *ItemUpdate) SetUnpublishAt(v time.Time) *ItemUpdate {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "UnpublishAt" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableUnpublishAt(v *time.Time) *ItemUpdate {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
+	}
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "UnpublishAt" field.
+func (_u *ItemUpdate) ClearUnpublishAt() *ItemUpdate {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_u *ItemUpdate) SetPDFDownloadLimit(v int) *ItemUpdate {
+	_u.mutation.ResetPDFDownloadLimit()
+	_u.mutation.SetPDFDownloadLimit(v)
+	return _u
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillablePDFDownloadLimit(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetPDFDownloadLimit(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadLimit adds value to the "PDFDownloadLimit" field.
+func (_u *ItemUpdate) AddPDFDownloadLimit(v int) *ItemUpdate {
+	_u.mutation.AddPDFDownloadLimit(v)
+	return _u
+}
+
+// ClearPDFDownloadLimit clears the value of the "PDFDownloadLimit" field.
+func (_u *ItemUpdate) ClearPDFDownloadLimit() *ItemUpdate {
+	_u.mutation.ClearPDFDownloadLimit()
+	return _u
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdate) SetPDFDownloadExpiryDays(v int) *ItemUpdate {
+	_u.mutation.ResetPDFDownloadExpiryDays()
+	_u.mutation.SetPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillablePDFDownloadExpiryDays(v *int) *ItemUpdate {
+	if v != nil {
+		_u.SetPDFDownloadExpiryDays(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadExpiryDays adds value to the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdate) AddPDFDownloadExpiryDays(v int) *ItemUpdate {
+	_u.mutation.AddPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// ClearPDFDownloadExpiryDays clears the value of the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdate) ClearPDFDownloadExpiryDays() *ItemUpdate {
+	_u.mutation.ClearPDFDownloadExpiryDays()
+	return _u
+}
+
+// SetVATRate sets the "VATRate" field.
+func (_u *ItemUpdate) SetVATRate(v float64) *ItemUpdate {
+	_u.mutation.ResetVATRate()
+	_u.mutation.SetVATRate(v)
+	return _u
+}
+
+// SetNillableVATRate sets the "VATRate" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableVATRate(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetVATRate(*v)
+	}
+	return _u
+}
+
+// AddVATRate adds value to the "VATRate" field.
+func (_u *ItemUpdate) AddVATRate(v float64) *ItemUpdate {
+	_u.mutation.AddVATRate(v)
+	return _u
+}
+
+// ClearVATRate clears the value of the "VATRate" field.
+func (_u *ItemUpdate) ClearVATRate() *ItemUpdate {
+	_u.mutation.ClearVATRate()
+	return _u
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_u *ItemUpdate) SetImageVariants(v map[string]string) *ItemUpdate {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "ImageVariants" field.
+func (_u *ItemUpdate) ClearImageVariants() *ItemUpdate {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
 // SetLicenseItemID sets the "LicenseItem" edge to the Item entity by ID.
 func (_u *ItemUpdate) SetLicenseItemID(id int) *ItemUpdate {
 	_u.mutation.SetLicenseItemID(id)
@@ -383,6 +573,66 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.ItemTextColor(); ok {
 		_spec.SetField(item.FieldItemTextColor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrackStock(); ok {
+		_spec.SetField(item.FieldTrackStock, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStock(); ok {
+		_spec.AddField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LowStockThreshold(); ok {
+		_spec.SetField(item.FieldLowStockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLowStockThreshold(); ok {
+		_spec.AddField(item.FieldLowStockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(item.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(item.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(item.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(item.FieldUnpublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(item.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadLimit(); ok {
+		_spec.AddField(item.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if _u.mutation.PDFDownloadLimitCleared() {
+		_spec.ClearField(item.FieldPDFDownloadLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(item.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadExpiryDays(); ok {
+		_spec.AddField(item.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if _u.mutation.PDFDownloadExpiryDaysCleared() {
+		_spec.ClearField(item.FieldPDFDownloadExpiryDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VATRate(); ok {
+		_spec.SetField(item.FieldVATRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVATRate(); ok {
+		_spec.AddField(item.FieldVATRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.VATRateCleared() {
+		_spec.ClearField(item.FieldVATRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(item.FieldImageVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(item.FieldImageVariants, field.TypeJSON)
+	}
 	if _u.mutation.LicenseItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -657,6 +907,195 @@ func (_u *ItemUpdateOne) SetNillableItemTextColor(v *string) *ItemUpdateOne {
 	return _u
 }
 
+// SetTrackStock sets the "TrackStock" field.
+func (_u *ItemUpdateOne) SetTrackStock(v bool) *ItemUpdateOne {
+	_u.mutation.SetTrackStock(v)
+	return _u
+}
+
+// SetNillableTrackStock sets the "TrackStock" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableTrackStock(v *bool) *ItemUpdateOne {
+	if v != nil {
+		_u.SetTrackStock(*v)
+	}
+	return _u
+}
+
+// SetStock sets the "Stock" field.
+func (_u *ItemUpdateOne) SetStock(v int) *ItemUpdateOne {
+	_u.mutation.ResetStock()
+	_u.mutation.SetStock(v)
+	return _u
+}
+
+// SetNillableStock sets the "Stock" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableStock(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetStock(*v)
+	}
+	return _u
+}
+
+// AddStock adds value to the "Stock" field.
+func (_u *ItemUpdateOne) AddStock(v int) *ItemUpdateOne {
+	_u.mutation.AddStock(v)
+	return _u
+}
+
+// SetLowStockThreshold sets the "LowStockThreshold" field.
+func (_u *ItemUpdateOne) SetLowStockThreshold(v int) *ItemUpdateOne {
+	_u.mutation.ResetLowStockThreshold()
+	_u.mutation.SetLowStockThreshold(v)
+	return _u
+}
+
+// SetNillableLowStockThreshold sets the "LowStockThreshold" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableLowStockThreshold(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetLowStockThreshold(*v)
+	}
+	return _u
+}
+
+// AddLowStockThreshold adds value to the "LowStockThreshold" field.
+func (_u *ItemUpdateOne) AddLowStockThreshold(v int) *ItemUpdateOne {
+	_u.mutation.AddLowStockThreshold(v)
+	return _u
+}
+
+// SetPublishAt sets the "PublishAt" field.
+func (_u *ItemUpdateOne) SetPublishAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "PublishAt" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillablePublishAt(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "PublishAt" field.
+func (_u *ItemUpdateOne) ClearPublishAt() *ItemUpdateOne {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetUnpublishAt sets the "UnpublishAt" field.
+func (_u *ItemUpdateOne) SetUnpublishAt(v time.Time) *ItemUpdateOne {
+	_u.mutation.SetUnpublishAt(v)
+	return _u
+}
+
+// SetNillableUnpublishAt sets the "UnpublishAt" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableUnpublishAt(v *time.Time) *ItemUpdateOne {
+	if v != nil {
+		_u.SetUnpublishAt(*v)
+	}
+	return _u
+}
+
+// ClearUnpublishAt clears the value of the "UnpublishAt" field.
+func (_u *ItemUpdateOne) ClearUnpublishAt() *ItemUpdateOne {
+	_u.mutation.ClearUnpublishAt()
+	return _u
+}
+
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_u *ItemUpdateOne) SetPDFDownloadLimit(v int) *ItemUpdateOne {
+	_u.mutation.ResetPDFDownloadLimit()
+	_u.mutation.SetPDFDownloadLimit(v)
+	return _u
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillablePDFDownloadLimit(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetPDFDownloadLimit(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadLimit adds value to the "PDFDownloadLimit" field.
+func (_u *ItemUpdateOne) AddPDFDownloadLimit(v int) *ItemUpdateOne {
+	_u.mutation.AddPDFDownloadLimit(v)
+	return _u
+}
+
+// ClearPDFDownloadLimit clears the value of the "PDFDownloadLimit" field.
+func (_u *ItemUpdateOne) ClearPDFDownloadLimit() *ItemUpdateOne {
+	_u.mutation.ClearPDFDownloadLimit()
+	return _u
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdateOne) SetPDFDownloadExpiryDays(v int) *ItemUpdateOne {
+	_u.mutation.ResetPDFDownloadExpiryDays()
+	_u.mutation.SetPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillablePDFDownloadExpiryDays(v *int) *ItemUpdateOne {
+	if v != nil {
+		_u.SetPDFDownloadExpiryDays(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadExpiryDays adds value to the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdateOne) AddPDFDownloadExpiryDays(v int) *ItemUpdateOne {
+	_u.mutation.AddPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// ClearPDFDownloadExpiryDays clears the value of the "PDFDownloadExpiryDays" field.
+func (_u *ItemUpdateOne) ClearPDFDownloadExpiryDays() *ItemUpdateOne {
+	_u.mutation.ClearPDFDownloadExpiryDays()
+	return _u
+}
+
+// SetVATRate sets the "VATRate" field.
+func (_u *ItemUpdateOne) SetVATRate(v float64) *ItemUpdateOne {
+	_u.mutation.ResetVATRate()
+	_u.mutation.SetVATRate(v)
+	return _u
+}
+
+// SetNillableVATRate sets the "VATRate" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableVATRate(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetVATRate(*v)
+	}
+	return _u
+}
+
+// AddVATRate adds value to the "VATRate" field.
+func (_u *ItemUpdateOne) AddVATRate(v float64) *ItemUpdateOne {
+	_u.mutation.AddVATRate(v)
+	return _u
+}
+
+// ClearVATRate clears the value of the "VATRate" field.
+func (_u *ItemUpdateOne) ClearVATRate() *ItemUpdateOne {
+	_u.mutation.ClearVATRate()
+	return _u
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_u *ItemUpdateOne) SetImageVariants(v map[string]string) *ItemUpdateOne {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "ImageVariants" field.
+func (_u *ItemUpdateOne) ClearImageVariants() *ItemUpdateOne {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
 // SetLicenseItemID sets the "LicenseItem" edge to the Item entity by ID.
 func (_u *ItemUpdateOne) SetLicenseItemID(id int) *ItemUpdateOne {
 	_u.mutation.SetLicenseItemID(id)
@@ -846,6 +1285,66 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if value, ok := _u.mutation.ItemTextColor(); ok {
 		_spec.SetField(item.FieldItemTextColor, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.TrackStock(); ok {
+		_spec.SetField(item.FieldTrackStock, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Stock(); ok {
+		_spec.SetField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStock(); ok {
+		_spec.AddField(item.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LowStockThreshold(); ok {
+		_spec.SetField(item.FieldLowStockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLowStockThreshold(); ok {
+		_spec.AddField(item.FieldLowStockThreshold, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(item.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(item.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnpublishAt(); ok {
+		_spec.SetField(item.FieldUnpublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.UnpublishAtCleared() {
+		_spec.ClearField(item.FieldUnpublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(item.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadLimit(); ok {
+		_spec.AddField(item.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if _u.mutation.PDFDownloadLimitCleared() {
+		_spec.ClearField(item.FieldPDFDownloadLimit, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(item.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadExpiryDays(); ok {
+		_spec.AddField(item.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if _u.mutation.PDFDownloadExpiryDaysCleared() {
+		_spec.ClearField(item.FieldPDFDownloadExpiryDays, field.TypeInt)
+	}
+	if value, ok := _u.mutation.VATRate(); ok {
+		_spec.SetField(item.FieldVATRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVATRate(); ok {
+		_spec.AddField(item.FieldVATRate, field.TypeFloat64, value)
+	}
+	if _u.mutation.VATRateCleared() {
+		_spec.ClearField(item.FieldVATRate, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(item.FieldImageVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(item.FieldImageVariants, field.TypeJSON)
+	}
 	if _u.mutation.LicenseItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -29,6 +30,10 @@ type Location struct {
 	Latitude float64 `json:"latitude,omitempty"`
 	// Zip holds the value of the "zip" field.
 	Zip string `json:"zip,omitempty"`
+	// GeocodingConfidence holds the value of the "geocoding_confidence" field.
+	GeocodingConfidence float64 `json:"geocoding_confidence,omitempty"`
+	// GeocodeAttemptedAt holds the value of the "geocode_attempted_at" field.
+	GeocodeAttemptedAt *time.Time `json:"geocode_attempted_at,omitempty"`
 	// WorkingTime holds the value of the "working_time" field.
 	WorkingTime *schema.WorkingTime `json:"working_time,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -65,12 +70,14 @@ func (*Location) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case location.FieldWorkingTime:
 			values[i] = new([]byte)
-		case location.FieldLongitude, location.FieldLatitude:
+		case location.FieldLongitude, location.FieldLatitude, location.FieldGeocodingConfidence:
 			values[i] = new(sql.NullFloat64)
 		case location.FieldID:
 			values[i] = new(sql.NullInt64)
 		case location.FieldName, location.FieldAddress, location.FieldZip:
 			values[i] = new(sql.NullString)
+		case location.FieldGeocodeAttemptedAt:
+			values[i] = new(sql.NullTime)
 		case location.ForeignKeys[0]: // vendor_locations
 			values[i] = new(sql.NullInt64)
 		default:
@@ -124,6 +131,19 @@ func (_m *Location) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Zip = value.String
 			}
+		case location.FieldGeocodingConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field geocoding_confidence", values[i])
+			} else if value.Valid {
+				_m.GeocodingConfidence = value.Float64
+			}
+		case location.FieldGeocodeAttemptedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field geocode_attempted_at", values[i])
+			} else if value.Valid {
+				_m.GeocodeAttemptedAt = new(time.Time)
+				*_m.GeocodeAttemptedAt = value.Time
+			}
 		case location.FieldWorkingTime:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field working_time", values[i])
@@ -195,6 +215,14 @@ func (_m *Location) String() string {
 	builder.WriteString("zip=")
 	builder.WriteString(_m.Zip)
 	builder.WriteString(", ")
+	builder.WriteString("geocoding_confidence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GeocodingConfidence))
+	builder.WriteString(", ")
+	if v := _m.GeocodeAttemptedAt; v != nil {
+		builder.WriteString("geocode_attempted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("working_time=")
 	builder.WriteString(fmt.Sprintf("%v", _m.WorkingTime))
 	builder.WriteByte(')')
@@ -22,6 +22,10 @@ const (
 	FieldLatitude = "latitude"
 	// FieldZip holds the string denoting the zip field in the database.
 	FieldZip = "zip"
+	// FieldGeocodingConfidence holds the string denoting the geocoding_confidence field in the database.
+	FieldGeocodingConfidence = "geocoding_confidence"
+	// FieldGeocodeAttemptedAt holds the string denoting the geocode_attempted_at field in the database.
+	FieldGeocodeAttemptedAt = "geocode_attempted_at"
 	// FieldWorkingTime holds the string denoting the working_time field in the database.
 	FieldWorkingTime = "working_time"
 	// EdgeVendor holds the string denoting the vendor edge name in mutations.
@@ -45,6 +49,8 @@ var Columns = []string{
 	FieldLongitude,
 	FieldLatitude,
 	FieldZip,
+	FieldGeocodingConfidence,
+	FieldGeocodeAttemptedAt,
 	FieldWorkingTime,
 }
 
@@ -74,6 +80,8 @@ var (
 	DefaultLongitude float64
 	// DefaultLatitude holds the default value on creation for the "latitude" field.
 	DefaultLatitude float64
+	// DefaultGeocodingConfidence holds the default value on creation for the "geocoding_confidence" field.
+	DefaultGeocodingConfidence float64
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -111,6 +119,16 @@ func ByZip(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldZip, opts...).ToFunc()
 }
 
+// ByGeocodingConfidence orders the results by the geocoding_confidence field.
+func ByGeocodingConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeocodingConfidence, opts...).ToFunc()
+}
+
+// ByGeocodeAttemptedAt orders the results by the geocode_attempted_at field.
+func ByGeocodeAttemptedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeocodeAttemptedAt, opts...).ToFunc()
+}
+
 // ByVendorField orders the results by vendor field.
 func ByVendorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -3,6 +3,8 @@
 package location
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/augustin-wien/augustina-backend/ent/predicate"
@@ -78,6 +80,16 @@ func Zip(v string) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldZip, v))
 }
 
+// GeocodingConfidence applies equality check predicate on the "geocoding_confidence" field. It's identical to GeocodingConfidenceEQ.
+func GeocodingConfidence(v float64) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldGeocodingConfidence, v))
+}
+
+// GeocodeAttemptedAt applies equality check predicate on the "geocode_attempted_at" field. It's identical to GeocodeAttemptedAtEQ.
+func GeocodeAttemptedAt(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldGeocodeAttemptedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldName, v))
@@ -353,6 +365,96 @@ func ZipContainsFold(v string) predicate.Location {
 	return predicate.Location(sql.FieldContainsFold(FieldZip, v))
 }
 
+// GeocodingConfidenceEQ applies the EQ predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceEQ(v float64) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldGeocodingConfidence, v))
+}
+
+// GeocodingConfidenceNEQ applies the NEQ predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceNEQ(v float64) predicate.Location {
+	return predicate.Location(sql.FieldNEQ(FieldGeocodingConfidence, v))
+}
+
+// GeocodingConfidenceIn applies the In predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceIn(vs ...float64) predicate.Location {
+	return predicate.Location(sql.FieldIn(FieldGeocodingConfidence, vs...))
+}
+
+// GeocodingConfidenceNotIn applies the NotIn predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceNotIn(vs ...float64) predicate.Location {
+	return predicate.Location(sql.FieldNotIn(FieldGeocodingConfidence, vs...))
+}
+
+// GeocodingConfidenceGT applies the GT predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceGT(v float64) predicate.Location {
+	return predicate.Location(sql.FieldGT(FieldGeocodingConfidence, v))
+}
+
+// GeocodingConfidenceGTE applies the GTE predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceGTE(v float64) predicate.Location {
+	return predicate.Location(sql.FieldGTE(FieldGeocodingConfidence, v))
+}
+
+// GeocodingConfidenceLT applies the LT predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceLT(v float64) predicate.Location {
+	return predicate.Location(sql.FieldLT(FieldGeocodingConfidence, v))
+}
+
+// GeocodingConfidenceLTE applies the LTE predicate on the "geocoding_confidence" field.
+func GeocodingConfidenceLTE(v float64) predicate.Location {
+	return predicate.Location(sql.FieldLTE(FieldGeocodingConfidence, v))
+}
+
+// GeocodeAttemptedAtEQ applies the EQ predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtEQ(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtNEQ applies the NEQ predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtNEQ(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldNEQ(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtIn applies the In predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtIn(vs ...time.Time) predicate.Location {
+	return predicate.Location(sql.FieldIn(FieldGeocodeAttemptedAt, vs...))
+}
+
+// GeocodeAttemptedAtNotIn applies the NotIn predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtNotIn(vs ...time.Time) predicate.Location {
+	return predicate.Location(sql.FieldNotIn(FieldGeocodeAttemptedAt, vs...))
+}
+
+// GeocodeAttemptedAtGT applies the GT predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtGT(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldGT(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtGTE applies the GTE predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtGTE(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldGTE(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtLT applies the LT predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtLT(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldLT(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtLTE applies the LTE predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtLTE(v time.Time) predicate.Location {
+	return predicate.Location(sql.FieldLTE(FieldGeocodeAttemptedAt, v))
+}
+
+// GeocodeAttemptedAtIsNil applies the IsNil predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtIsNil() predicate.Location {
+	return predicate.Location(sql.FieldIsNull(FieldGeocodeAttemptedAt))
+}
+
+// GeocodeAttemptedAtNotNil applies the NotNil predicate on the "geocode_attempted_at" field.
+func GeocodeAttemptedAtNotNil() predicate.Location {
+	return predicate.Location(sql.FieldNotNull(FieldGeocodeAttemptedAt))
+}
+
 // HasVendor applies the HasEdge predicate on the "vendor" edge.
 func HasVendor() predicate.Location {
 	return predicate.Location(func(s *sql.Selector) {
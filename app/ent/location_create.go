@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -67,6 +68,34 @@ func (_c *LocationCreate) SetZip(v string) *LocationCreate {
 	return _c
 }
 
+// SetGeocodingConfidence sets the "geocoding_confidence" field.
+func (_c *LocationCreate) SetGeocodingConfidence(v float64) *LocationCreate {
+	_c.mutation.SetGeocodingConfidence(v)
+	return _c
+}
+
+// SetNillableGeocodingConfidence sets the "geocoding_confidence" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableGeocodingConfidence(v *float64) *LocationCreate {
+	if v != nil {
+		_c.SetGeocodingConfidence(*v)
+	}
+	return _c
+}
+
+// SetGeocodeAttemptedAt sets the "geocode_attempted_at" field.
+func (_c *LocationCreate) SetGeocodeAttemptedAt(v time.Time) *LocationCreate {
+	_c.mutation.SetGeocodeAttemptedAt(v)
+	return _c
+}
+
+// SetNillableGeocodeAttemptedAt sets the "geocode_attempted_at" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableGeocodeAttemptedAt(v *time.Time) *LocationCreate {
+	if v != nil {
+		_c.SetGeocodeAttemptedAt(*v)
+	}
+	return _c
+}
+
 // SetWorkingTime sets the "working_time" field.
 func (_c *LocationCreate) SetWorkingTime(v *schema.WorkingTime) *LocationCreate {
 	_c.mutation.SetWorkingTime(v)
@@ -141,6 +170,10 @@ func (_c *LocationCreate) defaults() {
 		v := location.DefaultLatitude
 		_c.mutation.SetLatitude(v)
 	}
+	if _, ok := _c.mutation.GeocodingConfidence(); !ok {
+		v := location.DefaultGeocodingConfidence
+		_c.mutation.SetGeocodingConfidence(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -160,6 +193,9 @@ func (_c *LocationCreate) check() error {
 	if _, ok := _c.mutation.Zip(); !ok {
 		return &ValidationError{Name: "zip", err: errors.New(`ent: missing required field "Location.zip"`)}
 	}
+	if _, ok := _c.mutation.GeocodingConfidence(); !ok {
+		return &ValidationError{Name: "geocoding_confidence", err: errors.New(`ent: missing required field "Location.geocoding_confidence"`)}
+	}
 	if _, ok := _c.mutation.WorkingTime(); !ok {
 		return &ValidationError{Name: "working_time", err: errors.New(`ent: missing required field "Location.working_time"`)}
 	}
@@ -220,6 +256,14 @@ func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		_spec.SetField(location.FieldZip, field.TypeString, value)
 		_node.Zip = value
 	}
+	if value, ok := _c.mutation.GeocodingConfidence(); ok {
+		_spec.SetField(location.FieldGeocodingConfidence, field.TypeFloat64, value)
+		_node.GeocodingConfidence = value
+	}
+	if value, ok := _c.mutation.GeocodeAttemptedAt(); ok {
+		_spec.SetField(location.FieldGeocodeAttemptedAt, field.TypeTime, value)
+		_node.GeocodeAttemptedAt = &value
+	}
 	if value, ok := _c.mutation.WorkingTime(); ok {
 		_spec.SetField(location.FieldWorkingTime, field.TypeJSON, value)
 		_node.WorkingTime = value
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -113,6 +114,47 @@ func (_u *LocationUpdate) SetNillableZip(v *string) *LocationUpdate {
 	return _u
 }
 
+// SetGeocodingConfidence sets the "geocoding_confidence" field.
+func (_u *LocationUpdate) SetGeocodingConfidence(v float64) *LocationUpdate {
+	_u.mutation.ResetGeocodingConfidence()
+	_u.mutation.SetGeocodingConfidence(v)
+	return _u
+}
+
+// SetNillableGeocodingConfidence sets the "geocoding_confidence" field if the given value is not nil.
+func (_u *LocationUpdate) SetNillableGeocodingConfidence(v *float64) *LocationUpdate {
+	if v != nil {
+		_u.SetGeocodingConfidence(*v)
+	}
+	return _u
+}
+
+// AddGeocodingConfidence adds value to the "geocoding_confidence" field.
+func (_u *LocationUpdate) AddGeocodingConfidence(v float64) *LocationUpdate {
+	_u.mutation.AddGeocodingConfidence(v)
+	return _u
+}
+
+// SetGeocodeAttemptedAt sets the "geocode_attempted_at" field.
+func (_u *LocationUpdate) SetGeocodeAttemptedAt(v time.Time) *LocationUpdate {
+	_u.mutation.SetGeocodeAttemptedAt(v)
+	return _u
+}
+
+// SetNillableGeocodeAttemptedAt sets the "geocode_attempted_at" field if the given value is not nil.
+func (_u *LocationUpdate) SetNillableGeocodeAttemptedAt(v *time.Time) *LocationUpdate {
+	if v != nil {
+		_u.SetGeocodeAttemptedAt(*v)
+	}
+	return _u
+}
+
+// ClearGeocodeAttemptedAt clears the value of the "geocode_attempted_at" field.
+func (_u *LocationUpdate) ClearGeocodeAttemptedAt() *LocationUpdate {
+	_u.mutation.ClearGeocodeAttemptedAt()
+	return _u
+}
+
 // SetWorkingTime sets the "working_time" field.
 func (_u *LocationUpdate) SetWorkingTime(v *schema.WorkingTime) *LocationUpdate {
 	_u.mutation.SetWorkingTime(v)
@@ -206,6 +248,18 @@ func (_u *LocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Zip(); ok {
 		_spec.SetField(location.FieldZip, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.GeocodingConfidence(); ok {
+		_spec.SetField(location.FieldGeocodingConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGeocodingConfidence(); ok {
+		_spec.AddField(location.FieldGeocodingConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GeocodeAttemptedAt(); ok {
+		_spec.SetField(location.FieldGeocodeAttemptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GeocodeAttemptedAtCleared() {
+		_spec.ClearField(location.FieldGeocodeAttemptedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.WorkingTime(); ok {
 		_spec.SetField(location.FieldWorkingTime, field.TypeJSON, value)
 	}
@@ -342,6 +396,47 @@ func (_u *LocationUpdateOne) SetNillableZip(v *string) *LocationUpdateOne {
 	return _u
 }
 
+// SetGeocodingConfidence sets the "geocoding_confidence" field.
+func (_u *LocationUpdateOne) SetGeocodingConfidence(v float64) *LocationUpdateOne {
+	_u.mutation.ResetGeocodingConfidence()
+	_u.mutation.SetGeocodingConfidence(v)
+	return _u
+}
+
+// SetNillableGeocodingConfidence sets the "geocoding_confidence" field if the given value is not nil.
+func (_u *LocationUpdateOne) SetNillableGeocodingConfidence(v *float64) *LocationUpdateOne {
+	if v != nil {
+		_u.SetGeocodingConfidence(*v)
+	}
+	return _u
+}
+
+// AddGeocodingConfidence adds value to the "geocoding_confidence" field.
+func (_u *LocationUpdateOne) AddGeocodingConfidence(v float64) *LocationUpdateOne {
+	_u.mutation.AddGeocodingConfidence(v)
+	return _u
+}
+
+// SetGeocodeAttemptedAt sets the "geocode_attempted_at" field.
+func (_u *LocationUpdateOne) SetGeocodeAttemptedAt(v time.Time) *LocationUpdateOne {
+	_u.mutation.SetGeocodeAttemptedAt(v)
+	return _u
+}
+
+// SetNillableGeocodeAttemptedAt sets the "geocode_attempted_at" field if the given value is not nil.
+func (_u *LocationUpdateOne) SetNillableGeocodeAttemptedAt(v *time.Time) *LocationUpdateOne {
+	if v != nil {
+		_u.SetGeocodeAttemptedAt(*v)
+	}
+	return _u
+}
+
+// ClearGeocodeAttemptedAt clears the value of the "geocode_attempted_at" field.
+func (_u *LocationUpdateOne) ClearGeocodeAttemptedAt() *LocationUpdateOne {
+	_u.mutation.ClearGeocodeAttemptedAt()
+	return _u
+}
+
 // SetWorkingTime sets the "working_time" field.
 func (_u *LocationUpdateOne) SetWorkingTime(v *schema.WorkingTime) *LocationUpdateOne {
 	_u.mutation.SetWorkingTime(v)
@@ -465,6 +560,18 @@ func (_u *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 	if value, ok := _u.mutation.Zip(); ok {
 		_spec.SetField(location.FieldZip, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.GeocodingConfidence(); ok {
+		_spec.SetField(location.FieldGeocodingConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedGeocodingConfidence(); ok {
+		_spec.AddField(location.FieldGeocodingConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.GeocodeAttemptedAt(); ok {
+		_spec.SetField(location.FieldGeocodeAttemptedAt, field.TypeTime, value)
+	}
+	if _u.mutation.GeocodeAttemptedAtCleared() {
+		_spec.ClearField(location.FieldGeocodeAttemptedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.WorkingTime(); ok {
 		_spec.SetField(location.FieldWorkingTime, field.TypeJSON, value)
 	}
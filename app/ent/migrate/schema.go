@@ -9,12 +9,42 @@ import (
 )
 
 var (
+	// APIKeyColumns holds the columns for the "api_key" table.
+	APIKeyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "key_hash", Type: field.TypeString},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "route_groups", Type: field.TypeJSON},
+		{Name: "allowed_ips", Type: field.TypeJSON},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_ip", Type: field.TypeString, Default: ""},
+		{Name: "rotated_from", Type: field.TypeInt, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// APIKeyTable holds the schema information for the "api_key" table.
+	APIKeyTable = &schema.Table{
+		Name:       "api_key",
+		Columns:    APIKeyColumns,
+		PrimaryKey: []*schema.Column{APIKeyColumns[0]},
+	}
 	// AbonementColumns holds the columns for the "abonement" table.
 	AbonementColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "from_date", Type: field.TypeTime},
 		{Name: "to_date", Type: field.TypeTime},
 		{Name: "status", Type: field.TypeString, Default: "active"},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reminded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "paymentorder", Type: field.TypeInt, Nullable: true},
+		{Name: "auto_renew", Type: field.TypeBool, Default: false},
+		{Name: "payment_mandate", Type: field.TypeInt, Nullable: true},
+		{Name: "renewal_attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_renewal_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "abonement_item", Type: field.TypeInt, Nullable: true},
@@ -28,13 +58,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "abonement_item_item",
-				Columns:    []*schema.Column{AbonementColumns[6]},
+				Columns:    []*schema.Column{AbonementColumns[13]},
 				RefColumns: []*schema.Column{ItemColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "abonement_customer_abonements",
-				Columns:    []*schema.Column{AbonementColumns[7]},
+				Columns:    []*schema.Column{AbonementColumns[14]},
 				RefColumns: []*schema.Column{CustomerColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -63,6 +93,37 @@ var (
 			},
 		},
 	}
+	// AuditLogColumns holds the columns for the "audit_log" table.
+	AuditLogColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "actor_id", Type: field.TypeString, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "entity_type", Type: field.TypeString},
+		{Name: "entity_id", Type: field.TypeInt, Default: 0},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "request_id", Type: field.TypeString, Default: ""},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogTable holds the schema information for the "audit_log" table.
+	AuditLogTable = &schema.Table{
+		Name:       "audit_log",
+		Columns:    AuditLogColumns,
+		PrimaryKey: []*schema.Column{AuditLogColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_entity_type_entity_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogColumns[4], AuditLogColumns[5]},
+			},
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogColumns[9]},
+			},
+		},
+	}
 	// BlockedIpsColumns holds the columns for the "blocked_ips" table.
 	BlockedIpsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -100,14 +161,65 @@ var (
 			},
 		},
 	}
+	// ConsignmentColumns holds the columns for the "consignment" table.
+	ConsignmentColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "handed_out", Type: field.TypeInt, Default: 0},
+		{Name: "returned", Type: field.TypeInt, Default: 0},
+		{Name: "settled", Type: field.TypeInt, Default: 0},
+		{Name: "price", Type: field.TypeInt},
+		{Name: "settled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "vendor", Type: field.TypeInt},
+		{Name: "item", Type: field.TypeInt},
+	}
+	// ConsignmentTable holds the schema information for the "consignment" table.
+	ConsignmentTable = &schema.Table{
+		Name:       "consignment",
+		Columns:    ConsignmentColumns,
+		PrimaryKey: []*schema.Column{ConsignmentColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "consignment_vendor_vendor",
+				Columns:    []*schema.Column{ConsignmentColumns[8]},
+				RefColumns: []*schema.Column{VendorColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "consignment_item_item",
+				Columns:    []*schema.Column{ConsignmentColumns[9]},
+				RefColumns: []*schema.Column{ItemColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "consignment_vendor_item",
+				Unique:  true,
+				Columns: []*schema.Column{ConsignmentColumns[8], ConsignmentColumns[9]},
+			},
+			{
+				Name:    "consignment_item",
+				Unique:  false,
+				Columns: []*schema.Column{ConsignmentColumns[9]},
+			},
+		},
+	}
 	// CustomerColumns holds the columns for the "customer" table.
 	CustomerColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "keycloakid", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "pending_email", Type: field.TypeString, Default: ""},
 		{Name: "firstname", Type: field.TypeString, Default: ""},
 		{Name: "lastname", Type: field.TypeString, Default: ""},
 		{Name: "licensegroups", Type: field.TypeString, Default: ""},
+		{Name: "newsletter", Type: field.TypeBool, Default: false},
+		{Name: "notify_new_issues", Type: field.TypeBool, Default: true},
+		{Name: "notify_abonement_reminders", Type: field.TypeBool, Default: true},
+		{Name: "deletion_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deletion_reason", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
@@ -128,6 +240,179 @@ var (
 		Columns:    DbSettingsColumns,
 		PrimaryKey: []*schema.Column{DbSettingsColumns[0]},
 	}
+	// DiscountCodeColumns holds the columns for the "discount_code" table.
+	DiscountCodeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "value", Type: field.TypeInt},
+		{Name: "item", Type: field.TypeInt, Nullable: true},
+		{Name: "license_group", Type: field.TypeString, Default: ""},
+		{Name: "valid_from", Type: field.TypeTime, Nullable: true},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "disabled", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DiscountCodeTable holds the schema information for the "discount_code" table.
+	DiscountCodeTable = &schema.Table{
+		Name:       "discount_code",
+		Columns:    DiscountCodeColumns,
+		PrimaryKey: []*schema.Column{DiscountCodeColumns[0]},
+	}
+	// DiscountRedemptionColumns holds the columns for the "discount_redemption" table.
+	DiscountRedemptionColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "discount_code", Type: field.TypeInt},
+		{Name: "paymentorder", Type: field.TypeInt},
+		{Name: "amount", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DiscountRedemptionTable holds the schema information for the "discount_redemption" table.
+	DiscountRedemptionTable = &schema.Table{
+		Name:       "discount_redemption",
+		Columns:    DiscountRedemptionColumns,
+		PrimaryKey: []*schema.Column{DiscountRedemptionColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discountredemption_discount_code",
+				Unique:  false,
+				Columns: []*schema.Column{DiscountRedemptionColumns[1]},
+			},
+			{
+				Name:    "discountredemption_paymentorder",
+				Unique:  false,
+				Columns: []*schema.Column{DiscountRedemptionColumns[2]},
+			},
+		},
+	}
+	// EntitlementColumns holds the columns for the "entitlement" table.
+	EntitlementColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "customer", Type: field.TypeInt},
+		{Name: "item", Type: field.TypeInt, Nullable: true},
+		{Name: "license_group", Type: field.TypeString, Default: ""},
+		{Name: "source", Type: field.TypeString},
+		{Name: "paymentorder", Type: field.TypeInt, Nullable: true},
+		{Name: "abonement", Type: field.TypeInt, Nullable: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EntitlementTable holds the schema information for the "entitlement" table.
+	EntitlementTable = &schema.Table{
+		Name:       "entitlement",
+		Columns:    EntitlementColumns,
+		PrimaryKey: []*schema.Column{EntitlementColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "entitlement_customer",
+				Unique:  false,
+				Columns: []*schema.Column{EntitlementColumns[1]},
+			},
+			{
+				Name:    "entitlement_item",
+				Unique:  false,
+				Columns: []*schema.Column{EntitlementColumns[2]},
+			},
+			{
+				Name:    "entitlement_abonement",
+				Unique:  false,
+				Columns: []*schema.Column{EntitlementColumns[6]},
+			},
+		},
+	}
+	// GiftCodeColumns holds the columns for the "gift_code" table.
+	GiftCodeColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "item", Type: field.TypeInt},
+		{Name: "paymentorder", Type: field.TypeInt, Nullable: true},
+		{Name: "buyer_email", Type: field.TypeString},
+		{Name: "recipient_email", Type: field.TypeString, Nullable: true},
+		{Name: "message", Type: field.TypeString, Default: ""},
+		{Name: "send_at", Type: field.TypeTime, Nullable: true},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "redeemed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "customer", Type: field.TypeInt, Nullable: true},
+		{Name: "abonement", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// GiftCodeTable holds the schema information for the "gift_code" table.
+	GiftCodeTable = &schema.Table{
+		Name:       "gift_code",
+		Columns:    GiftCodeColumns,
+		PrimaryKey: []*schema.Column{GiftCodeColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "giftcode_paymentorder",
+				Unique:  false,
+				Columns: []*schema.Column{GiftCodeColumns[3]},
+			},
+			{
+				Name:    "giftcode_redeemed_at",
+				Unique:  false,
+				Columns: []*schema.Column{GiftCodeColumns[9]},
+			},
+		},
+	}
+	// InvoiceColumns holds the columns for the "invoice" table.
+	InvoiceColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "sequence", Type: field.TypeInt},
+		{Name: "paymentorder", Type: field.TypeInt, Unique: true},
+		{Name: "customer", Type: field.TypeInt, Nullable: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "billing_address", Type: field.TypeJSON, Nullable: true},
+		{Name: "issuer", Type: field.TypeJSON},
+		{Name: "lines", Type: field.TypeJSON},
+		{Name: "net", Type: field.TypeInt},
+		{Name: "tax", Type: field.TypeInt},
+		{Name: "gross", Type: field.TypeInt},
+		{Name: "issued_at", Type: field.TypeTime},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true},
+	}
+	// InvoiceTable holds the schema information for the "invoice" table.
+	InvoiceTable = &schema.Table{
+		Name:       "invoice",
+		Columns:    InvoiceColumns,
+		PrimaryKey: []*schema.Column{InvoiceColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoice_year_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{InvoiceColumns[2], InvoiceColumns[3]},
+			},
+			{
+				Name:    "invoice_customer",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceColumns[5]},
+			},
+			{
+				Name:    "invoice_issued_at",
+				Unique:  false,
+				Columns: []*schema.Column{InvoiceColumns[13]},
+			},
+		},
+	}
+	// InvoiceSequenceColumns holds the columns for the "invoice_sequence" table.
+	InvoiceSequenceColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "year", Type: field.TypeInt, Unique: true},
+		{Name: "last", Type: field.TypeInt, Default: 0},
+	}
+	// InvoiceSequenceTable holds the schema information for the "invoice_sequence" table.
+	InvoiceSequenceTable = &schema.Table{
+		Name:       "invoice_sequence",
+		Columns:    InvoiceSequenceColumns,
+		PrimaryKey: []*schema.Column{InvoiceSequenceColumns[0]},
+	}
 	// ItemColumns holds the columns for the "item" table.
 	ItemColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -144,6 +429,15 @@ var (
 		{Name: "itemorder", Type: field.TypeInt, Default: 0},
 		{Name: "itemcolor", Type: field.TypeString, Default: "#FFFFFF"},
 		{Name: "itemtextcolor", Type: field.TypeString, Default: "#000000"},
+		{Name: "trackstock", Type: field.TypeBool, Default: false},
+		{Name: "stock", Type: field.TypeInt, Default: 0},
+		{Name: "lowstockthreshold", Type: field.TypeInt, Default: 0},
+		{Name: "publishat", Type: field.TypeTime, Nullable: true},
+		{Name: "unpublishat", Type: field.TypeTime, Nullable: true},
+		{Name: "pdfdownloadlimit", Type: field.TypeInt, Nullable: true},
+		{Name: "pdfdownloadexpirydays", Type: field.TypeInt, Nullable: true},
+		{Name: "vatrate", Type: field.TypeFloat64, Nullable: true},
+		{Name: "imagevariants", Type: field.TypeJSON, Nullable: true},
 		{Name: "licenseitem", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "pdf", Type: field.TypeInt, Nullable: true},
 	}
@@ -155,13 +449,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "item_item_LicenseItem",
-				Columns:    []*schema.Column{ItemColumns[14]},
+				Columns:    []*schema.Column{ItemColumns[23]},
 				RefColumns: []*schema.Column{ItemColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "item_pdf_PDF",
-				Columns:    []*schema.Column{ItemColumns[15]},
+				Columns:    []*schema.Column{ItemColumns[24]},
 				RefColumns: []*schema.Column{PdfColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -175,6 +469,8 @@ var (
 		{Name: "longitude", Type: field.TypeFloat64, Default: 0.1},
 		{Name: "latitude", Type: field.TypeFloat64, Default: 0.1},
 		{Name: "zip", Type: field.TypeString},
+		{Name: "geocoding_confidence", Type: field.TypeFloat64, Default: 0},
+		{Name: "geocode_attempted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "working_time", Type: field.TypeJSON},
 		{Name: "vendor_locations", Type: field.TypeInt, Nullable: true},
 	}
@@ -186,7 +482,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "locations_vendor_locations",
-				Columns:    []*schema.Column{LocationsColumns[7]},
+				Columns:    []*schema.Column{LocationsColumns[9]},
 				RefColumns: []*schema.Column{VendorColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -219,6 +515,12 @@ var (
 		{Name: "userid", Type: field.TypeString, Nullable: true},
 		{Name: "vendor_id", Type: field.TypeInt},
 		{Name: "customeremail", Type: field.TypeString, Nullable: true},
+		{Name: "gift", Type: field.TypeBool, Default: false},
+		{Name: "gift_recipient_email", Type: field.TypeString, Nullable: true},
+		{Name: "gift_send_at", Type: field.TypeTime, Nullable: true},
+		{Name: "gift_message", Type: field.TypeString, Default: ""},
+		{Name: "billing_address", Type: field.TypeJSON, Nullable: true},
+		{Name: "auto_renew", Type: field.TypeBool, Default: false},
 	}
 	// PaymentorderTable holds the schema information for the "paymentorder" table.
 	PaymentorderTable = &schema.Table{
@@ -232,6 +534,10 @@ var (
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "price", Type: field.TypeInt},
 		{Name: "is_sale", Type: field.TypeBool},
+		{Name: "vat_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "net", Type: field.TypeInt, Default: 0},
+		{Name: "tax", Type: field.TypeInt, Default: 0},
+		{Name: "gross", Type: field.TypeInt, Default: 0},
 		{Name: "paymentorder", Type: field.TypeInt, Nullable: true},
 		{Name: "item", Type: field.TypeInt},
 		{Name: "sender", Type: field.TypeInt},
@@ -245,25 +551,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "orderentry_paymentorder_entries",
-				Columns:    []*schema.Column{OrderentryColumns[4]},
+				Columns:    []*schema.Column{OrderentryColumns[8]},
 				RefColumns: []*schema.Column{PaymentorderColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "orderentry_item_item",
-				Columns:    []*schema.Column{OrderentryColumns[5]},
+				Columns:    []*schema.Column{OrderentryColumns[9]},
 				RefColumns: []*schema.Column{ItemColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "orderentry_account_sender",
-				Columns:    []*schema.Column{OrderentryColumns[6]},
+				Columns:    []*schema.Column{OrderentryColumns[10]},
 				RefColumns: []*schema.Column{AccountColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "orderentry_account_receiver",
-				Columns:    []*schema.Column{OrderentryColumns[7]},
+				Columns:    []*schema.Column{OrderentryColumns[11]},
 				RefColumns: []*schema.Column{AccountColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -273,7 +579,7 @@ var (
 	PdfColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "path", Type: field.TypeString},
-		{Name: "timestamp", Type: field.TypeString},
+		{Name: "timestamp", Type: field.TypeTime},
 	}
 	// PdfTable holds the schema information for the "pdf" table.
 	PdfTable = &schema.Table{
@@ -292,6 +598,9 @@ var (
 		{Name: "download_count", Type: field.TypeInt, Default: 0},
 		{Name: "order_id", Type: field.TypeInt, Nullable: true},
 		{Name: "item_id", Type: field.TypeInt, Nullable: true},
+		{Name: "suspended", Type: field.TypeBool, Default: false},
+		{Name: "suspended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suspend_reason", Type: field.TypeString, Default: ""},
 	}
 	// PdfDownloadTable holds the schema information for the "pdf_download" table.
 	PdfDownloadTable = &schema.Table{
@@ -299,6 +608,29 @@ var (
 		Columns:    PdfDownloadColumns,
 		PrimaryKey: []*schema.Column{PdfDownloadColumns[0]},
 	}
+	// PdfDownloadAccessColumns holds the columns for the "pdf_download_access" table.
+	PdfDownloadAccessColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "pdf_download_id", Type: field.TypeInt},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "allowed", Type: field.TypeBool},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PdfDownloadAccessTable holds the schema information for the "pdf_download_access" table.
+	PdfDownloadAccessTable = &schema.Table{
+		Name:       "pdf_download_access",
+		Columns:    PdfDownloadAccessColumns,
+		PrimaryKey: []*schema.Column{PdfDownloadAccessColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pdfdownloadaccess_pdf_download_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PdfDownloadAccessColumns[1], PdfDownloadAccessColumns[6]},
+			},
+		},
+	}
 	// PaymentColumns holds the columns for the "payment" table.
 	PaymentColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -336,6 +668,30 @@ var (
 			},
 		},
 	}
+	// PaymentMandateColumns holds the columns for the "payment_mandate" table.
+	PaymentMandateColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "customer", Type: field.TypeInt},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "token", Type: field.TypeString},
+		{Name: "card_number", Type: field.TypeString, Default: ""},
+		{Name: "paymentorder", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PaymentMandateTable holds the schema information for the "payment_mandate" table.
+	PaymentMandateTable = &schema.Table{
+		Name:       "payment_mandate",
+		Columns:    PaymentMandateColumns,
+		PrimaryKey: []*schema.Column{PaymentMandateColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paymentmandate_customer",
+				Unique:  false,
+				Columns: []*schema.Column{PaymentMandateColumns[1]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -367,6 +723,16 @@ var (
 		{Name: "wordpressinviteurl", Type: field.TypeString, Default: ""},
 		{Name: "wordpressinviteapikey", Type: field.TypeString, Default: ""},
 		{Name: "wordpressinvitettl", Type: field.TypeInt, Default: 604800},
+		{Name: "pdfdownloadlimit", Type: field.TypeInt, Default: 0},
+		{Name: "pdfdownloadexpirydays", Type: field.TypeInt, Default: 42},
+		{Name: "pdfdownloadmaxips", Type: field.TypeInt, Default: 10},
+		{Name: "imagevariants", Type: field.TypeJSON, Nullable: true},
+		{Name: "invoicesenabled", Type: field.TypeBool, Default: false},
+		{Name: "invoiceissuername", Type: field.TypeString, Default: ""},
+		{Name: "invoiceissueraddress", Type: field.TypeString, Default: ""},
+		{Name: "invoiceissuervatid", Type: field.TypeString, Default: ""},
+		{Name: "invoicefooter", Type: field.TypeString, Default: ""},
+		{Name: "defaultvatrate", Type: field.TypeFloat64, Default: 10},
 		{Name: "mainitem", Type: field.TypeInt, Nullable: true},
 	}
 	// SettingsTable holds the schema information for the "settings" table.
@@ -377,12 +743,56 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "settings_item_MainItem",
-				Columns:    []*schema.Column{SettingsColumns[29]},
+				Columns:    []*schema.Column{SettingsColumns[39]},
 				RefColumns: []*schema.Column{ItemColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// StockMovementColumns holds the columns for the "stock_movement" table.
+	StockMovementColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "stock_after", Type: field.TypeInt},
+		{Name: "note", Type: field.TypeString, Default: ""},
+		{Name: "created_by", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "item", Type: field.TypeInt},
+		{Name: "vendor", Type: field.TypeInt, Nullable: true},
+	}
+	// StockMovementTable holds the schema information for the "stock_movement" table.
+	StockMovementTable = &schema.Table{
+		Name:       "stock_movement",
+		Columns:    StockMovementColumns,
+		PrimaryKey: []*schema.Column{StockMovementColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_movement_item_item",
+				Columns:    []*schema.Column{StockMovementColumns[7]},
+				RefColumns: []*schema.Column{ItemColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "stock_movement_vendor_vendor",
+				Columns:    []*schema.Column{StockMovementColumns[8]},
+				RefColumns: []*schema.Column{VendorColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "stockmovement_item_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementColumns[7], StockMovementColumns[6]},
+			},
+			{
+				Name:    "stockmovement_vendor",
+				Unique:  false,
+				Columns: []*schema.Column{StockMovementColumns[8]},
+			},
+		},
+	}
 	// VendorColumns holds the columns for the "vendor" table.
 	VendorColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -413,12 +823,21 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeyTable,
 		AbonementTable,
 		AccountTable,
+		AuditLogTable,
 		BlockedIpsTable,
 		CommentsTable,
+		ConsignmentTable,
 		CustomerTable,
 		DbSettingsTable,
+		DiscountCodeTable,
+		DiscountRedemptionTable,
+		EntitlementTable,
+		GiftCodeTable,
+		InvoiceTable,
+		InvoiceSequenceTable,
 		ItemTable,
 		LocationsTable,
 		MailTemplatesTable,
@@ -426,13 +845,19 @@ var (
 		OrderentryTable,
 		PdfTable,
 		PdfDownloadTable,
+		PdfDownloadAccessTable,
 		PaymentTable,
+		PaymentMandateTable,
 		SettingsTable,
+		StockMovementTable,
 		VendorTable,
 	}
 )
 
 func init() {
+	APIKeyTable.Annotation = &entsql.Annotation{
+		Table: "api_key",
+	}
 	AbonementTable.ForeignKeys[0].RefTable = ItemTable
 	AbonementTable.ForeignKeys[1].RefTable = CustomerTable
 	AbonementTable.Annotation = &entsql.Annotation{
@@ -442,13 +867,39 @@ func init() {
 	AccountTable.Annotation = &entsql.Annotation{
 		Table: "account",
 	}
+	AuditLogTable.Annotation = &entsql.Annotation{
+		Table: "audit_log",
+	}
 	CommentsTable.ForeignKeys[0].RefTable = VendorTable
+	ConsignmentTable.ForeignKeys[0].RefTable = VendorTable
+	ConsignmentTable.ForeignKeys[1].RefTable = ItemTable
+	ConsignmentTable.Annotation = &entsql.Annotation{
+		Table: "consignment",
+	}
 	CustomerTable.Annotation = &entsql.Annotation{
 		Table: "customer",
 	}
 	DbSettingsTable.Annotation = &entsql.Annotation{
 		Table: "db_settings",
 	}
+	DiscountCodeTable.Annotation = &entsql.Annotation{
+		Table: "discount_code",
+	}
+	DiscountRedemptionTable.Annotation = &entsql.Annotation{
+		Table: "discount_redemption",
+	}
+	EntitlementTable.Annotation = &entsql.Annotation{
+		Table: "entitlement",
+	}
+	GiftCodeTable.Annotation = &entsql.Annotation{
+		Table: "gift_code",
+	}
+	InvoiceTable.Annotation = &entsql.Annotation{
+		Table: "invoice",
+	}
+	InvoiceSequenceTable.Annotation = &entsql.Annotation{
+		Table: "invoice_sequence",
+	}
 	ItemTable.ForeignKeys[0].RefTable = ItemTable
 	ItemTable.ForeignKeys[1].RefTable = PdfTable
 	ItemTable.Annotation = &entsql.Annotation{
@@ -471,12 +922,23 @@ func init() {
 	PdfDownloadTable.Annotation = &entsql.Annotation{
 		Table: "pdf_download",
 	}
+	PdfDownloadAccessTable.Annotation = &entsql.Annotation{
+		Table: "pdf_download_access",
+	}
 	PaymentTable.ForeignKeys[0].RefTable = PaymentorderTable
 	PaymentTable.ForeignKeys[1].RefTable = PaymentTable
 	PaymentTable.Annotation = &entsql.Annotation{
 		Table: "payment",
 	}
+	PaymentMandateTable.Annotation = &entsql.Annotation{
+		Table: "payment_mandate",
+	}
 	SettingsTable.ForeignKeys[0].RefTable = ItemTable
+	StockMovementTable.ForeignKeys[0].RefTable = ItemTable
+	StockMovementTable.ForeignKeys[1].RefTable = VendorTable
+	StockMovementTable.Annotation = &entsql.Annotation{
+		Table: "stock_movement",
+	}
 	VendorTable.Annotation = &entsql.Annotation{
 		Table: "vendor",
 	}
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/augustin-wien/augustina-backend/ent/order"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
 )
 
 // Order is the model entity for the Order schema.
@@ -35,6 +37,18 @@ type Order struct {
 	VendorID int `json:"vendor_id,omitempty"`
 	// CustomerEmail holds the value of the "customer_email" field.
 	CustomerEmail *string `json:"customer_email,omitempty"`
+	// Gift holds the value of the "gift" field.
+	Gift bool `json:"gift,omitempty"`
+	// GiftRecipientEmail holds the value of the "gift_recipient_email" field.
+	GiftRecipientEmail *string `json:"gift_recipient_email,omitempty"`
+	// GiftSendAt holds the value of the "gift_send_at" field.
+	GiftSendAt *time.Time `json:"gift_send_at,omitempty"`
+	// GiftMessage holds the value of the "gift_message" field.
+	GiftMessage string `json:"gift_message,omitempty"`
+	// BillingAddress holds the value of the "billing_address" field.
+	BillingAddress *schema.BillingAddress `json:"billing_address,omitempty"`
+	// AutoRenew holds the value of the "auto_renew" field.
+	AutoRenew bool `json:"auto_renew,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
 	Edges        OrderEdges `json:"edges"`
@@ -75,13 +89,15 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldVerified:
+		case order.FieldBillingAddress:
+			values[i] = new([]byte)
+		case order.FieldVerified, order.FieldGift, order.FieldAutoRenew:
 			values[i] = new(sql.NullBool)
 		case order.FieldID, order.FieldTransactionTypeID, order.FieldVendorID:
 			values[i] = new(sql.NullInt64)
-		case order.FieldOrderCode, order.FieldTransactionID, order.FieldUserID, order.FieldCustomerEmail:
+		case order.FieldOrderCode, order.FieldTransactionID, order.FieldUserID, order.FieldCustomerEmail, order.FieldGiftRecipientEmail, order.FieldGiftMessage:
 			values[i] = new(sql.NullString)
-		case order.FieldVerifiedAt, order.FieldTimestamp:
+		case order.FieldVerifiedAt, order.FieldTimestamp, order.FieldGiftSendAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -162,6 +178,46 @@ func (_m *Order) assignValues(columns []string, values []any) error {
 				_m.CustomerEmail = new(string)
 				*_m.CustomerEmail = value.String
 			}
+		case order.FieldGift:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field gift", values[i])
+			} else if value.Valid {
+				_m.Gift = value.Bool
+			}
+		case order.FieldGiftRecipientEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gift_recipient_email", values[i])
+			} else if value.Valid {
+				_m.GiftRecipientEmail = new(string)
+				*_m.GiftRecipientEmail = value.String
+			}
+		case order.FieldGiftSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field gift_send_at", values[i])
+			} else if value.Valid {
+				_m.GiftSendAt = new(time.Time)
+				*_m.GiftSendAt = value.Time
+			}
+		case order.FieldGiftMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field gift_message", values[i])
+			} else if value.Valid {
+				_m.GiftMessage = value.String
+			}
+		case order.FieldBillingAddress:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field billing_address", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BillingAddress); err != nil {
+					return fmt.Errorf("unmarshal field billing_address: %w", err)
+				}
+			}
+		case order.FieldAutoRenew:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_renew", values[i])
+			} else if value.Valid {
+				_m.AutoRenew = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -242,6 +298,28 @@ func (_m *Order) String() string {
 		builder.WriteString("customer_email=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("gift=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gift))
+	builder.WriteString(", ")
+	if v := _m.GiftRecipientEmail; v != nil {
+		builder.WriteString("gift_recipient_email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.GiftSendAt; v != nil {
+		builder.WriteString("gift_send_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("gift_message=")
+	builder.WriteString(_m.GiftMessage)
+	builder.WriteString(", ")
+	builder.WriteString("billing_address=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BillingAddress))
+	builder.WriteString(", ")
+	builder.WriteString("auto_renew=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoRenew))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,6 +30,18 @@ const (
 	FieldVendorID = "vendor_id"
 	// FieldCustomerEmail holds the string denoting the customer_email field in the database.
 	FieldCustomerEmail = "customeremail"
+	// FieldGift holds the string denoting the gift field in the database.
+	FieldGift = "gift"
+	// FieldGiftRecipientEmail holds the string denoting the gift_recipient_email field in the database.
+	FieldGiftRecipientEmail = "gift_recipient_email"
+	// FieldGiftSendAt holds the string denoting the gift_send_at field in the database.
+	FieldGiftSendAt = "gift_send_at"
+	// FieldGiftMessage holds the string denoting the gift_message field in the database.
+	FieldGiftMessage = "gift_message"
+	// FieldBillingAddress holds the string denoting the billing_address field in the database.
+	FieldBillingAddress = "billing_address"
+	// FieldAutoRenew holds the string denoting the auto_renew field in the database.
+	FieldAutoRenew = "auto_renew"
 	// EdgeEntries holds the string denoting the entries edge name in mutations.
 	EdgeEntries = "entries"
 	// EdgePayments holds the string denoting the payments edge name in mutations.
@@ -64,6 +76,12 @@ var Columns = []string{
 	FieldUserID,
 	FieldVendorID,
 	FieldCustomerEmail,
+	FieldGift,
+	FieldGiftRecipientEmail,
+	FieldGiftSendAt,
+	FieldGiftMessage,
+	FieldBillingAddress,
+	FieldAutoRenew,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -77,6 +95,12 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultGift holds the default value on creation for the "gift" field.
+	DefaultGift bool
+	// DefaultGiftMessage holds the default value on creation for the "gift_message" field.
+	DefaultGiftMessage string
+	// DefaultAutoRenew holds the default value on creation for the "auto_renew" field.
+	DefaultAutoRenew bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -134,6 +158,31 @@ func ByCustomerEmail(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCustomerEmail, opts...).ToFunc()
 }
 
+// ByGift orders the results by the gift field.
+func ByGift(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGift, opts...).ToFunc()
+}
+
+// ByGiftRecipientEmail orders the results by the gift_recipient_email field.
+func ByGiftRecipientEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGiftRecipientEmail, opts...).ToFunc()
+}
+
+// ByGiftSendAt orders the results by the gift_send_at field.
+func ByGiftSendAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGiftSendAt, opts...).ToFunc()
+}
+
+// ByGiftMessage orders the results by the gift_message field.
+func ByGiftMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGiftMessage, opts...).ToFunc()
+}
+
+// ByAutoRenew orders the results by the auto_renew field.
+func ByAutoRenew(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoRenew, opts...).ToFunc()
+}
+
 // ByEntriesCount orders the results by entries count.
 func ByEntriesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -100,6 +100,31 @@ func CustomerEmail(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCustomerEmail, v))
 }
 
+// Gift applies equality check predicate on the "gift" field. It's identical to GiftEQ.
+func Gift(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGift, v))
+}
+
+// GiftRecipientEmail applies equality check predicate on the "gift_recipient_email" field. It's identical to GiftRecipientEmailEQ.
+func GiftRecipientEmail(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftRecipientEmail, v))
+}
+
+// GiftSendAt applies equality check predicate on the "gift_send_at" field. It's identical to GiftSendAtEQ.
+func GiftSendAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftSendAt, v))
+}
+
+// GiftMessage applies equality check predicate on the "gift_message" field. It's identical to GiftMessageEQ.
+func GiftMessage(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftMessage, v))
+}
+
+// AutoRenew applies equality check predicate on the "auto_renew" field. It's identical to AutoRenewEQ.
+func AutoRenew(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAutoRenew, v))
+}
+
 // OrderCodeEQ applies the EQ predicate on the "order_code" field.
 func OrderCodeEQ(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldOrderCode, v))
@@ -570,6 +595,226 @@ func CustomerEmailContainsFold(v string) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldCustomerEmail, v))
 }
 
+// GiftEQ applies the EQ predicate on the "gift" field.
+func GiftEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGift, v))
+}
+
+// GiftNEQ applies the NEQ predicate on the "gift" field.
+func GiftNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGift, v))
+}
+
+// GiftRecipientEmailEQ applies the EQ predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailNEQ applies the NEQ predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailIn applies the In predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldGiftRecipientEmail, vs...))
+}
+
+// GiftRecipientEmailNotIn applies the NotIn predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldGiftRecipientEmail, vs...))
+}
+
+// GiftRecipientEmailGT applies the GT predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailGTE applies the GTE predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailLT applies the LT predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailLTE applies the LTE predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailContains applies the Contains predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailHasPrefix applies the HasPrefix predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailHasSuffix applies the HasSuffix predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailIsNil applies the IsNil predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldGiftRecipientEmail))
+}
+
+// GiftRecipientEmailNotNil applies the NotNil predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldGiftRecipientEmail))
+}
+
+// GiftRecipientEmailEqualFold applies the EqualFold predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldGiftRecipientEmail, v))
+}
+
+// GiftRecipientEmailContainsFold applies the ContainsFold predicate on the "gift_recipient_email" field.
+func GiftRecipientEmailContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldGiftRecipientEmail, v))
+}
+
+// GiftSendAtEQ applies the EQ predicate on the "gift_send_at" field.
+func GiftSendAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftSendAt, v))
+}
+
+// GiftSendAtNEQ applies the NEQ predicate on the "gift_send_at" field.
+func GiftSendAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGiftSendAt, v))
+}
+
+// GiftSendAtIn applies the In predicate on the "gift_send_at" field.
+func GiftSendAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldGiftSendAt, vs...))
+}
+
+// GiftSendAtNotIn applies the NotIn predicate on the "gift_send_at" field.
+func GiftSendAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldGiftSendAt, vs...))
+}
+
+// GiftSendAtGT applies the GT predicate on the "gift_send_at" field.
+func GiftSendAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldGiftSendAt, v))
+}
+
+// GiftSendAtGTE applies the GTE predicate on the "gift_send_at" field.
+func GiftSendAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldGiftSendAt, v))
+}
+
+// GiftSendAtLT applies the LT predicate on the "gift_send_at" field.
+func GiftSendAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldGiftSendAt, v))
+}
+
+// GiftSendAtLTE applies the LTE predicate on the "gift_send_at" field.
+func GiftSendAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldGiftSendAt, v))
+}
+
+// GiftSendAtIsNil applies the IsNil predicate on the "gift_send_at" field.
+func GiftSendAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldGiftSendAt))
+}
+
+// GiftSendAtNotNil applies the NotNil predicate on the "gift_send_at" field.
+func GiftSendAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldGiftSendAt))
+}
+
+// GiftMessageEQ applies the EQ predicate on the "gift_message" field.
+func GiftMessageEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGiftMessage, v))
+}
+
+// GiftMessageNEQ applies the NEQ predicate on the "gift_message" field.
+func GiftMessageNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGiftMessage, v))
+}
+
+// GiftMessageIn applies the In predicate on the "gift_message" field.
+func GiftMessageIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldGiftMessage, vs...))
+}
+
+// GiftMessageNotIn applies the NotIn predicate on the "gift_message" field.
+func GiftMessageNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldGiftMessage, vs...))
+}
+
+// GiftMessageGT applies the GT predicate on the "gift_message" field.
+func GiftMessageGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldGiftMessage, v))
+}
+
+// GiftMessageGTE applies the GTE predicate on the "gift_message" field.
+func GiftMessageGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldGiftMessage, v))
+}
+
+// GiftMessageLT applies the LT predicate on the "gift_message" field.
+func GiftMessageLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldGiftMessage, v))
+}
+
+// GiftMessageLTE applies the LTE predicate on the "gift_message" field.
+func GiftMessageLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldGiftMessage, v))
+}
+
+// GiftMessageContains applies the Contains predicate on the "gift_message" field.
+func GiftMessageContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldGiftMessage, v))
+}
+
+// GiftMessageHasPrefix applies the HasPrefix predicate on the "gift_message" field.
+func GiftMessageHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldGiftMessage, v))
+}
+
+// GiftMessageHasSuffix applies the HasSuffix predicate on the "gift_message" field.
+func GiftMessageHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldGiftMessage, v))
+}
+
+// GiftMessageEqualFold applies the EqualFold predicate on the "gift_message" field.
+func GiftMessageEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldGiftMessage, v))
+}
+
+// GiftMessageContainsFold applies the ContainsFold predicate on the "gift_message" field.
+func GiftMessageContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldGiftMessage, v))
+}
+
+// BillingAddressIsNil applies the IsNil predicate on the "billing_address" field.
+func BillingAddressIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldBillingAddress))
+}
+
+// BillingAddressNotNil applies the NotNil predicate on the "billing_address" field.
+func BillingAddressNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldBillingAddress))
+}
+
+// AutoRenewEQ applies the EQ predicate on the "auto_renew" field.
+func AutoRenewEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAutoRenew, v))
+}
+
+// AutoRenewNEQ applies the NEQ predicate on the "auto_renew" field.
+func AutoRenewNEQ(v bool) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldAutoRenew, v))
+}
+
 // HasEntries applies the HasEdge predicate on the "entries" edge.
 func HasEntries() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -13,6 +13,7 @@ import (
 	"github.com/augustin-wien/augustina-backend/ent/order"
 	"github.com/augustin-wien/augustina-backend/ent/orderentry"
 	"github.com/augustin-wien/augustina-backend/ent/payment"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
 )
 
 // OrderCreate is the builder for creating a Order entity.
@@ -108,6 +109,82 @@ func (_c *OrderCreate) SetNillableCustomerEmail(v *string) *OrderCreate {
 	return _c
 }
 
+// SetGift sets the "gift" field.
+func (_c *OrderCreate) SetGift(v bool) *OrderCreate {
+	_c.mutation.SetGift(v)
+	return _c
+}
+
+// SetNillableGift sets the "gift" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableGift(v *bool) *OrderCreate {
+	if v != nil {
+		_c.SetGift(*v)
+	}
+	return _c
+}
+
+// SetGiftRecipientEmail sets the "gift_recipient_email" field.
+func (_c *OrderCreate) SetGiftRecipientEmail(v string) *OrderCreate {
+	_c.mutation.SetGiftRecipientEmail(v)
+	return _c
+}
+
+// SetNillableGiftRecipientEmail sets the "gift_recipient_email" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableGiftRecipientEmail(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetGiftRecipientEmail(*v)
+	}
+	return _c
+}
+
+// SetGiftSendAt sets the "gift_send_at" field.
+func (_c *OrderCreate) SetGiftSendAt(v time.Time) *OrderCreate {
+	_c.mutation.SetGiftSendAt(v)
+	return _c
+}
+
+// SetNillableGiftSendAt sets the "gift_send_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableGiftSendAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetGiftSendAt(*v)
+	}
+	return _c
+}
+
+// SetGiftMessage sets the "gift_message" field.
+func (_c *OrderCreate) SetGiftMessage(v string) *OrderCreate {
+	_c.mutation.SetGiftMessage(v)
+	return _c
+}
+
+// SetNillableGiftMessage sets the "gift_message" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableGiftMessage(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetGiftMessage(*v)
+	}
+	return _c
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_c *OrderCreate) SetBillingAddress(v *schema.BillingAddress) *OrderCreate {
+	_c.mutation.SetBillingAddress(v)
+	return _c
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_c *OrderCreate) SetAutoRenew(v bool) *OrderCreate {
+	_c.mutation.SetAutoRenew(v)
+	return _c
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableAutoRenew(v *bool) *OrderCreate {
+	if v != nil {
+		_c.SetAutoRenew(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OrderCreate) SetID(v int) *OrderCreate {
 	_c.mutation.SetID(v)
@@ -151,6 +228,7 @@ func (_c *OrderCreate) Mutation() *OrderMutation {
 
 // Save creates the Order in the database.
 func (_c *OrderCreate) Save(ctx context.Context) (*Order, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -176,6 +254,22 @@ func (_c *OrderCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *OrderCreate) defaults() {
+	if _, ok := _c.mutation.Gift(); !ok {
+		v := order.DefaultGift
+		_c.mutation.SetGift(v)
+	}
+	if _, ok := _c.mutation.GiftMessage(); !ok {
+		v := order.DefaultGiftMessage
+		_c.mutation.SetGiftMessage(v)
+	}
+	if _, ok := _c.mutation.AutoRenew(); !ok {
+		v := order.DefaultAutoRenew
+		_c.mutation.SetAutoRenew(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *OrderCreate) check() error {
 	if _, ok := _c.mutation.TransactionID(); !ok {
@@ -193,6 +287,15 @@ func (_c *OrderCreate) check() error {
 	if _, ok := _c.mutation.VendorID(); !ok {
 		return &ValidationError{Name: "vendor_id", err: errors.New(`ent: missing required field "Order.vendor_id"`)}
 	}
+	if _, ok := _c.mutation.Gift(); !ok {
+		return &ValidationError{Name: "gift", err: errors.New(`ent: missing required field "Order.gift"`)}
+	}
+	if _, ok := _c.mutation.GiftMessage(); !ok {
+		return &ValidationError{Name: "gift_message", err: errors.New(`ent: missing required field "Order.gift_message"`)}
+	}
+	if _, ok := _c.mutation.AutoRenew(); !ok {
+		return &ValidationError{Name: "auto_renew", err: errors.New(`ent: missing required field "Order.auto_renew"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := order.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Order.id": %w`, err)}
@@ -266,6 +369,30 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldCustomerEmail, field.TypeString, value)
 		_node.CustomerEmail = &value
 	}
+	if value, ok := _c.mutation.Gift(); ok {
+		_spec.SetField(order.FieldGift, field.TypeBool, value)
+		_node.Gift = value
+	}
+	if value, ok := _c.mutation.GiftRecipientEmail(); ok {
+		_spec.SetField(order.FieldGiftRecipientEmail, field.TypeString, value)
+		_node.GiftRecipientEmail = &value
+	}
+	if value, ok := _c.mutation.GiftSendAt(); ok {
+		_spec.SetField(order.FieldGiftSendAt, field.TypeTime, value)
+		_node.GiftSendAt = &value
+	}
+	if value, ok := _c.mutation.GiftMessage(); ok {
+		_spec.SetField(order.FieldGiftMessage, field.TypeString, value)
+		_node.GiftMessage = value
+	}
+	if value, ok := _c.mutation.BillingAddress(); ok {
+		_spec.SetField(order.FieldBillingAddress, field.TypeJSON, value)
+		_node.BillingAddress = value
+	}
+	if value, ok := _c.mutation.AutoRenew(); ok {
+		_spec.SetField(order.FieldAutoRenew, field.TypeBool, value)
+		_node.AutoRenew = value
+	}
 	if nodes := _c.mutation.EntriesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -319,6 +446,7 @@ func (_c *OrderCreateBulk) Save(ctx context.Context) ([]*Order, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*OrderMutation)
 				if !ok {
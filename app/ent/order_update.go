@@ -15,6 +15,7 @@ import (
 	"github.com/augustin-wien/augustina-backend/ent/orderentry"
 	"github.com/augustin-wien/augustina-backend/ent/payment"
 	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
 )
 
 // OrderUpdate is the builder for updating Order entities.
@@ -194,6 +195,100 @@ func (_u *OrderUpdate) ClearCustomerEmail() *OrderUpdate {
 	return _u
 }
 
+// SetGift sets the "gift" field.
+func (_u *OrderUpdate) SetGift(v bool) *OrderUpdate {
+	_u.mutation.SetGift(v)
+	return _u
+}
+
+// SetNillableGift sets the "gift" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableGift(v *bool) *OrderUpdate {
+	if v != nil {
+		_u.SetGift(*v)
+	}
+	return _u
+}
+
+// SetGiftRecipientEmail sets the "gift_recipient_email" field.
+func (_u *OrderUpdate) SetGiftRecipientEmail(v string) *OrderUpdate {
+	_u.mutation.SetGiftRecipientEmail(v)
+	return _u
+}
+
+// SetNillableGiftRecipientEmail sets the "gift_recipient_email" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableGiftRecipientEmail(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetGiftRecipientEmail(*v)
+	}
+	return _u
+}
+
+// ClearGiftRecipientEmail clears the value of the "gift_recipient_email" field.
+func (_u *OrderUpdate) ClearGiftRecipientEmail() *OrderUpdate {
+	_u.mutation.ClearGiftRecipientEmail()
+	return _u
+}
+
+// SetGiftSendAt sets the "gift_send_at" field.
+func (_u *OrderUpdate) SetGiftSendAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetGiftSendAt(v)
+	return _u
+}
+
+// SetNillableGiftSendAt sets the "gift_send_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableGiftSendAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetGiftSendAt(*v)
+	}
+	return _u
+}
+
+// ClearGiftSendAt clears the value of the "gift_send_at" field.
+func (_u *OrderUpdate) ClearGiftSendAt() *OrderUpdate {
+	_u.mutation.ClearGiftSendAt()
+	return _u
+}
+
+// SetGiftMessage sets the "gift_message" field.
+func (_u *OrderUpdate) SetGiftMessage(v string) *OrderUpdate {
+	_u.mutation.SetGiftMessage(v)
+	return _u
+}
+
+// SetNillableGiftMessage sets the "gift_message" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableGiftMessage(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetGiftMessage(*v)
+	}
+	return _u
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_u *OrderUpdate) SetBillingAddress(v *schema.BillingAddress) *OrderUpdate {
+	_u.mutation.SetBillingAddress(v)
+	return _u
+}
+
+// ClearBillingAddress clears the value of the "billing_address" field.
+func (_u *OrderUpdate) ClearBillingAddress() *OrderUpdate {
+	_u.mutation.ClearBillingAddress()
+	return _u
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_u *OrderUpdate) SetAutoRenew(v bool) *OrderUpdate {
+	_u.mutation.SetAutoRenew(v)
+	return _u
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableAutoRenew(v *bool) *OrderUpdate {
+	if v != nil {
+		_u.SetAutoRenew(*v)
+	}
+	return _u
+}
+
 // AddEntryIDs adds the "entries" edge to the OrderEntry entity by IDs.
 func (_u *OrderUpdate) AddEntryIDs(ids ...int) *OrderUpdate {
 	_u.mutation.AddEntryIDs(ids...)
@@ -352,6 +447,33 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.CustomerEmailCleared() {
 		_spec.ClearField(order.FieldCustomerEmail, field.TypeString)
 	}
+	if value, ok := _u.mutation.Gift(); ok {
+		_spec.SetField(order.FieldGift, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GiftRecipientEmail(); ok {
+		_spec.SetField(order.FieldGiftRecipientEmail, field.TypeString, value)
+	}
+	if _u.mutation.GiftRecipientEmailCleared() {
+		_spec.ClearField(order.FieldGiftRecipientEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.GiftSendAt(); ok {
+		_spec.SetField(order.FieldGiftSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.GiftSendAtCleared() {
+		_spec.ClearField(order.FieldGiftSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GiftMessage(); ok {
+		_spec.SetField(order.FieldGiftMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingAddress(); ok {
+		_spec.SetField(order.FieldBillingAddress, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingAddressCleared() {
+		_spec.ClearField(order.FieldBillingAddress, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoRenew(); ok {
+		_spec.SetField(order.FieldAutoRenew, field.TypeBool, value)
+	}
 	if _u.mutation.EntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -626,6 +748,100 @@ func (_u *OrderUpdateOne) ClearCustomerEmail() *OrderUpdateOne {
 	return _u
 }
 
+// SetGift sets the "gift" field.
+func (_u *OrderUpdateOne) SetGift(v bool) *OrderUpdateOne {
+	_u.mutation.SetGift(v)
+	return _u
+}
+
+// SetNillableGift sets the "gift" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableGift(v *bool) *OrderUpdateOne {
+	if v != nil {
+		_u.SetGift(*v)
+	}
+	return _u
+}
+
+// SetGiftRecipientEmail sets the "gift_recipient_email" field.
+func (_u *OrderUpdateOne) SetGiftRecipientEmail(v string) *OrderUpdateOne {
+	_u.mutation.SetGiftRecipientEmail(v)
+	return _u
+}
+
+// SetNillableGiftRecipientEmail sets the "gift_recipient_email" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableGiftRecipientEmail(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetGiftRecipientEmail(*v)
+	}
+	return _u
+}
+
+// ClearGiftRecipientEmail clears the value of the "gift_recipient_email" field.
+func (_u *OrderUpdateOne) ClearGiftRecipientEmail() *OrderUpdateOne {
+	_u.mutation.ClearGiftRecipientEmail()
+	return _u
+}
+
+// SetGiftSendAt sets the "gift_send_at" field.
+func (_u *OrderUpdateOne) SetGiftSendAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetGiftSendAt(v)
+	return _u
+}
+
+// SetNillableGiftSendAt sets the "gift_send_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableGiftSendAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetGiftSendAt(*v)
+	}
+	return _u
+}
+
+// ClearGiftSendAt clears the value of the "gift_send_at" field.
+func (_u *OrderUpdateOne) ClearGiftSendAt() *OrderUpdateOne {
+	_u.mutation.ClearGiftSendAt()
+	return _u
+}
+
+// SetGiftMessage sets the "gift_message" field.
+func (_u *OrderUpdateOne) SetGiftMessage(v string) *OrderUpdateOne {
+	_u.mutation.SetGiftMessage(v)
+	return _u
+}
+
+// SetNillableGiftMessage sets the "gift_message" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableGiftMessage(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetGiftMessage(*v)
+	}
+	return _u
+}
+
+// SetBillingAddress sets the "billing_address" field.
+func (_u *OrderUpdateOne) SetBillingAddress(v *schema.BillingAddress) *OrderUpdateOne {
+	_u.mutation.SetBillingAddress(v)
+	return _u
+}
+
+// ClearBillingAddress clears the value of the "billing_address" field.
+func (_u *OrderUpdateOne) ClearBillingAddress() *OrderUpdateOne {
+	_u.mutation.ClearBillingAddress()
+	return _u
+}
+
+// SetAutoRenew sets the "auto_renew" field.
+func (_u *OrderUpdateOne) SetAutoRenew(v bool) *OrderUpdateOne {
+	_u.mutation.SetAutoRenew(v)
+	return _u
+}
+
+// SetNillableAutoRenew sets the "auto_renew" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableAutoRenew(v *bool) *OrderUpdateOne {
+	if v != nil {
+		_u.SetAutoRenew(*v)
+	}
+	return _u
+}
+
 // AddEntryIDs adds the "entries" edge to the OrderEntry entity by IDs.
 func (_u *OrderUpdateOne) AddEntryIDs(ids ...int) *OrderUpdateOne {
 	_u.mutation.AddEntryIDs(ids...)
@@ -814,6 +1030,33 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 	if _u.mutation.CustomerEmailCleared() {
 		_spec.ClearField(order.FieldCustomerEmail, field.TypeString)
 	}
+	if value, ok := _u.mutation.Gift(); ok {
+		_spec.SetField(order.FieldGift, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.GiftRecipientEmail(); ok {
+		_spec.SetField(order.FieldGiftRecipientEmail, field.TypeString, value)
+	}
+	if _u.mutation.GiftRecipientEmailCleared() {
+		_spec.ClearField(order.FieldGiftRecipientEmail, field.TypeString)
+	}
+	if value, ok := _u.mutation.GiftSendAt(); ok {
+		_spec.SetField(order.FieldGiftSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.GiftSendAtCleared() {
+		_spec.ClearField(order.FieldGiftSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.GiftMessage(); ok {
+		_spec.SetField(order.FieldGiftMessage, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BillingAddress(); ok {
+		_spec.SetField(order.FieldBillingAddress, field.TypeJSON, value)
+	}
+	if _u.mutation.BillingAddressCleared() {
+		_spec.ClearField(order.FieldBillingAddress, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AutoRenew(); ok {
+		_spec.SetField(order.FieldAutoRenew, field.TypeBool, value)
+	}
 	if _u.mutation.EntriesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -25,6 +25,14 @@ type OrderEntry struct {
 	Price int `json:"price,omitempty"`
 	// IsSale holds the value of the "is_sale" field.
 	IsSale bool `json:"is_sale,omitempty"`
+	// VatRate holds the value of the "vat_rate" field.
+	VatRate float64 `json:"vat_rate,omitempty"`
+	// Net holds the value of the "net" field.
+	Net int `json:"net,omitempty"`
+	// Tax holds the value of the "tax" field.
+	Tax int `json:"tax,omitempty"`
+	// Gross holds the value of the "gross" field.
+	Gross int `json:"gross,omitempty"`
 	// ItemID holds the value of the "item_id" field.
 	ItemID int `json:"item_id,omitempty"`
 	// SenderID holds the value of the "sender_id" field.
@@ -105,7 +113,9 @@ func (*OrderEntry) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case orderentry.FieldIsSale:
 			values[i] = new(sql.NullBool)
-		case orderentry.FieldID, orderentry.FieldQuantity, orderentry.FieldPrice, orderentry.FieldItemID, orderentry.FieldSenderID, orderentry.FieldReceiverID, orderentry.FieldOrderID:
+		case orderentry.FieldVatRate:
+			values[i] = new(sql.NullFloat64)
+		case orderentry.FieldID, orderentry.FieldQuantity, orderentry.FieldPrice, orderentry.FieldNet, orderentry.FieldTax, orderentry.FieldGross, orderentry.FieldItemID, orderentry.FieldSenderID, orderentry.FieldReceiverID, orderentry.FieldOrderID:
 			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -146,6 +156,30 @@ func (_m *OrderEntry) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsSale = value.Bool
 			}
+		case orderentry.FieldVatRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field vat_rate", values[i])
+			} else if value.Valid {
+				_m.VatRate = value.Float64
+			}
+		case orderentry.FieldNet:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field net", values[i])
+			} else if value.Valid {
+				_m.Net = int(value.Int64)
+			}
+		case orderentry.FieldTax:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tax", values[i])
+			} else if value.Valid {
+				_m.Tax = int(value.Int64)
+			}
+		case orderentry.FieldGross:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field gross", values[i])
+			} else if value.Valid {
+				_m.Gross = int(value.Int64)
+			}
 		case orderentry.FieldItemID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field item_id", values[i])
@@ -235,6 +269,18 @@ func (_m *OrderEntry) String() string {
 	builder.WriteString("is_sale=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsSale))
 	builder.WriteString(", ")
+	builder.WriteString("vat_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.VatRate))
+	builder.WriteString(", ")
+	builder.WriteString("net=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Net))
+	builder.WriteString(", ")
+	builder.WriteString("tax=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tax))
+	builder.WriteString(", ")
+	builder.WriteString("gross=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gross))
+	builder.WriteString(", ")
 	builder.WriteString("item_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
 	builder.WriteString(", ")
@@ -18,6 +18,14 @@ const (
 	FieldPrice = "price"
 	// FieldIsSale holds the string denoting the is_sale field in the database.
 	FieldIsSale = "is_sale"
+	// FieldVatRate holds the string denoting the vat_rate field in the database.
+	FieldVatRate = "vat_rate"
+	// FieldNet holds the string denoting the net field in the database.
+	FieldNet = "net"
+	// FieldTax holds the string denoting the tax field in the database.
+	FieldTax = "tax"
+	// FieldGross holds the string denoting the gross field in the database.
+	FieldGross = "gross"
 	// FieldItemID holds the string denoting the item_id field in the database.
 	FieldItemID = "item"
 	// FieldSenderID holds the string denoting the sender_id field in the database.
@@ -72,6 +80,10 @@ var Columns = []string{
 	FieldQuantity,
 	FieldPrice,
 	FieldIsSale,
+	FieldVatRate,
+	FieldNet,
+	FieldTax,
+	FieldGross,
 	FieldItemID,
 	FieldSenderID,
 	FieldReceiverID,
@@ -89,6 +101,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultVatRate holds the default value on creation for the "vat_rate" field.
+	DefaultVatRate float64
+	// DefaultNet holds the default value on creation for the "net" field.
+	DefaultNet int
+	// DefaultTax holds the default value on creation for the "tax" field.
+	DefaultTax int
+	// DefaultGross holds the default value on creation for the "gross" field.
+	DefaultGross int
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -116,6 +136,26 @@ func ByIsSale(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsSale, opts...).ToFunc()
 }
 
+// ByVatRate orders the results by the vat_rate field.
+func ByVatRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVatRate, opts...).ToFunc()
+}
+
+// ByNet orders the results by the net field.
+func ByNet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNet, opts...).ToFunc()
+}
+
+// ByTax orders the results by the tax field.
+func ByTax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTax, opts...).ToFunc()
+}
+
+// ByGross orders the results by the gross field.
+func ByGross(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGross, opts...).ToFunc()
+}
+
 // ByItemID orders the results by the item_id field.
 func ByItemID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldItemID, opts...).ToFunc()
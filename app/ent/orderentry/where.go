@@ -68,6 +68,26 @@ func IsSale(v bool) predicate.OrderEntry {
 	return predicate.OrderEntry(sql.FieldEQ(FieldIsSale, v))
 }
 
+// VatRate applies equality check predicate on the "vat_rate" field. It's identical to VatRateEQ.
+func VatRate(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldVatRate, v))
+}
+
+// Net applies equality check predicate on the "net" field. It's identical to NetEQ.
+func Net(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldNet, v))
+}
+
+// Tax applies equality check predicate on the "tax" field. It's identical to TaxEQ.
+func Tax(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldTax, v))
+}
+
+// Gross applies equality check predicate on the "gross" field. It's identical to GrossEQ.
+func Gross(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldGross, v))
+}
+
 // ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
 func ItemID(v int) predicate.OrderEntry {
 	return predicate.OrderEntry(sql.FieldEQ(FieldItemID, v))
@@ -178,6 +198,166 @@ func IsSaleNEQ(v bool) predicate.OrderEntry {
 	return predicate.OrderEntry(sql.FieldNEQ(FieldIsSale, v))
 }
 
+// VatRateEQ applies the EQ predicate on the "vat_rate" field.
+func VatRateEQ(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldVatRate, v))
+}
+
+// VatRateNEQ applies the NEQ predicate on the "vat_rate" field.
+func VatRateNEQ(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNEQ(FieldVatRate, v))
+}
+
+// VatRateIn applies the In predicate on the "vat_rate" field.
+func VatRateIn(vs ...float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldIn(FieldVatRate, vs...))
+}
+
+// VatRateNotIn applies the NotIn predicate on the "vat_rate" field.
+func VatRateNotIn(vs ...float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNotIn(FieldVatRate, vs...))
+}
+
+// VatRateGT applies the GT predicate on the "vat_rate" field.
+func VatRateGT(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGT(FieldVatRate, v))
+}
+
+// VatRateGTE applies the GTE predicate on the "vat_rate" field.
+func VatRateGTE(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGTE(FieldVatRate, v))
+}
+
+// VatRateLT applies the LT predicate on the "vat_rate" field.
+func VatRateLT(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLT(FieldVatRate, v))
+}
+
+// VatRateLTE applies the LTE predicate on the "vat_rate" field.
+func VatRateLTE(v float64) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLTE(FieldVatRate, v))
+}
+
+// NetEQ applies the EQ predicate on the "net" field.
+func NetEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldNet, v))
+}
+
+// NetNEQ applies the NEQ predicate on the "net" field.
+func NetNEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNEQ(FieldNet, v))
+}
+
+// NetIn applies the In predicate on the "net" field.
+func NetIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldIn(FieldNet, vs...))
+}
+
+// NetNotIn applies the NotIn predicate on the "net" field.
+func NetNotIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNotIn(FieldNet, vs...))
+}
+
+// NetGT applies the GT predicate on the "net" field.
+func NetGT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGT(FieldNet, v))
+}
+
+// NetGTE applies the GTE predicate on the "net" field.
+func NetGTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGTE(FieldNet, v))
+}
+
+// NetLT applies the LT predicate on the "net" field.
+func NetLT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLT(FieldNet, v))
+}
+
+// NetLTE applies the LTE predicate on the "net" field.
+func NetLTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLTE(FieldNet, v))
+}
+
+// TaxEQ applies the EQ predicate on the "tax" field.
+func TaxEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldTax, v))
+}
+
+// TaxNEQ applies the NEQ predicate on the "tax" field.
+func TaxNEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNEQ(FieldTax, v))
+}
+
+// TaxIn applies the In predicate on the "tax" field.
+func TaxIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldIn(FieldTax, vs...))
+}
+
+// TaxNotIn applies the NotIn predicate on the "tax" field.
+func TaxNotIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNotIn(FieldTax, vs...))
+}
+
+// TaxGT applies the GT predicate on the "tax" field.
+func TaxGT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGT(FieldTax, v))
+}
+
+// TaxGTE applies the GTE predicate on the "tax" field.
+func TaxGTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGTE(FieldTax, v))
+}
+
+// TaxLT applies the LT predicate on the "tax" field.
+func TaxLT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLT(FieldTax, v))
+}
+
+// TaxLTE applies the LTE predicate on the "tax" field.
+func TaxLTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLTE(FieldTax, v))
+}
+
+// GrossEQ applies the EQ predicate on the "gross" field.
+func GrossEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldEQ(FieldGross, v))
+}
+
+// GrossNEQ applies the NEQ predicate on the "gross" field.
+func GrossNEQ(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNEQ(FieldGross, v))
+}
+
+// GrossIn applies the In predicate on the "gross" field.
+func GrossIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldIn(FieldGross, vs...))
+}
+
+// GrossNotIn applies the NotIn predicate on the "gross" field.
+func GrossNotIn(vs ...int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldNotIn(FieldGross, vs...))
+}
+
+// GrossGT applies the GT predicate on the "gross" field.
+func GrossGT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGT(FieldGross, v))
+}
+
+// GrossGTE applies the GTE predicate on the "gross" field.
+func GrossGTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldGTE(FieldGross, v))
+}
+
+// GrossLT applies the LT predicate on the "gross" field.
+func GrossLT(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLT(FieldGross, v))
+}
+
+// GrossLTE applies the LTE predicate on the "gross" field.
+func GrossLTE(v int) predicate.OrderEntry {
+	return predicate.OrderEntry(sql.FieldLTE(FieldGross, v))
+}
+
 // ItemIDEQ applies the EQ predicate on the "item_id" field.
 func ItemIDEQ(v int) predicate.OrderEntry {
 	return predicate.OrderEntry(sql.FieldEQ(FieldItemID, v))
@@ -40,6 +40,62 @@ func (_c *OrderEntryCreate) SetIsSale(v bool) *OrderEntryCreate {
 	return _c
 }
 
+// SetVatRate sets the "vat_rate" field.
+func (_c *OrderEntryCreate) SetVatRate(v float64) *OrderEntryCreate {
+	_c.mutation.SetVatRate(v)
+	return _c
+}
+
+// SetNillableVatRate sets the "vat_rate" field if the given value is not nil.
+func (_c *OrderEntryCreate) SetNillableVatRate(v *float64) *OrderEntryCreate {
+	if v != nil {
+		_c.SetVatRate(*v)
+	}
+	return _c
+}
+
+// SetNet sets the "net" field.
+func (_c *OrderEntryCreate) SetNet(v int) *OrderEntryCreate {
+	_c.mutation.SetNet(v)
+	return _c
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_c *OrderEntryCreate) SetNillableNet(v *int) *OrderEntryCreate {
+	if v != nil {
+		_c.SetNet(*v)
+	}
+	return _c
+}
+
+// SetTax sets the "tax" field.
+func (_c *OrderEntryCreate) SetTax(v int) *OrderEntryCreate {
+	_c.mutation.SetTax(v)
+	return _c
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (_c *OrderEntryCreate) SetNillableTax(v *int) *OrderEntryCreate {
+	if v != nil {
+		_c.SetTax(*v)
+	}
+	return _c
+}
+
+// SetGross sets the "gross" field.
+func (_c *OrderEntryCreate) SetGross(v int) *OrderEntryCreate {
+	_c.mutation.SetGross(v)
+	return _c
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_c *OrderEntryCreate) SetNillableGross(v *int) *OrderEntryCreate {
+	if v != nil {
+		_c.SetGross(*v)
+	}
+	return _c
+}
+
 // SetItemID sets the "item_id" field.
 func (_c *OrderEntryCreate) SetItemID(v int) *OrderEntryCreate {
 	_c.mutation.SetItemID(v)
@@ -105,6 +161,7 @@ func (_c *OrderEntryCreate) Mutation() *OrderEntryMutation {
 
 // Save creates the OrderEntry in the database.
 func (_c *OrderEntryCreate) Save(ctx context.Context) (*OrderEntry, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -130,6 +187,26 @@ func (_c *OrderEntryCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *OrderEntryCreate) defaults() {
+	if _, ok := _c.mutation.VatRate(); !ok {
+		v := orderentry.DefaultVatRate
+		_c.mutation.SetVatRate(v)
+	}
+	if _, ok := _c.mutation.Net(); !ok {
+		v := orderentry.DefaultNet
+		_c.mutation.SetNet(v)
+	}
+	if _, ok := _c.mutation.Tax(); !ok {
+		v := orderentry.DefaultTax
+		_c.mutation.SetTax(v)
+	}
+	if _, ok := _c.mutation.Gross(); !ok {
+		v := orderentry.DefaultGross
+		_c.mutation.SetGross(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *OrderEntryCreate) check() error {
 	if _, ok := _c.mutation.Quantity(); !ok {
@@ -141,6 +218,18 @@ func (_c *OrderEntryCreate) check() error {
 	if _, ok := _c.mutation.IsSale(); !ok {
 		return &ValidationError{Name: "is_sale", err: errors.New(`ent: missing required field "OrderEntry.is_sale"`)}
 	}
+	if _, ok := _c.mutation.VatRate(); !ok {
+		return &ValidationError{Name: "vat_rate", err: errors.New(`ent: missing required field "OrderEntry.vat_rate"`)}
+	}
+	if _, ok := _c.mutation.Net(); !ok {
+		return &ValidationError{Name: "net", err: errors.New(`ent: missing required field "OrderEntry.net"`)}
+	}
+	if _, ok := _c.mutation.Tax(); !ok {
+		return &ValidationError{Name: "tax", err: errors.New(`ent: missing required field "OrderEntry.tax"`)}
+	}
+	if _, ok := _c.mutation.Gross(); !ok {
+		return &ValidationError{Name: "gross", err: errors.New(`ent: missing required field "OrderEntry.gross"`)}
+	}
 	if _, ok := _c.mutation.ItemID(); !ok {
 		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "OrderEntry.item_id"`)}
 	}
@@ -208,6 +297,22 @@ func (_c *OrderEntryCreate) createSpec() (*OrderEntry, *sqlgraph.CreateSpec) {
 		_spec.SetField(orderentry.FieldIsSale, field.TypeBool, value)
 		_node.IsSale = value
 	}
+	if value, ok := _c.mutation.VatRate(); ok {
+		_spec.SetField(orderentry.FieldVatRate, field.TypeFloat64, value)
+		_node.VatRate = value
+	}
+	if value, ok := _c.mutation.Net(); ok {
+		_spec.SetField(orderentry.FieldNet, field.TypeInt, value)
+		_node.Net = value
+	}
+	if value, ok := _c.mutation.Tax(); ok {
+		_spec.SetField(orderentry.FieldTax, field.TypeInt, value)
+		_node.Tax = value
+	}
+	if value, ok := _c.mutation.Gross(); ok {
+		_spec.SetField(orderentry.FieldGross, field.TypeInt, value)
+		_node.Gross = value
+	}
 	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -297,6 +402,7 @@ func (_c *OrderEntryCreateBulk) Save(ctx context.Context) ([]*OrderEntry, error)
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*OrderEntryMutation)
 				if !ok {
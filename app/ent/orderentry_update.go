@@ -86,6 +86,90 @@ func (_u *OrderEntryUpdate) SetNillableIsSale(v *bool) *OrderEntryUpdate {
 	return _u
 }
 
+// SetVatRate sets the "vat_rate" field.
+func (_u *OrderEntryUpdate) SetVatRate(v float64) *OrderEntryUpdate {
+	_u.mutation.ResetVatRate()
+	_u.mutation.SetVatRate(v)
+	return _u
+}
+
+// SetNillableVatRate sets the "vat_rate" field if the given value is not nil.
+func (_u *OrderEntryUpdate) SetNillableVatRate(v *float64) *OrderEntryUpdate {
+	if v != nil {
+		_u.SetVatRate(*v)
+	}
+	return _u
+}
+
+// AddVatRate adds value to the "vat_rate" field.
+func (_u *OrderEntryUpdate) AddVatRate(v float64) *OrderEntryUpdate {
+	_u.mutation.AddVatRate(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *OrderEntryUpdate) SetNet(v int) *OrderEntryUpdate {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *OrderEntryUpdate) SetNillableNet(v *int) *OrderEntryUpdate {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *OrderEntryUpdate) AddNet(v int) *OrderEntryUpdate {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetTax sets the "tax" field.
+func (_u *OrderEntryUpdate) SetTax(v int) *OrderEntryUpdate {
+	_u.mutation.ResetTax()
+	_u.mutation.SetTax(v)
+	return _u
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (_u *OrderEntryUpdate) SetNillableTax(v *int) *OrderEntryUpdate {
+	if v != nil {
+		_u.SetTax(*v)
+	}
+	return _u
+}
+
+// AddTax adds value to the "tax" field.
+func (_u *OrderEntryUpdate) AddTax(v int) *OrderEntryUpdate {
+	_u.mutation.AddTax(v)
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *OrderEntryUpdate) SetGross(v int) *OrderEntryUpdate {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *OrderEntryUpdate) SetNillableGross(v *int) *OrderEntryUpdate {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *OrderEntryUpdate) AddGross(v int) *OrderEntryUpdate {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
 // SetItemID sets the "item_id" field.
 func (_u *OrderEntryUpdate) SetItemID(v int) *OrderEntryUpdate {
 	_u.mutation.SetItemID(v)
@@ -265,6 +349,30 @@ func (_u *OrderEntryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.IsSale(); ok {
 		_spec.SetField(orderentry.FieldIsSale, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VatRate(); ok {
+		_spec.SetField(orderentry.FieldVatRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRate(); ok {
+		_spec.AddField(orderentry.FieldVatRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(orderentry.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(orderentry.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Tax(); ok {
+		_spec.SetField(orderentry.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTax(); ok {
+		_spec.AddField(orderentry.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(orderentry.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(orderentry.FieldGross, field.TypeInt, value)
+	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -457,6 +565,90 @@ func (_u *OrderEntryUpdateOne) SetNillableIsSale(v *bool) *OrderEntryUpdateOne {
 	return _u
 }
 
+// SetVatRate sets the "vat_rate" field.
+func (_u *OrderEntryUpdateOne) SetVatRate(v float64) *OrderEntryUpdateOne {
+	_u.mutation.ResetVatRate()
+	_u.mutation.SetVatRate(v)
+	return _u
+}
+
+// SetNillableVatRate sets the "vat_rate" field if the given value is not nil.
+func (_u *OrderEntryUpdateOne) SetNillableVatRate(v *float64) *OrderEntryUpdateOne {
+	if v != nil {
+		_u.SetVatRate(*v)
+	}
+	return _u
+}
+
+// AddVatRate adds value to the "vat_rate" field.
+func (_u *OrderEntryUpdateOne) AddVatRate(v float64) *OrderEntryUpdateOne {
+	_u.mutation.AddVatRate(v)
+	return _u
+}
+
+// SetNet sets the "net" field.
+func (_u *OrderEntryUpdateOne) SetNet(v int) *OrderEntryUpdateOne {
+	_u.mutation.ResetNet()
+	_u.mutation.SetNet(v)
+	return _u
+}
+
+// SetNillableNet sets the "net" field if the given value is not nil.
+func (_u *OrderEntryUpdateOne) SetNillableNet(v *int) *OrderEntryUpdateOne {
+	if v != nil {
+		_u.SetNet(*v)
+	}
+	return _u
+}
+
+// AddNet adds value to the "net" field.
+func (_u *OrderEntryUpdateOne) AddNet(v int) *OrderEntryUpdateOne {
+	_u.mutation.AddNet(v)
+	return _u
+}
+
+// SetTax sets the "tax" field.
+func (_u *OrderEntryUpdateOne) SetTax(v int) *OrderEntryUpdateOne {
+	_u.mutation.ResetTax()
+	_u.mutation.SetTax(v)
+	return _u
+}
+
+// SetNillableTax sets the "tax" field if the given value is not nil.
+func (_u *OrderEntryUpdateOne) SetNillableTax(v *int) *OrderEntryUpdateOne {
+	if v != nil {
+		_u.SetTax(*v)
+	}
+	return _u
+}
+
+// AddTax adds value to the "tax" field.
+func (_u *OrderEntryUpdateOne) AddTax(v int) *OrderEntryUpdateOne {
+	_u.mutation.AddTax(v)
+	return _u
+}
+
+// SetGross sets the "gross" field.
+func (_u *OrderEntryUpdateOne) SetGross(v int) *OrderEntryUpdateOne {
+	_u.mutation.ResetGross()
+	_u.mutation.SetGross(v)
+	return _u
+}
+
+// SetNillableGross sets the "gross" field if the given value is not nil.
+func (_u *OrderEntryUpdateOne) SetNillableGross(v *int) *OrderEntryUpdateOne {
+	if v != nil {
+		_u.SetGross(*v)
+	}
+	return _u
+}
+
+// AddGross adds value to the "gross" field.
+func (_u *OrderEntryUpdateOne) AddGross(v int) *OrderEntryUpdateOne {
+	_u.mutation.AddGross(v)
+	return _u
+}
+
 // SetItemID sets the "item_id" field.
 func (_u *OrderEntryUpdateOne) SetItemID(v int) *OrderEntryUpdateOne {
 	_u.mutation.SetItemID(v)
@@ -666,6 +858,30 @@ func (_u *OrderEntryUpdateOne) sqlSave(ctx context.Context) (_node *OrderEntry,
 	if value, ok := _u.mutation.IsSale(); ok {
 		_spec.SetField(orderentry.FieldIsSale, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VatRate(); ok {
+		_spec.SetField(orderentry.FieldVatRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedVatRate(); ok {
+		_spec.AddField(orderentry.FieldVatRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Net(); ok {
+		_spec.SetField(orderentry.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNet(); ok {
+		_spec.AddField(orderentry.FieldNet, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Tax(); ok {
+		_spec.SetField(orderentry.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTax(); ok {
+		_spec.AddField(orderentry.FieldTax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Gross(); ok {
+		_spec.SetField(orderentry.FieldGross, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedGross(); ok {
+		_spec.AddField(orderentry.FieldGross, field.TypeInt, value)
+	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paymentmandate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldID, id))
+}
+
+// CustomerID applies equality check predicate on the "customer_id" field. It's identical to CustomerIDEQ.
+func CustomerID(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldProvider, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldToken, v))
+}
+
+// CardNumber applies equality check predicate on the "card_number" field. It's identical to CardNumberEQ.
+func CardNumber(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCardNumber, v))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldOrderID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// CustomerIDEQ applies the EQ predicate on the "customer_id" field.
+func CustomerIDEQ(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCustomerID, v))
+}
+
+// CustomerIDNEQ applies the NEQ predicate on the "customer_id" field.
+func CustomerIDNEQ(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldCustomerID, v))
+}
+
+// CustomerIDIn applies the In predicate on the "customer_id" field.
+func CustomerIDIn(vs ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDNotIn applies the NotIn predicate on the "customer_id" field.
+func CustomerIDNotIn(vs ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldCustomerID, vs...))
+}
+
+// CustomerIDGT applies the GT predicate on the "customer_id" field.
+func CustomerIDGT(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldCustomerID, v))
+}
+
+// CustomerIDGTE applies the GTE predicate on the "customer_id" field.
+func CustomerIDGTE(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldCustomerID, v))
+}
+
+// CustomerIDLT applies the LT predicate on the "customer_id" field.
+func CustomerIDLT(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldCustomerID, v))
+}
+
+// CustomerIDLTE applies the LTE predicate on the "customer_id" field.
+func CustomerIDLTE(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldCustomerID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContainsFold(FieldToken, v))
+}
+
+// CardNumberEQ applies the EQ predicate on the "card_number" field.
+func CardNumberEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCardNumber, v))
+}
+
+// CardNumberNEQ applies the NEQ predicate on the "card_number" field.
+func CardNumberNEQ(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldCardNumber, v))
+}
+
+// CardNumberIn applies the In predicate on the "card_number" field.
+func CardNumberIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldCardNumber, vs...))
+}
+
+// CardNumberNotIn applies the NotIn predicate on the "card_number" field.
+func CardNumberNotIn(vs ...string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldCardNumber, vs...))
+}
+
+// CardNumberGT applies the GT predicate on the "card_number" field.
+func CardNumberGT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldCardNumber, v))
+}
+
+// CardNumberGTE applies the GTE predicate on the "card_number" field.
+func CardNumberGTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldCardNumber, v))
+}
+
+// CardNumberLT applies the LT predicate on the "card_number" field.
+func CardNumberLT(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldCardNumber, v))
+}
+
+// CardNumberLTE applies the LTE predicate on the "card_number" field.
+func CardNumberLTE(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldCardNumber, v))
+}
+
+// CardNumberContains applies the Contains predicate on the "card_number" field.
+func CardNumberContains(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContains(FieldCardNumber, v))
+}
+
+// CardNumberHasPrefix applies the HasPrefix predicate on the "card_number" field.
+func CardNumberHasPrefix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasPrefix(FieldCardNumber, v))
+}
+
+// CardNumberHasSuffix applies the HasSuffix predicate on the "card_number" field.
+func CardNumberHasSuffix(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldHasSuffix(FieldCardNumber, v))
+}
+
+// CardNumberEqualFold applies the EqualFold predicate on the "card_number" field.
+func CardNumberEqualFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEqualFold(FieldCardNumber, v))
+}
+
+// CardNumberContainsFold applies the ContainsFold predicate on the "card_number" field.
+func CardNumberContainsFold(v string) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldContainsFold(FieldCardNumber, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v int) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotNull(FieldOrderID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaymentMandate) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaymentMandate) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaymentMandate) predicate.PaymentMandate {
+	return predicate.PaymentMandate(sql.NotPredicates(p))
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/paymentmandate"
+)
+
+// PaymentMandateCreate is the builder for creating a PaymentMandate entity.
+type PaymentMandateCreate struct {
+	config
+	mutation *PaymentMandateMutation
+	hooks    []Hook
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_c *PaymentMandateCreate) SetCustomerID(v int) *PaymentMandateCreate {
+	_c.mutation.SetCustomerID(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *PaymentMandateCreate) SetProvider(v string) *PaymentMandateCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *PaymentMandateCreate) SetToken(v string) *PaymentMandateCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetCardNumber sets the "card_number" field.
+func (_c *PaymentMandateCreate) SetCardNumber(v string) *PaymentMandateCreate {
+	_c.mutation.SetCardNumber(v)
+	return _c
+}
+
+// SetNillableCardNumber sets the "card_number" field if the given value is not nil.
+func (_c *PaymentMandateCreate) SetNillableCardNumber(v *string) *PaymentMandateCreate {
+	if v != nil {
+		_c.SetCardNumber(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order_id" field.
+func (_c *PaymentMandateCreate) SetOrderID(v int) *PaymentMandateCreate {
+	_c.mutation.SetOrderID(v)
+	return _c
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_c *PaymentMandateCreate) SetNillableOrderID(v *int) *PaymentMandateCreate {
+	if v != nil {
+		_c.SetOrderID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaymentMandateCreate) SetCreatedAt(v time.Time) *PaymentMandateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *PaymentMandateCreate) SetRevokedAt(v time.Time) *PaymentMandateCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *PaymentMandateCreate) SetNillableRevokedAt(v *time.Time) *PaymentMandateCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaymentMandateCreate) SetID(v int) *PaymentMandateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PaymentMandateMutation object of the builder.
+func (_c *PaymentMandateCreate) Mutation() *PaymentMandateMutation {
+	return _c.mutation
+}
+
+// Save creates the PaymentMandate in the database.
+func (_c *PaymentMandateCreate) Save(ctx context.Context) (*PaymentMandate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaymentMandateCreate) SaveX(ctx context.Context) *PaymentMandate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentMandateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentMandateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaymentMandateCreate) defaults() {
+	if _, ok := _c.mutation.CardNumber(); !ok {
+		v := paymentmandate.DefaultCardNumber
+		_c.mutation.SetCardNumber(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaymentMandateCreate) check() error {
+	if _, ok := _c.mutation.CustomerID(); !ok {
+		return &ValidationError{Name: "customer_id", err: errors.New(`ent: missing required field "PaymentMandate.customer_id"`)}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "PaymentMandate.provider"`)}
+	}
+	if _, ok := _c.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "PaymentMandate.token"`)}
+	}
+	if _, ok := _c.mutation.CardNumber(); !ok {
+		return &ValidationError{Name: "card_number", err: errors.New(`ent: missing required field "PaymentMandate.card_number"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PaymentMandate.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := paymentmandate.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PaymentMandate.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PaymentMandateCreate) sqlSave(ctx context.Context) (*PaymentMandate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaymentMandateCreate) createSpec() (*PaymentMandate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaymentMandate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paymentmandate.Table, sqlgraph.NewFieldSpec(paymentmandate.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CustomerID(); ok {
+		_spec.SetField(paymentmandate.FieldCustomerID, field.TypeInt, value)
+		_node.CustomerID = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(paymentmandate.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(paymentmandate.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.CardNumber(); ok {
+		_spec.SetField(paymentmandate.FieldCardNumber, field.TypeString, value)
+		_node.CardNumber = value
+	}
+	if value, ok := _c.mutation.OrderID(); ok {
+		_spec.SetField(paymentmandate.FieldOrderID, field.TypeInt, value)
+		_node.OrderID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentmandate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(paymentmandate.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	return _node, _spec
+}
+
+// PaymentMandateCreateBulk is the builder for creating many PaymentMandate entities in bulk.
+type PaymentMandateCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentMandateCreate
+}
+
+// Save creates the PaymentMandate entities in the database.
+func (_c *PaymentMandateCreateBulk) Save(ctx context.Context) ([]*PaymentMandate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaymentMandate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentMandateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaymentMandateCreateBulk) SaveX(ctx context.Context) []*PaymentMandate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaymentMandateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaymentMandateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,490 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/paymentmandate"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// PaymentMandateUpdate is the builder for updating PaymentMandate entities.
+type PaymentMandateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentMandateMutation
+}
+
+// Where appends a list predicates to the PaymentMandateUpdate builder.
+func (_u *PaymentMandateUpdate) Where(ps ...predicate.PaymentMandate) *PaymentMandateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *PaymentMandateUpdate) SetCustomerID(v int) *PaymentMandateUpdate {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableCustomerID(v *int) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *PaymentMandateUpdate) AddCustomerID(v int) *PaymentMandateUpdate {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentMandateUpdate) SetProvider(v string) *PaymentMandateUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableProvider(v *string) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *PaymentMandateUpdate) SetToken(v string) *PaymentMandateUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableToken(v *string) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetCardNumber sets the "card_number" field.
+func (_u *PaymentMandateUpdate) SetCardNumber(v string) *PaymentMandateUpdate {
+	_u.mutation.SetCardNumber(v)
+	return _u
+}
+
+// SetNillableCardNumber sets the "card_number" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableCardNumber(v *string) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetCardNumber(*v)
+	}
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PaymentMandateUpdate) SetOrderID(v int) *PaymentMandateUpdate {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableOrderID(v *int) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *PaymentMandateUpdate) AddOrderID(v int) *PaymentMandateUpdate {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *PaymentMandateUpdate) ClearOrderID() *PaymentMandateUpdate {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PaymentMandateUpdate) SetCreatedAt(v time.Time) *PaymentMandateUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableCreatedAt(v *time.Time) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PaymentMandateUpdate) SetRevokedAt(v time.Time) *PaymentMandateUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PaymentMandateUpdate) SetNillableRevokedAt(v *time.Time) *PaymentMandateUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PaymentMandateUpdate) ClearRevokedAt() *PaymentMandateUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the PaymentMandateMutation object of the builder.
+func (_u *PaymentMandateUpdate) Mutation() *PaymentMandateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaymentMandateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentMandateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaymentMandateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentMandateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PaymentMandateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paymentmandate.Table, paymentmandate.Columns, sqlgraph.NewFieldSpec(paymentmandate.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(paymentmandate.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(paymentmandate.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentmandate.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(paymentmandate.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CardNumber(); ok {
+		_spec.SetField(paymentmandate.FieldCardNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(paymentmandate.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(paymentmandate.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(paymentmandate.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentmandate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(paymentmandate.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(paymentmandate.FieldRevokedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentmandate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaymentMandateUpdateOne is the builder for updating a single PaymentMandate entity.
+type PaymentMandateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentMandateMutation
+}
+
+// SetCustomerID sets the "customer_id" field.
+func (_u *PaymentMandateUpdateOne) SetCustomerID(v int) *PaymentMandateUpdateOne {
+	_u.mutation.ResetCustomerID()
+	_u.mutation.SetCustomerID(v)
+	return _u
+}
+
+// SetNillableCustomerID sets the "customer_id" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableCustomerID(v *int) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetCustomerID(*v)
+	}
+	return _u
+}
+
+// AddCustomerID adds value to the "customer_id" field.
+func (_u *PaymentMandateUpdateOne) AddCustomerID(v int) *PaymentMandateUpdateOne {
+	_u.mutation.AddCustomerID(v)
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PaymentMandateUpdateOne) SetProvider(v string) *PaymentMandateUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableProvider(v *string) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *PaymentMandateUpdateOne) SetToken(v string) *PaymentMandateUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableToken(v *string) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// SetCardNumber sets the "card_number" field.
+func (_u *PaymentMandateUpdateOne) SetCardNumber(v string) *PaymentMandateUpdateOne {
+	_u.mutation.SetCardNumber(v)
+	return _u
+}
+
+// SetNillableCardNumber sets the "card_number" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableCardNumber(v *string) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetCardNumber(*v)
+	}
+	return _u
+}
+
+// SetOrderID sets the "order_id" field.
+func (_u *PaymentMandateUpdateOne) SetOrderID(v int) *PaymentMandateUpdateOne {
+	_u.mutation.ResetOrderID()
+	_u.mutation.SetOrderID(v)
+	return _u
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableOrderID(v *int) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetOrderID(*v)
+	}
+	return _u
+}
+
+// AddOrderID adds value to the "order_id" field.
+func (_u *PaymentMandateUpdateOne) AddOrderID(v int) *PaymentMandateUpdateOne {
+	_u.mutation.AddOrderID(v)
+	return _u
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (_u *PaymentMandateUpdateOne) ClearOrderID() *PaymentMandateUpdateOne {
+	_u.mutation.ClearOrderID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PaymentMandateUpdateOne) SetCreatedAt(v time.Time) *PaymentMandateUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableCreatedAt(v *time.Time) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PaymentMandateUpdateOne) SetRevokedAt(v time.Time) *PaymentMandateUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PaymentMandateUpdateOne) SetNillableRevokedAt(v *time.Time) *PaymentMandateUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PaymentMandateUpdateOne) ClearRevokedAt() *PaymentMandateUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the PaymentMandateMutation object of the builder.
+func (_u *PaymentMandateUpdateOne) Mutation() *PaymentMandateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PaymentMandateUpdate builder.
+func (_u *PaymentMandateUpdateOne) Where(ps ...predicate.PaymentMandate) *PaymentMandateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaymentMandateUpdateOne) Select(field string, fields ...string) *PaymentMandateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaymentMandate entity.
+func (_u *PaymentMandateUpdateOne) Save(ctx context.Context) (*PaymentMandate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaymentMandateUpdateOne) SaveX(ctx context.Context) *PaymentMandate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaymentMandateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaymentMandateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PaymentMandateUpdateOne) sqlSave(ctx context.Context) (_node *PaymentMandate, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paymentmandate.Table, paymentmandate.Columns, sqlgraph.NewFieldSpec(paymentmandate.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaymentMandate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paymentmandate.FieldID)
+		for _, f := range fields {
+			if !paymentmandate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paymentmandate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CustomerID(); ok {
+		_spec.SetField(paymentmandate.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCustomerID(); ok {
+		_spec.AddField(paymentmandate.FieldCustomerID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(paymentmandate.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(paymentmandate.FieldToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CardNumber(); ok {
+		_spec.SetField(paymentmandate.FieldCardNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.OrderID(); ok {
+		_spec.SetField(paymentmandate.FieldOrderID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOrderID(); ok {
+		_spec.AddField(paymentmandate.FieldOrderID, field.TypeInt, value)
+	}
+	if _u.mutation.OrderIDCleared() {
+		_spec.ClearField(paymentmandate.FieldOrderID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(paymentmandate.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(paymentmandate.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(paymentmandate.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &PaymentMandate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paymentmandate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
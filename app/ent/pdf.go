@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -19,7 +20,7 @@ type PDF struct {
 	// Path holds the value of the "path" field.
 	Path string `json:"path,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
-	Timestamp    string `json:"timestamp,omitempty"`
+	Timestamp    time.Time `json:"timestamp,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -30,8 +31,10 @@ func (*PDF) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case pdf.FieldID:
 			values[i] = new(sql.NullInt64)
-		case pdf.FieldPath, pdf.FieldTimestamp:
+		case pdf.FieldPath:
 			values[i] = new(sql.NullString)
+		case pdf.FieldTimestamp:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -60,10 +63,10 @@ func (_m *PDF) assignValues(columns []string, values []any) error {
 				_m.Path = value.String
 			}
 		case pdf.FieldTimestamp:
-			if value, ok := values[i].(*sql.NullString); !ok {
+			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
 			} else if value.Valid {
-				_m.Timestamp = value.String
+				_m.Timestamp = value.Time
 			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
@@ -105,7 +108,7 @@ func (_m *PDF) String() string {
 	builder.WriteString(_m.Path)
 	builder.WriteString(", ")
 	builder.WriteString("timestamp=")
-	builder.WriteString(_m.Timestamp)
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package pdf
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -37,6 +39,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -3,6 +3,8 @@
 package pdf
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"github.com/augustin-wien/augustina-backend/ent/predicate"
 )
@@ -58,7 +60,7 @@ func Path(v string) predicate.PDF {
 }
 
 // Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
-func Timestamp(v string) predicate.PDF {
+func Timestamp(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldEQ(FieldTimestamp, v))
 }
 
@@ -128,70 +130,45 @@ func PathContainsFold(v string) predicate.PDF {
 }
 
 // TimestampEQ applies the EQ predicate on the "timestamp" field.
-func TimestampEQ(v string) predicate.PDF {
+func TimestampEQ(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldEQ(FieldTimestamp, v))
 }
 
 // TimestampNEQ applies the NEQ predicate on the "timestamp" field.
-func TimestampNEQ(v string) predicate.PDF {
+func TimestampNEQ(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldNEQ(FieldTimestamp, v))
 }
 
 // TimestampIn applies the In predicate on the "timestamp" field.
-func TimestampIn(vs ...string) predicate.PDF {
+func TimestampIn(vs ...time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldIn(FieldTimestamp, vs...))
 }
 
 // TimestampNotIn applies the NotIn predicate on the "timestamp" field.
-func TimestampNotIn(vs ...string) predicate.PDF {
+func TimestampNotIn(vs ...time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldNotIn(FieldTimestamp, vs...))
 }
 
 // TimestampGT applies the GT predicate on the "timestamp" field.
-func TimestampGT(v string) predicate.PDF {
+func TimestampGT(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldGT(FieldTimestamp, v))
 }
 
 // TimestampGTE applies the GTE predicate on the "timestamp" field.
-func TimestampGTE(v string) predicate.PDF {
+func TimestampGTE(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldGTE(FieldTimestamp, v))
 }
 
 // TimestampLT applies the LT predicate on the "timestamp" field.
-func TimestampLT(v string) predicate.PDF {
+func TimestampLT(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldLT(FieldTimestamp, v))
 }
 
 // TimestampLTE applies the LTE predicate on the "timestamp" field.
-func TimestampLTE(v string) predicate.PDF {
+func TimestampLTE(v time.Time) predicate.PDF {
 	return predicate.PDF(sql.FieldLTE(FieldTimestamp, v))
 }
 
-// TimestampContains applies the Contains predicate on the "timestamp" field.
-func TimestampContains(v string) predicate.PDF {
-	return predicate.PDF(sql.FieldContains(FieldTimestamp, v))
-}
-
-// TimestampHasPrefix applies the HasPrefix predicate on the "timestamp" field.
-func TimestampHasPrefix(v string) predicate.PDF {
-	return predicate.PDF(sql.FieldHasPrefix(FieldTimestamp, v))
-}
-
-// TimestampHasSuffix applies the HasSuffix predicate on the "timestamp" field.
-func TimestampHasSuffix(v string) predicate.PDF {
-	return predicate.PDF(sql.FieldHasSuffix(FieldTimestamp, v))
-}
-
-// TimestampEqualFold applies the EqualFold predicate on the "timestamp" field.
-func TimestampEqualFold(v string) predicate.PDF {
-	return predicate.PDF(sql.FieldEqualFold(FieldTimestamp, v))
-}
-
-// TimestampContainsFold applies the ContainsFold predicate on the "timestamp" field.
-func TimestampContainsFold(v string) predicate.PDF {
-	return predicate.PDF(sql.FieldContainsFold(FieldTimestamp, v))
-}
-
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PDF) predicate.PDF {
 	return predicate.PDF(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -26,11 +27,19 @@ func (_c *PDFCreate) SetPath(v string) *PDFCreate {
 }
 
 // SetTimestamp sets the "timestamp" field.
-func (_c *PDFCreate) SetTimestamp(v string) *PDFCreate {
+func (_c *PDFCreate) SetTimestamp(v time.Time) *PDFCreate {
 	_c.mutation.SetTimestamp(v)
 	return _c
 }
 
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_c *PDFCreate) SetNillableTimestamp(v *time.Time) *PDFCreate {
+	if v != nil {
+		_c.SetTimestamp(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PDFCreate) SetID(v int) *PDFCreate {
 	_c.mutation.SetID(v)
@@ -44,6 +53,7 @@ func (_c *PDFCreate) Mutation() *PDFMutation {
 
 // Save creates the PDF in the database.
 func (_c *PDFCreate) Save(ctx context.Context) (*PDF, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -69,6 +79,14 @@ func (_c *PDFCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *PDFCreate) defaults() {
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		v := pdf.DefaultTimestamp()
+		_c.mutation.SetTimestamp(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *PDFCreate) check() error {
 	if _, ok := _c.mutation.Path(); !ok {
@@ -119,7 +137,7 @@ func (_c *PDFCreate) createSpec() (*PDF, *sqlgraph.CreateSpec) {
 		_node.Path = value
 	}
 	if value, ok := _c.mutation.Timestamp(); ok {
-		_spec.SetField(pdf.FieldTimestamp, field.TypeString, value)
+		_spec.SetField(pdf.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
 	}
 	return _node, _spec
@@ -143,6 +161,7 @@ func (_c *PDFCreateBulk) Save(ctx context.Context) ([]*PDF, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*PDFMutation)
 				if !ok {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -42,13 +43,13 @@ func (_u *PDFUpdate) SetNillablePath(v *string) *PDFUpdate {
 }
 
 // SetTimestamp sets the "timestamp" field.
-func (_u *PDFUpdate) SetTimestamp(v string) *PDFUpdate {
+func (_u *PDFUpdate) SetTimestamp(v time.Time) *PDFUpdate {
 	_u.mutation.SetTimestamp(v)
 	return _u
 }
 
 // SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
-func (_u *PDFUpdate) SetNillableTimestamp(v *string) *PDFUpdate {
+func (_u *PDFUpdate) SetNillableTimestamp(v *time.Time) *PDFUpdate {
 	if v != nil {
 		_u.SetTimestamp(*v)
 	}
@@ -100,7 +101,7 @@ func (_u *PDFUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		_spec.SetField(pdf.FieldPath, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Timestamp(); ok {
-		_spec.SetField(pdf.FieldTimestamp, field.TypeString, value)
+		_spec.SetField(pdf.FieldTimestamp, field.TypeTime, value)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -137,13 +138,13 @@ func (_u *PDFUpdateOne) SetNillablePath(v *string) *PDFUpdateOne {
 }
 
 // SetTimestamp sets the "timestamp" field.
-func (_u *PDFUpdateOne) SetTimestamp(v string) *PDFUpdateOne {
+func (_u *PDFUpdateOne) SetTimestamp(v time.Time) *PDFUpdateOne {
 	_u.mutation.SetTimestamp(v)
 	return _u
 }
 
 // SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
-func (_u *PDFUpdateOne) SetNillableTimestamp(v *string) *PDFUpdateOne {
+func (_u *PDFUpdateOne) SetNillableTimestamp(v *time.Time) *PDFUpdateOne {
 	if v != nil {
 		_u.SetTimestamp(*v)
 	}
@@ -225,7 +226,7 @@ func (_u *PDFUpdateOne) sqlSave(ctx context.Context) (_node *PDF, err error) {
 		_spec.SetField(pdf.FieldPath, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Timestamp(); ok {
-		_spec.SetField(pdf.FieldTimestamp, field.TypeString, value)
+		_spec.SetField(pdf.FieldTimestamp, field.TypeTime, value)
 	}
 	_node = &PDF{config: _u.config}
 	_spec.Assign = _node.assignValues
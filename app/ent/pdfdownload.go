@@ -32,8 +32,14 @@ type PDFDownload struct {
 	// OrderID holds the value of the "order_id" field.
 	OrderID *int `json:"order_id,omitempty"`
 	// ItemID holds the value of the "item_id" field.
-	ItemID       *int `json:"item_id,omitempty"`
-	selectValues sql.SelectValues
+	ItemID *int `json:"item_id,omitempty"`
+	// Suspended holds the value of the "suspended" field.
+	Suspended bool `json:"suspended,omitempty"`
+	// SuspendedAt holds the value of the "suspended_at" field.
+	SuspendedAt *time.Time `json:"suspended_at,omitempty"`
+	// SuspendReason holds the value of the "suspend_reason" field.
+	SuspendReason string `json:"suspend_reason,omitempty"`
+	selectValues  sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -41,13 +47,13 @@ func (*PDFDownload) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case pdfdownload.FieldEmailSent:
+		case pdfdownload.FieldEmailSent, pdfdownload.FieldSuspended:
 			values[i] = new(sql.NullBool)
 		case pdfdownload.FieldID, pdfdownload.FieldPdfID, pdfdownload.FieldDownloadCount, pdfdownload.FieldOrderID, pdfdownload.FieldItemID:
 			values[i] = new(sql.NullInt64)
-		case pdfdownload.FieldLinkID:
+		case pdfdownload.FieldLinkID, pdfdownload.FieldSuspendReason:
 			values[i] = new(sql.NullString)
-		case pdfdownload.FieldTimestamp, pdfdownload.FieldLastDownload:
+		case pdfdownload.FieldTimestamp, pdfdownload.FieldLastDownload, pdfdownload.FieldSuspendedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -121,6 +127,25 @@ func (_m *PDFDownload) assignValues(columns []string, values []any) error {
 				_m.ItemID = new(int)
 				*_m.ItemID = int(value.Int64)
 			}
+		case pdfdownload.FieldSuspended:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended", values[i])
+			} else if value.Valid {
+				_m.Suspended = value.Bool
+			}
+		case pdfdownload.FieldSuspendedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field suspended_at", values[i])
+			} else if value.Valid {
+				_m.SuspendedAt = new(time.Time)
+				*_m.SuspendedAt = value.Time
+			}
+		case pdfdownload.FieldSuspendReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field suspend_reason", values[i])
+			} else if value.Valid {
+				_m.SuspendReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -186,6 +211,17 @@ func (_m *PDFDownload) String() string {
 		builder.WriteString("item_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("suspended=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Suspended))
+	builder.WriteString(", ")
+	if v := _m.SuspendedAt; v != nil {
+		builder.WriteString("suspended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("suspend_reason=")
+	builder.WriteString(_m.SuspendReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -27,6 +27,12 @@ const (
 	FieldOrderID = "order_id"
 	// FieldItemID holds the string denoting the item_id field in the database.
 	FieldItemID = "item_id"
+	// FieldSuspended holds the string denoting the suspended field in the database.
+	FieldSuspended = "suspended"
+	// FieldSuspendedAt holds the string denoting the suspended_at field in the database.
+	FieldSuspendedAt = "suspended_at"
+	// FieldSuspendReason holds the string denoting the suspend_reason field in the database.
+	FieldSuspendReason = "suspend_reason"
 	// Table holds the table name of the pdfdownload in the database.
 	Table = "pdf_download"
 )
@@ -42,6 +48,9 @@ var Columns = []string{
 	FieldDownloadCount,
 	FieldOrderID,
 	FieldItemID,
+	FieldSuspended,
+	FieldSuspendedAt,
+	FieldSuspendReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -59,6 +68,10 @@ var (
 	DefaultEmailSent bool
 	// DefaultDownloadCount holds the default value on creation for the "download_count" field.
 	DefaultDownloadCount int
+	// DefaultSuspended holds the default value on creation for the "suspended" field.
+	DefaultSuspended bool
+	// DefaultSuspendReason holds the default value on creation for the "suspend_reason" field.
+	DefaultSuspendReason string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -110,3 +123,18 @@ func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
 func ByItemID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldItemID, opts...).ToFunc()
 }
+
+// BySuspended orders the results by the suspended field.
+func BySuspended(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspended, opts...).ToFunc()
+}
+
+// BySuspendedAt orders the results by the suspended_at field.
+func BySuspendedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendedAt, opts...).ToFunc()
+}
+
+// BySuspendReason orders the results by the suspend_reason field.
+func BySuspendReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspendReason, opts...).ToFunc()
+}
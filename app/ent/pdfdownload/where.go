@@ -94,6 +94,21 @@ func ItemID(v int) predicate.PDFDownload {
 	return predicate.PDFDownload(sql.FieldEQ(FieldItemID, v))
 }
 
+// Suspended applies equality check predicate on the "suspended" field. It's identical to SuspendedEQ.
+func Suspended(v bool) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspended, v))
+}
+
+// SuspendedAt applies equality check predicate on the "suspended_at" field. It's identical to SuspendedAtEQ.
+func SuspendedAt(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspendedAt, v))
+}
+
+// SuspendReason applies equality check predicate on the "suspend_reason" field. It's identical to SuspendReasonEQ.
+func SuspendReason(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspendReason, v))
+}
+
 // LinkIDEQ applies the EQ predicate on the "link_id" field.
 func LinkIDEQ(v string) predicate.PDFDownload {
 	return predicate.PDFDownload(sql.FieldEQ(FieldLinkID, v))
@@ -439,6 +454,131 @@ func ItemIDNotNil() predicate.PDFDownload {
 	return predicate.PDFDownload(sql.FieldNotNull(FieldItemID))
 }
 
+// SuspendedEQ applies the EQ predicate on the "suspended" field.
+func SuspendedEQ(v bool) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspended, v))
+}
+
+// SuspendedNEQ applies the NEQ predicate on the "suspended" field.
+func SuspendedNEQ(v bool) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNEQ(FieldSuspended, v))
+}
+
+// SuspendedAtEQ applies the EQ predicate on the "suspended_at" field.
+func SuspendedAtEQ(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspendedAt, v))
+}
+
+// SuspendedAtNEQ applies the NEQ predicate on the "suspended_at" field.
+func SuspendedAtNEQ(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNEQ(FieldSuspendedAt, v))
+}
+
+// SuspendedAtIn applies the In predicate on the "suspended_at" field.
+func SuspendedAtIn(vs ...time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldIn(FieldSuspendedAt, vs...))
+}
+
+// SuspendedAtNotIn applies the NotIn predicate on the "suspended_at" field.
+func SuspendedAtNotIn(vs ...time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNotIn(FieldSuspendedAt, vs...))
+}
+
+// SuspendedAtGT applies the GT predicate on the "suspended_at" field.
+func SuspendedAtGT(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldGT(FieldSuspendedAt, v))
+}
+
+// SuspendedAtGTE applies the GTE predicate on the "suspended_at" field.
+func SuspendedAtGTE(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldGTE(FieldSuspendedAt, v))
+}
+
+// SuspendedAtLT applies the LT predicate on the "suspended_at" field.
+func SuspendedAtLT(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldLT(FieldSuspendedAt, v))
+}
+
+// SuspendedAtLTE applies the LTE predicate on the "suspended_at" field.
+func SuspendedAtLTE(v time.Time) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldLTE(FieldSuspendedAt, v))
+}
+
+// SuspendedAtIsNil applies the IsNil predicate on the "suspended_at" field.
+func SuspendedAtIsNil() predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldIsNull(FieldSuspendedAt))
+}
+
+// SuspendedAtNotNil applies the NotNil predicate on the "suspended_at" field.
+func SuspendedAtNotNil() predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNotNull(FieldSuspendedAt))
+}
+
+// SuspendReasonEQ applies the EQ predicate on the "suspend_reason" field.
+func SuspendReasonEQ(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEQ(FieldSuspendReason, v))
+}
+
+// SuspendReasonNEQ applies the NEQ predicate on the "suspend_reason" field.
+func SuspendReasonNEQ(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNEQ(FieldSuspendReason, v))
+}
+
+// SuspendReasonIn applies the In predicate on the "suspend_reason" field.
+func SuspendReasonIn(vs ...string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldIn(FieldSuspendReason, vs...))
+}
+
+// SuspendReasonNotIn applies the NotIn predicate on the "suspend_reason" field.
+func SuspendReasonNotIn(vs ...string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldNotIn(FieldSuspendReason, vs...))
+}
+
+// SuspendReasonGT applies the GT predicate on the "suspend_reason" field.
+func SuspendReasonGT(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldGT(FieldSuspendReason, v))
+}
+
+// SuspendReasonGTE applies the GTE predicate on the "suspend_reason" field.
+func SuspendReasonGTE(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldGTE(FieldSuspendReason, v))
+}
+
+// SuspendReasonLT applies the LT predicate on the "suspend_reason" field.
+func SuspendReasonLT(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldLT(FieldSuspendReason, v))
+}
+
+// SuspendReasonLTE applies the LTE predicate on the "suspend_reason" field.
+func SuspendReasonLTE(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldLTE(FieldSuspendReason, v))
+}
+
+// SuspendReasonContains applies the Contains predicate on the "suspend_reason" field.
+func SuspendReasonContains(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldContains(FieldSuspendReason, v))
+}
+
+// SuspendReasonHasPrefix applies the HasPrefix predicate on the "suspend_reason" field.
+func SuspendReasonHasPrefix(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldHasPrefix(FieldSuspendReason, v))
+}
+
+// SuspendReasonHasSuffix applies the HasSuffix predicate on the "suspend_reason" field.
+func SuspendReasonHasSuffix(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldHasSuffix(FieldSuspendReason, v))
+}
+
+// SuspendReasonEqualFold applies the EqualFold predicate on the "suspend_reason" field.
+func SuspendReasonEqualFold(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldEqualFold(FieldSuspendReason, v))
+}
+
+// SuspendReasonContainsFold applies the ContainsFold predicate on the "suspend_reason" field.
+func SuspendReasonContainsFold(v string) predicate.PDFDownload {
+	return predicate.PDFDownload(sql.FieldContainsFold(FieldSuspendReason, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PDFDownload) predicate.PDFDownload {
 	return predicate.PDFDownload(sql.AndPredicates(predicates...))
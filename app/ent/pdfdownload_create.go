@@ -108,6 +108,48 @@ func (_c *PDFDownloadCreate) SetNillableItemID(v *int) *PDFDownloadCreate {
 	return _c
 }
 
+// SetSuspended sets the "suspended" field.
+func (_c *PDFDownloadCreate) SetSuspended(v bool) *PDFDownloadCreate {
+	_c.mutation.SetSuspended(v)
+	return _c
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (_c *PDFDownloadCreate) SetNillableSuspended(v *bool) *PDFDownloadCreate {
+	if v != nil {
+		_c.SetSuspended(*v)
+	}
+	return _c
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_c *PDFDownloadCreate) SetSuspendedAt(v time.Time) *PDFDownloadCreate {
+	_c.mutation.SetSuspendedAt(v)
+	return _c
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_c *PDFDownloadCreate) SetNillableSuspendedAt(v *time.Time) *PDFDownloadCreate {
+	if v != nil {
+		_c.SetSuspendedAt(*v)
+	}
+	return _c
+}
+
+// SetSuspendReason sets the "suspend_reason" field.
+func (_c *PDFDownloadCreate) SetSuspendReason(v string) *PDFDownloadCreate {
+	_c.mutation.SetSuspendReason(v)
+	return _c
+}
+
+// SetNillableSuspendReason sets the "suspend_reason" field if the given value is not nil.
+func (_c *PDFDownloadCreate) SetNillableSuspendReason(v *string) *PDFDownloadCreate {
+	if v != nil {
+		_c.SetSuspendReason(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *PDFDownloadCreate) SetID(v int) *PDFDownloadCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +199,14 @@ func (_c *PDFDownloadCreate) defaults() {
 		v := pdfdownload.DefaultDownloadCount
 		_c.mutation.SetDownloadCount(v)
 	}
+	if _, ok := _c.mutation.Suspended(); !ok {
+		v := pdfdownload.DefaultSuspended
+		_c.mutation.SetSuspended(v)
+	}
+	if _, ok := _c.mutation.SuspendReason(); !ok {
+		v := pdfdownload.DefaultSuspendReason
+		_c.mutation.SetSuspendReason(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -176,6 +226,12 @@ func (_c *PDFDownloadCreate) check() error {
 	if _, ok := _c.mutation.DownloadCount(); !ok {
 		return &ValidationError{Name: "download_count", err: errors.New(`ent: missing required field "PDFDownload.download_count"`)}
 	}
+	if _, ok := _c.mutation.Suspended(); !ok {
+		return &ValidationError{Name: "suspended", err: errors.New(`ent: missing required field "PDFDownload.suspended"`)}
+	}
+	if _, ok := _c.mutation.SuspendReason(); !ok {
+		return &ValidationError{Name: "suspend_reason", err: errors.New(`ent: missing required field "PDFDownload.suspend_reason"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := pdfdownload.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PDFDownload.id": %w`, err)}
@@ -245,6 +301,18 @@ func (_c *PDFDownloadCreate) createSpec() (*PDFDownload, *sqlgraph.CreateSpec) {
 		_spec.SetField(pdfdownload.FieldItemID, field.TypeInt, value)
 		_node.ItemID = &value
 	}
+	if value, ok := _c.mutation.Suspended(); ok {
+		_spec.SetField(pdfdownload.FieldSuspended, field.TypeBool, value)
+		_node.Suspended = value
+	}
+	if value, ok := _c.mutation.SuspendedAt(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendedAt, field.TypeTime, value)
+		_node.SuspendedAt = &value
+	}
+	if value, ok := _c.mutation.SuspendReason(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendReason, field.TypeString, value)
+		_node.SuspendReason = value
+	}
 	return _node, _spec
 }
 
@@ -186,6 +186,54 @@ func (_u *PDFDownloadUpdate) ClearItemID() *PDFDownloadUpdate {
 	return _u
 }
 
+// SetSuspended sets the "suspended" field.
+func (_u *PDFDownloadUpdate) SetSuspended(v bool) *PDFDownloadUpdate {
+	_u.mutation.SetSuspended(v)
+	return _u
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (_u *PDFDownloadUpdate) SetNillableSuspended(v *bool) *PDFDownloadUpdate {
+	if v != nil {
+		_u.SetSuspended(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *PDFDownloadUpdate) SetSuspendedAt(v time.Time) *PDFDownloadUpdate {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *PDFDownloadUpdate) SetNillableSuspendedAt(v *time.Time) *PDFDownloadUpdate {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *PDFDownloadUpdate) ClearSuspendedAt() *PDFDownloadUpdate {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetSuspendReason sets the "suspend_reason" field.
+func (_u *PDFDownloadUpdate) SetSuspendReason(v string) *PDFDownloadUpdate {
+	_u.mutation.SetSuspendReason(v)
+	return _u
+}
+
+// SetNillableSuspendReason sets the "suspend_reason" field if the given value is not nil.
+func (_u *PDFDownloadUpdate) SetNillableSuspendReason(v *string) *PDFDownloadUpdate {
+	if v != nil {
+		_u.SetSuspendReason(*v)
+	}
+	return _u
+}
+
 // Mutation returns the PDFDownloadMutation object of the builder.
 func (_u *PDFDownloadUpdate) Mutation() *PDFDownloadMutation {
 	return _u.mutation
@@ -272,6 +320,18 @@ func (_u *PDFDownloadUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.ItemIDCleared() {
 		_spec.ClearField(pdfdownload.FieldItemID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Suspended(); ok {
+		_spec.SetField(pdfdownload.FieldSuspended, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(pdfdownload.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspendReason(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendReason, field.TypeString, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pdfdownload.Label}
@@ -450,6 +510,54 @@ func (_u *PDFDownloadUpdateOne) ClearItemID() *PDFDownloadUpdateOne {
 	return _u
 }
 
+// SetSuspended sets the "suspended" field.
+func (_u *PDFDownloadUpdateOne) SetSuspended(v bool) *PDFDownloadUpdateOne {
+	_u.mutation.SetSuspended(v)
+	return _u
+}
+
+// SetNillableSuspended sets the "suspended" field if the given value is not nil.
+func (_u *PDFDownloadUpdateOne) SetNillableSuspended(v *bool) *PDFDownloadUpdateOne {
+	if v != nil {
+		_u.SetSuspended(*v)
+	}
+	return _u
+}
+
+// SetSuspendedAt sets the "suspended_at" field.
+func (_u *PDFDownloadUpdateOne) SetSuspendedAt(v time.Time) *PDFDownloadUpdateOne {
+	_u.mutation.SetSuspendedAt(v)
+	return _u
+}
+
+// SetNillableSuspendedAt sets the "suspended_at" field if the given value is not nil.
+func (_u *PDFDownloadUpdateOne) SetNillableSuspendedAt(v *time.Time) *PDFDownloadUpdateOne {
+	if v != nil {
+		_u.SetSuspendedAt(*v)
+	}
+	return _u
+}
+
+// ClearSuspendedAt clears the value of the "suspended_at" field.
+func (_u *PDFDownloadUpdateOne) ClearSuspendedAt() *PDFDownloadUpdateOne {
+	_u.mutation.ClearSuspendedAt()
+	return _u
+}
+
+// SetSuspendReason sets the "suspend_reason" field.
+func (_u *PDFDownloadUpdateOne) SetSuspendReason(v string) *PDFDownloadUpdateOne {
+	_u.mutation.SetSuspendReason(v)
+	return _u
+}
+
+// SetNillableSuspendReason sets the "suspend_reason" field if the given value is not nil.
+func (_u *PDFDownloadUpdateOne) SetNillableSuspendReason(v *string) *PDFDownloadUpdateOne {
+	if v != nil {
+		_u.SetSuspendReason(*v)
+	}
+	return _u
+}
+
 // Mutation returns the PDFDownloadMutation object of the builder.
 func (_u *PDFDownloadUpdateOne) Mutation() *PDFDownloadMutation {
 	return _u.mutation
@@ -566,6 +674,18 @@ func (_u *PDFDownloadUpdateOne) sqlSave(ctx context.Context) (_node *PDFDownload
 	if _u.mutation.ItemIDCleared() {
 		_spec.ClearField(pdfdownload.FieldItemID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Suspended(); ok {
+		_spec.SetField(pdfdownload.FieldSuspended, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SuspendedAt(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SuspendedAtCleared() {
+		_spec.ClearField(pdfdownload.FieldSuspendedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SuspendReason(); ok {
+		_spec.SetField(pdfdownload.FieldSuspendReason, field.TypeString, value)
+	}
 	_node = &PDFDownload{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+)
+
+// PDFDownloadAccess is the model entity for the PDFDownloadAccess schema.
+type PDFDownloadAccess struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PdfDownloadID holds the value of the "pdf_download_id" field.
+	PdfDownloadID int `json:"pdf_download_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Allowed holds the value of the "allowed" field.
+	Allowed bool `json:"allowed,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PDFDownloadAccess) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pdfdownloadaccess.FieldAllowed:
+			values[i] = new(sql.NullBool)
+		case pdfdownloadaccess.FieldID, pdfdownloadaccess.FieldPdfDownloadID:
+			values[i] = new(sql.NullInt64)
+		case pdfdownloadaccess.FieldIP, pdfdownloadaccess.FieldUserAgent, pdfdownloadaccess.FieldReason:
+			values[i] = new(sql.NullString)
+		case pdfdownloadaccess.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PDFDownloadAccess fields.
+func (_m *PDFDownloadAccess) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case pdfdownloadaccess.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case pdfdownloadaccess.FieldPdfDownloadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pdf_download_id", values[i])
+			} else if value.Valid {
+				_m.PdfDownloadID = int(value.Int64)
+			}
+		case pdfdownloadaccess.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				_m.IP = value.String
+			}
+		case pdfdownloadaccess.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				_m.UserAgent = value.String
+			}
+		case pdfdownloadaccess.FieldAllowed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed", values[i])
+			} else if value.Valid {
+				_m.Allowed = value.Bool
+			}
+		case pdfdownloadaccess.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case pdfdownloadaccess.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PDFDownloadAccess.
+// This includes values selected through modifiers, order, etc.
+func (_m *PDFDownloadAccess) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PDFDownloadAccess.
+// Note that you need to call PDFDownloadAccess.Unwrap() before calling this method if this PDFDownloadAccess
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PDFDownloadAccess) Update() *PDFDownloadAccessUpdateOne {
+	return NewPDFDownloadAccessClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PDFDownloadAccess entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PDFDownloadAccess) Unwrap() *PDFDownloadAccess {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PDFDownloadAccess is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PDFDownloadAccess) String() string {
+	var builder strings.Builder
+	builder.WriteString("PDFDownloadAccess(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("pdf_download_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PdfDownloadID))
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(_m.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(_m.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("allowed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Allowed))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PDFDownloadAccesses is a parsable slice of PDFDownloadAccess.
+type PDFDownloadAccesses []*PDFDownloadAccess
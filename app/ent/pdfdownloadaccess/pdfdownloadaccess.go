@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pdfdownloadaccess
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the pdfdownloadaccess type in the database.
+	Label = "pdf_download_access"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPdfDownloadID holds the string denoting the pdf_download_id field in the database.
+	FieldPdfDownloadID = "pdf_download_id"
+	// FieldIP holds the string denoting the ip field in the database.
+	FieldIP = "ip"
+	// FieldUserAgent holds the string denoting the user_agent field in the database.
+	FieldUserAgent = "user_agent"
+	// FieldAllowed holds the string denoting the allowed field in the database.
+	FieldAllowed = "allowed"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the pdfdownloadaccess in the database.
+	Table = "pdf_download_access"
+)
+
+// Columns holds all SQL columns for pdfdownloadaccess fields.
+var Columns = []string{
+	FieldID,
+	FieldPdfDownloadID,
+	FieldIP,
+	FieldUserAgent,
+	FieldAllowed,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultIP holds the default value on creation for the "ip" field.
+	DefaultIP string
+	// DefaultUserAgent holds the default value on creation for the "user_agent" field.
+	DefaultUserAgent string
+	// DefaultReason holds the default value on creation for the "reason" field.
+	DefaultReason string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the PDFDownloadAccess queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPdfDownloadID orders the results by the pdf_download_id field.
+func ByPdfDownloadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPdfDownloadID, opts...).ToFunc()
+}
+
+// ByIP orders the results by the ip field.
+func ByIP(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIP, opts...).ToFunc()
+}
+
+// ByUserAgent orders the results by the user_agent field.
+func ByUserAgent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserAgent, opts...).ToFunc()
+}
+
+// ByAllowed orders the results by the allowed field.
+func ByAllowed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAllowed, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,385 @@
+// Code generated by ent, DO NOT EDIT.
+
+package pdfdownloadaccess
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldID, id))
+}
+
+// PdfDownloadID applies equality check predicate on the "pdf_download_id" field. It's identical to PdfDownloadIDEQ.
+func PdfDownloadID(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldPdfDownloadID, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldIP, v))
+}
+
+// UserAgent applies equality check predicate on the "user_agent" field. It's identical to UserAgentEQ.
+func UserAgent(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// Allowed applies equality check predicate on the "allowed" field. It's identical to AllowedEQ.
+func Allowed(v bool) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldAllowed, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PdfDownloadIDEQ applies the EQ predicate on the "pdf_download_id" field.
+func PdfDownloadIDEQ(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldPdfDownloadID, v))
+}
+
+// PdfDownloadIDNEQ applies the NEQ predicate on the "pdf_download_id" field.
+func PdfDownloadIDNEQ(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldPdfDownloadID, v))
+}
+
+// PdfDownloadIDIn applies the In predicate on the "pdf_download_id" field.
+func PdfDownloadIDIn(vs ...int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldPdfDownloadID, vs...))
+}
+
+// PdfDownloadIDNotIn applies the NotIn predicate on the "pdf_download_id" field.
+func PdfDownloadIDNotIn(vs ...int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldPdfDownloadID, vs...))
+}
+
+// PdfDownloadIDGT applies the GT predicate on the "pdf_download_id" field.
+func PdfDownloadIDGT(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldPdfDownloadID, v))
+}
+
+// PdfDownloadIDGTE applies the GTE predicate on the "pdf_download_id" field.
+func PdfDownloadIDGTE(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldPdfDownloadID, v))
+}
+
+// PdfDownloadIDLT applies the LT predicate on the "pdf_download_id" field.
+func PdfDownloadIDLT(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldPdfDownloadID, v))
+}
+
+// PdfDownloadIDLTE applies the LTE predicate on the "pdf_download_id" field.
+func PdfDownloadIDLTE(v int) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldPdfDownloadID, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContainsFold(FieldIP, v))
+}
+
+// UserAgentEQ applies the EQ predicate on the "user_agent" field.
+func UserAgentEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldUserAgent, v))
+}
+
+// UserAgentNEQ applies the NEQ predicate on the "user_agent" field.
+func UserAgentNEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldUserAgent, v))
+}
+
+// UserAgentIn applies the In predicate on the "user_agent" field.
+func UserAgentIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldUserAgent, vs...))
+}
+
+// UserAgentNotIn applies the NotIn predicate on the "user_agent" field.
+func UserAgentNotIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldUserAgent, vs...))
+}
+
+// UserAgentGT applies the GT predicate on the "user_agent" field.
+func UserAgentGT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldUserAgent, v))
+}
+
+// UserAgentGTE applies the GTE predicate on the "user_agent" field.
+func UserAgentGTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldUserAgent, v))
+}
+
+// UserAgentLT applies the LT predicate on the "user_agent" field.
+func UserAgentLT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldUserAgent, v))
+}
+
+// UserAgentLTE applies the LTE predicate on the "user_agent" field.
+func UserAgentLTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldUserAgent, v))
+}
+
+// UserAgentContains applies the Contains predicate on the "user_agent" field.
+func UserAgentContains(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContains(FieldUserAgent, v))
+}
+
+// UserAgentHasPrefix applies the HasPrefix predicate on the "user_agent" field.
+func UserAgentHasPrefix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasPrefix(FieldUserAgent, v))
+}
+
+// UserAgentHasSuffix applies the HasSuffix predicate on the "user_agent" field.
+func UserAgentHasSuffix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasSuffix(FieldUserAgent, v))
+}
+
+// UserAgentEqualFold applies the EqualFold predicate on the "user_agent" field.
+func UserAgentEqualFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEqualFold(FieldUserAgent, v))
+}
+
+// UserAgentContainsFold applies the ContainsFold predicate on the "user_agent" field.
+func UserAgentContainsFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContainsFold(FieldUserAgent, v))
+}
+
+// AllowedEQ applies the EQ predicate on the "allowed" field.
+func AllowedEQ(v bool) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldAllowed, v))
+}
+
+// AllowedNEQ applies the NEQ predicate on the "allowed" field.
+func AllowedNEQ(v bool) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldAllowed, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PDFDownloadAccess) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PDFDownloadAccess) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PDFDownloadAccess) predicate.PDFDownloadAccess {
+	return predicate.PDFDownloadAccess(sql.NotPredicates(p))
+}
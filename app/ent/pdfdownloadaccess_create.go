@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+)
+
+// PDFDownloadAccessCreate is the builder for creating a PDFDownloadAccess entity.
+type PDFDownloadAccessCreate struct {
+	config
+	mutation *PDFDownloadAccessMutation
+	hooks    []Hook
+}
+
+// SetPdfDownloadID sets the "pdf_download_id" field.
+func (_c *PDFDownloadAccessCreate) SetPdfDownloadID(v int) *PDFDownloadAccessCreate {
+	_c.mutation.SetPdfDownloadID(v)
+	return _c
+}
+
+// SetIP sets the "ip" field.
+func (_c *PDFDownloadAccessCreate) SetIP(v string) *PDFDownloadAccessCreate {
+	_c.mutation.SetIP(v)
+	return _c
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_c *PDFDownloadAccessCreate) SetNillableIP(v *string) *PDFDownloadAccessCreate {
+	if v != nil {
+		_c.SetIP(*v)
+	}
+	return _c
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_c *PDFDownloadAccessCreate) SetUserAgent(v string) *PDFDownloadAccessCreate {
+	_c.mutation.SetUserAgent(v)
+	return _c
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_c *PDFDownloadAccessCreate) SetNillableUserAgent(v *string) *PDFDownloadAccessCreate {
+	if v != nil {
+		_c.SetUserAgent(*v)
+	}
+	return _c
+}
+
+// SetAllowed sets the "allowed" field.
+func (_c *PDFDownloadAccessCreate) SetAllowed(v bool) *PDFDownloadAccessCreate {
+	_c.mutation.SetAllowed(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *PDFDownloadAccessCreate) SetReason(v string) *PDFDownloadAccessCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *PDFDownloadAccessCreate) SetNillableReason(v *string) *PDFDownloadAccessCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PDFDownloadAccessCreate) SetCreatedAt(v time.Time) *PDFDownloadAccessCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PDFDownloadAccessCreate) SetID(v int) *PDFDownloadAccessCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the PDFDownloadAccessMutation object of the builder.
+func (_c *PDFDownloadAccessCreate) Mutation() *PDFDownloadAccessMutation {
+	return _c.mutation
+}
+
+// Save creates the PDFDownloadAccess in the database.
+func (_c *PDFDownloadAccessCreate) Save(ctx context.Context) (*PDFDownloadAccess, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PDFDownloadAccessCreate) SaveX(ctx context.Context) *PDFDownloadAccess {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PDFDownloadAccessCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PDFDownloadAccessCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PDFDownloadAccessCreate) defaults() {
+	if _, ok := _c.mutation.IP(); !ok {
+		v := pdfdownloadaccess.DefaultIP
+		_c.mutation.SetIP(v)
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		v := pdfdownloadaccess.DefaultUserAgent
+		_c.mutation.SetUserAgent(v)
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		v := pdfdownloadaccess.DefaultReason
+		_c.mutation.SetReason(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PDFDownloadAccessCreate) check() error {
+	if _, ok := _c.mutation.PdfDownloadID(); !ok {
+		return &ValidationError{Name: "pdf_download_id", err: errors.New(`ent: missing required field "PDFDownloadAccess.pdf_download_id"`)}
+	}
+	if _, ok := _c.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "PDFDownloadAccess.ip"`)}
+	}
+	if _, ok := _c.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "PDFDownloadAccess.user_agent"`)}
+	}
+	if _, ok := _c.mutation.Allowed(); !ok {
+		return &ValidationError{Name: "allowed", err: errors.New(`ent: missing required field "PDFDownloadAccess.allowed"`)}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "PDFDownloadAccess.reason"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PDFDownloadAccess.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := pdfdownloadaccess.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "PDFDownloadAccess.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *PDFDownloadAccessCreate) sqlSave(ctx context.Context) (*PDFDownloadAccess, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PDFDownloadAccessCreate) createSpec() (*PDFDownloadAccess, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PDFDownloadAccess{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(pdfdownloadaccess.Table, sqlgraph.NewFieldSpec(pdfdownloadaccess.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.PdfDownloadID(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldPdfDownloadID, field.TypeInt, value)
+		_node.PdfDownloadID = value
+	}
+	if value, ok := _c.mutation.IP(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := _c.mutation.UserAgent(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := _c.mutation.Allowed(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldAllowed, field.TypeBool, value)
+		_node.Allowed = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PDFDownloadAccessCreateBulk is the builder for creating many PDFDownloadAccess entities in bulk.
+type PDFDownloadAccessCreateBulk struct {
+	config
+	err      error
+	builders []*PDFDownloadAccessCreate
+}
+
+// Save creates the PDFDownloadAccess entities in the database.
+func (_c *PDFDownloadAccessCreateBulk) Save(ctx context.Context) ([]*PDFDownloadAccess, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PDFDownloadAccess, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PDFDownloadAccessMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PDFDownloadAccessCreateBulk) SaveX(ctx context.Context) []*PDFDownloadAccess {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PDFDownloadAccessCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PDFDownloadAccessCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
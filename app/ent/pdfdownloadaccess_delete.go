@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// PDFDownloadAccessDelete is the builder for deleting a PDFDownloadAccess entity.
+type PDFDownloadAccessDelete struct {
+	config
+	hooks    []Hook
+	mutation *PDFDownloadAccessMutation
+}
+
+// Where appends a list predicates to the PDFDownloadAccessDelete builder.
+func (_d *PDFDownloadAccessDelete) Where(ps ...predicate.PDFDownloadAccess) *PDFDownloadAccessDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PDFDownloadAccessDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PDFDownloadAccessDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PDFDownloadAccessDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(pdfdownloadaccess.Table, sqlgraph.NewFieldSpec(pdfdownloadaccess.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PDFDownloadAccessDeleteOne is the builder for deleting a single PDFDownloadAccess entity.
+type PDFDownloadAccessDeleteOne struct {
+	_d *PDFDownloadAccessDelete
+}
+
+// Where appends a list predicates to the PDFDownloadAccessDelete builder.
+func (_d *PDFDownloadAccessDeleteOne) Where(ps ...predicate.PDFDownloadAccess) *PDFDownloadAccessDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PDFDownloadAccessDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{pdfdownloadaccess.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PDFDownloadAccessDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+)
+
+// PDFDownloadAccessUpdate is the builder for updating PDFDownloadAccess entities.
+type PDFDownloadAccessUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PDFDownloadAccessMutation
+}
+
+// Where appends a list predicates to the PDFDownloadAccessUpdate builder.
+func (_u *PDFDownloadAccessUpdate) Where(ps ...predicate.PDFDownloadAccess) *PDFDownloadAccessUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPdfDownloadID sets the "pdf_download_id" field.
+func (_u *PDFDownloadAccessUpdate) SetPdfDownloadID(v int) *PDFDownloadAccessUpdate {
+	_u.mutation.ResetPdfDownloadID()
+	_u.mutation.SetPdfDownloadID(v)
+	return _u
+}
+
+// SetNillablePdfDownloadID sets the "pdf_download_id" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillablePdfDownloadID(v *int) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetPdfDownloadID(*v)
+	}
+	return _u
+}
+
+// AddPdfDownloadID adds value to the "pdf_download_id" field.
+func (_u *PDFDownloadAccessUpdate) AddPdfDownloadID(v int) *PDFDownloadAccessUpdate {
+	_u.mutation.AddPdfDownloadID(v)
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *PDFDownloadAccessUpdate) SetIP(v string) *PDFDownloadAccessUpdate {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillableIP(v *string) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *PDFDownloadAccessUpdate) SetUserAgent(v string) *PDFDownloadAccessUpdate {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillableUserAgent(v *string) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetAllowed sets the "allowed" field.
+func (_u *PDFDownloadAccessUpdate) SetAllowed(v bool) *PDFDownloadAccessUpdate {
+	_u.mutation.SetAllowed(v)
+	return _u
+}
+
+// SetNillableAllowed sets the "allowed" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillableAllowed(v *bool) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetAllowed(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PDFDownloadAccessUpdate) SetReason(v string) *PDFDownloadAccessUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillableReason(v *string) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PDFDownloadAccessUpdate) SetCreatedAt(v time.Time) *PDFDownloadAccessUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdate) SetNillableCreatedAt(v *time.Time) *PDFDownloadAccessUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PDFDownloadAccessMutation object of the builder.
+func (_u *PDFDownloadAccessUpdate) Mutation() *PDFDownloadAccessMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PDFDownloadAccessUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PDFDownloadAccessUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PDFDownloadAccessUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PDFDownloadAccessUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PDFDownloadAccessUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pdfdownloadaccess.Table, pdfdownloadaccess.Columns, sqlgraph.NewFieldSpec(pdfdownloadaccess.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PdfDownloadID(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldPdfDownloadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPdfDownloadID(); ok {
+		_spec.AddField(pdfdownloadaccess.FieldPdfDownloadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Allowed(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldAllowed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pdfdownloadaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PDFDownloadAccessUpdateOne is the builder for updating a single PDFDownloadAccess entity.
+type PDFDownloadAccessUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PDFDownloadAccessMutation
+}
+
+// SetPdfDownloadID sets the "pdf_download_id" field.
+func (_u *PDFDownloadAccessUpdateOne) SetPdfDownloadID(v int) *PDFDownloadAccessUpdateOne {
+	_u.mutation.ResetPdfDownloadID()
+	_u.mutation.SetPdfDownloadID(v)
+	return _u
+}
+
+// SetNillablePdfDownloadID sets the "pdf_download_id" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillablePdfDownloadID(v *int) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetPdfDownloadID(*v)
+	}
+	return _u
+}
+
+// AddPdfDownloadID adds value to the "pdf_download_id" field.
+func (_u *PDFDownloadAccessUpdateOne) AddPdfDownloadID(v int) *PDFDownloadAccessUpdateOne {
+	_u.mutation.AddPdfDownloadID(v)
+	return _u
+}
+
+// SetIP sets the "ip" field.
+func (_u *PDFDownloadAccessUpdateOne) SetIP(v string) *PDFDownloadAccessUpdateOne {
+	_u.mutation.SetIP(v)
+	return _u
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillableIP(v *string) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetIP(*v)
+	}
+	return _u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (_u *PDFDownloadAccessUpdateOne) SetUserAgent(v string) *PDFDownloadAccessUpdateOne {
+	_u.mutation.SetUserAgent(v)
+	return _u
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillableUserAgent(v *string) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetUserAgent(*v)
+	}
+	return _u
+}
+
+// SetAllowed sets the "allowed" field.
+func (_u *PDFDownloadAccessUpdateOne) SetAllowed(v bool) *PDFDownloadAccessUpdateOne {
+	_u.mutation.SetAllowed(v)
+	return _u
+}
+
+// SetNillableAllowed sets the "allowed" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillableAllowed(v *bool) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetAllowed(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *PDFDownloadAccessUpdateOne) SetReason(v string) *PDFDownloadAccessUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillableReason(v *string) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PDFDownloadAccessUpdateOne) SetCreatedAt(v time.Time) *PDFDownloadAccessUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PDFDownloadAccessUpdateOne) SetNillableCreatedAt(v *time.Time) *PDFDownloadAccessUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PDFDownloadAccessMutation object of the builder.
+func (_u *PDFDownloadAccessUpdateOne) Mutation() *PDFDownloadAccessMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PDFDownloadAccessUpdate builder.
+func (_u *PDFDownloadAccessUpdateOne) Where(ps ...predicate.PDFDownloadAccess) *PDFDownloadAccessUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PDFDownloadAccessUpdateOne) Select(field string, fields ...string) *PDFDownloadAccessUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PDFDownloadAccess entity.
+func (_u *PDFDownloadAccessUpdateOne) Save(ctx context.Context) (*PDFDownloadAccess, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PDFDownloadAccessUpdateOne) SaveX(ctx context.Context) *PDFDownloadAccess {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PDFDownloadAccessUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PDFDownloadAccessUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PDFDownloadAccessUpdateOne) sqlSave(ctx context.Context) (_node *PDFDownloadAccess, err error) {
+	_spec := sqlgraph.NewUpdateSpec(pdfdownloadaccess.Table, pdfdownloadaccess.Columns, sqlgraph.NewFieldSpec(pdfdownloadaccess.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PDFDownloadAccess.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pdfdownloadaccess.FieldID)
+		for _, f := range fields {
+			if !pdfdownloadaccess.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pdfdownloadaccess.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PdfDownloadID(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldPdfDownloadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPdfDownloadID(); ok {
+		_spec.AddField(pdfdownloadaccess.FieldPdfDownloadID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.IP(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldIP, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserAgent(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Allowed(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldAllowed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(pdfdownloadaccess.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &PDFDownloadAccess{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pdfdownloadaccess.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,24 +6,51 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
 // Abonement is the predicate function for abonement builders.
 type Abonement func(*sql.Selector)
 
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // BlockedIP is the predicate function for blockedip builders.
 type BlockedIP func(*sql.Selector)
 
 // Comment is the predicate function for comment builders.
 type Comment func(*sql.Selector)
 
+// Consignment is the predicate function for consignment builders.
+type Consignment func(*sql.Selector)
+
 // Customer is the predicate function for customer builders.
 type Customer func(*sql.Selector)
 
 // DBSettings is the predicate function for dbsettings builders.
 type DBSettings func(*sql.Selector)
 
+// DiscountCode is the predicate function for discountcode builders.
+type DiscountCode func(*sql.Selector)
+
+// DiscountRedemption is the predicate function for discountredemption builders.
+type DiscountRedemption func(*sql.Selector)
+
+// Entitlement is the predicate function for entitlement builders.
+type Entitlement func(*sql.Selector)
+
+// GiftCode is the predicate function for giftcode builders.
+type GiftCode func(*sql.Selector)
+
+// Invoice is the predicate function for invoice builders.
+type Invoice func(*sql.Selector)
+
+// InvoiceSequence is the predicate function for invoicesequence builders.
+type InvoiceSequence func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
@@ -45,11 +72,20 @@ type PDF func(*sql.Selector)
 // PDFDownload is the predicate function for pdfdownload builders.
 type PDFDownload func(*sql.Selector)
 
+// PDFDownloadAccess is the predicate function for pdfdownloadaccess builders.
+type PDFDownloadAccess func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
+// PaymentMandate is the predicate function for paymentmandate builders.
+type PaymentMandate func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
+// StockMovement is the predicate function for stockmovement builders.
+type StockMovement func(*sql.Selector)
+
 // Vendor is the predicate function for vendor builders.
 type Vendor func(*sql.Selector)
@@ -3,20 +3,34 @@
 package ent
 
 import (
+	"time"
+
 	"github.com/augustin-wien/augustina-backend/ent/abonement"
 	"github.com/augustin-wien/augustina-backend/ent/account"
+	"github.com/augustin-wien/augustina-backend/ent/apikey"
+	"github.com/augustin-wien/augustina-backend/ent/auditlog"
 	"github.com/augustin-wien/augustina-backend/ent/blockedip"
+	"github.com/augustin-wien/augustina-backend/ent/consignment"
 	"github.com/augustin-wien/augustina-backend/ent/customer"
 	"github.com/augustin-wien/augustina-backend/ent/dbsettings"
+	"github.com/augustin-wien/augustina-backend/ent/discountcode"
+	"github.com/augustin-wien/augustina-backend/ent/discountredemption"
+	"github.com/augustin-wien/augustina-backend/ent/entitlement"
+	"github.com/augustin-wien/augustina-backend/ent/giftcode"
+	"github.com/augustin-wien/augustina-backend/ent/invoice"
+	"github.com/augustin-wien/augustina-backend/ent/invoicesequence"
 	"github.com/augustin-wien/augustina-backend/ent/item"
 	"github.com/augustin-wien/augustina-backend/ent/location"
 	"github.com/augustin-wien/augustina-backend/ent/order"
 	"github.com/augustin-wien/augustina-backend/ent/orderentry"
 	"github.com/augustin-wien/augustina-backend/ent/payment"
+	"github.com/augustin-wien/augustina-backend/ent/paymentmandate"
 	"github.com/augustin-wien/augustina-backend/ent/pdf"
 	"github.com/augustin-wien/augustina-backend/ent/pdfdownload"
+	"github.com/augustin-wien/augustina-backend/ent/pdfdownloadaccess"
 	"github.com/augustin-wien/augustina-backend/ent/schema"
 	"github.com/augustin-wien/augustina-backend/ent/settings"
+	"github.com/augustin-wien/augustina-backend/ent/stockmovement"
 	"github.com/augustin-wien/augustina-backend/ent/vendor"
 )
 
@@ -24,12 +38,34 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescLastUsedIP is the schema descriptor for last_used_ip field.
+	apikeyDescLastUsedIP := apikeyFields[10].Descriptor()
+	// apikey.DefaultLastUsedIP holds the default value on creation for the last_used_ip field.
+	apikey.DefaultLastUsedIP = apikeyDescLastUsedIP.Default.(string)
+	// apikeyDescCreatedBy is the schema descriptor for created_by field.
+	apikeyDescCreatedBy := apikeyFields[12].Descriptor()
+	// apikey.DefaultCreatedBy holds the default value on creation for the created_by field.
+	apikey.DefaultCreatedBy = apikeyDescCreatedBy.Default.(string)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apikey.IDValidator = apikeyDescID.Validators[0].(func(int) error)
 	abonementFields := schema.Abonement{}.Fields()
 	_ = abonementFields
 	// abonementDescStatus is the schema descriptor for status field.
 	abonementDescStatus := abonementFields[5].Descriptor()
 	// abonement.DefaultStatus holds the default value on creation for the status field.
 	abonement.DefaultStatus = abonementDescStatus.Default.(string)
+	// abonementDescAutoRenew is the schema descriptor for auto_renew field.
+	abonementDescAutoRenew := abonementFields[9].Descriptor()
+	// abonement.DefaultAutoRenew holds the default value on creation for the auto_renew field.
+	abonement.DefaultAutoRenew = abonementDescAutoRenew.Default.(bool)
+	// abonementDescRenewalAttempts is the schema descriptor for renewal_attempts field.
+	abonementDescRenewalAttempts := abonementFields[11].Descriptor()
+	// abonement.DefaultRenewalAttempts holds the default value on creation for the renewal_attempts field.
+	abonement.DefaultRenewalAttempts = abonementDescRenewalAttempts.Default.(int)
 	// abonementDescID is the schema descriptor for id field.
 	abonementDescID := abonementFields[0].Descriptor()
 	// abonement.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -44,6 +80,28 @@ func init() {
 	accountDescID := accountFields[0].Descriptor()
 	// account.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	account.IDValidator = accountDescID.Validators[0].(func(int) error)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescActorID is the schema descriptor for actor_id field.
+	auditlogDescActorID := auditlogFields[2].Descriptor()
+	// auditlog.DefaultActorID holds the default value on creation for the actor_id field.
+	auditlog.DefaultActorID = auditlogDescActorID.Default.(string)
+	// auditlogDescEntityID is the schema descriptor for entity_id field.
+	auditlogDescEntityID := auditlogFields[5].Descriptor()
+	// auditlog.DefaultEntityID holds the default value on creation for the entity_id field.
+	auditlog.DefaultEntityID = auditlogDescEntityID.Default.(int)
+	// auditlogDescRequestID is the schema descriptor for request_id field.
+	auditlogDescRequestID := auditlogFields[7].Descriptor()
+	// auditlog.DefaultRequestID holds the default value on creation for the request_id field.
+	auditlog.DefaultRequestID = auditlogDescRequestID.Default.(string)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[8].Descriptor()
+	// auditlog.DefaultIP holds the default value on creation for the ip field.
+	auditlog.DefaultIP = auditlogDescIP.Default.(string)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	auditlog.IDValidator = auditlogDescID.Validators[0].(func(int) error)
 	blockedipFields := schema.BlockedIP{}.Fields()
 	_ = blockedipFields
 	// blockedipDescIP is the schema descriptor for ip field.
@@ -54,24 +112,62 @@ func init() {
 	blockedipDescStrikes := blockedipFields[1].Descriptor()
 	// blockedip.DefaultStrikes holds the default value on creation for the strikes field.
 	blockedip.DefaultStrikes = blockedipDescStrikes.Default.(int)
+	consignmentFields := schema.Consignment{}.Fields()
+	_ = consignmentFields
+	// consignmentDescHandedOut is the schema descriptor for handed_out field.
+	consignmentDescHandedOut := consignmentFields[1].Descriptor()
+	// consignment.DefaultHandedOut holds the default value on creation for the handed_out field.
+	consignment.DefaultHandedOut = consignmentDescHandedOut.Default.(int)
+	// consignmentDescReturned is the schema descriptor for returned field.
+	consignmentDescReturned := consignmentFields[2].Descriptor()
+	// consignment.DefaultReturned holds the default value on creation for the returned field.
+	consignment.DefaultReturned = consignmentDescReturned.Default.(int)
+	// consignmentDescSettled is the schema descriptor for settled field.
+	consignmentDescSettled := consignmentFields[3].Descriptor()
+	// consignment.DefaultSettled holds the default value on creation for the settled field.
+	consignment.DefaultSettled = consignmentDescSettled.Default.(int)
+	// consignmentDescID is the schema descriptor for id field.
+	consignmentDescID := consignmentFields[0].Descriptor()
+	// consignment.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	consignment.IDValidator = consignmentDescID.Validators[0].(func(int) error)
 	customerFields := schema.Customer{}.Fields()
 	_ = customerFields
 	// customerDescEmail is the schema descriptor for email field.
 	customerDescEmail := customerFields[2].Descriptor()
 	// customer.DefaultEmail holds the default value on creation for the email field.
 	customer.DefaultEmail = customerDescEmail.Default.(string)
+	// customerDescPendingEmail is the schema descriptor for pending_email field.
+	customerDescPendingEmail := customerFields[3].Descriptor()
+	// customer.DefaultPendingEmail holds the default value on creation for the pending_email field.
+	customer.DefaultPendingEmail = customerDescPendingEmail.Default.(string)
 	// customerDescFirstname is the schema descriptor for firstname field.
-	customerDescFirstname := customerFields[3].Descriptor()
+	customerDescFirstname := customerFields[4].Descriptor()
 	// customer.DefaultFirstname holds the default value on creation for the firstname field.
 	customer.DefaultFirstname = customerDescFirstname.Default.(string)
 	// customerDescLastname is the schema descriptor for lastname field.
-	customerDescLastname := customerFields[4].Descriptor()
+	customerDescLastname := customerFields[5].Descriptor()
 	// customer.DefaultLastname holds the default value on creation for the lastname field.
 	customer.DefaultLastname = customerDescLastname.Default.(string)
 	// customerDescLicensegroups is the schema descriptor for licensegroups field.
-	customerDescLicensegroups := customerFields[5].Descriptor()
+	customerDescLicensegroups := customerFields[6].Descriptor()
 	// customer.DefaultLicensegroups holds the default value on creation for the licensegroups field.
 	customer.DefaultLicensegroups = customerDescLicensegroups.Default.(string)
+	// customerDescNewsletter is the schema descriptor for newsletter field.
+	customerDescNewsletter := customerFields[7].Descriptor()
+	// customer.DefaultNewsletter holds the default value on creation for the newsletter field.
+	customer.DefaultNewsletter = customerDescNewsletter.Default.(bool)
+	// customerDescNotifyNewIssues is the schema descriptor for notify_new_issues field.
+	customerDescNotifyNewIssues := customerFields[8].Descriptor()
+	// customer.DefaultNotifyNewIssues holds the default value on creation for the notify_new_issues field.
+	customer.DefaultNotifyNewIssues = customerDescNotifyNewIssues.Default.(bool)
+	// customerDescNotifyAbonementReminders is the schema descriptor for notify_abonement_reminders field.
+	customerDescNotifyAbonementReminders := customerFields[9].Descriptor()
+	// customer.DefaultNotifyAbonementReminders holds the default value on creation for the notify_abonement_reminders field.
+	customer.DefaultNotifyAbonementReminders = customerDescNotifyAbonementReminders.Default.(bool)
+	// customerDescDeletionReason is the schema descriptor for deletion_reason field.
+	customerDescDeletionReason := customerFields[11].Descriptor()
+	// customer.DefaultDeletionReason holds the default value on creation for the deletion_reason field.
+	customer.DefaultDeletionReason = customerDescDeletionReason.Default.(string)
 	// customerDescID is the schema descriptor for id field.
 	customerDescID := customerFields[0].Descriptor()
 	// customer.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -82,6 +178,74 @@ func init() {
 	dbsettingsDescID := dbsettingsFields[0].Descriptor()
 	// dbsettings.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	dbsettings.IDValidator = dbsettingsDescID.Validators[0].(func(int) error)
+	discountcodeFields := schema.DiscountCode{}.Fields()
+	_ = discountcodeFields
+	// discountcodeDescDescription is the schema descriptor for description field.
+	discountcodeDescDescription := discountcodeFields[2].Descriptor()
+	// discountcode.DefaultDescription holds the default value on creation for the description field.
+	discountcode.DefaultDescription = discountcodeDescDescription.Default.(string)
+	// discountcodeDescLicenseGroup is the schema descriptor for license_group field.
+	discountcodeDescLicenseGroup := discountcodeFields[6].Descriptor()
+	// discountcode.DefaultLicenseGroup holds the default value on creation for the license_group field.
+	discountcode.DefaultLicenseGroup = discountcodeDescLicenseGroup.Default.(string)
+	// discountcodeDescMaxUses is the schema descriptor for max_uses field.
+	discountcodeDescMaxUses := discountcodeFields[9].Descriptor()
+	// discountcode.DefaultMaxUses holds the default value on creation for the max_uses field.
+	discountcode.DefaultMaxUses = discountcodeDescMaxUses.Default.(int)
+	// discountcodeDescUses is the schema descriptor for uses field.
+	discountcodeDescUses := discountcodeFields[10].Descriptor()
+	// discountcode.DefaultUses holds the default value on creation for the uses field.
+	discountcode.DefaultUses = discountcodeDescUses.Default.(int)
+	// discountcodeDescDisabled is the schema descriptor for disabled field.
+	discountcodeDescDisabled := discountcodeFields[11].Descriptor()
+	// discountcode.DefaultDisabled holds the default value on creation for the disabled field.
+	discountcode.DefaultDisabled = discountcodeDescDisabled.Default.(bool)
+	// discountcodeDescID is the schema descriptor for id field.
+	discountcodeDescID := discountcodeFields[0].Descriptor()
+	// discountcode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discountcode.IDValidator = discountcodeDescID.Validators[0].(func(int) error)
+	discountredemptionFields := schema.DiscountRedemption{}.Fields()
+	_ = discountredemptionFields
+	// discountredemptionDescID is the schema descriptor for id field.
+	discountredemptionDescID := discountredemptionFields[0].Descriptor()
+	// discountredemption.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discountredemption.IDValidator = discountredemptionDescID.Validators[0].(func(int) error)
+	entitlementFields := schema.Entitlement{}.Fields()
+	_ = entitlementFields
+	// entitlementDescLicenseGroup is the schema descriptor for license_group field.
+	entitlementDescLicenseGroup := entitlementFields[3].Descriptor()
+	// entitlement.DefaultLicenseGroup holds the default value on creation for the license_group field.
+	entitlement.DefaultLicenseGroup = entitlementDescLicenseGroup.Default.(string)
+	// entitlementDescID is the schema descriptor for id field.
+	entitlementDescID := entitlementFields[0].Descriptor()
+	// entitlement.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	entitlement.IDValidator = entitlementDescID.Validators[0].(func(int) error)
+	giftcodeFields := schema.GiftCode{}.Fields()
+	_ = giftcodeFields
+	// giftcodeDescMessage is the schema descriptor for message field.
+	giftcodeDescMessage := giftcodeFields[6].Descriptor()
+	// giftcode.DefaultMessage holds the default value on creation for the message field.
+	giftcode.DefaultMessage = giftcodeDescMessage.Default.(string)
+	// giftcodeDescID is the schema descriptor for id field.
+	giftcodeDescID := giftcodeFields[0].Descriptor()
+	// giftcode.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	giftcode.IDValidator = giftcodeDescID.Validators[0].(func(int) error)
+	invoiceFields := schema.Invoice{}.Fields()
+	_ = invoiceFields
+	// invoiceDescID is the schema descriptor for id field.
+	invoiceDescID := invoiceFields[0].Descriptor()
+	// invoice.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	invoice.IDValidator = invoiceDescID.Validators[0].(func(int) error)
+	invoicesequenceFields := schema.InvoiceSequence{}.Fields()
+	_ = invoicesequenceFields
+	// invoicesequenceDescLast is the schema descriptor for last field.
+	invoicesequenceDescLast := invoicesequenceFields[2].Descriptor()
+	// invoicesequence.DefaultLast holds the default value on creation for the last field.
+	invoicesequence.DefaultLast = invoicesequenceDescLast.Default.(int)
+	// invoicesequenceDescID is the schema descriptor for id field.
+	invoicesequenceDescID := invoicesequenceFields[0].Descriptor()
+	// invoicesequence.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	invoicesequence.IDValidator = invoicesequenceDescID.Validators[0].(func(int) error)
 	itemFields := schema.Item{}.Fields()
 	_ = itemFields
 	// itemDescName is the schema descriptor for Name field.
@@ -132,6 +296,18 @@ func init() {
 	itemDescItemTextColor := itemFields[13].Descriptor()
 	// item.DefaultItemTextColor holds the default value on creation for the ItemTextColor field.
 	item.DefaultItemTextColor = itemDescItemTextColor.Default.(string)
+	// itemDescTrackStock is the schema descriptor for TrackStock field.
+	itemDescTrackStock := itemFields[14].Descriptor()
+	// item.DefaultTrackStock holds the default value on creation for the TrackStock field.
+	item.DefaultTrackStock = itemDescTrackStock.Default.(bool)
+	// itemDescStock is the schema descriptor for Stock field.
+	itemDescStock := itemFields[15].Descriptor()
+	// item.DefaultStock holds the default value on creation for the Stock field.
+	item.DefaultStock = itemDescStock.Default.(int)
+	// itemDescLowStockThreshold is the schema descriptor for LowStockThreshold field.
+	itemDescLowStockThreshold := itemFields[16].Descriptor()
+	// item.DefaultLowStockThreshold holds the default value on creation for the LowStockThreshold field.
+	item.DefaultLowStockThreshold = itemDescLowStockThreshold.Default.(int)
 	// itemDescID is the schema descriptor for id field.
 	itemDescID := itemFields[0].Descriptor()
 	// item.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -146,24 +322,60 @@ func init() {
 	locationDescLatitude := locationFields[4].Descriptor()
 	// location.DefaultLatitude holds the default value on creation for the latitude field.
 	location.DefaultLatitude = locationDescLatitude.Default.(float64)
+	// locationDescGeocodingConfidence is the schema descriptor for geocoding_confidence field.
+	locationDescGeocodingConfidence := locationFields[6].Descriptor()
+	// location.DefaultGeocodingConfidence holds the default value on creation for the geocoding_confidence field.
+	location.DefaultGeocodingConfidence = locationDescGeocodingConfidence.Default.(float64)
 	// locationDescID is the schema descriptor for id field.
 	locationDescID := locationFields[0].Descriptor()
 	// location.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	location.IDValidator = locationDescID.Validators[0].(func(int) error)
 	orderFields := schema.Order{}.Fields()
 	_ = orderFields
+	// orderDescGift is the schema descriptor for gift field.
+	orderDescGift := orderFields[10].Descriptor()
+	// order.DefaultGift holds the default value on creation for the gift field.
+	order.DefaultGift = orderDescGift.Default.(bool)
+	// orderDescGiftMessage is the schema descriptor for gift_message field.
+	orderDescGiftMessage := orderFields[13].Descriptor()
+	// order.DefaultGiftMessage holds the default value on creation for the gift_message field.
+	order.DefaultGiftMessage = orderDescGiftMessage.Default.(string)
+	// orderDescAutoRenew is the schema descriptor for auto_renew field.
+	orderDescAutoRenew := orderFields[15].Descriptor()
+	// order.DefaultAutoRenew holds the default value on creation for the auto_renew field.
+	order.DefaultAutoRenew = orderDescAutoRenew.Default.(bool)
 	// orderDescID is the schema descriptor for id field.
 	orderDescID := orderFields[0].Descriptor()
 	// order.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	order.IDValidator = orderDescID.Validators[0].(func(int) error)
 	orderentryFields := schema.OrderEntry{}.Fields()
 	_ = orderentryFields
+	// orderentryDescVatRate is the schema descriptor for vat_rate field.
+	orderentryDescVatRate := orderentryFields[4].Descriptor()
+	// orderentry.DefaultVatRate holds the default value on creation for the vat_rate field.
+	orderentry.DefaultVatRate = orderentryDescVatRate.Default.(float64)
+	// orderentryDescNet is the schema descriptor for net field.
+	orderentryDescNet := orderentryFields[5].Descriptor()
+	// orderentry.DefaultNet holds the default value on creation for the net field.
+	orderentry.DefaultNet = orderentryDescNet.Default.(int)
+	// orderentryDescTax is the schema descriptor for tax field.
+	orderentryDescTax := orderentryFields[6].Descriptor()
+	// orderentry.DefaultTax holds the default value on creation for the tax field.
+	orderentry.DefaultTax = orderentryDescTax.Default.(int)
+	// orderentryDescGross is the schema descriptor for gross field.
+	orderentryDescGross := orderentryFields[7].Descriptor()
+	// orderentry.DefaultGross holds the default value on creation for the gross field.
+	orderentry.DefaultGross = orderentryDescGross.Default.(int)
 	// orderentryDescID is the schema descriptor for id field.
 	orderentryDescID := orderentryFields[0].Descriptor()
 	// orderentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	orderentry.IDValidator = orderentryDescID.Validators[0].(func(int) error)
 	pdfFields := schema.PDF{}.Fields()
 	_ = pdfFields
+	// pdfDescTimestamp is the schema descriptor for timestamp field.
+	pdfDescTimestamp := pdfFields[2].Descriptor()
+	// pdf.DefaultTimestamp holds the default value on creation for the timestamp field.
+	pdf.DefaultTimestamp = pdfDescTimestamp.Default.(func() time.Time)
 	// pdfDescID is the schema descriptor for id field.
 	pdfDescID := pdfFields[0].Descriptor()
 	// pdf.IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -178,10 +390,36 @@ func init() {
 	pdfdownloadDescDownloadCount := pdfdownloadFields[6].Descriptor()
 	// pdfdownload.DefaultDownloadCount holds the default value on creation for the download_count field.
 	pdfdownload.DefaultDownloadCount = pdfdownloadDescDownloadCount.Default.(int)
+	// pdfdownloadDescSuspended is the schema descriptor for suspended field.
+	pdfdownloadDescSuspended := pdfdownloadFields[9].Descriptor()
+	// pdfdownload.DefaultSuspended holds the default value on creation for the suspended field.
+	pdfdownload.DefaultSuspended = pdfdownloadDescSuspended.Default.(bool)
+	// pdfdownloadDescSuspendReason is the schema descriptor for suspend_reason field.
+	pdfdownloadDescSuspendReason := pdfdownloadFields[11].Descriptor()
+	// pdfdownload.DefaultSuspendReason holds the default value on creation for the suspend_reason field.
+	pdfdownload.DefaultSuspendReason = pdfdownloadDescSuspendReason.Default.(string)
 	// pdfdownloadDescID is the schema descriptor for id field.
 	pdfdownloadDescID := pdfdownloadFields[0].Descriptor()
 	// pdfdownload.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	pdfdownload.IDValidator = pdfdownloadDescID.Validators[0].(func(int) error)
+	pdfdownloadaccessFields := schema.PDFDownloadAccess{}.Fields()
+	_ = pdfdownloadaccessFields
+	// pdfdownloadaccessDescIP is the schema descriptor for ip field.
+	pdfdownloadaccessDescIP := pdfdownloadaccessFields[2].Descriptor()
+	// pdfdownloadaccess.DefaultIP holds the default value on creation for the ip field.
+	pdfdownloadaccess.DefaultIP = pdfdownloadaccessDescIP.Default.(string)
+	// pdfdownloadaccessDescUserAgent is the schema descriptor for user_agent field.
+	pdfdownloadaccessDescUserAgent := pdfdownloadaccessFields[3].Descriptor()
+	// pdfdownloadaccess.DefaultUserAgent holds the default value on creation for the user_agent field.
+	pdfdownloadaccess.DefaultUserAgent = pdfdownloadaccessDescUserAgent.Default.(string)
+	// pdfdownloadaccessDescReason is the schema descriptor for reason field.
+	pdfdownloadaccessDescReason := pdfdownloadaccessFields[5].Descriptor()
+	// pdfdownloadaccess.DefaultReason holds the default value on creation for the reason field.
+	pdfdownloadaccess.DefaultReason = pdfdownloadaccessDescReason.Default.(string)
+	// pdfdownloadaccessDescID is the schema descriptor for id field.
+	pdfdownloadaccessDescID := pdfdownloadaccessFields[0].Descriptor()
+	// pdfdownloadaccess.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	pdfdownloadaccess.IDValidator = pdfdownloadaccessDescID.Validators[0].(func(int) error)
 	paymentFields := schema.Payment{}.Fields()
 	_ = paymentFields
 	// paymentDescIsPos is the schema descriptor for is_pos field.
@@ -192,6 +430,16 @@ func init() {
 	paymentDescID := paymentFields[0].Descriptor()
 	// payment.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	payment.IDValidator = paymentDescID.Validators[0].(func(int) error)
+	paymentmandateFields := schema.PaymentMandate{}.Fields()
+	_ = paymentmandateFields
+	// paymentmandateDescCardNumber is the schema descriptor for card_number field.
+	paymentmandateDescCardNumber := paymentmandateFields[4].Descriptor()
+	// paymentmandate.DefaultCardNumber holds the default value on creation for the card_number field.
+	paymentmandate.DefaultCardNumber = paymentmandateDescCardNumber.Default.(string)
+	// paymentmandateDescID is the schema descriptor for id field.
+	paymentmandateDescID := paymentmandateFields[0].Descriptor()
+	// paymentmandate.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	paymentmandate.IDValidator = paymentmandateDescID.Validators[0].(func(int) error)
 	settingsFields := schema.Settings{}.Fields()
 	_ = settingsFields
 	// settingsDescAGBUrl is the schema descriptor for AGBUrl field.
@@ -306,10 +554,60 @@ func init() {
 	settingsDescWordPressInviteTTL := settingsFields[28].Descriptor()
 	// settings.DefaultWordPressInviteTTL holds the default value on creation for the WordPressInviteTTL field.
 	settings.DefaultWordPressInviteTTL = settingsDescWordPressInviteTTL.Default.(int)
+	// settingsDescPDFDownloadLimit is the schema descriptor for PDFDownloadLimit field.
+	settingsDescPDFDownloadLimit := settingsFields[29].Descriptor()
+	// settings.DefaultPDFDownloadLimit holds the default value on creation for the PDFDownloadLimit field.
+	settings.DefaultPDFDownloadLimit = settingsDescPDFDownloadLimit.Default.(int)
+	// settingsDescPDFDownloadExpiryDays is the schema descriptor for PDFDownloadExpiryDays field.
+	settingsDescPDFDownloadExpiryDays := settingsFields[30].Descriptor()
+	// settings.DefaultPDFDownloadExpiryDays holds the default value on creation for the PDFDownloadExpiryDays field.
+	settings.DefaultPDFDownloadExpiryDays = settingsDescPDFDownloadExpiryDays.Default.(int)
+	// settingsDescPDFDownloadMaxIPs is the schema descriptor for PDFDownloadMaxIPs field.
+	settingsDescPDFDownloadMaxIPs := settingsFields[31].Descriptor()
+	// settings.DefaultPDFDownloadMaxIPs holds the default value on creation for the PDFDownloadMaxIPs field.
+	settings.DefaultPDFDownloadMaxIPs = settingsDescPDFDownloadMaxIPs.Default.(int)
+	// settingsDescInvoicesEnabled is the schema descriptor for InvoicesEnabled field.
+	settingsDescInvoicesEnabled := settingsFields[33].Descriptor()
+	// settings.DefaultInvoicesEnabled holds the default value on creation for the InvoicesEnabled field.
+	settings.DefaultInvoicesEnabled = settingsDescInvoicesEnabled.Default.(bool)
+	// settingsDescInvoiceIssuerName is the schema descriptor for InvoiceIssuerName field.
+	settingsDescInvoiceIssuerName := settingsFields[34].Descriptor()
+	// settings.DefaultInvoiceIssuerName holds the default value on creation for the InvoiceIssuerName field.
+	settings.DefaultInvoiceIssuerName = settingsDescInvoiceIssuerName.Default.(string)
+	// settingsDescInvoiceIssuerAddress is the schema descriptor for InvoiceIssuerAddress field.
+	settingsDescInvoiceIssuerAddress := settingsFields[35].Descriptor()
+	// settings.DefaultInvoiceIssuerAddress holds the default value on creation for the InvoiceIssuerAddress field.
+	settings.DefaultInvoiceIssuerAddress = settingsDescInvoiceIssuerAddress.Default.(string)
+	// settingsDescInvoiceIssuerVATID is the schema descriptor for InvoiceIssuerVATID field.
+	settingsDescInvoiceIssuerVATID := settingsFields[36].Descriptor()
+	// settings.DefaultInvoiceIssuerVATID holds the default value on creation for the InvoiceIssuerVATID field.
+	settings.DefaultInvoiceIssuerVATID = settingsDescInvoiceIssuerVATID.Default.(string)
+	// settingsDescInvoiceFooter is the schema descriptor for InvoiceFooter field.
+	settingsDescInvoiceFooter := settingsFields[37].Descriptor()
+	// settings.DefaultInvoiceFooter holds the default value on creation for the InvoiceFooter field.
+	settings.DefaultInvoiceFooter = settingsDescInvoiceFooter.Default.(string)
+	// settingsDescDefaultVATRate is the schema descriptor for DefaultVATRate field.
+	settingsDescDefaultVATRate := settingsFields[38].Descriptor()
+	// settings.DefaultDefaultVATRate holds the default value on creation for the DefaultVATRate field.
+	settings.DefaultDefaultVATRate = settingsDescDefaultVATRate.Default.(float64)
 	// settingsDescID is the schema descriptor for id field.
 	settingsDescID := settingsFields[0].Descriptor()
 	// settings.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	settings.IDValidator = settingsDescID.Validators[0].(func(int) error)
+	stockmovementFields := schema.StockMovement{}.Fields()
+	_ = stockmovementFields
+	// stockmovementDescNote is the schema descriptor for note field.
+	stockmovementDescNote := stockmovementFields[4].Descriptor()
+	// stockmovement.DefaultNote holds the default value on creation for the note field.
+	stockmovement.DefaultNote = stockmovementDescNote.Default.(string)
+	// stockmovementDescCreatedBy is the schema descriptor for created_by field.
+	stockmovementDescCreatedBy := stockmovementFields[5].Descriptor()
+	// stockmovement.DefaultCreatedBy holds the default value on creation for the created_by field.
+	stockmovement.DefaultCreatedBy = stockmovementDescCreatedBy.Default.(string)
+	// stockmovementDescID is the schema descriptor for id field.
+	stockmovementDescID := stockmovementFields[0].Descriptor()
+	// stockmovement.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	stockmovement.IDValidator = stockmovementDescID.Validators[0].(func(int) error)
 	vendorFields := schema.Vendor{}.Fields()
 	_ = vendorFields
 	// vendorDescLicenseid is the schema descriptor for licenseid field.
@@ -31,6 +31,27 @@ func (Abonement) Fields() []ent.Field {
 		field.String("status").
 			Default("active").
 			StorageKey("status"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.Time("reminded_at").
+			Optional().
+			Nillable(),
+		field.Int("order_id").
+			StorageKey("paymentorder").
+			Optional().
+			Nillable(),
+		field.Bool("auto_renew").
+			Default(false),
+		field.Int("payment_mandate_id").
+			StorageKey("payment_mandate").
+			Optional().
+			Nillable(),
+		field.Int("renewal_attempts").
+			Default(0),
+		field.Time("next_renewal_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Optional().
 			Nillable().
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+// It authenticates machine clients like Odoo or Flour. Only a hash of the
+// key is stored, the prefix identifies the key.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("name"),
+		field.String("prefix").
+			Unique(),
+		field.String("key_hash").
+			Sensitive(),
+		field.Strings("permissions"),
+		field.Strings("route_groups"),
+		field.Strings("allowed_ips"),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.String("last_used_ip").
+			Default(""),
+		field.Int("rotated_from_id").
+			StorageKey("rotated_from").
+			Optional().
+			Nillable(),
+		field.String("created_by").
+			Default(""),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Annotations of the APIKey.
+func (APIKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_key"},
+	}
+}
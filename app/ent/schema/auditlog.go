@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// It records who changed what in the backoffice. Entries are never updated
+// or deleted.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("actor"),
+		field.String("actor_id").
+			Default(""),
+		field.String("action"),
+		field.String("entity_type"),
+		field.Int("entity_id").
+			Default(0),
+		field.JSON("changes", map[string]any{}),
+		field.String("request_id").
+			Default(""),
+		field.String("ip").
+			Default(""),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id"),
+		index.Fields("created_at"),
+	}
+}
+
+// Annotations of the AuditLog.
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "audit_log"},
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+// BillingAddress is the address a customer enters at checkout to receive
+// an invoice addressed to them or their business.
+type BillingAddress struct {
+	Name    string `json:"name"`
+	Company string `json:"company,omitempty"`
+	Street  string `json:"street"`
+	Zip     string `json:"zip"`
+	City    string `json:"city"`
+	Country string `json:"country"`
+	// VATID is the VAT identification number (UID) of a business
+	VATID string `json:"vat_id,omitempty"`
+}
+
+// InvoiceIssuer is the organization that issues invoices, as configured in
+// the settings.
+type InvoiceIssuer struct {
+	Name string `json:"name"`
+	// Address may span several lines
+	Address string `json:"address"`
+	VATID   string `json:"vat_id,omitempty"`
+	// Footer is printed at the bottom, e.g. bank details and registry
+	Footer string `json:"footer,omitempty"`
+}
+
+// InvoiceLine is a line of an invoice. Amounts are in cents, the unit
+// price and gross amount include VAT.
+type InvoiceLine struct {
+	ItemID    int     `json:"item_id"`
+	Name      string  `json:"name"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice int     `json:"unit_price"`
+	VATRate   float64 `json:"vat_rate"` // Percent
+	Net       int     `json:"net"`
+	Tax       int     `json:"tax"`
+	Gross     int     `json:"gross"`
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Consignment holds the schema definition for the Consignment entity.
+// It counts the copies of an issue a vendor took from the office, brought
+// back and already paid for.
+type Consignment struct {
+	ent.Schema
+}
+
+// Fields of the Consignment.
+func (Consignment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("handed_out").
+			Default(0),
+		field.Int("returned").
+			Default(0),
+		field.Int("settled").
+			Default(0),
+		field.Int("price"),
+		field.Time("settled_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at"),
+		field.Time("updated_at"),
+		field.Int("vendor_id").
+			StorageKey("vendor"),
+		field.Int("item_id").
+			StorageKey("item"),
+	}
+}
+
+// Edges of the Consignment.
+func (Consignment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("vendor", Vendor.Type).
+			Unique().
+			Required().
+			Field("vendor_id"),
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Field("item_id"),
+	}
+}
+
+// Indexes of the Consignment.
+func (Consignment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("vendor_id", "item_id").
+			Unique(),
+		index.Fields("item_id"),
+	}
+}
+
+// Annotations of the Consignment.
+func (Consignment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "consignment"},
+	}
+}
@@ -22,6 +22,10 @@ func (Customer) Fields() []ent.Field {
 			StorageKey("keycloakid"),
 		field.String("email").
 			Default(""),
+		// A changed email waits here until the customer verified it in
+		// Keycloak, orders are matched by the verified email only
+		field.String("pending_email").
+			Default(""),
 		field.String("firstname").
 			Default(""),
 		field.String("lastname").
@@ -29,6 +33,19 @@ func (Customer) Fields() []ent.Field {
 		field.String("licensegroups").
 			Default("").
 			StorageKey("licensegroups"),
+		// Preferences the customer manages themselves
+		field.Bool("newsletter").
+			Default(false),
+		field.Bool("notify_new_issues").
+			Default(true),
+		field.Bool("notify_abonement_reminders").
+			Default(true),
+		// Set while the customer asked for their account to be deleted
+		field.Time("deletion_requested_at").
+			Optional().
+			Nillable(),
+		field.String("deletion_reason").
+			Default(""),
 		field.Time("created_at").
 			Optional().
 			Nillable().
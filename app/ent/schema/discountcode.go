@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscountCode holds the schema definition for the DiscountCode entity.
+// It reduces the price of an order by a percentage or a fixed amount,
+// optionally only for one item or the items of a license group.
+type DiscountCode struct {
+	ent.Schema
+}
+
+// Fields of the DiscountCode.
+func (DiscountCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("code").
+			Unique(),
+		field.String("description").
+			Default(""),
+		field.String("kind"),
+		field.Int("value"),
+		field.Int("item_id").
+			StorageKey("item").
+			Optional().
+			Nillable(),
+		field.String("license_group").
+			Default(""),
+		field.Time("valid_from").
+			Optional().
+			Nillable(),
+		field.Time("valid_to").
+			Optional().
+			Nillable(),
+		field.Int("max_uses").
+			Default(0),
+		field.Int("uses").
+			Default(0),
+		field.Bool("disabled").
+			Default(false),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the DiscountCode.
+func (DiscountCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Annotations of the DiscountCode.
+func (DiscountCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "discount_code"},
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscountRedemption holds the schema definition for the DiscountRedemption
+// entity. It records the discount granted to an order by a discount code.
+type DiscountRedemption struct {
+	ent.Schema
+}
+
+// Fields of the DiscountRedemption.
+func (DiscountRedemption) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("discount_code_id").
+			StorageKey("discount_code"),
+		field.Int("order_id").
+			StorageKey("paymentorder"),
+		// Discount in cents
+		field.Int("amount"),
+		field.Time("created_at"),
+		// Set when the use of the code was given back because the order
+		// was not paid in time
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the DiscountRedemption.
+func (DiscountRedemption) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DiscountRedemption.
+func (DiscountRedemption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("discount_code_id"),
+		index.Fields("order_id"),
+	}
+}
+
+// Annotations of the DiscountRedemption.
+func (DiscountRedemption) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "discount_redemption"},
+	}
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Entitlement holds the schema definition for the Entitlement entity.
+// It grants a customer access to a single online issue or, if no item is
+// set, to all issues of a license group within the validity window.
+type Entitlement struct {
+	ent.Schema
+}
+
+// Fields of the Entitlement.
+func (Entitlement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("customer_id").
+			StorageKey("customer"),
+		field.Int("item_id").
+			StorageKey("item").
+			Optional().
+			Nillable(),
+		field.String("license_group").
+			Default(""),
+		field.String("source"),
+		field.Int("order_id").
+			StorageKey("paymentorder").
+			Optional().
+			Nillable(),
+		field.Int("abonement_id").
+			StorageKey("abonement").
+			Optional().
+			Nillable(),
+		field.Time("valid_from"),
+		field.Time("valid_to").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the Entitlement.
+func (Entitlement) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Entitlement.
+func (Entitlement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("customer_id"),
+		index.Fields("item_id"),
+		index.Fields("abonement_id"),
+	}
+}
+
+// Annotations of the Entitlement.
+func (Entitlement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "entitlement"},
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// GiftCode holds the schema definition for the GiftCode entity.
+// It is created for each abonement or digital item bought as a gift and
+// grants the item to whoever redeems it.
+type GiftCode struct {
+	ent.Schema
+}
+
+// Fields of the GiftCode.
+func (GiftCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("code").
+			Unique(),
+		field.Int("item_id").
+			StorageKey("item"),
+		field.Int("order_id").
+			StorageKey("paymentorder").
+			Optional().
+			Nillable(),
+		field.String("buyer_email"),
+		field.String("recipient_email").
+			Optional().
+			Nillable(),
+		field.String("message").
+			Default(""),
+		field.Time("send_at").
+			Optional().
+			Nillable(),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+		field.Time("redeemed_at").
+			Optional().
+			Nillable(),
+		field.Int("customer_id").
+			StorageKey("customer").
+			Optional().
+			Nillable(),
+		field.Int("abonement_id").
+			StorageKey("abonement").
+			Optional().
+			Nillable(),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the GiftCode.
+func (GiftCode) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the GiftCode.
+func (GiftCode) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("order_id"),
+		index.Fields("redeemed_at"),
+	}
+}
+
+// Annotations of the GiftCode.
+func (GiftCode) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "gift_code"},
+	}
+}
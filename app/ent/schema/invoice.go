@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// It is issued for a verified online order. Issuer, billing address and
+// lines are copied at issue time, so the invoice does not change when the
+// settings or items do.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("number").
+			Unique(),
+		field.Int("year"),
+		field.Int("sequence"),
+		field.Int("order_id").
+			StorageKey("paymentorder").
+			Unique(),
+		field.Int("customer_id").
+			StorageKey("customer").
+			Optional().
+			Nillable(),
+		field.String("email"),
+		field.JSON("billing_address", &BillingAddress{}).
+			Optional(),
+		field.JSON("issuer", InvoiceIssuer{}),
+		field.JSON("lines", []InvoiceLine{}),
+		field.Int("net"),
+		field.Int("tax"),
+		field.Int("gross"),
+		field.Time("issued_at"),
+		field.Time("sent_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Invoice.
+func (Invoice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("year", "sequence").
+			Unique(),
+		index.Fields("customer_id"),
+		index.Fields("issued_at"),
+	}
+}
+
+// Annotations of the Invoice.
+func (Invoice) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invoice"},
+	}
+}
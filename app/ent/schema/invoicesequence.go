@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// InvoiceSequence holds the schema definition for the InvoiceSequence
+// entity. It counts the invoices of a year, so invoice numbers have no gaps.
+type InvoiceSequence struct {
+	ent.Schema
+}
+
+// Fields of the InvoiceSequence.
+func (InvoiceSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("year").
+			Unique(),
+		field.Int("last").
+			Default(0),
+	}
+}
+
+// Edges of the InvoiceSequence.
+func (InvoiceSequence) Edges() []ent.Edge {
+	return nil
+}
+
+// Annotations of the InvoiceSequence.
+func (InvoiceSequence) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "invoice_sequence"},
+	}
+}
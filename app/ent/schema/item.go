@@ -51,6 +51,39 @@ func (Item) Fields() []ent.Field {
 		field.String("ItemTextColor").
 			StorageKey("itemtextcolor").
 			Default("#000000"),
+		field.Bool("TrackStock").
+			StorageKey("trackstock").
+			Default(false),
+		field.Int("Stock").
+			StorageKey("stock").
+			Default(0),
+		field.Int("LowStockThreshold").
+			StorageKey("lowstockthreshold").
+			Default(0),
+		field.Time("PublishAt").
+			StorageKey("publishat").
+			Optional().
+			Nillable(),
+		field.Time("UnpublishAt").
+			StorageKey("unpublishat").
+			Optional().
+			Nillable(),
+		field.Int("PDFDownloadLimit").
+			StorageKey("pdfdownloadlimit").
+			Optional().
+			Nillable(),
+		field.Int("PDFDownloadExpiryDays").
+			StorageKey("pdfdownloadexpirydays").
+			Optional().
+			Nillable(),
+		// VAT rate in percent, empty uses the default rate of the settings
+		field.Float("VATRate").
+			StorageKey("vatrate").
+			Optional().
+			Nillable(),
+		field.JSON("ImageVariants", map[string]string{}).
+			StorageKey("imagevariants").
+			Optional(),
 	}
 	for _, f := range fields {
 		f.Descriptor().Tag = `json:"` + f.Descriptor().Name + `"`
@@ -23,6 +23,13 @@ func (Location) Fields() []ent.Field {
 		field.Float("latitude").
 			Default(0.1),
 		field.String("zip"),
+		field.Float("geocoding_confidence").
+			Default(0),
+		// Last failed geocoding of the address, so the batch geocoding
+		// tries other locations first
+		field.Time("geocode_attempted_at").
+			Optional().
+			Nillable(),
 		field.JSON("working_time", &WorkingTime{}),
 	}
 }
@@ -37,6 +37,25 @@ func (Order) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			StorageKey("customeremail"),
+		// Gift orders grant their items through gift codes instead of to
+		// the customer
+		field.Bool("gift").
+			Default(false),
+		field.String("gift_recipient_email").
+			Optional().
+			Nillable(),
+		field.Time("gift_send_at").
+			Optional().
+			Nillable(),
+		field.String("gift_message").
+			Default(""),
+		// Entered at checkout for the invoice
+		field.JSON("billing_address", &BillingAddress{}).
+			Optional(),
+		// The buyer agreed at checkout to renew the abonements of the order
+		// automatically with their payment method
+		field.Bool("auto_renew").
+			Default(false),
 	}
 }
 
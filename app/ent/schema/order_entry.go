@@ -21,6 +21,16 @@ func (OrderEntry) Fields() []ent.Field {
 		field.Int("quantity"),
 		field.Int("price"),
 		field.Bool("is_sale"),
+		// Taxes at the time of purchase, amounts in cents for the whole
+		// entry. Entries from before VAT was recorded have zeros.
+		field.Float("vat_rate").
+			Default(0),
+		field.Int("net").
+			Default(0),
+		field.Int("tax").
+			Default(0),
+		field.Int("gross").
+			Default(0),
 		// Foreign keys as fields if not using Edges for everything,
 		// but Ent prefers Edges.
 		// However, existing SQL uses IDs.
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PaymentMandate holds the schema definition for the PaymentMandate entity.
+// It stores the token a payment provider returned for the first purchase of
+// an abonement, so the abonement can be charged again on renewal.
+type PaymentMandate struct {
+	ent.Schema
+}
+
+// Fields of the PaymentMandate.
+func (PaymentMandate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("customer_id").
+			StorageKey("customer"),
+		field.String("provider"),
+		field.String("token").
+			Sensitive(),
+		field.String("card_number").
+			Default(""),
+		field.Int("order_id").
+			StorageKey("paymentorder").
+			Optional().
+			Nillable(),
+		field.Time("created_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PaymentMandate.
+func (PaymentMandate) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PaymentMandate.
+func (PaymentMandate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("customer_id"),
+	}
+}
+
+// Annotations of the PaymentMandate.
+func (PaymentMandate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "payment_mandate"},
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -18,7 +20,8 @@ func (PDF) Fields() []ent.Field {
 		field.Int("id").
 			Positive(),
 		field.String("path"),
-		field.String("timestamp"),
+		field.Time("timestamp").
+			Default(time.Now),
 	}
 }
 
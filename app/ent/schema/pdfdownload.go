@@ -34,6 +34,13 @@ func (PDFDownload) Fields() []ent.Field {
 		field.Int("item_id").
 			Optional().
 			Nillable(),
+		field.Bool("suspended").
+			Default(false),
+		field.Time("suspended_at").
+			Optional().
+			Nillable(),
+		field.String("suspend_reason").
+			Default(""),
 	}
 }
 
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PDFDownloadAccess holds the schema definition for the PDFDownloadAccess entity.
+// Each request to a PDF download link is logged, including denied ones.
+type PDFDownloadAccess struct {
+	ent.Schema
+}
+
+// Fields of the PDFDownloadAccess.
+func (PDFDownloadAccess) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.Int("pdf_download_id"),
+		field.String("ip").
+			Default(""),
+		field.String("user_agent").
+			Default(""),
+		field.Bool("allowed"),
+		field.String("reason").
+			Default(""),
+		field.Time("created_at"),
+	}
+}
+
+// Edges of the PDFDownloadAccess.
+func (PDFDownloadAccess) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PDFDownloadAccess.
+func (PDFDownloadAccess) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("pdf_download_id", "created_at"),
+	}
+}
+
+// Annotations of the PDFDownloadAccess.
+func (PDFDownloadAccess) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "pdf_download_access"},
+	}
+}
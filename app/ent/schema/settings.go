@@ -97,6 +97,36 @@ func (Settings) Fields() []ent.Field {
 		field.Int("WordPressInviteTTL").
 			StorageKey("wordpressinvitettl").
 			Default(604800),
+		field.Int("PDFDownloadLimit").
+			StorageKey("pdfdownloadlimit").
+			Default(0),
+		field.Int("PDFDownloadExpiryDays").
+			StorageKey("pdfdownloadexpirydays").
+			Default(42),
+		field.Int("PDFDownloadMaxIPs").
+			StorageKey("pdfdownloadmaxips").
+			Default(10),
+		field.JSON("ImageVariants", map[string]map[string]string{}).
+			StorageKey("imagevariants").
+			Optional(),
+		field.Bool("InvoicesEnabled").
+			StorageKey("invoicesenabled").
+			Default(false),
+		field.String("InvoiceIssuerName").
+			StorageKey("invoiceissuername").
+			Default(""),
+		field.String("InvoiceIssuerAddress").
+			StorageKey("invoiceissueraddress").
+			Default(""),
+		field.String("InvoiceIssuerVATID").
+			StorageKey("invoiceissuervatid").
+			Default(""),
+		field.String("InvoiceFooter").
+			StorageKey("invoicefooter").
+			Default(""),
+		field.Float("DefaultVATRate").
+			StorageKey("defaultvatrate").
+			Default(10),
 	}
 	for _, f := range fields {
 		f.Descriptor().Tag = `json:"` + f.Descriptor().Name + `"`
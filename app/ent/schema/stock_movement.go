@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StockMovement holds the schema definition for the StockMovement entity.
+// Each movement changes the office stock of an item by Quantity.
+type StockMovement struct {
+	ent.Schema
+}
+
+// Fields of the StockMovement.
+func (StockMovement) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			Positive(),
+		field.String("type"),
+		field.Int("quantity"),
+		field.Int("stock_after"),
+		field.String("note").
+			Default(""),
+		field.String("created_by").
+			Default(""),
+		field.Time("created_at"),
+		field.Int("item_id").
+			StorageKey("item"),
+		field.Int("vendor_id").
+			Optional().
+			Nillable().
+			StorageKey("vendor"),
+	}
+}
+
+// Edges of the StockMovement.
+func (StockMovement) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Field("item_id"),
+		edge.To("vendor", Vendor.Type).
+			Unique().
+			Field("vendor_id"),
+	}
+}
+
+// Indexes of the StockMovement.
+func (StockMovement) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "created_at"),
+		index.Fields("vendor_id"),
+	}
+}
+
+// Annotations of the StockMovement.
+func (StockMovement) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "stock_movement"},
+	}
+}
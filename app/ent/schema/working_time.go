@@ -17,6 +17,23 @@ type WorkingTime struct {
 
 	// WholeWeek indicates the location is open the whole week (24/7).
 	WholeWeek bool `json:"whole_week,omitempty"`
+
+	// ClosedOnHolidays marks the location as closed on Austrian public
+	// holidays regardless of the regular opening hours.
+	ClosedOnHolidays bool `json:"closed_on_holidays,omitempty"`
+
+	// Exceptions override the regular opening hours on specific dates,
+	// e.g. a closure for vacation or extended hours before Christmas.
+	Exceptions []DateException `json:"exceptions,omitempty"`
+}
+
+// DateException replaces the opening hours of a single date. Date is
+// expected as YYYY-MM-DD. If Closed is set, Ranges are ignored.
+type DateException struct {
+	Date   string      `json:"date"`
+	Closed bool        `json:"closed,omitempty"`
+	Ranges []TimeRange `json:"ranges,omitempty"`
+	Note   string      `json:"note,omitempty"`
 }
 
 // TimeRange represents an opening period. Use FullDay to indicate the
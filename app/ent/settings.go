@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -73,6 +74,26 @@ type Settings struct {
 	WordPressInviteAPIKey string `json:"WordPressInviteAPIKey"`
 	// WordPressInviteTTL holds the value of the "WordPressInviteTTL" field.
 	WordPressInviteTTL int `json:"WordPressInviteTTL"`
+	// PDFDownloadLimit holds the value of the "PDFDownloadLimit" field.
+	PDFDownloadLimit int `json:"PDFDownloadLimit"`
+	// PDFDownloadExpiryDays holds the value of the "PDFDownloadExpiryDays" field.
+	PDFDownloadExpiryDays int `json:"PDFDownloadExpiryDays"`
+	// PDFDownloadMaxIPs holds the value of the "PDFDownloadMaxIPs" field.
+	PDFDownloadMaxIPs int `json:"PDFDownloadMaxIPs"`
+	// ImageVariants holds the value of the "ImageVariants" field.
+	ImageVariants map[string]map[string]string `json:"ImageVariants"`
+	// InvoicesEnabled holds the value of the "InvoicesEnabled" field.
+	InvoicesEnabled bool `json:"InvoicesEnabled"`
+	// InvoiceIssuerName holds the value of the "InvoiceIssuerName" field.
+	InvoiceIssuerName string `json:"InvoiceIssuerName"`
+	// InvoiceIssuerAddress holds the value of the "InvoiceIssuerAddress" field.
+	InvoiceIssuerAddress string `json:"InvoiceIssuerAddress"`
+	// InvoiceIssuerVATID holds the value of the "InvoiceIssuerVATID" field.
+	InvoiceIssuerVATID string `json:"InvoiceIssuerVATID"`
+	// InvoiceFooter holds the value of the "InvoiceFooter" field.
+	InvoiceFooter string `json:"InvoiceFooter"`
+	// DefaultVATRate holds the value of the "DefaultVATRate" field.
+	DefaultVATRate float64 `json:"DefaultVATRate"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SettingsQuery when eager-loading is set.
 	Edges        SettingsEdges `json:"edges"`
@@ -105,13 +126,15 @@ func (*Settings) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case settings.FieldOrgaCoversTransactionCosts, settings.FieldWebshopIsClosed, settings.FieldUseVendorLicenseIdInShop, settings.FieldQRCodeEnableLogo, settings.FieldUseTipInsteadOfDonation, settings.FieldShopLanding, settings.FieldAbonementEnabled, settings.FieldPOSEnabled:
+		case settings.FieldImageVariants:
+			values[i] = new([]byte)
+		case settings.FieldOrgaCoversTransactionCosts, settings.FieldWebshopIsClosed, settings.FieldUseVendorLicenseIdInShop, settings.FieldQRCodeEnableLogo, settings.FieldUseTipInsteadOfDonation, settings.FieldShopLanding, settings.FieldAbonementEnabled, settings.FieldPOSEnabled, settings.FieldInvoicesEnabled:
 			values[i] = new(sql.NullBool)
-		case settings.FieldMapCenterLat, settings.FieldMapCenterLong:
+		case settings.FieldMapCenterLat, settings.FieldMapCenterLong, settings.FieldDefaultVATRate:
 			values[i] = new(sql.NullFloat64)
-		case settings.FieldID, settings.FieldMaxOrderAmount, settings.FieldWordPressInviteTTL:
+		case settings.FieldID, settings.FieldMaxOrderAmount, settings.FieldWordPressInviteTTL, settings.FieldPDFDownloadLimit, settings.FieldPDFDownloadExpiryDays, settings.FieldPDFDownloadMaxIPs:
 			values[i] = new(sql.NullInt64)
-		case settings.FieldAGBUrl, settings.FieldColor, settings.FieldFontColor, settings.FieldLogo, settings.FieldVendorNotFoundHelpUrl, settings.FieldMaintainanceModeHelpUrl, settings.FieldVendorEmailPostfix, settings.FieldNewspaperName, settings.FieldQRCodeUrl, settings.FieldQRCodeLogoImgUrl, settings.FieldFavicon, settings.FieldQRCodeSettings, settings.FieldDigitalItemsUrl, settings.FieldAbonementUrl, settings.FieldWordPressInviteURL, settings.FieldWordPressInviteAPIKey:
+		case settings.FieldAGBUrl, settings.FieldColor, settings.FieldFontColor, settings.FieldLogo, settings.FieldVendorNotFoundHelpUrl, settings.FieldMaintainanceModeHelpUrl, settings.FieldVendorEmailPostfix, settings.FieldNewspaperName, settings.FieldQRCodeUrl, settings.FieldQRCodeLogoImgUrl, settings.FieldFavicon, settings.FieldQRCodeSettings, settings.FieldDigitalItemsUrl, settings.FieldAbonementUrl, settings.FieldWordPressInviteURL, settings.FieldWordPressInviteAPIKey, settings.FieldInvoiceIssuerName, settings.FieldInvoiceIssuerAddress, settings.FieldInvoiceIssuerVATID, settings.FieldInvoiceFooter:
 			values[i] = new(sql.NullString)
 		case settings.ForeignKeys[0]: // mainitem
 			values[i] = new(sql.NullInt64)
@@ -304,6 +327,68 @@ func (_m *Settings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.WordPressInviteTTL = int(value.Int64)
 			}
+		case settings.FieldPDFDownloadLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field PDFDownloadLimit", values[i])
+			} else if value.Valid {
+				_m.PDFDownloadLimit = int(value.Int64)
+			}
+		case settings.FieldPDFDownloadExpiryDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field PDFDownloadExpiryDays", values[i])
+			} else if value.Valid {
+				_m.PDFDownloadExpiryDays = int(value.Int64)
+			}
+		case settings.FieldPDFDownloadMaxIPs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field PDFDownloadMaxIPs", values[i])
+			} else if value.Valid {
+				_m.PDFDownloadMaxIPs = int(value.Int64)
+			}
+		case settings.FieldImageVariants:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ImageVariants", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ImageVariants); err != nil {
+					return fmt.Errorf("unmarshal field ImageVariants: %w", err)
+				}
+			}
+		case settings.FieldInvoicesEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field InvoicesEnabled", values[i])
+			} else if value.Valid {
+				_m.InvoicesEnabled = value.Bool
+			}
+		case settings.FieldInvoiceIssuerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field InvoiceIssuerName", values[i])
+			} else if value.Valid {
+				_m.InvoiceIssuerName = value.String
+			}
+		case settings.FieldInvoiceIssuerAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field InvoiceIssuerAddress", values[i])
+			} else if value.Valid {
+				_m.InvoiceIssuerAddress = value.String
+			}
+		case settings.FieldInvoiceIssuerVATID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field InvoiceIssuerVATID", values[i])
+			} else if value.Valid {
+				_m.InvoiceIssuerVATID = value.String
+			}
+		case settings.FieldInvoiceFooter:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field InvoiceFooter", values[i])
+			} else if value.Valid {
+				_m.InvoiceFooter = value.String
+			}
+		case settings.FieldDefaultVATRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field DefaultVATRate", values[i])
+			} else if value.Valid {
+				_m.DefaultVATRate = value.Float64
+			}
 		case settings.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field mainitem", value)
@@ -435,6 +520,36 @@ func (_m *Settings) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("WordPressInviteTTL=")
 	builder.WriteString(fmt.Sprintf("%v", _m.WordPressInviteTTL))
+	builder.WriteString(", ")
+	builder.WriteString("PDFDownloadLimit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PDFDownloadLimit))
+	builder.WriteString(", ")
+	builder.WriteString("PDFDownloadExpiryDays=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PDFDownloadExpiryDays))
+	builder.WriteString(", ")
+	builder.WriteString("PDFDownloadMaxIPs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PDFDownloadMaxIPs))
+	builder.WriteString(", ")
+	builder.WriteString("ImageVariants=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ImageVariants))
+	builder.WriteString(", ")
+	builder.WriteString("InvoicesEnabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InvoicesEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("InvoiceIssuerName=")
+	builder.WriteString(_m.InvoiceIssuerName)
+	builder.WriteString(", ")
+	builder.WriteString("InvoiceIssuerAddress=")
+	builder.WriteString(_m.InvoiceIssuerAddress)
+	builder.WriteString(", ")
+	builder.WriteString("InvoiceIssuerVATID=")
+	builder.WriteString(_m.InvoiceIssuerVATID)
+	builder.WriteString(", ")
+	builder.WriteString("InvoiceFooter=")
+	builder.WriteString(_m.InvoiceFooter)
+	builder.WriteString(", ")
+	builder.WriteString("DefaultVATRate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultVATRate))
 	builder.WriteByte(')')
 	return builder.String()
 }
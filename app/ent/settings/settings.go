@@ -68,6 +68,26 @@ const (
 	FieldWordPressInviteAPIKey = "wordpressinviteapikey"
 	// FieldWordPressInviteTTL holds the string denoting the wordpressinvitettl field in the database.
 	FieldWordPressInviteTTL = "wordpressinvitettl"
+	// FieldPDFDownloadLimit holds the string denoting the pdfdownloadlimit field in the database.
+	FieldPDFDownloadLimit = "pdfdownloadlimit"
+	// FieldPDFDownloadExpiryDays holds the string denoting the pdfdownloadexpirydays field in the database.
+	FieldPDFDownloadExpiryDays = "pdfdownloadexpirydays"
+	// FieldPDFDownloadMaxIPs holds the string denoting the pdfdownloadmaxips field in the database.
+	FieldPDFDownloadMaxIPs = "pdfdownloadmaxips"
+	// FieldImageVariants holds the string denoting the imagevariants field in the database.
+	FieldImageVariants = "imagevariants"
+	// FieldInvoicesEnabled holds the string denoting the invoicesenabled field in the database.
+	FieldInvoicesEnabled = "invoicesenabled"
+	// FieldInvoiceIssuerName holds the string denoting the invoiceissuername field in the database.
+	FieldInvoiceIssuerName = "invoiceissuername"
+	// FieldInvoiceIssuerAddress holds the string denoting the invoiceissueraddress field in the database.
+	FieldInvoiceIssuerAddress = "invoiceissueraddress"
+	// FieldInvoiceIssuerVATID holds the string denoting the invoiceissuervatid field in the database.
+	FieldInvoiceIssuerVATID = "invoiceissuervatid"
+	// FieldInvoiceFooter holds the string denoting the invoicefooter field in the database.
+	FieldInvoiceFooter = "invoicefooter"
+	// FieldDefaultVATRate holds the string denoting the defaultvatrate field in the database.
+	FieldDefaultVATRate = "defaultvatrate"
 	// EdgeMainItem holds the string denoting the mainitem edge name in mutations.
 	EdgeMainItem = "MainItem"
 	// Table holds the table name of the settings in the database.
@@ -112,6 +132,16 @@ var Columns = []string{
 	FieldWordPressInviteURL,
 	FieldWordPressInviteAPIKey,
 	FieldWordPressInviteTTL,
+	FieldPDFDownloadLimit,
+	FieldPDFDownloadExpiryDays,
+	FieldPDFDownloadMaxIPs,
+	FieldImageVariants,
+	FieldInvoicesEnabled,
+	FieldInvoiceIssuerName,
+	FieldInvoiceIssuerAddress,
+	FieldInvoiceIssuerVATID,
+	FieldInvoiceFooter,
+	FieldDefaultVATRate,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "settings"
@@ -192,6 +222,24 @@ var (
 	DefaultWordPressInviteAPIKey string
 	// DefaultWordPressInviteTTL holds the default value on creation for the "WordPressInviteTTL" field.
 	DefaultWordPressInviteTTL int
+	// DefaultPDFDownloadLimit holds the default value on creation for the "PDFDownloadLimit" field.
+	DefaultPDFDownloadLimit int
+	// DefaultPDFDownloadExpiryDays holds the default value on creation for the "PDFDownloadExpiryDays" field.
+	DefaultPDFDownloadExpiryDays int
+	// DefaultPDFDownloadMaxIPs holds the default value on creation for the "PDFDownloadMaxIPs" field.
+	DefaultPDFDownloadMaxIPs int
+	// DefaultInvoicesEnabled holds the default value on creation for the "InvoicesEnabled" field.
+	DefaultInvoicesEnabled bool
+	// DefaultInvoiceIssuerName holds the default value on creation for the "InvoiceIssuerName" field.
+	DefaultInvoiceIssuerName string
+	// DefaultInvoiceIssuerAddress holds the default value on creation for the "InvoiceIssuerAddress" field.
+	DefaultInvoiceIssuerAddress string
+	// DefaultInvoiceIssuerVATID holds the default value on creation for the "InvoiceIssuerVATID" field.
+	DefaultInvoiceIssuerVATID string
+	// DefaultInvoiceFooter holds the default value on creation for the "InvoiceFooter" field.
+	DefaultInvoiceFooter string
+	// DefaultDefaultVATRate holds the default value on creation for the "DefaultVATRate" field.
+	DefaultDefaultVATRate float64
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(int) error
 )
@@ -344,6 +392,51 @@ func ByWordPressInviteTTL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWordPressInviteTTL, opts...).ToFunc()
 }
 
+// ByPDFDownloadLimit orders the results by the PDFDownloadLimit field.
+func ByPDFDownloadLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPDFDownloadLimit, opts...).ToFunc()
+}
+
+// ByPDFDownloadExpiryDays orders the results by the PDFDownloadExpiryDays field.
+func ByPDFDownloadExpiryDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPDFDownloadExpiryDays, opts...).ToFunc()
+}
+
+// ByPDFDownloadMaxIPs orders the results by the PDFDownloadMaxIPs field.
+func ByPDFDownloadMaxIPs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPDFDownloadMaxIPs, opts...).ToFunc()
+}
+
+// ByInvoicesEnabled orders the results by the InvoicesEnabled field.
+func ByInvoicesEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoicesEnabled, opts...).ToFunc()
+}
+
+// ByInvoiceIssuerName orders the results by the InvoiceIssuerName field.
+func ByInvoiceIssuerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceIssuerName, opts...).ToFunc()
+}
+
+// ByInvoiceIssuerAddress orders the results by the InvoiceIssuerAddress field.
+func ByInvoiceIssuerAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceIssuerAddress, opts...).ToFunc()
+}
+
+// ByInvoiceIssuerVATID orders the results by the InvoiceIssuerVATID field.
+func ByInvoiceIssuerVATID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceIssuerVATID, opts...).ToFunc()
+}
+
+// ByInvoiceFooter orders the results by the InvoiceFooter field.
+func ByInvoiceFooter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInvoiceFooter, opts...).ToFunc()
+}
+
+// ByDefaultVATRate orders the results by the DefaultVATRate field.
+func ByDefaultVATRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultVATRate, opts...).ToFunc()
+}
+
 // ByMainItemField orders the results by MainItem field.
 func ByMainItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -193,6 +193,51 @@ func WordPressInviteTTL(v int) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldWordPressInviteTTL, v))
 }
 
+// PDFDownloadLimit applies equality check predicate on the "PDFDownloadLimit" field. It's identical to PDFDownloadLimitEQ.
+func PDFDownloadLimit(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadExpiryDays applies equality check predicate on the "PDFDownloadExpiryDays" field. It's identical to PDFDownloadExpiryDaysEQ.
+func PDFDownloadExpiryDays(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadMaxIPs applies equality check predicate on the "PDFDownloadMaxIPs" field. It's identical to PDFDownloadMaxIPsEQ.
+func PDFDownloadMaxIPs(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadMaxIPs, v))
+}
+
+// InvoicesEnabled applies equality check predicate on the "InvoicesEnabled" field. It's identical to InvoicesEnabledEQ.
+func InvoicesEnabled(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoicesEnabled, v))
+}
+
+// InvoiceIssuerName applies equality check predicate on the "InvoiceIssuerName" field. It's identical to InvoiceIssuerNameEQ.
+func InvoiceIssuerName(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerAddress applies equality check predicate on the "InvoiceIssuerAddress" field. It's identical to InvoiceIssuerAddressEQ.
+func InvoiceIssuerAddress(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerVATID applies equality check predicate on the "InvoiceIssuerVATID" field. It's identical to InvoiceIssuerVATIDEQ.
+func InvoiceIssuerVATID(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceFooter applies equality check predicate on the "InvoiceFooter" field. It's identical to InvoiceFooterEQ.
+func InvoiceFooter(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceFooter, v))
+}
+
+// DefaultVATRate applies equality check predicate on the "DefaultVATRate" field. It's identical to DefaultVATRateEQ.
+func DefaultVATRate(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldDefaultVATRate, v))
+}
+
 // AGBUrlEQ applies the EQ predicate on the "AGBUrl" field.
 func AGBUrlEQ(v string) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldAGBUrl, v))
@@ -1473,6 +1518,446 @@ func WordPressInviteTTLLTE(v int) predicate.Settings {
 	return predicate.Settings(sql.FieldLTE(FieldWordPressInviteTTL, v))
 }
 
+// PDFDownloadLimitEQ applies the EQ predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitNEQ applies the NEQ predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitIn applies the In predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPDFDownloadLimit, vs...))
+}
+
+// PDFDownloadLimitNotIn applies the NotIn predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPDFDownloadLimit, vs...))
+}
+
+// PDFDownloadLimitGT applies the GT predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitGTE applies the GTE predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitLT applies the LT predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadLimitLTE applies the LTE predicate on the "PDFDownloadLimit" field.
+func PDFDownloadLimitLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPDFDownloadLimit, v))
+}
+
+// PDFDownloadExpiryDaysEQ applies the EQ predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysNEQ applies the NEQ predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysIn applies the In predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPDFDownloadExpiryDays, vs...))
+}
+
+// PDFDownloadExpiryDaysNotIn applies the NotIn predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPDFDownloadExpiryDays, vs...))
+}
+
+// PDFDownloadExpiryDaysGT applies the GT predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysGTE applies the GTE predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysLT applies the LT predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadExpiryDaysLTE applies the LTE predicate on the "PDFDownloadExpiryDays" field.
+func PDFDownloadExpiryDaysLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPDFDownloadExpiryDays, v))
+}
+
+// PDFDownloadMaxIPsEQ applies the EQ predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPDFDownloadMaxIPs, v))
+}
+
+// PDFDownloadMaxIPsNEQ applies the NEQ predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPDFDownloadMaxIPs, v))
+}
+
+// PDFDownloadMaxIPsIn applies the In predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPDFDownloadMaxIPs, vs...))
+}
+
+// PDFDownloadMaxIPsNotIn applies the NotIn predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPDFDownloadMaxIPs, vs...))
+}
+
+// PDFDownloadMaxIPsGT applies the GT predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPDFDownloadMaxIPs, v))
+}
+
+// PDFDownloadMaxIPsGTE applies the GTE predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPDFDownloadMaxIPs, v))
+}
+
+// PDFDownloadMaxIPsLT applies the LT predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPDFDownloadMaxIPs, v))
+}
+
+// PDFDownloadMaxIPsLTE applies the LTE predicate on the "PDFDownloadMaxIPs" field.
+func PDFDownloadMaxIPsLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPDFDownloadMaxIPs, v))
+}
+
+// ImageVariantsIsNil applies the IsNil predicate on the "ImageVariants" field.
+func ImageVariantsIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldImageVariants))
+}
+
+// ImageVariantsNotNil applies the NotNil predicate on the "ImageVariants" field.
+func ImageVariantsNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldImageVariants))
+}
+
+// InvoicesEnabledEQ applies the EQ predicate on the "InvoicesEnabled" field.
+func InvoicesEnabledEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoicesEnabled, v))
+}
+
+// InvoicesEnabledNEQ applies the NEQ predicate on the "InvoicesEnabled" field.
+func InvoicesEnabledNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoicesEnabled, v))
+}
+
+// InvoiceIssuerNameEQ applies the EQ predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameNEQ applies the NEQ predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameIn applies the In predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldInvoiceIssuerName, vs...))
+}
+
+// InvoiceIssuerNameNotIn applies the NotIn predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldInvoiceIssuerName, vs...))
+}
+
+// InvoiceIssuerNameGT applies the GT predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameGTE applies the GTE predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameLT applies the LT predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameLTE applies the LTE predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameContains applies the Contains predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameHasPrefix applies the HasPrefix predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameHasSuffix applies the HasSuffix predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameEqualFold applies the EqualFold predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerNameContainsFold applies the ContainsFold predicate on the "InvoiceIssuerName" field.
+func InvoiceIssuerNameContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldInvoiceIssuerName, v))
+}
+
+// InvoiceIssuerAddressEQ applies the EQ predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressNEQ applies the NEQ predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressIn applies the In predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldInvoiceIssuerAddress, vs...))
+}
+
+// InvoiceIssuerAddressNotIn applies the NotIn predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldInvoiceIssuerAddress, vs...))
+}
+
+// InvoiceIssuerAddressGT applies the GT predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressGTE applies the GTE predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressLT applies the LT predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressLTE applies the LTE predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressContains applies the Contains predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressHasPrefix applies the HasPrefix predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressHasSuffix applies the HasSuffix predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressEqualFold applies the EqualFold predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerAddressContainsFold applies the ContainsFold predicate on the "InvoiceIssuerAddress" field.
+func InvoiceIssuerAddressContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldInvoiceIssuerAddress, v))
+}
+
+// InvoiceIssuerVATIDEQ applies the EQ predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDNEQ applies the NEQ predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDIn applies the In predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldInvoiceIssuerVATID, vs...))
+}
+
+// InvoiceIssuerVATIDNotIn applies the NotIn predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldInvoiceIssuerVATID, vs...))
+}
+
+// InvoiceIssuerVATIDGT applies the GT predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDGTE applies the GTE predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDLT applies the LT predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDLTE applies the LTE predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDContains applies the Contains predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDHasPrefix applies the HasPrefix predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDHasSuffix applies the HasSuffix predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDEqualFold applies the EqualFold predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceIssuerVATIDContainsFold applies the ContainsFold predicate on the "InvoiceIssuerVATID" field.
+func InvoiceIssuerVATIDContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldInvoiceIssuerVATID, v))
+}
+
+// InvoiceFooterEQ applies the EQ predicate on the "InvoiceFooter" field.
+func InvoiceFooterEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterNEQ applies the NEQ predicate on the "InvoiceFooter" field.
+func InvoiceFooterNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterIn applies the In predicate on the "InvoiceFooter" field.
+func InvoiceFooterIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldInvoiceFooter, vs...))
+}
+
+// InvoiceFooterNotIn applies the NotIn predicate on the "InvoiceFooter" field.
+func InvoiceFooterNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldInvoiceFooter, vs...))
+}
+
+// InvoiceFooterGT applies the GT predicate on the "InvoiceFooter" field.
+func InvoiceFooterGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterGTE applies the GTE predicate on the "InvoiceFooter" field.
+func InvoiceFooterGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterLT applies the LT predicate on the "InvoiceFooter" field.
+func InvoiceFooterLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterLTE applies the LTE predicate on the "InvoiceFooter" field.
+func InvoiceFooterLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterContains applies the Contains predicate on the "InvoiceFooter" field.
+func InvoiceFooterContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterHasPrefix applies the HasPrefix predicate on the "InvoiceFooter" field.
+func InvoiceFooterHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterHasSuffix applies the HasSuffix predicate on the "InvoiceFooter" field.
+func InvoiceFooterHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterEqualFold applies the EqualFold predicate on the "InvoiceFooter" field.
+func InvoiceFooterEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldInvoiceFooter, v))
+}
+
+// InvoiceFooterContainsFold applies the ContainsFold predicate on the "InvoiceFooter" field.
+func InvoiceFooterContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldInvoiceFooter, v))
+}
+
+// DefaultVATRateEQ applies the EQ predicate on the "DefaultVATRate" field.
+func DefaultVATRateEQ(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldDefaultVATRate, v))
+}
+
+// DefaultVATRateNEQ applies the NEQ predicate on the "DefaultVATRate" field.
+func DefaultVATRateNEQ(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldDefaultVATRate, v))
+}
+
+// DefaultVATRateIn applies the In predicate on the "DefaultVATRate" field.
+func DefaultVATRateIn(vs ...float64) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldDefaultVATRate, vs...))
+}
+
+// DefaultVATRateNotIn applies the NotIn predicate on the "DefaultVATRate" field.
+func DefaultVATRateNotIn(vs ...float64) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldDefaultVATRate, vs...))
+}
+
+// DefaultVATRateGT applies the GT predicate on the "DefaultVATRate" field.
+func DefaultVATRateGT(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldDefaultVATRate, v))
+}
+
+// DefaultVATRateGTE applies the GTE predicate on the "DefaultVATRate" field.
+func DefaultVATRateGTE(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldDefaultVATRate, v))
+}
+
+// DefaultVATRateLT applies the LT predicate on the "DefaultVATRate" field.
+func DefaultVATRateLT(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldDefaultVATRate, v))
+}
+
+// DefaultVATRateLTE applies the LTE predicate on the "DefaultVATRate" field.
+func DefaultVATRateLTE(v float64) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldDefaultVATRate, v))
+}
+
 // HasMainItem applies the HasEdge predicate on the "MainItem" edge.
 func HasMainItem() predicate.Settings {
 	return predicate.Settings(func(s *sql.Selector) {
@@ -412,6 +412,138 @@ func (_c *SettingsCreate) SetNillableWordPressInviteTTL(v *int) *SettingsCreate
 	return _c
 }
 
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_c *SettingsCreate) SetPDFDownloadLimit(v int) *SettingsCreate {
+	_c.mutation.SetPDFDownloadLimit(v)
+	return _c
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePDFDownloadLimit(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetPDFDownloadLimit(*v)
+	}
+	return _c
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_c *SettingsCreate) SetPDFDownloadExpiryDays(v int) *SettingsCreate {
+	_c.mutation.SetPDFDownloadExpiryDays(v)
+	return _c
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePDFDownloadExpiryDays(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetPDFDownloadExpiryDays(*v)
+	}
+	return _c
+}
+
+// SetPDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field.
+func (_c *SettingsCreate) SetPDFDownloadMaxIPs(v int) *SettingsCreate {
+	_c.mutation.SetPDFDownloadMaxIPs(v)
+	return _c
+}
+
+// SetNillablePDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePDFDownloadMaxIPs(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetPDFDownloadMaxIPs(*v)
+	}
+	return _c
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_c *SettingsCreate) SetImageVariants(v map[string]map[string]string) *SettingsCreate {
+	_c.mutation.SetImageVariants(v)
+	return _c
+}
+
+// SetInvoicesEnabled sets the "InvoicesEnabled" field.
+func (_c *SettingsCreate) SetInvoicesEnabled(v bool) *SettingsCreate {
+	_c.mutation.SetInvoicesEnabled(v)
+	return _c
+}
+
+// SetNillableInvoicesEnabled sets the "InvoicesEnabled" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoicesEnabled(v *bool) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoicesEnabled(*v)
+	}
+	return _c
+}
+
+// SetInvoiceIssuerName sets the "InvoiceIssuerName" field.
+func (_c *SettingsCreate) SetInvoiceIssuerName(v string) *SettingsCreate {
+	_c.mutation.SetInvoiceIssuerName(v)
+	return _c
+}
+
+// SetNillableInvoiceIssuerName sets the "InvoiceIssuerName" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoiceIssuerName(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoiceIssuerName(*v)
+	}
+	return _c
+}
+
+// SetInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field.
+func (_c *SettingsCreate) SetInvoiceIssuerAddress(v string) *SettingsCreate {
+	_c.mutation.SetInvoiceIssuerAddress(v)
+	return _c
+}
+
+// SetNillableInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoiceIssuerAddress(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoiceIssuerAddress(*v)
+	}
+	return _c
+}
+
+// SetInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field.
+func (_c *SettingsCreate) SetInvoiceIssuerVATID(v string) *SettingsCreate {
+	_c.mutation.SetInvoiceIssuerVATID(v)
+	return _c
+}
+
+// SetNillableInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoiceIssuerVATID(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoiceIssuerVATID(*v)
+	}
+	return _c
+}
+
+// SetInvoiceFooter sets the "InvoiceFooter" field.
+func (_c *SettingsCreate) SetInvoiceFooter(v string) *SettingsCreate {
+	_c.mutation.SetInvoiceFooter(v)
+	return _c
+}
+
+// SetNillableInvoiceFooter sets the "InvoiceFooter" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableInvoiceFooter(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetInvoiceFooter(*v)
+	}
+	return _c
+}
+
+// SetDefaultVATRate sets the "DefaultVATRate" field.
+func (_c *SettingsCreate) SetDefaultVATRate(v float64) *SettingsCreate {
+	_c.mutation.SetDefaultVATRate(v)
+	return _c
+}
+
+// SetNillableDefaultVATRate sets the "DefaultVATRate" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableDefaultVATRate(v *float64) *SettingsCreate {
+	if v != nil {
+		_c.SetDefaultVATRate(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SettingsCreate) SetID(v int) *SettingsCreate {
 	_c.mutation.SetID(v)
@@ -584,6 +716,42 @@ func (_c *SettingsCreate) defaults() {
 		v := settings.DefaultWordPressInviteTTL
 		_c.mutation.SetWordPressInviteTTL(v)
 	}
+	if _, ok := _c.mutation.PDFDownloadLimit(); !ok {
+		v := settings.DefaultPDFDownloadLimit
+		_c.mutation.SetPDFDownloadLimit(v)
+	}
+	if _, ok := _c.mutation.PDFDownloadExpiryDays(); !ok {
+		v := settings.DefaultPDFDownloadExpiryDays
+		_c.mutation.SetPDFDownloadExpiryDays(v)
+	}
+	if _, ok := _c.mutation.PDFDownloadMaxIPs(); !ok {
+		v := settings.DefaultPDFDownloadMaxIPs
+		_c.mutation.SetPDFDownloadMaxIPs(v)
+	}
+	if _, ok := _c.mutation.InvoicesEnabled(); !ok {
+		v := settings.DefaultInvoicesEnabled
+		_c.mutation.SetInvoicesEnabled(v)
+	}
+	if _, ok := _c.mutation.InvoiceIssuerName(); !ok {
+		v := settings.DefaultInvoiceIssuerName
+		_c.mutation.SetInvoiceIssuerName(v)
+	}
+	if _, ok := _c.mutation.InvoiceIssuerAddress(); !ok {
+		v := settings.DefaultInvoiceIssuerAddress
+		_c.mutation.SetInvoiceIssuerAddress(v)
+	}
+	if _, ok := _c.mutation.InvoiceIssuerVATID(); !ok {
+		v := settings.DefaultInvoiceIssuerVATID
+		_c.mutation.SetInvoiceIssuerVATID(v)
+	}
+	if _, ok := _c.mutation.InvoiceFooter(); !ok {
+		v := settings.DefaultInvoiceFooter
+		_c.mutation.SetInvoiceFooter(v)
+	}
+	if _, ok := _c.mutation.DefaultVATRate(); !ok {
+		v := settings.DefaultDefaultVATRate
+		_c.mutation.SetDefaultVATRate(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -672,6 +840,33 @@ func (_c *SettingsCreate) check() error {
 	if _, ok := _c.mutation.WordPressInviteTTL(); !ok {
 		return &ValidationError{Name: "WordPressInviteTTL", err: errors.New(`ent: missing required field "Settings.WordPressInviteTTL"`)}
 	}
+	if _, ok := _c.mutation.PDFDownloadLimit(); !ok {
+		return &ValidationError{Name: "PDFDownloadLimit", err: errors.New(`ent: missing required field "Settings.PDFDownloadLimit"`)}
+	}
+	if _, ok := _c.mutation.PDFDownloadExpiryDays(); !ok {
+		return &ValidationError{Name: "PDFDownloadExpiryDays", err: errors.New(`ent: missing required field "Settings.PDFDownloadExpiryDays"`)}
+	}
+	if _, ok := _c.mutation.PDFDownloadMaxIPs(); !ok {
+		return &ValidationError{Name: "PDFDownloadMaxIPs", err: errors.New(`ent: missing required field "Settings.PDFDownloadMaxIPs"`)}
+	}
+	if _, ok := _c.mutation.InvoicesEnabled(); !ok {
+		return &ValidationError{Name: "InvoicesEnabled", err: errors.New(`ent: missing required field "Settings.InvoicesEnabled"`)}
+	}
+	if _, ok := _c.mutation.InvoiceIssuerName(); !ok {
+		return &ValidationError{Name: "InvoiceIssuerName", err: errors.New(`ent: missing required field "Settings.InvoiceIssuerName"`)}
+	}
+	if _, ok := _c.mutation.InvoiceIssuerAddress(); !ok {
+		return &ValidationError{Name: "InvoiceIssuerAddress", err: errors.New(`ent: missing required field "Settings.InvoiceIssuerAddress"`)}
+	}
+	if _, ok := _c.mutation.InvoiceIssuerVATID(); !ok {
+		return &ValidationError{Name: "InvoiceIssuerVATID", err: errors.New(`ent: missing required field "Settings.InvoiceIssuerVATID"`)}
+	}
+	if _, ok := _c.mutation.InvoiceFooter(); !ok {
+		return &ValidationError{Name: "InvoiceFooter", err: errors.New(`ent: missing required field "Settings.InvoiceFooter"`)}
+	}
+	if _, ok := _c.mutation.DefaultVATRate(); !ok {
+		return &ValidationError{Name: "DefaultVATRate", err: errors.New(`ent: missing required field "Settings.DefaultVATRate"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := settings.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Settings.id": %w`, err)}
@@ -821,6 +1016,46 @@ func (_c *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
 		_spec.SetField(settings.FieldWordPressInviteTTL, field.TypeInt, value)
 		_node.WordPressInviteTTL = value
 	}
+	if value, ok := _c.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(settings.FieldPDFDownloadLimit, field.TypeInt, value)
+		_node.PDFDownloadLimit = value
+	}
+	if value, ok := _c.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(settings.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+		_node.PDFDownloadExpiryDays = value
+	}
+	if value, ok := _c.mutation.PDFDownloadMaxIPs(); ok {
+		_spec.SetField(settings.FieldPDFDownloadMaxIPs, field.TypeInt, value)
+		_node.PDFDownloadMaxIPs = value
+	}
+	if value, ok := _c.mutation.ImageVariants(); ok {
+		_spec.SetField(settings.FieldImageVariants, field.TypeJSON, value)
+		_node.ImageVariants = value
+	}
+	if value, ok := _c.mutation.InvoicesEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicesEnabled, field.TypeBool, value)
+		_node.InvoicesEnabled = value
+	}
+	if value, ok := _c.mutation.InvoiceIssuerName(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerName, field.TypeString, value)
+		_node.InvoiceIssuerName = value
+	}
+	if value, ok := _c.mutation.InvoiceIssuerAddress(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerAddress, field.TypeString, value)
+		_node.InvoiceIssuerAddress = value
+	}
+	if value, ok := _c.mutation.InvoiceIssuerVATID(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerVATID, field.TypeString, value)
+		_node.InvoiceIssuerVATID = value
+	}
+	if value, ok := _c.mutation.InvoiceFooter(); ok {
+		_spec.SetField(settings.FieldInvoiceFooter, field.TypeString, value)
+		_node.InvoiceFooter = value
+	}
+	if value, ok := _c.mutation.DefaultVATRate(); ok {
+		_spec.SetField(settings.FieldDefaultVATRate, field.TypeFloat64, value)
+		_node.DefaultVATRate = value
+	}
 	if nodes := _c.mutation.MainItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
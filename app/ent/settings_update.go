@@ -448,6 +448,172 @@ func (_u *SettingsUpdate) AddWordPressInviteTTL(v int) *SettingsUpdate {
 	return _u
 }
 
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_u *SettingsUpdate) SetPDFDownloadLimit(v int) *SettingsUpdate {
+	_u.mutation.ResetPDFDownloadLimit()
+	_u.mutation.SetPDFDownloadLimit(v)
+	return _u
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePDFDownloadLimit(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetPDFDownloadLimit(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadLimit adds value to the "PDFDownloadLimit" field.
+func (_u *SettingsUpdate) AddPDFDownloadLimit(v int) *SettingsUpdate {
+	_u.mutation.AddPDFDownloadLimit(v)
+	return _u
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_u *SettingsUpdate) SetPDFDownloadExpiryDays(v int) *SettingsUpdate {
+	_u.mutation.ResetPDFDownloadExpiryDays()
+	_u.mutation.SetPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePDFDownloadExpiryDays(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetPDFDownloadExpiryDays(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadExpiryDays adds value to the "PDFDownloadExpiryDays" field.
+func (_u *SettingsUpdate) AddPDFDownloadExpiryDays(v int) *SettingsUpdate {
+	_u.mutation.AddPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetPDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field.
+func (_u *SettingsUpdate) SetPDFDownloadMaxIPs(v int) *SettingsUpdate {
+	_u.mutation.ResetPDFDownloadMaxIPs()
+	_u.mutation.SetPDFDownloadMaxIPs(v)
+	return _u
+}
+
+// SetNillablePDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePDFDownloadMaxIPs(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetPDFDownloadMaxIPs(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadMaxIPs adds value to the "PDFDownloadMaxIPs" field.
+func (_u *SettingsUpdate) AddPDFDownloadMaxIPs(v int) *SettingsUpdate {
+	_u.mutation.AddPDFDownloadMaxIPs(v)
+	return _u
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_u *SettingsUpdate) SetImageVariants(v map[string]map[string]string) *SettingsUpdate {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "ImageVariants" field.
+func (_u *SettingsUpdate) ClearImageVariants() *SettingsUpdate {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
+// SetInvoicesEnabled sets the "InvoicesEnabled" field.
+func (_u *SettingsUpdate) SetInvoicesEnabled(v bool) *SettingsUpdate {
+	_u.mutation.SetInvoicesEnabled(v)
+	return _u
+}
+
+// SetNillableInvoicesEnabled sets the "InvoicesEnabled" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoicesEnabled(v *bool) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoicesEnabled(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerName sets the "InvoiceIssuerName" field.
+func (_u *SettingsUpdate) SetInvoiceIssuerName(v string) *SettingsUpdate {
+	_u.mutation.SetInvoiceIssuerName(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerName sets the "InvoiceIssuerName" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoiceIssuerName(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoiceIssuerName(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field.
+func (_u *SettingsUpdate) SetInvoiceIssuerAddress(v string) *SettingsUpdate {
+	_u.mutation.SetInvoiceIssuerAddress(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoiceIssuerAddress(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoiceIssuerAddress(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field.
+func (_u *SettingsUpdate) SetInvoiceIssuerVATID(v string) *SettingsUpdate {
+	_u.mutation.SetInvoiceIssuerVATID(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoiceIssuerVATID(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoiceIssuerVATID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceFooter sets the "InvoiceFooter" field.
+func (_u *SettingsUpdate) SetInvoiceFooter(v string) *SettingsUpdate {
+	_u.mutation.SetInvoiceFooter(v)
+	return _u
+}
+
+// SetNillableInvoiceFooter sets the "InvoiceFooter" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableInvoiceFooter(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetInvoiceFooter(*v)
+	}
+	return _u
+}
+
+// SetDefaultVATRate sets the "DefaultVATRate" field.
+func (_u *SettingsUpdate) SetDefaultVATRate(v float64) *SettingsUpdate {
+	_u.mutation.ResetDefaultVATRate()
+	_u.mutation.SetDefaultVATRate(v)
+	return _u
+}
+
+// SetNillableDefaultVATRate sets the "DefaultVATRate" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableDefaultVATRate(v *float64) *SettingsUpdate {
+	if v != nil {
+		_u.SetDefaultVATRate(*v)
+	}
+	return _u
+}
+
+// AddDefaultVATRate adds value to the "DefaultVATRate" field.
+func (_u *SettingsUpdate) AddDefaultVATRate(v float64) *SettingsUpdate {
+	_u.mutation.AddDefaultVATRate(v)
+	return _u
+}
+
 // SetMainItemID sets the "MainItem" edge to the Item entity by ID.
 func (_u *SettingsUpdate) SetMainItemID(id int) *SettingsUpdate {
 	_u.mutation.SetMainItemID(id)
@@ -610,6 +776,51 @@ func (_u *SettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedWordPressInviteTTL(); ok {
 		_spec.AddField(settings.FieldWordPressInviteTTL, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(settings.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadLimit(); ok {
+		_spec.AddField(settings.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(settings.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadExpiryDays(); ok {
+		_spec.AddField(settings.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PDFDownloadMaxIPs(); ok {
+		_spec.SetField(settings.FieldPDFDownloadMaxIPs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadMaxIPs(); ok {
+		_spec.AddField(settings.FieldPDFDownloadMaxIPs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(settings.FieldImageVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(settings.FieldImageVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InvoicesEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicesEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerName(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerAddress(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerVATID(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerVATID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceFooter(); ok {
+		_spec.SetField(settings.FieldInvoiceFooter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DefaultVATRate(); ok {
+		_spec.SetField(settings.FieldDefaultVATRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultVATRate(); ok {
+		_spec.AddField(settings.FieldDefaultVATRate, field.TypeFloat64, value)
+	}
 	if _u.mutation.MainItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1079,6 +1290,172 @@ func (_u *SettingsUpdateOne) AddWordPressInviteTTL(v int) *SettingsUpdateOne {
 	return _u
 }
 
+// SetPDFDownloadLimit sets the "PDFDownloadLimit" field.
+func (_u *SettingsUpdateOne) SetPDFDownloadLimit(v int) *SettingsUpdateOne {
+	_u.mutation.ResetPDFDownloadLimit()
+	_u.mutation.SetPDFDownloadLimit(v)
+	return _u
+}
+
+// SetNillablePDFDownloadLimit sets the "PDFDownloadLimit" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePDFDownloadLimit(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPDFDownloadLimit(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadLimit adds value to the "PDFDownloadLimit" field.
+func (_u *SettingsUpdateOne) AddPDFDownloadLimit(v int) *SettingsUpdateOne {
+	_u.mutation.AddPDFDownloadLimit(v)
+	return _u
+}
+
+// SetPDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field.
+func (_u *SettingsUpdateOne) SetPDFDownloadExpiryDays(v int) *SettingsUpdateOne {
+	_u.mutation.ResetPDFDownloadExpiryDays()
+	_u.mutation.SetPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetNillablePDFDownloadExpiryDays sets the "PDFDownloadExpiryDays" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePDFDownloadExpiryDays(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPDFDownloadExpiryDays(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadExpiryDays adds value to the "PDFDownloadExpiryDays" field.
+func (_u *SettingsUpdateOne) AddPDFDownloadExpiryDays(v int) *SettingsUpdateOne {
+	_u.mutation.AddPDFDownloadExpiryDays(v)
+	return _u
+}
+
+// SetPDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field.
+func (_u *SettingsUpdateOne) SetPDFDownloadMaxIPs(v int) *SettingsUpdateOne {
+	_u.mutation.ResetPDFDownloadMaxIPs()
+	_u.mutation.SetPDFDownloadMaxIPs(v)
+	return _u
+}
+
+// SetNillablePDFDownloadMaxIPs sets the "PDFDownloadMaxIPs" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePDFDownloadMaxIPs(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPDFDownloadMaxIPs(*v)
+	}
+	return _u
+}
+
+// AddPDFDownloadMaxIPs adds value to the "PDFDownloadMaxIPs" field.
+func (_u *SettingsUpdateOne) AddPDFDownloadMaxIPs(v int) *SettingsUpdateOne {
+	_u.mutation.AddPDFDownloadMaxIPs(v)
+	return _u
+}
+
+// SetImageVariants sets the "ImageVariants" field.
+func (_u *SettingsUpdateOne) SetImageVariants(v map[string]map[string]string) *SettingsUpdateOne {
+	_u.mutation.SetImageVariants(v)
+	return _u
+}
+
+// ClearImageVariants clears the value of the "ImageVariants" field.
+func (_u *SettingsUpdateOne) ClearImageVariants() *SettingsUpdateOne {
+	_u.mutation.ClearImageVariants()
+	return _u
+}
+
+// SetInvoicesEnabled sets the "InvoicesEnabled" field.
+func (_u *SettingsUpdateOne) SetInvoicesEnabled(v bool) *SettingsUpdateOne {
+	_u.mutation.SetInvoicesEnabled(v)
+	return _u
+}
+
+// SetNillableInvoicesEnabled sets the "InvoicesEnabled" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoicesEnabled(v *bool) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoicesEnabled(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerName sets the "InvoiceIssuerName" field.
+func (_u *SettingsUpdateOne) SetInvoiceIssuerName(v string) *SettingsUpdateOne {
+	_u.mutation.SetInvoiceIssuerName(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerName sets the "InvoiceIssuerName" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoiceIssuerName(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoiceIssuerName(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field.
+func (_u *SettingsUpdateOne) SetInvoiceIssuerAddress(v string) *SettingsUpdateOne {
+	_u.mutation.SetInvoiceIssuerAddress(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerAddress sets the "InvoiceIssuerAddress" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoiceIssuerAddress(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoiceIssuerAddress(*v)
+	}
+	return _u
+}
+
+// SetInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field.
+func (_u *SettingsUpdateOne) SetInvoiceIssuerVATID(v string) *SettingsUpdateOne {
+	_u.mutation.SetInvoiceIssuerVATID(v)
+	return _u
+}
+
+// SetNillableInvoiceIssuerVATID sets the "InvoiceIssuerVATID" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoiceIssuerVATID(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoiceIssuerVATID(*v)
+	}
+	return _u
+}
+
+// SetInvoiceFooter sets the "InvoiceFooter" field.
+func (_u *SettingsUpdateOne) SetInvoiceFooter(v string) *SettingsUpdateOne {
+	_u.mutation.SetInvoiceFooter(v)
+	return _u
+}
+
+// SetNillableInvoiceFooter sets the "InvoiceFooter" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableInvoiceFooter(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetInvoiceFooter(*v)
+	}
+	return _u
+}
+
+// SetDefaultVATRate sets the "DefaultVATRate" field.
+func (_u *SettingsUpdateOne) SetDefaultVATRate(v float64) *SettingsUpdateOne {
+	_u.mutation.ResetDefaultVATRate()
+	_u.mutation.SetDefaultVATRate(v)
+	return _u
+}
+
+// SetNillableDefaultVATRate sets the "DefaultVATRate" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableDefaultVATRate(v *float64) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetDefaultVATRate(*v)
+	}
+	return _u
+}
+
+// AddDefaultVATRate adds value to the "DefaultVATRate" field.
+func (_u *SettingsUpdateOne) AddDefaultVATRate(v float64) *SettingsUpdateOne {
+	_u.mutation.AddDefaultVATRate(v)
+	return _u
+}
+
 // SetMainItemID sets the "MainItem" edge to the Item entity by ID.
 func (_u *SettingsUpdateOne) SetMainItemID(id int) *SettingsUpdateOne {
 	_u.mutation.SetMainItemID(id)
@@ -1271,6 +1648,51 @@ func (_u *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err
 	if value, ok := _u.mutation.AddedWordPressInviteTTL(); ok {
 		_spec.AddField(settings.FieldWordPressInviteTTL, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.PDFDownloadLimit(); ok {
+		_spec.SetField(settings.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadLimit(); ok {
+		_spec.AddField(settings.FieldPDFDownloadLimit, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PDFDownloadExpiryDays(); ok {
+		_spec.SetField(settings.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadExpiryDays(); ok {
+		_spec.AddField(settings.FieldPDFDownloadExpiryDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PDFDownloadMaxIPs(); ok {
+		_spec.SetField(settings.FieldPDFDownloadMaxIPs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPDFDownloadMaxIPs(); ok {
+		_spec.AddField(settings.FieldPDFDownloadMaxIPs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ImageVariants(); ok {
+		_spec.SetField(settings.FieldImageVariants, field.TypeJSON, value)
+	}
+	if _u.mutation.ImageVariantsCleared() {
+		_spec.ClearField(settings.FieldImageVariants, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.InvoicesEnabled(); ok {
+		_spec.SetField(settings.FieldInvoicesEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerName(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerAddress(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerAddress, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceIssuerVATID(); ok {
+		_spec.SetField(settings.FieldInvoiceIssuerVATID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvoiceFooter(); ok {
+		_spec.SetField(settings.FieldInvoiceFooter, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DefaultVATRate(); ok {
+		_spec.SetField(settings.FieldDefaultVATRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDefaultVATRate(); ok {
+		_spec.AddField(settings.FieldDefaultVATRate, field.TypeFloat64, value)
+	}
 	if _u.mutation.MainItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/item"
+	"github.com/augustin-wien/augustina-backend/ent/stockmovement"
+	"github.com/augustin-wien/augustina-backend/ent/vendor"
+)
+
+// StockMovementCreate is the builder for creating a StockMovement entity.
+type StockMovementCreate struct {
+	config
+	mutation *StockMovementMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (_c *StockMovementCreate) SetType(v string) *StockMovementCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *StockMovementCreate) SetQuantity(v int) *StockMovementCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (_c *StockMovementCreate) SetStockAfter(v int) *StockMovementCreate {
+	_c.mutation.SetStockAfter(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *StockMovementCreate) SetNote(v string) *StockMovementCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableNote(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *StockMovementCreate) SetCreatedBy(v string) *StockMovementCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableCreatedBy(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StockMovementCreate) SetCreatedAt(v time.Time) *StockMovementCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *StockMovementCreate) SetItemID(v int) *StockMovementCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_c *StockMovementCreate) SetVendorID(v int) *StockMovementCreate {
+	_c.mutation.SetVendorID(v)
+	return _c
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableVendorID(v *int) *StockMovementCreate {
+	if v != nil {
+		_c.SetVendorID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *StockMovementCreate) SetID(v int) *StockMovementCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *StockMovementCreate) SetItem(v *Item) *StockMovementCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_c *StockMovementCreate) SetVendor(v *Vendor) *StockMovementCreate {
+	return _c.SetVendorID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_c *StockMovementCreate) Mutation() *StockMovementMutation {
+	return _c.mutation
+}
+
+// Save creates the StockMovement in the database.
+func (_c *StockMovementCreate) Save(ctx context.Context) (*StockMovement, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StockMovementCreate) SaveX(ctx context.Context) *StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StockMovementCreate) defaults() {
+	if _, ok := _c.mutation.Note(); !ok {
+		v := stockmovement.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		v := stockmovement.DefaultCreatedBy
+		_c.mutation.SetCreatedBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StockMovementCreate) check() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "StockMovement.type"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "StockMovement.quantity"`)}
+	}
+	if _, ok := _c.mutation.StockAfter(); !ok {
+		return &ValidationError{Name: "stock_after", err: errors.New(`ent: missing required field "StockMovement.stock_after"`)}
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		return &ValidationError{Name: "note", err: errors.New(`ent: missing required field "StockMovement.note"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "StockMovement.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockMovement.created_at"`)}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "StockMovement.item_id"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := stockmovement.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "StockMovement.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "StockMovement.item"`)}
+	}
+	return nil
+}
+
+func (_c *StockMovementCreate) sqlSave(ctx context.Context) (*StockMovement, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockMovement{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stockmovement.Table, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(stockmovement.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.StockAfter(); ok {
+		_spec.SetField(stockmovement.FieldStockAfter, field.TypeInt, value)
+		_node.StockAfter = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(stockmovement.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stockmovement.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.VendorTable,
+			Columns: []string{stockmovement.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VendorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockMovementCreateBulk is the builder for creating many StockMovement entities in bulk.
+type StockMovementCreateBulk struct {
+	config
+	err      error
+	builders []*StockMovementCreate
+}
+
+// Save creates the StockMovement entities in the database.
+func (_c *StockMovementCreateBulk) Save(ctx context.Context) ([]*StockMovement, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StockMovement, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockMovementMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) SaveX(ctx context.Context) []*StockMovement {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockMovementCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockMovementCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/augustin-wien/augustina-backend/ent/item"
+	"github.com/augustin-wien/augustina-backend/ent/predicate"
+	"github.com/augustin-wien/augustina-backend/ent/stockmovement"
+	"github.com/augustin-wien/augustina-backend/ent/vendor"
+)
+
+// StockMovementUpdate is the builder for updating StockMovement entities.
+type StockMovementUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// Where appends a list predicates to the StockMovementUpdate builder.
+func (_u *StockMovementUpdate) Where(ps ...predicate.StockMovement) *StockMovementUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *StockMovementUpdate) SetType(v string) *StockMovementUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableType(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockMovementUpdate) SetQuantity(v int) *StockMovementUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableQuantity(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *StockMovementUpdate) AddQuantity(v int) *StockMovementUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (_u *StockMovementUpdate) SetStockAfter(v int) *StockMovementUpdate {
+	_u.mutation.ResetStockAfter()
+	_u.mutation.SetStockAfter(v)
+	return _u
+}
+
+// SetNillableStockAfter sets the "stock_after" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableStockAfter(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetStockAfter(*v)
+	}
+	return _u
+}
+
+// AddStockAfter adds value to the "stock_after" field.
+func (_u *StockMovementUpdate) AddStockAfter(v int) *StockMovementUpdate {
+	_u.mutation.AddStockAfter(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *StockMovementUpdate) SetNote(v string) *StockMovementUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableNote(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StockMovementUpdate) SetCreatedBy(v string) *StockMovementUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableCreatedBy(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StockMovementUpdate) SetCreatedAt(v time.Time) *StockMovementUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableCreatedAt(v *time.Time) *StockMovementUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StockMovementUpdate) SetItemID(v int) *StockMovementUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableItemID(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *StockMovementUpdate) SetVendorID(v int) *StockMovementUpdate {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableVendorID(v *int) *StockMovementUpdate {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *StockMovementUpdate) ClearVendorID() *StockMovementUpdate {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockMovementUpdate) SetItem(v *Item) *StockMovementUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *StockMovementUpdate) SetVendor(v *Vendor) *StockMovementUpdate {
+	return _u.SetVendorID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_u *StockMovementUpdate) Mutation() *StockMovementMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockMovementUpdate) ClearItem() *StockMovementUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *StockMovementUpdate) ClearVendor() *StockMovementUpdate {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StockMovementUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockMovementUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StockMovementUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockMovementUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockMovementUpdate) check() error {
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.item"`)
+	}
+	return nil
+}
+
+func (_u *StockMovementUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockmovement.Table, stockmovement.Columns, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(stockmovement.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StockAfter(); ok {
+		_spec.SetField(stockmovement.FieldStockAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStockAfter(); ok {
+		_spec.AddField(stockmovement.FieldStockAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(stockmovement.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(stockmovement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.VendorTable,
+			Columns: []string{stockmovement.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.VendorTable,
+			Columns: []string{stockmovement.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StockMovementUpdateOne is the builder for updating a single StockMovement entity.
+type StockMovementUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockMovementMutation
+}
+
+// SetType sets the "type" field.
+func (_u *StockMovementUpdateOne) SetType(v string) *StockMovementUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableType(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *StockMovementUpdateOne) SetQuantity(v int) *StockMovementUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableQuantity(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *StockMovementUpdateOne) AddQuantity(v int) *StockMovementUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetStockAfter sets the "stock_after" field.
+func (_u *StockMovementUpdateOne) SetStockAfter(v int) *StockMovementUpdateOne {
+	_u.mutation.ResetStockAfter()
+	_u.mutation.SetStockAfter(v)
+	return _u
+}
+
+// SetNillableStockAfter sets the "stock_after" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableStockAfter(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetStockAfter(*v)
+	}
+	return _u
+}
+
+// AddStockAfter adds value to the "stock_after" field.
+func (_u *StockMovementUpdateOne) AddStockAfter(v int) *StockMovementUpdateOne {
+	_u.mutation.AddStockAfter(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *StockMovementUpdateOne) SetNote(v string) *StockMovementUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableNote(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *StockMovementUpdateOne) SetCreatedBy(v string) *StockMovementUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableCreatedBy(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StockMovementUpdateOne) SetCreatedAt(v time.Time) *StockMovementUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableCreatedAt(v *time.Time) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *StockMovementUpdateOne) SetItemID(v int) *StockMovementUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableItemID(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetVendorID sets the "vendor_id" field.
+func (_u *StockMovementUpdateOne) SetVendorID(v int) *StockMovementUpdateOne {
+	_u.mutation.SetVendorID(v)
+	return _u
+}
+
+// SetNillableVendorID sets the "vendor_id" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableVendorID(v *int) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetVendorID(*v)
+	}
+	return _u
+}
+
+// ClearVendorID clears the value of the "vendor_id" field.
+func (_u *StockMovementUpdateOne) ClearVendorID() *StockMovementUpdateOne {
+	_u.mutation.ClearVendorID()
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockMovementUpdateOne) SetItem(v *Item) *StockMovementUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetVendor sets the "vendor" edge to the Vendor entity.
+func (_u *StockMovementUpdateOne) SetVendor(v *Vendor) *StockMovementUpdateOne {
+	return _u.SetVendorID(v.ID)
+}
+
+// Mutation returns the StockMovementMutation object of the builder.
+func (_u *StockMovementUpdateOne) Mutation() *StockMovementMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockMovementUpdateOne) ClearItem() *StockMovementUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearVendor clears the "vendor" edge to the Vendor entity.
+func (_u *StockMovementUpdateOne) ClearVendor() *StockMovementUpdateOne {
+	_u.mutation.ClearVendor()
+	return _u
+}
+
+// Where appends a list predicates to the StockMovementUpdate builder.
+func (_u *StockMovementUpdateOne) Where(ps ...predicate.StockMovement) *StockMovementUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StockMovementUpdateOne) Select(field string, fields ...string) *StockMovementUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StockMovement entity.
+func (_u *StockMovementUpdateOne) Save(ctx context.Context) (*StockMovement, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockMovementUpdateOne) SaveX(ctx context.Context) *StockMovement {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StockMovementUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockMovementUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockMovementUpdateOne) check() error {
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockMovement.item"`)
+	}
+	return nil
+}
+
+func (_u *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMovement, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockmovement.Table, stockmovement.Columns, sqlgraph.NewFieldSpec(stockmovement.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockMovement.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockmovement.FieldID)
+		for _, f := range fields {
+			if !stockmovement.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockmovement.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(stockmovement.FieldType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(stockmovement.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.StockAfter(); ok {
+		_spec.SetField(stockmovement.FieldStockAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStockAfter(); ok {
+		_spec.AddField(stockmovement.FieldStockAfter, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(stockmovement.FieldNote, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(stockmovement.FieldCreatedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(stockmovement.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.ItemTable,
+			Columns: []string{stockmovement.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VendorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.VendorTable,
+			Columns: []string{stockmovement.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VendorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockmovement.VendorTable,
+			Columns: []string{stockmovement.VendorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(vendor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StockMovement{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockmovement.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,18 +14,36 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
 	// Abonement is the client for interacting with the Abonement builders.
 	Abonement *AbonementClient
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BlockedIP is the client for interacting with the BlockedIP builders.
 	BlockedIP *BlockedIPClient
 	// Comment is the client for interacting with the Comment builders.
 	Comment *CommentClient
+	// Consignment is the client for interacting with the Consignment builders.
+	Consignment *ConsignmentClient
 	// Customer is the client for interacting with the Customer builders.
 	Customer *CustomerClient
 	// DBSettings is the client for interacting with the DBSettings builders.
 	DBSettings *DBSettingsClient
+	// DiscountCode is the client for interacting with the DiscountCode builders.
+	DiscountCode *DiscountCodeClient
+	// DiscountRedemption is the client for interacting with the DiscountRedemption builders.
+	DiscountRedemption *DiscountRedemptionClient
+	// Entitlement is the client for interacting with the Entitlement builders.
+	Entitlement *EntitlementClient
+	// GiftCode is the client for interacting with the GiftCode builders.
+	GiftCode *GiftCodeClient
+	// Invoice is the client for interacting with the Invoice builders.
+	Invoice *InvoiceClient
+	// InvoiceSequence is the client for interacting with the InvoiceSequence builders.
+	InvoiceSequence *InvoiceSequenceClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
 	// Location is the client for interacting with the Location builders.
@@ -38,10 +58,16 @@ type Tx struct {
 	PDF *PDFClient
 	// PDFDownload is the client for interacting with the PDFDownload builders.
 	PDFDownload *PDFDownloadClient
+	// PDFDownloadAccess is the client for interacting with the PDFDownloadAccess builders.
+	PDFDownloadAccess *PDFDownloadAccessClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// PaymentMandate is the client for interacting with the PaymentMandate builders.
+	PaymentMandate *PaymentMandateClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
+	// StockMovement is the client for interacting with the StockMovement builders.
+	StockMovement *StockMovementClient
 	// Vendor is the client for interacting with the Vendor builders.
 	Vendor *VendorClient
 
@@ -175,12 +201,21 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
 	tx.Abonement = NewAbonementClient(tx.config)
 	tx.Account = NewAccountClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.BlockedIP = NewBlockedIPClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
+	tx.Consignment = NewConsignmentClient(tx.config)
 	tx.Customer = NewCustomerClient(tx.config)
 	tx.DBSettings = NewDBSettingsClient(tx.config)
+	tx.DiscountCode = NewDiscountCodeClient(tx.config)
+	tx.DiscountRedemption = NewDiscountRedemptionClient(tx.config)
+	tx.Entitlement = NewEntitlementClient(tx.config)
+	tx.GiftCode = NewGiftCodeClient(tx.config)
+	tx.Invoice = NewInvoiceClient(tx.config)
+	tx.InvoiceSequence = NewInvoiceSequenceClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
 	tx.Location = NewLocationClient(tx.config)
 	tx.MailTemplate = NewMailTemplateClient(tx.config)
@@ -188,8 +223,11 @@ func (tx *Tx) init() {
 	tx.OrderEntry = NewOrderEntryClient(tx.config)
 	tx.PDF = NewPDFClient(tx.config)
 	tx.PDFDownload = NewPDFDownloadClient(tx.config)
+	tx.PDFDownloadAccess = NewPDFDownloadAccessClient(tx.config)
 	tx.Payment = NewPaymentClient(tx.config)
+	tx.PaymentMandate = NewPaymentMandateClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
+	tx.StockMovement = NewStockMovementClient(tx.config)
 	tx.Vendor = NewVendorClient(tx.config)
 }
 
@@ -200,7 +238,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Abonement.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -253,3 +291,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,77 @@
+// Package geocoding resolves vendor location addresses to coordinates. The
+// Geocoder interface has an HTTP implementation for Nominatim-compatible
+// services and an offline implementation backed by fixtures for tests.
+package geocoding
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+var log = utils.GetLogger()
+
+// DefaultCoordinate is the value the location schema falls back to when no
+// coordinates are entered
+const DefaultCoordinate = 0.1
+
+// ErrNotFound is returned if the address could not be resolved
+var ErrNotFound = errors.New("address not found")
+
+// Result is a resolved address. Confidence ranges from 0 (unknown) to 1
+// (exact match of a building).
+type Result struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	Confidence  float64 `json:"confidence"`
+	DisplayName string  `json:"display_name,omitempty"`
+}
+
+// Geocoder resolves a street address and zip code to coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, address, zip string) (Result, error)
+}
+
+// Client is the geocoder used by the handlers. It is nil if geocoding is
+// disabled.
+var Client Geocoder
+
+// Init sets up Client from the configuration
+func Init() error {
+	switch config.Config.GeocoderProvider {
+	case "":
+		log.Info("Geocoding disabled")
+		Client = nil
+	case "nominatim":
+		log.Info("Geocoding with Nominatim at ", config.Config.GeocoderURL)
+		Client = NewNominatimGeocoder(config.Config.GeocoderURL, config.Config.GeocoderUserAgent, config.Config.GeocoderCountryCodes)
+	case "fixture":
+		log.Info("Geocoding with fixtures from ", config.Config.GeocoderFixtures)
+		geocoder, err := LoadFixtureGeocoder(config.Config.GeocoderFixtures)
+		if err != nil {
+			return err
+		}
+		Client = geocoder
+	default:
+		return errors.New("unknown geocoder " + config.Config.GeocoderProvider)
+	}
+	return nil
+}
+
+// IsDefaultCoordinate reports whether the coordinates have not been set,
+// i.e. are zero or the schema default
+func IsDefaultCoordinate(latitude, longitude float64) bool {
+	isDefault := func(value float64) bool {
+		return value == 0 || math.Abs(value-DefaultCoordinate) < 1e-9
+	}
+	return isDefault(latitude) && isDefault(longitude)
+}
+
+// normalize is used to compare addresses case and whitespace insensitive
+func normalize(value string) string {
+	return strings.Join(strings.Fields(strings.ToLower(value)), " ")
+}
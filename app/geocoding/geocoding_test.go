@@ -0,0 +1,88 @@
+package geocoding
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsDefaultCoordinate(t *testing.T) {
+	require.True(t, IsDefaultCoordinate(0.1, 0.1))
+	require.True(t, IsDefaultCoordinate(0, 0))
+	require.True(t, IsDefaultCoordinate(0, 0.1))
+	require.False(t, IsDefaultCoordinate(48.2, 16.3))
+	require.False(t, IsDefaultCoordinate(48.2, 0.1))
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/search", r.URL.Path)
+		require.Equal(t, "augustina-test", r.Header.Get("User-Agent"))
+		query := r.URL.Query()
+		require.Equal(t, "jsonv2", query.Get("format"))
+		require.Equal(t, "at", query.Get("countrycodes"))
+		w.Header().Set("Content-Type", "application/json")
+		switch query.Get("street") {
+		case "Reinprechtsdorfer Straße 31":
+			require.Equal(t, "1050", query.Get("postalcode"))
+			_, _ = w.Write([]byte(`[{"lat":"48.1866","lon":"16.3531","display_name":"Reinprechtsdorfer Straße 31, Wien","place_rank":30}]`))
+		case "Reinprechtsdorfer Straße":
+			_, _ = w.Write([]byte(`[{"lat":"48.1870","lon":"16.3540","display_name":"Reinprechtsdorfer Straße, Wien","place_rank":26}]`))
+		case "broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	geocoder := NewNominatimGeocoder(server.URL+"/", "augustina-test", "at")
+	geocoder.MinInterval = 0
+	ctx := context.Background()
+
+	result, err := geocoder.Geocode(ctx, "Reinprechtsdorfer Straße 31", "1050")
+	require.NoError(t, err)
+	require.Equal(t, 48.1866, result.Latitude)
+	require.Equal(t, 16.3531, result.Longitude)
+	require.Equal(t, 1.0, result.Confidence)
+	require.Equal(t, "Reinprechtsdorfer Straße 31, Wien", result.DisplayName)
+
+	result, err = geocoder.Geocode(ctx, "Reinprechtsdorfer Straße", "")
+	require.NoError(t, err)
+	require.InDelta(t, 26.0/30, result.Confidence, 1e-9)
+
+	_, err = geocoder.Geocode(ctx, "Nowhere 1", "9999")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = geocoder.Geocode(ctx, "broken", "")
+	require.Error(t, err)
+
+	_, err = geocoder.Geocode(ctx, "  ", "")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFixtureGeocoder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	err := os.WriteFile(path, []byte(`[{"address":"Marktplatz 1","zip":"1010","latitude":48.2082,"longitude":16.3725,"confidence":0.9}]`), 0o600)
+	require.NoError(t, err)
+
+	geocoder, err := LoadFixtureGeocoder(path)
+	require.NoError(t, err)
+
+	result, err := geocoder.Geocode(context.Background(), "  marktplatz   1 ", "1010")
+	require.NoError(t, err)
+	require.Equal(t, 48.2082, result.Latitude)
+	require.Equal(t, 16.3725, result.Longitude)
+	require.Equal(t, 0.9, result.Confidence)
+
+	_, err = geocoder.Geocode(context.Background(), "Marktplatz 1", "1020")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = LoadFixtureGeocoder(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package geocoding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// nominatimMinInterval is the minimal delay between two requests as
+// required by the usage policy of the public Nominatim instance
+const nominatimMinInterval = time.Second
+
+// NominatimGeocoder queries a Nominatim-compatible /search endpoint
+type NominatimGeocoder struct {
+	BaseURL      string
+	UserAgent    string
+	CountryCodes string
+	HTTPClient   *http.Client
+	MinInterval  time.Duration
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewNominatimGeocoder creates a geocoder for the given base URL. The user
+// agent must identify the application as required by Nominatim.
+func NewNominatimGeocoder(baseURL, userAgent, countryCodes string) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		BaseURL:      strings.TrimRight(baseURL, "/"),
+		UserAgent:    userAgent,
+		CountryCodes: countryCodes,
+		HTTPClient:   &http.Client{Timeout: 10 * time.Second},
+		MinInterval:  nominatimMinInterval,
+	}
+}
+
+// nominatimPlace is the subset of a jsonv2 search result we use
+type nominatimPlace struct {
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+	PlaceRank   int    `json:"place_rank"`
+}
+
+// confidence derives a confidence from the place rank since Nominatim does
+// not return a score: 30 is a building, 26 a street, 21 a postcode area.
+func (p nominatimPlace) confidence() float64 {
+	if p.PlaceRank <= 0 {
+		return 0
+	}
+	if p.PlaceRank >= 30 {
+		return 1
+	}
+	return float64(p.PlaceRank) / 30
+}
+
+// throttle blocks until MinInterval has passed since the last request
+func (g *NominatimGeocoder) throttle(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	wait := time.Until(g.lastRequest.Add(g.MinInterval))
+	if wait > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	g.lastRequest = time.Now()
+	return nil
+}
+
+// Geocode implements Geocoder
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address, zip string) (Result, error) {
+	if strings.TrimSpace(address) == "" && strings.TrimSpace(zip) == "" {
+		return Result{}, ErrNotFound
+	}
+	query := url.Values{}
+	query.Set("format", "jsonv2")
+	query.Set("limit", "1")
+	query.Set("street", address)
+	if zip != "" {
+		query.Set("postalcode", zip)
+	}
+	if g.CountryCodes != "" {
+		query.Set("countrycodes", g.CountryCodes)
+	}
+
+	if err := g.throttle(ctx); err != nil {
+		return Result{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.BaseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return Result{}, err
+	}
+	req.Header.Set("User-Agent", g.UserAgent)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("nominatim request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Result{}, fmt.Errorf("nominatim returned status %d", resp.StatusCode)
+	}
+
+	var places []nominatimPlace
+	if err := json.NewDecoder(resp.Body).Decode(&places); err != nil {
+		return Result{}, fmt.Errorf("nominatim response invalid: %w", err)
+	}
+	if len(places) == 0 {
+		return Result{}, ErrNotFound
+	}
+	place := places[0]
+	latitude, err := strconv.ParseFloat(place.Lat, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("nominatim returned invalid latitude %q", place.Lat)
+	}
+	longitude, err := strconv.ParseFloat(place.Lon, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("nominatim returned invalid longitude %q", place.Lon)
+	}
+	return Result{
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Confidence:  place.confidence(),
+		DisplayName: place.DisplayName,
+	}, nil
+}
@@ -1,14 +1,19 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/paymentprovider"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/go-chi/chi/v5"
 )
@@ -18,6 +23,8 @@ type ActiveAbonementWithCustomer struct {
 	Customer  database.Customer  `json:"customer"`
 }
 
+var errInvalidAbonementStatus = errors.New("invalid status, use one of " + strings.Join(database.AbonementStatuses, ", "))
+
 // CreateAbonement godoc
 //
 //	@Summary		Create a new abonement
@@ -37,6 +44,13 @@ func CreateAbonement(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
+	if abonement.Status == "" {
+		abonement.Status = database.AbonementStatusActive
+	}
+	if !database.ValidAbonementStatus(abonement.Status) {
+		utils.ErrorJSON(w, errInvalidAbonementStatus, http.StatusBadRequest)
+		return
+	}
 
 	createdAbonement, err := database.Db.CreateAbonement(&abonement)
 	if err != nil {
@@ -44,7 +58,7 @@ func CreateAbonement(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Fetch customer and item details for email and license group assignment
+	// Fetch customer and item details for email and entitlements
 	customer, customerErr := database.Db.GetCustomerByID(abonement.CustomerID)
 	if customerErr == nil {
 		item, itemErr := database.Db.GetItem(abonement.ItemID)
@@ -63,23 +77,13 @@ func CreateAbonement(w http.ResponseWriter, r *http.Request) {
 					_, _ = mailer.Send(mailReq)
 				}
 			}
+		}
 
-			// Assign license group if the abonement is active right now
-			lg := ""
-			if item.LicenseGroup.Valid {
-				lg = item.LicenseGroup.String
-			}
-			now := time.Now()
-			if lg != "" && createdAbonement.Status == "active" &&
-				createdAbonement.FromDate.Before(now) && createdAbonement.ToDate.After(now) {
-				updatedCustomer, lgErr := database.Db.AddLicenseGroupToCustomer(customer.ID, lg)
-				if lgErr == nil && updatedCustomer.KeycloakID != "" {
-					svc := database.NewAbonementService(&database.Db)
-					if syncErr := svc.SyncAbonementLicensesToKeycloak(updatedCustomer.KeycloakID, []string{lg}); syncErr != nil {
-						log.Error("CreateAbonement: failed to sync license group to Keycloak: ", syncErr)
-					}
-				}
-			}
+		// Entitle the customer and assign the license group if the
+		// abonement is active right now
+		svc := database.NewAbonementService(&database.Db)
+		if syncErr := svc.ProcessAbonementForCustomer(customer.ID, time.Now()); syncErr != nil {
+			log.Error("CreateAbonement: failed to sync entitlements: ", syncErr)
 		}
 	}
 
@@ -140,6 +144,22 @@ func ListAbonements(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// authCustomerID returns the customer of the authenticated user and writes
+// an error response if there is none
+func authCustomerID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	principal := middlewares.AuthPrincipal(r)
+	if principal.UserID == "" {
+		utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
+		return 0, false
+	}
+	customerID, ok := principal.CustomerID()
+	if !ok {
+		utils.ErrorJSON(w, errors.New("customer not found"), http.StatusNotFound)
+		return 0, false
+	}
+	return customerID, true
+}
+
 // ListMyAbonements godoc
 //
 //	@Summary		List abonements for the authenticated customer
@@ -150,28 +170,160 @@ func ListAbonements(w http.ResponseWriter, r *http.Request) {
 //	@Security		KeycloakAuth
 //	@Router			/customers/me/abonements [get]
 func ListMyAbonements(w http.ResponseWriter, r *http.Request) {
-	userID := r.Header.Get("X-Auth-User")
-	if userID == "" {
-		utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+
+	abonements, err := database.Db.ListAbonementsByCustomer(customerID)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
 
-	customer, err := database.Db.GetCustomerByKeycloakID(userID)
+	err = utils.WriteJSON(w, http.StatusOK, abonements)
+	if err != nil {
+		log.Error("ListMyAbonements", err)
+	}
+}
+
+// CancelMyAbonement godoc
+//
+//	@Summary		Cancel an abonement of the authenticated customer
+//	@Description	The abonement is not renewed and ends at its to_date, until then the customer keeps access
+//	@Tags			Abonements
+//	@Produce		json
+//	@Param			id path int true "Abonement ID"
+//	@Success		200	{object}	database.Abonement
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/abonements/{id}/cancel/ [post]
+func CancelMyAbonement(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
 	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+
+	abonement, err := database.Db.CancelAbonement(id, customerID)
+	if errors.Is(err, database.ErrAbonementNotFound) {
 		utils.ErrorJSON(w, err, http.StatusNotFound)
 		return
 	}
+	if errors.Is(err, database.ErrAbonementNotCancellable) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	log.Infof("CancelMyAbonement: customer %d cancelled abonement %d", customerID, abonement.ID)
 
-	abonements, err := database.Db.ListAbonementsByCustomer(customer.ID)
+	svc := database.NewAbonementService(&database.Db)
+	if syncErr := svc.ProcessAbonementForCustomer(customerID, time.Now()); syncErr != nil {
+		log.Error("CancelMyAbonement: failed to sync entitlements: ", syncErr)
+	}
+
+	err = utils.WriteJSON(w, http.StatusOK, abonement)
 	if err != nil {
+		log.Error("CancelMyAbonement", err)
+	}
+}
+
+type autoRenewRequest struct {
+	AutoRenew bool `json:"auto_renew"`
+}
+
+// SetMyAbonementAutoRenew godoc
+//
+//	@Summary		Turn automatic renewal of an abonement of the authenticated customer on or off
+//	@Description	Abonements bought with a card supporting recurring payments renew automatically before they end
+//	@Tags			Abonements
+//	@Accept			json
+//	@Produce		json
+//	@Param			id path int true "Abonement ID"
+//	@Param			data body autoRenewRequest true "Auto renew"
+//	@Success		200	{object}	database.Abonement
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/abonements/{id}/auto-renew/ [put]
+func SetMyAbonementAutoRenew(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	var request autoRenewRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
 
-	err = utils.WriteJSON(w, http.StatusOK, abonements)
+	abonement, err := database.Db.SetAbonementAutoRenew(id, customerID, request.AutoRenew)
+	if errors.Is(err, database.ErrAbonementNotFound) {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, database.ErrNoPaymentMandate) || errors.Is(err, database.ErrAbonementNotRenewable) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
 	if err != nil {
-		log.Error("ListMyAbonements", err)
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
 	}
+	log.Infof("SetMyAbonementAutoRenew: customer %d set auto renew of abonement %d to %t", customerID, abonement.ID, abonement.AutoRenew)
+
+	err = utils.WriteJSON(w, http.StatusOK, abonement)
+	if err != nil {
+		log.Error("SetMyAbonementAutoRenew", err)
+	}
+}
+
+// RenewAbonements charges the stored payment methods of abonements renewing
+// automatically. It is run periodically by the scheduler.
+func RenewAbonements(ctx context.Context) error {
+	svc := database.NewAbonementService(&database.Db)
+	report, err := svc.RunRenewals(time.Now(), database.RenewalPolicy{
+		LeadDays:    config.Config.AbonementRenewalLeadDays,
+		RetryAfter:  time.Duration(config.Config.AbonementRenewalRetryHours) * time.Hour,
+		MaxAttempts: config.Config.AbonementRenewalMaxAttempts,
+	}, map[string]database.RecurringCharger{
+		database.PaymentProviderVivaWallet: paymentprovider.VivaWalletCharger{},
+	})
+	if err != nil {
+		return err
+	}
+	if len(report.Renewed) > 0 || len(report.Failed) > 0 {
+		log.Infof("RenewAbonements: %d renewed, %d failed", len(report.Renewed), len(report.Failed))
+	}
+	return nil
+}
+
+// AdvanceAbonementLifecycle expires ended abonements and reminds customers
+// of abonements ending soon. It is run periodically by the scheduler.
+func AdvanceAbonementLifecycle(ctx context.Context) error {
+	svc := database.NewAbonementService(&database.Db)
+	report, err := svc.RunLifecycle(time.Now(), config.Config.AbonementReminderDays)
+	if err != nil {
+		return err
+	}
+	if len(report.Expired) > 0 || len(report.Expiring) > 0 {
+		log.Infof("AdvanceAbonementLifecycle: %d expired, %d reminded", len(report.Expired), len(report.Expiring))
+	}
+	return nil
 }
 
 // ListAbonementsByCustomer godoc
@@ -228,6 +380,10 @@ func UpdateAbonement(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
+	if !database.ValidAbonementStatus(abonement.Status) {
+		utils.ErrorJSON(w, errInvalidAbonementStatus, http.StatusBadRequest)
+		return
+	}
 
 	abonement.ID = id
 	updatedAbonement, err := database.Db.UpdateAbonement(&abonement)
@@ -236,6 +392,11 @@ func UpdateAbonement(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	svc := database.NewAbonementService(&database.Db)
+	if syncErr := svc.ProcessAbonementForCustomer(updatedAbonement.CustomerID, time.Now()); syncErr != nil {
+		log.Error("UpdateAbonement: failed to sync entitlements: ", syncErr)
+	}
+
 	err = utils.WriteJSON(w, http.StatusOK, updatedAbonement)
 	if err != nil {
 		log.Error("UpdateAbonement", err)
@@ -258,12 +419,23 @@ func DeleteAbonement(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	abonement, err := database.Db.GetAbonementByID(id)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+
 	err = database.Db.DeleteAbonement(id)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
 
+	svc := database.NewAbonementService(&database.Db)
+	if syncErr := svc.ProjectEntitlements(abonement.CustomerID, time.Now()); syncErr != nil {
+		log.Error("DeleteAbonement: failed to project entitlements: ", syncErr)
+	}
+
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// IssuedAPIKey is returned when an API key is issued. Key is only shown
+// once, just its hash is stored.
+type IssuedAPIKey struct {
+	APIKey database.APIKey `json:"api_key"`
+	Key    string          `json:"key"`
+}
+
+type rotateAPIKeyRequest struct {
+	// Minutes the old key keeps working, 0 revokes it right away
+	GraceMinutes int `json:"grace_minutes"`
+}
+
+// validateAPIKeyScopes checks the permissions and route groups of an API key
+func validateAPIKeyScopes(apiKey database.APIKey) error {
+	if err := apiKey.Validate(); err != nil {
+		return err
+	}
+	for _, permission := range apiKey.Permissions {
+		if !middlewares.APIKeyPermission(middlewares.Permission(permission)) {
+			return fmt.Errorf("permission %q can not be granted to API keys", permission)
+		}
+	}
+	for _, group := range apiKey.RouteGroups {
+		if !slices.Contains(middlewares.RouteGroups, middlewares.RouteGroup(group)) {
+			return fmt.Errorf("unknown route group %q", group)
+		}
+	}
+	return nil
+}
+
+// apiKeyID returns the ID of the API key in the URL
+func apiKeyID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// apiKeyErrorStatus maps errors of API keys to HTTP status codes
+func apiKeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, database.ErrAPIKeyNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrAPIKeyRevoked):
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
+
+// CreateAPIKey godoc
+//
+//	@Summary		Issue an API key
+//	@Description	Issues an API key for a machine client like Odoo or Flour. The key grants its permissions on the routes of its route groups (integrations, backoffice), optionally only from the allowed IPs or CIDR ranges and until expires_at. Clients send it in the X-API-Key header or as bearer token. The key is only returned once.
+//	@Tags			API keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			data body database.APIKey true "API key"
+//	@Success		201	{object}	IssuedAPIKey
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/api-keys/ [post]
+func CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var apiKey database.APIKey
+	if err := utils.ReadJSON(w, r, &apiKey); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if err := validateAPIKeyScopes(apiKey); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if apiKey.ExpiresAt.Valid && !apiKey.ExpiresAt.Time.After(time.Now()) {
+		utils.ErrorJSON(w, errors.New("expires_at must be in the future"), http.StatusBadRequest)
+		return
+	}
+	apiKey.RotatedFromID.Valid = false
+	apiKey.CreatedBy = middlewares.AuthPrincipal(r).Username
+
+	created, key, err := database.Db.CreateAPIKey(apiKey)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	log.Infof("CreateAPIKey: %s issued API key %d (%s)", created.CreatedBy, created.ID, created.Name)
+	err = utils.WriteJSON(w, http.StatusCreated, IssuedAPIKey{APIKey: created, Key: key})
+	if err != nil {
+		log.Error("CreateAPIKey: ", err)
+	}
+}
+
+// ListAPIKeys godoc
+//
+//	@Summary		List API keys
+//	@Description	Lists all API keys including revoked and expired ones, newest first
+//	@Tags			API keys
+//	@Produce		json
+//	@Success		200	{array}	database.APIKey
+//	@Security		KeycloakAuth
+//	@Router			/api-keys/ [get]
+func ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	apiKeys, err := database.Db.ListAPIKeys()
+	respond(w, err, apiKeys)
+}
+
+// GetAPIKey godoc
+//
+//	@Summary		Get an API key
+//	@Tags			API keys
+//	@Produce		json
+//	@Param			id	path	int	true	"API key ID"
+//	@Success		200	{object}	database.APIKey
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/api-keys/{id}/ [get]
+func GetAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := apiKeyID(w, r)
+	if !ok {
+		return
+	}
+	apiKey, err := database.Db.GetAPIKey(id)
+	if err != nil {
+		utils.ErrorJSON(w, err, apiKeyErrorStatus(err))
+		return
+	}
+	respond(w, nil, apiKey)
+}
+
+// RevokeAPIKey godoc
+//
+//	@Summary		Revoke an API key
+//	@Description	The key is rejected right away
+//	@Tags			API keys
+//	@Produce		json
+//	@Param			id	path	int	true	"API key ID"
+//	@Success		200	{object}	database.APIKey
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/api-keys/{id}/revoke/ [post]
+func RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := apiKeyID(w, r)
+	if !ok {
+		return
+	}
+	apiKey, err := database.Db.RevokeAPIKey(id, time.Now())
+	if err != nil {
+		utils.ErrorJSON(w, err, apiKeyErrorStatus(err))
+		return
+	}
+	log.Infof("RevokeAPIKey: %s revoked API key %d", middlewares.AuthPrincipal(r).Username, id)
+	respond(w, nil, apiKey)
+}
+
+// RotateAPIKey godoc
+//
+//	@Summary		Rotate an API key
+//	@Description	Issues a new key with the permissions, route groups, allowed IPs and expiry of an active key. The old key keeps working for grace_minutes so the client can be switched over, with 0 it is revoked right away. The new key is only returned once.
+//	@Tags			API keys
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int					true	"API key ID"
+//	@Param			data	body	rotateAPIKeyRequest	false	"Grace period"
+//	@Success		201	{object}	IssuedAPIKey
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/api-keys/{id}/rotate/ [post]
+func RotateAPIKey(w http.ResponseWriter, r *http.Request) {
+	id, ok := apiKeyID(w, r)
+	if !ok {
+		return
+	}
+	var request rotateAPIKeyRequest
+	if r.ContentLength != 0 {
+		if err := utils.ReadJSON(w, r, &request); err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	if request.GraceMinutes < 0 {
+		utils.ErrorJSON(w, errors.New("grace_minutes must not be negative"), http.StatusBadRequest)
+		return
+	}
+	principal := middlewares.AuthPrincipal(r)
+	created, key, err := database.Db.RotateAPIKey(id, time.Duration(request.GraceMinutes)*time.Minute, principal.Username, time.Now())
+	if err != nil {
+		utils.ErrorJSON(w, err, apiKeyErrorStatus(err))
+		return
+	}
+	log.Infof("RotateAPIKey: %s rotated API key %d to %d", principal.Username, id, created.ID)
+	err = utils.WriteJSON(w, http.StatusCreated, IssuedAPIKey{APIKey: created, Key: key})
+	if err != nil {
+		log.Error("RotateAPIKey: ", err)
+	}
+}
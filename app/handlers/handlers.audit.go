@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+// defaultAuditLogLimit is the number of audit log entries returned if the
+// request does not set a limit
+const defaultAuditLogLimit = 100
+
+// ListAuditLog godoc
+//
+//	@Summary		List the audit log
+//	@Description	Lists who changed vendors, comments, items, settings, orders, balances and payouts, newest first. Each entry holds the changed fields with their values before and after, the request ID and the IP of the request.
+//	@Tags			Audit log
+//	@Produce		json
+//	@Param			actor		query		string	false	"Username of the actor"
+//	@Param			action		query		string	false	"Action, e.g. vendor.update"
+//	@Param			entity_type	query		string	false	"Entity type, e.g. vendor"
+//	@Param			entity_id	query		int		false	"Entity ID"
+//	@Param			from		query		string	false	"Start date (RFC3339)"
+//	@Param			to			query		string	false	"End date (RFC3339)"
+//	@Param			limit		query		int		false	"Maximum number of entries (default 100)"
+//	@Param			offset		query		int		false	"Number of entries to skip"
+//	@Success		200			{array}		database.AuditEntry
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/audit-log/ [get]
+func ListAuditLog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	filter := database.AuditFilter{
+		Actor:      query.Get("actor"),
+		Action:     query.Get("action"),
+		EntityType: query.Get("entity_type"),
+		Limit:      defaultAuditLogLimit,
+	}
+	var err error
+	filter.From, filter.To, err = parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	for name, target := range map[string]*int{"entity_id": &filter.EntityID, "limit": &filter.Limit, "offset": &filter.Offset} {
+		value := query.Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			utils.ErrorJSON(w, fmt.Errorf("invalid %s %q", name, value), http.StatusBadRequest)
+			return
+		}
+		*target = parsed
+	}
+
+	entries, err := database.Db.ListAuditEntries(filter)
+	respond(w, err, entries)
+}
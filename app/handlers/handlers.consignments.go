@@ -0,0 +1,195 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+type consignmentRequest struct {
+	Item     int `json:"item"`
+	Quantity int `json:"quantity"`
+	Price    int `json:"price,omitempty"` // Price per copy in cents, defaults to the price of the license item
+}
+
+// readConsignmentRequest resolves the vendor of the URL and reads the body
+func readConsignmentRequest(w http.ResponseWriter, r *http.Request) (vendor database.Vendor, req consignmentRequest, ok bool) {
+	vendor, err := database.Db.GetVendorByLicenseID(chi.URLParam(r, "licenseID"))
+	if err != nil {
+		utils.ErrorJSON(w, errors.New("vendor not found"), http.StatusNotFound)
+		return
+	}
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if req.Quantity <= 0 {
+		utils.ErrorJSON(w, errors.New("quantity must be greater than 0"), http.StatusBadRequest)
+		return
+	}
+	item, err := database.Db.GetItem(req.Item)
+	if err != nil {
+		utils.ErrorJSON(w, errors.New("item not found"), http.StatusBadRequest)
+		return
+	}
+	if item.Type != "issue" {
+		utils.ErrorJSON(w, errors.New("only issues can be handed out on consignment"), http.StatusBadRequest)
+		return
+	}
+	return vendor, req, true
+}
+
+// HandOutConsignment godoc
+//
+//	@Summary		Hand out copies of an issue to a vendor
+//	@Description	Records copies a vendor takes from the office. They are charged to the vendor account when the consignment is settled.
+//	@Tags			consignments
+//	@Accept			json
+//	@Produce		json
+//	@Param			licenseID	path		string				true	"Vendor license ID"
+//	@Param			body		body		consignmentRequest	true	"Issue and number of copies"
+//	@Success		200			{object}	database.Consignment
+//	@Security		KeycloakAuth
+//	@Router			/vendors/{licenseID}/consignments/handout/ [post]
+func HandOutConsignment(w http.ResponseWriter, r *http.Request) {
+	vendor, req, ok := readConsignmentRequest(w, r)
+	if !ok {
+		return
+	}
+	consignment, err := database.Db.HandOutConsignment(vendor.ID, req.Item, req.Quantity, req.Price, middlewares.AuthPrincipal(r).Username)
+	if errors.Is(err, database.ErrInsufficientStock) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	if errors.Is(err, database.ErrConsignmentNoPrice) {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	respond(w, err, consignment)
+}
+
+// ReturnConsignment godoc
+//
+//	@Summary		Return unsold copies of an issue
+//	@Description	Records copies a vendor brings back. Only copies which are neither returned nor settled can be returned.
+//	@Tags			consignments
+//	@Accept			json
+//	@Produce		json
+//	@Param			licenseID	path		string				true	"Vendor license ID"
+//	@Param			body		body		consignmentRequest	true	"Issue and number of copies"
+//	@Success		200			{object}	database.Consignment
+//	@Security		KeycloakAuth
+//	@Router			/vendors/{licenseID}/consignments/return/ [post]
+func ReturnConsignment(w http.ResponseWriter, r *http.Request) {
+	vendor, req, ok := readConsignmentRequest(w, r)
+	if !ok {
+		return
+	}
+	consignment, err := database.Db.ReturnConsignment(vendor.ID, req.Item, req.Quantity, middlewares.AuthPrincipal(r).Username)
+	if errors.Is(err, database.ErrConsignmentReturnTooHigh) {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	respond(w, err, consignment)
+}
+
+// ListConsignmentsForVendor godoc
+//
+//	@Summary		List consignments of a vendor
+//	@Tags			consignments
+//	@Produce		json
+//	@Param			licenseID	path		string	true	"Vendor license ID"
+//	@Param			open		query		bool	false	"Only consignments with open copies"
+//	@Success		200			{array}		database.Consignment
+//	@Security		KeycloakAuth
+//	@Router			/vendors/{licenseID}/consignments/ [get]
+func ListConsignmentsForVendor(w http.ResponseWriter, r *http.Request) {
+	vendor, err := database.Db.GetVendorByLicenseID(chi.URLParam(r, "licenseID"))
+	if err != nil {
+		utils.ErrorJSON(w, errors.New("vendor not found"), http.StatusNotFound)
+		return
+	}
+	consignments, err := database.Db.ListConsignments(vendor.ID, 0, r.URL.Query().Get("open") == "true")
+	respond(w, err, consignments)
+}
+
+// ListConsignments godoc
+//
+//	@Summary		List consignments of all vendors
+//	@Tags			consignments
+//	@Produce		json
+//	@Param			item	query		int		false	"Only consignments of this issue"
+//	@Param			open	query		bool	false	"Only consignments with open copies"
+//	@Success		200		{array}		database.Consignment
+//	@Security		KeycloakAuth
+//	@Router			/consignments/ [get]
+func ListConsignments(w http.ResponseWriter, r *http.Request) {
+	itemID := 0
+	if v := r.URL.Query().Get("item"); v != "" {
+		var err error
+		if itemID, err = strconv.Atoi(v); err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	consignments, err := database.Db.ListConsignments(0, itemID, r.URL.Query().Get("open") == "true")
+	respond(w, err, consignments)
+}
+
+type settleConsignmentResponse struct {
+	Consignment *database.Consignment `json:"consignment"`
+	Amount      int                   `json:"amount"` // Amount charged to the vendor account in cents
+}
+
+// SettleConsignment godoc
+//
+//	@Summary		Settle a consignment
+//	@Description	Charges all open copies to the vendor account. They count as sold afterwards.
+//	@Tags			consignments
+//	@Produce		json
+//	@Param			id	path		int	true	"Consignment ID"
+//	@Success		200	{object}	settleConsignmentResponse
+//	@Security		KeycloakAuth
+//	@Router			/consignments/{id}/settle/ [post]
+func SettleConsignment(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	consignment, amount, err := database.Db.SettleConsignment(id, middlewares.AuthPrincipal(r).Username)
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("consignment not found"), http.StatusNotFound)
+		return
+	}
+	respond(w, err, settleConsignmentResponse{Consignment: consignment, Amount: amount})
+}
+
+// GetConsignmentStatistics godoc
+//
+//	@Summary		Sell-through statistics per issue
+//	@Description	Copies handed out, returned and sold per issue. The sell-through is the share of sold copies among settled and returned copies.
+//	@Tags			consignments
+//	@Produce		json
+//	@Param			item	query		int	false	"Only this issue"
+//	@Success		200		{array}		database.ConsignmentStatistics
+//	@Security		KeycloakAuth
+//	@Router			/consignments/statistics/ [get]
+func GetConsignmentStatistics(w http.ResponseWriter, r *http.Request) {
+	itemID := 0
+	if v := r.URL.Query().Get("item"); v != "" {
+		var err error
+		if itemID, err = strconv.Atoi(v); err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	statistics, err := database.Db.GetConsignmentStatistics(itemID)
+	respond(w, err, statistics)
+}
@@ -2,11 +2,16 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
 	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/go-chi/chi/v5"
 )
@@ -37,6 +42,10 @@ func CreateCustomer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := database.Db.ApplyManualLicenseGroups(createdCustomer.ID, nil, createdCustomer.LicenseGroups); err != nil {
+		log.Error("CreateCustomer: failed to grant entitlements: ", err)
+	}
+
 	err = utils.WriteJSON(w, http.StatusCreated, createdCustomer)
 	if err != nil {
 		log.Error("CreateCustomer", err)
@@ -134,6 +143,12 @@ func UpdateCustomer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// License groups set by an admin are stored as manual entitlements, so
+	// they survive the next projection
+	if err := database.Db.ApplyManualLicenseGroups(updatedCustomer.ID, oldCustomer.LicenseGroups, updatedCustomer.LicenseGroups); err != nil {
+		log.Error("UpdateCustomer: failed to update entitlements: ", err)
+	}
+
 	if updatedCustomer.KeycloakID != "" {
 		if err := keycloak.KeycloakClient.SyncLicenseGroupsDiffToKeycloak(updatedCustomer.KeycloakID, oldCustomer.LicenseGroups, updatedCustomer.LicenseGroups); err != nil {
 			log.Error("UpdateCustomer: failed to sync license groups to Keycloak: ", err)
@@ -170,3 +185,110 @@ func DeleteCustomer(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+type mergeCustomersRequest struct {
+	// SourceID is the duplicate merged into the customer and deleted
+	SourceID int `json:"source_id"`
+}
+
+// MergeCustomers godoc
+//
+//	@Summary		Merge a duplicate into a customer
+//	@Description	Moves the abonements, entitlements, payment mandates, gift codes, invoices and orders of the duplicate to the customer, adds its license groups and deletes it. If both have a Keycloak user, the user of the customer gets the groups of the duplicate's user, which is disabled.
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Customer ID"
+//	@Param			data	body		mergeCustomersRequest	true	"Duplicate"
+//	@Success		200		{object}	database.CustomerMerge
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/{id}/merge/ [post]
+func MergeCustomers(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	var req mergeCustomersRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+
+	report, err := database.NewCustomerMerger(auditedDb(r)).Merge(req.SourceID, id)
+	switch {
+	case errors.Is(err, database.ErrCustomerMergeSelf):
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	case ent.IsNotFound(err):
+		utils.ErrorJSON(w, errors.New("customer not found"), http.StatusNotFound)
+		return
+	case err != nil:
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	respond(w, nil, report)
+}
+
+// ListDuplicateCustomers godoc
+//
+//	@Summary		List customers that might be duplicates
+//	@Description	Groups customers with the same normalized email (lower case, without +tags and dots of Gmail addresses) or the same normalized name
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	database.DuplicateCustomers
+//	@Security		KeycloakAuth
+//	@Router			/customers/duplicates/ [get]
+func ListDuplicateCustomers(w http.ResponseWriter, r *http.Request) {
+	candidates, err := database.Db.ListDuplicateCustomerCandidates()
+	respond(w, err, candidates)
+}
+
+// ImportCustomers godoc
+//
+//	@Summary		Import customers from a CSV file
+//	@Description	Imports a legacy subscriber list. The CSV file (comma or semicolon separated, with header) has the columns email, firstname, lastname and optionally item (ID or name of an abonement item), from and to (2026-01-31 or 31.01.2026). Each row gets a Keycloak user, a customer and an abonement with its license groups, existing ones are reused. The file is sent as multipart field file or as request body.
+//	@Tags			Customers
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			file	formData	file	false	"CSV file"
+//	@Param			dry_run	query		bool	false	"Validate the rows without importing them"
+//	@Param			welcome	query		bool	false	"Send the welcome mail to new Keycloak users"
+//	@Success		200		{object}	database.CustomerImport
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/import/ [post]
+func ImportCustomers(w http.ResponseWriter, r *http.Request) {
+	var options database.CustomerImportOptions
+	var err error
+	if options.DryRun, err = parseBool(r.URL.Query().Get("dry_run")); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if options.SendWelcome, err = parseBool(r.URL.Query().Get("welcome")); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 16<<20)
+	var file io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		f, _, err := r.FormFile("file")
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+		defer f.Close()
+		file = f
+	}
+
+	log.Infof("ImportCustomers: %s imports customers, dry run %t", middlewares.AuthPrincipal(r).Username, options.DryRun)
+	report, err := database.NewCustomerImporter(auditedDb(r)).Import(file, options)
+	if errors.Is(err, database.ErrCustomerImportNoEmailColumn) {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	respond(w, err, report)
+}
@@ -0,0 +1,458 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/pdfdoc"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// updateKeycloakProfile changes name and email of a Keycloak user, who
+// has to verify a changed email, and keycloakVerifiedEmail returns the
+// email of a user once it is verified. They are variables so tests can
+// replace them.
+var (
+	updateKeycloakProfile = func(userID, firstName, lastName, email string) error {
+		return keycloak.KeycloakClient.UpdateCustomerProfile(userID, firstName, lastName, email)
+	}
+	keycloakVerifiedEmail = func(userID string) (string, error) {
+		return keycloak.KeycloakClient.GetVerifiedEmail(userID)
+	}
+)
+
+// authCustomer returns the customer of the authenticated user and writes
+// an error response if there is none
+func authCustomer(w http.ResponseWriter, r *http.Request) (*database.Customer, bool) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return nil, false
+	}
+	customer, err := database.Db.GetCustomerByID(customerID)
+	if err != nil {
+		utils.ErrorJSON(w, errors.New("customer not found"), http.StatusNotFound)
+		return nil, false
+	}
+	if customer.PendingEmail != "" {
+		customer = confirmPendingEmail(customer)
+	}
+	return customer, true
+}
+
+// confirmPendingEmail applies the pending email of a customer once their
+// Keycloak user verified it. Until then the old email stays, so nobody
+// sees the orders of an email they do not own.
+func confirmPendingEmail(customer *database.Customer) *database.Customer {
+	verified, err := keycloakVerifiedEmail(customer.KeycloakID)
+	if err != nil {
+		log.Error("confirmPendingEmail: failed to get Keycloak user: ", err)
+		return customer
+	}
+	if verified != customer.PendingEmail {
+		return customer
+	}
+	confirmed, err := database.Db.ConfirmCustomerEmail(customer.ID, verified)
+	if err != nil {
+		log.Error("confirmPendingEmail: ", err)
+		return customer
+	}
+	return confirmed
+}
+
+// GetMyProfile godoc
+//
+//	@Summary		Get the profile of the authenticated customer
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{object}	database.Customer
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/ [get]
+func GetMyProfile(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	respond(w, nil, customer)
+}
+
+type updateMyProfileRequest struct {
+	FirstName string `json:"firstname"`
+	LastName  string `json:"lastname"`
+	Email     string `json:"email"`
+}
+
+// UpdateMyProfile godoc
+//
+//	@Summary		Update name and email of the authenticated customer
+//	@Description	The changes are applied to the Keycloak user as well. A changed email is returned as pending_email and replaces the email once the customer followed the link of the verification email.
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		updateMyProfileRequest	true	"Profile"
+//	@Success		200		{object}	database.Customer
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/ [put]
+func UpdateMyProfile(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	var req updateMyProfileRequest
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		utils.ErrorJSON(w, errors.New("invalid email"), http.StatusBadRequest)
+		return
+	}
+	if existing, err := database.Db.GetCustomerByEmail(email); err == nil && existing.ID != customer.ID {
+		utils.ErrorJSON(w, database.ErrCustomerEmailTaken, http.StatusConflict)
+		return
+	}
+
+	// Keycloak rejects emails of other users, so it is updated first
+	if customer.KeycloakID != "" {
+		if err := updateKeycloakProfile(customer.KeycloakID, strings.TrimSpace(req.FirstName), strings.TrimSpace(req.LastName), email); err != nil {
+			log.Error("UpdateMyProfile: failed to update Keycloak user: ", err)
+			utils.ErrorJSON(w, errors.New("failed to update account"), http.StatusBadGateway)
+			return
+		}
+	}
+	updated, err := database.Db.UpdateCustomerProfile(customer.ID, req.FirstName, req.LastName, email)
+	if errors.Is(err, database.ErrCustomerEmailTaken) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	respond(w, err, updated)
+}
+
+// UpdateMyPreferences godoc
+//
+//	@Summary		Update the notification preferences of the authenticated customer
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		database.CustomerPreferences	true	"Preferences"
+//	@Success		200		{object}	database.Customer
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/preferences/ [put]
+func UpdateMyPreferences(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+	var preferences database.CustomerPreferences
+	if err := utils.ReadJSON(w, r, &preferences); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	updated, err := database.Db.UpdateCustomerPreferences(customerID, preferences)
+	respond(w, err, updated)
+}
+
+// ListMyOrders godoc
+//
+//	@Summary		List the orders of the authenticated customer
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	database.Order
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/orders/ [get]
+func ListMyOrders(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	orders, err := database.Db.ListCustomerOrders(*customer)
+	respond(w, err, orders)
+}
+
+// receiptLine is a line of a receipt, amounts in cents
+type receiptLine struct {
+	Name      string
+	Quantity  int
+	UnitPrice int
+	Total     int
+}
+
+// receiptLines returns the lines of the sold entries of an order. Entries
+// with a price of one cent, like donations, carry their amount in the
+// quantity and are shown as a single amount.
+func receiptLines(order database.Order, itemName func(int) string) []receiptLine {
+	lines := []receiptLine{}
+	for _, entry := range order.Entries {
+		if !entry.IsSale {
+			continue
+		}
+		line := receiptLine{
+			Name:      itemName(entry.Item),
+			Quantity:  entry.Quantity,
+			UnitPrice: entry.Price,
+			Total:     entry.Price * entry.Quantity,
+		}
+		if entry.Price == 1 || entry.Price == -1 {
+			line.Quantity = 1
+			line.UnitPrice = line.Total
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// renderReceipt renders the receipt of an order as PDF
+func renderReceipt(issuer string, order database.Order, lines []receiptLine) []byte {
+	title := "Beleg " + order.OrderCode.String
+	doc := pdfdoc.New(title, issuer)
+	page := doc.AddPage()
+	const left, right = 50.0, pdfdoc.PageWidth - 50
+	y := pdfdoc.PageHeight - 70
+
+	page.Text(left, y, 16, true, issuer)
+	y -= 30
+	page.Text(left, y, 13, true, title)
+	y -= 18
+	date := order.Timestamp
+	if order.VerifiedAt.Valid {
+		date = order.VerifiedAt.Time
+	}
+	page.Text(left, y, 10, false, "Datum: "+date.Format("02.01.2006"))
+	if order.CustomerEmail.Valid {
+		y -= 14
+		page.Text(left, y, 10, false, "Kunde: "+order.CustomerEmail.String)
+	}
+
+	y -= 30
+	page.Text(left, y, 10, true, "Artikel")
+	page.TextRight(right-160, y, 10, true, "Menge")
+	page.TextRight(right-80, y, 10, true, "Einzelpreis")
+	page.TextRight(right, y, 10, true, "Betrag")
+	y -= 6
+	page.Line(left, y, right, y)
+	for _, line := range lines {
+		// Start a new page before the footer area
+		if y < 100 {
+			page = doc.AddPage()
+			y = pdfdoc.PageHeight - 70
+		}
+		y -= 16
+		page.Text(left, y, 10, false, line.Name)
+		page.TextRight(right-160, y, 10, false, strconv.Itoa(line.Quantity))
+		page.TextRight(right-80, y, 10, false, pdfdoc.FormatEuro(line.UnitPrice))
+		page.TextRight(right, y, 10, false, pdfdoc.FormatEuro(line.Total))
+	}
+	y -= 8
+	page.Line(left, y, right, y)
+	y -= 18
+	page.Text(left, y, 11, true, "Gesamt")
+	page.TextRight(right, y, 11, true, pdfdoc.FormatEuro(order.GetTotal()))
+	return doc.Bytes()
+}
+
+// GetMyOrderInvoice godoc
+//
+//	@Summary		Download the receipt of an order of the authenticated customer
+//	@Tags			Customers
+//	@Produce		application/pdf
+//	@Param			id	path	int	true	"Order ID"
+//	@Success		200
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/orders/{id}/invoice/ [get]
+func GetMyOrderInvoice(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	orderID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	order, err := database.Db.GetCustomerOrder(*customer, orderID)
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("order not found"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	// Orders with an issued invoice get the invoice instead of the receipt
+	if inv, err := database.Db.GetInvoiceByOrderID(order.ID); err == nil {
+		writeInvoicePDF(w, inv)
+		return
+	} else if !ent.IsNotFound(err) {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	settings, err := database.Db.GetSettings()
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	names := map[int]string{}
+	itemName := func(id int) string {
+		if name, ok := names[id]; ok {
+			return name
+		}
+		name := "Artikel " + strconv.Itoa(id)
+		if item, err := database.Db.GetItem(id); err == nil {
+			name = item.Name
+		}
+		names[id] = name
+		return name
+	}
+	data := renderReceipt(settings.NewspaperName, order, receiptLines(order, itemName))
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="beleg-%d.pdf"`, order.ID))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		log.Error("GetMyOrderInvoice: ", err)
+	}
+}
+
+// myPDFDownload is a download link of the authenticated customer with the
+// limits that apply to it
+type myPDFDownload struct {
+	LinkID        string     `json:"link_id"`
+	URL           string     `json:"url"`
+	OrderID       int        `json:"order_id"`
+	ItemID        int        `json:"item_id"`
+	ItemName      string     `json:"item_name"`
+	CreatedAt     time.Time  `json:"created_at"`
+	DownloadCount int        `json:"download_count"`
+	Limit         int        `json:"limit"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	Suspended     bool       `json:"suspended"`
+}
+
+// ListMyPDFDownloads godoc
+//
+//	@Summary		List the PDF download links of the authenticated customer
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	myPDFDownload
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/pdf-downloads/ [get]
+func ListMyPDFDownloads(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	downloads, err := database.Db.ListCustomerPDFDownloads(*customer)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	result := make([]myPDFDownload, 0, len(downloads))
+	for _, download := range downloads {
+		policy, err := database.Db.GetPDFDownloadPolicy(download.ItemID)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusInternalServerError)
+			return
+		}
+		link := myPDFDownload{
+			LinkID:        download.LinkID,
+			URL:           database.PDFDownloadURL(download.LinkID),
+			OrderID:       int(download.OrderID.Int64),
+			ItemID:        int(download.ItemID.Int64),
+			CreatedAt:     download.Timestamp,
+			DownloadCount: download.DownloadCount,
+			Limit:         policy.Limit,
+			Suspended:     download.Suspended,
+		}
+		if expiresAt := policy.ExpiresAt(download); !expiresAt.IsZero() {
+			link.ExpiresAt = &expiresAt
+		}
+		if download.ItemID.Valid {
+			if item, err := database.Db.GetItem(link.ItemID); err == nil {
+				link.ItemName = item.Name
+			}
+		}
+		result = append(result, link)
+	}
+	respond(w, nil, result)
+}
+
+type deletionRequest struct {
+	Reason string `json:"reason"`
+}
+
+// RequestMyAccountDeletion godoc
+//
+//	@Summary		Request the deletion of the account of the authenticated customer
+//	@Description	The admins are notified and delete the account. Until then the request can be withdrawn.
+//	@Tags			Customers
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body		deletionRequest	false	"Reason"
+//	@Success		200		{object}	database.Customer
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/deletion-request/ [post]
+func RequestMyAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+	var req deletionRequest
+	if r.ContentLength > 0 {
+		if err := utils.ReadJSON(w, r, &req); err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	customer, err := database.Db.RequestCustomerDeletion(customerID, req.Reason, time.Now())
+	respond(w, err, customer)
+}
+
+// WithdrawMyAccountDeletion godoc
+//
+//	@Summary		Withdraw the deletion request of the authenticated customer
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{object}	database.Customer
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/deletion-request/ [delete]
+func WithdrawMyAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+	customer, err := database.Db.WithdrawCustomerDeletion(customerID)
+	if errors.Is(err, database.ErrCustomerDeletionNotRequested) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	respond(w, err, customer)
+}
+
+// ListCustomerDeletionRequests godoc
+//
+//	@Summary		List customers that requested the deletion of their account
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	database.Customer
+//	@Security		KeycloakAuth
+//	@Router			/customers/deletion-requests/ [get]
+func ListCustomerDeletionRequests(w http.ResponseWriter, r *http.Request) {
+	customers, err := database.Db.ListCustomerDeletionRequests()
+	respond(w, err, customers)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestReceipt(t *testing.T) {
+	order := database.Order{
+		ID:            7,
+		OrderCode:     null.StringFrom("abc"),
+		Timestamp:     time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		CustomerEmail: null.StringFrom("anna@example.com"),
+		Entries: []database.OrderEntry{
+			{Item: 1, Quantity: 2, Price: 300, IsSale: true},
+			{Item: 2, Quantity: 150, Price: 1, IsSale: true},
+			{Item: 1, Quantity: 2, Price: 300},
+		},
+	}
+	names := map[int]string{1: "Zeitung", 2: "Spende"}
+	lines := receiptLines(order, func(id int) string { return names[id] })
+	require.Equal(t, []receiptLine{
+		{Name: "Zeitung", Quantity: 2, UnitPrice: 300, Total: 600},
+		{Name: "Spende", Quantity: 1, UnitPrice: 150, Total: 150},
+	}, lines)
+
+	data := renderReceipt("Augustin", order, lines)
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-")))
+	require.Contains(t, string(data), "(Beleg abc) Tj")
+	require.Contains(t, string(data), "(7,50 \x80) Tj")
+}
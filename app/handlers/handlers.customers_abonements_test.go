@@ -16,6 +16,7 @@ import (
 
 	"github.com/augustin-wien/augustina-backend/config"
 	dbpkg "github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 )
 
 func skipIfNoCustomerAbonementTables(t *testing.T) {
@@ -394,7 +395,7 @@ func TestUpdateAbonementHandler(t *testing.T) {
 
 	// Update the abonement
 	updatedAbonement := *createdAbonement
-	updatedAbonement.Status = "inactive"
+	updatedAbonement.Status = dbpkg.AbonementStatusPaused
 
 	b, err := json.Marshal(updatedAbonement)
 	require.NoError(t, err)
@@ -412,7 +413,17 @@ func TestUpdateAbonementHandler(t *testing.T) {
 	var response dbpkg.Abonement
 	err = json.Unmarshal(rr.Body.Bytes(), &response)
 	require.NoError(t, err)
-	require.Equal(t, "inactive", response.Status)
+	require.Equal(t, dbpkg.AbonementStatusPaused, response.Status)
+
+	// Unknown statuses are rejected
+	updatedAbonement.Status = "inactive"
+	b, err = json.Marshal(updatedAbonement)
+	require.NoError(t, err)
+	req = httptest.NewRequest(http.MethodPut, "/api/abonements/"+strconv.Itoa(createdAbonement.ID), bytes.NewReader(b))
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rc))
+	rr = httptest.NewRecorder()
+	UpdateAbonement(rr, req)
+	require.Equal(t, http.StatusBadRequest, rr.Code)
 }
 
 func TestDeleteAbonementHandler(t *testing.T) {
@@ -498,7 +509,7 @@ func TestListMyAbonementsHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/customers/me/abonements/", nil)
-	req.Header.Set("X-Auth-User", createdCustomer.KeycloakID)
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{UserID: createdCustomer.KeycloakID}))
 	rr := httptest.NewRecorder()
 
 	ListMyAbonements(rr, req)
@@ -587,7 +598,7 @@ func TestListMyPaymentsHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	req := httptest.NewRequest(http.MethodGet, "/api/customers/me/payments/", nil)
-	req.Header.Set("X-Auth-User-Email", customerEmail)
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Email: customerEmail}))
 	rr := httptest.NewRecorder()
 
 	ListMyPayments(rr, req)
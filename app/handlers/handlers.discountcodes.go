@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// orderDiscount looks up a discount code and computes its discount for the
+// entries of an order, split over the VAT rates of the discounted entries
+func orderDiscount(code string, entries []database.OrderEntry, defaultVATRate float64) (discountCode database.DiscountCode, amount int, shares []database.DiscountShare, err error) {
+	discountCode, err = database.Db.GetDiscountCodeByCode(code)
+	if err != nil {
+		return
+	}
+	if !discountCode.ValidAt(time.Now()) {
+		err = database.ErrDiscountCodeInvalid
+		return
+	}
+	if discountCode.MaxUses > 0 && discountCode.Uses >= discountCode.MaxUses {
+		err = database.ErrDiscountCodeExhausted
+		return
+	}
+	items := map[int]database.Item{}
+	for _, entry := range entries {
+		if _, ok := items[entry.Item]; ok {
+			continue
+		}
+		item, err := database.Db.GetItem(entry.Item)
+		if err != nil {
+			return discountCode, 0, nil, err
+		}
+		items[entry.Item] = item
+	}
+	amount = discountCode.Discount(entries, items)
+	if amount == 0 {
+		err = database.ErrDiscountNotApplicable
+		return
+	}
+	shares = discountCode.DiscountShares(amount, entries, items, defaultVATRate)
+	return
+}
+
+// errDiscountCodeRejected is returned to buyers for every discount code
+// that can not be used. Unknown, expired and used up codes are not told
+// apart, so codes can not be guessed from the errors of orders.
+var errDiscountCodeRejected = errors.New("discount code can not be used for this order")
+
+// writeDiscountError writes an error of a discount code of an order
+func writeDiscountError(w http.ResponseWriter, code string, err error) {
+	if errors.Is(err, database.ErrDiscountCodeNotFound) || errors.Is(err, database.ErrDiscountCodeExhausted) ||
+		errors.Is(err, database.ErrDiscountCodeInvalid) || errors.Is(err, database.ErrDiscountNotApplicable) {
+		log.Info("CreatePaymentOrder: discount code ", code, " rejected: ", err)
+		utils.ErrorJSON(w, errDiscountCodeRejected, http.StatusBadRequest)
+		return
+	}
+	utils.ErrorJSON(w, err, http.StatusInternalServerError)
+}
+
+// CreateDiscountCode godoc
+//
+//	@Summary		Create a discount code
+//	@Description	Creates a percentage or fixed (in cents) discount code. Restrict it to an item or a license group, a validity window or a number of uses (0 for unlimited).
+//	@Tags			Discount codes
+//	@Accept			json
+//	@Produce		json
+//	@Param			data body database.DiscountCode true "Discount code"
+//	@Success		200	{object}	database.DiscountCode
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/discount-codes/ [post]
+func CreateDiscountCode(w http.ResponseWriter, r *http.Request) {
+	var discountCode database.DiscountCode
+	if err := utils.ReadJSON(w, r, &discountCode); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if err := discountCode.Validate(); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	created, err := database.Db.CreateDiscountCode(discountCode)
+	if ent.IsConstraintError(err) {
+		utils.ErrorJSON(w, errors.New("discount code already exists"), http.StatusConflict)
+		return
+	}
+	respond(w, err, created)
+}
+
+// ListDiscountCodes godoc
+//
+//	@Summary		List discount codes
+//	@Description	Lists all discount codes with their number of uses, newest first
+//	@Tags			Discount codes
+//	@Produce		json
+//	@Success		200	{array}	database.DiscountCode
+//	@Security		KeycloakAuth
+//	@Router			/discount-codes/ [get]
+func ListDiscountCodes(w http.ResponseWriter, r *http.Request) {
+	codes, err := database.Db.ListDiscountCodes()
+	respond(w, err, codes)
+}
+
+// GetDiscountCode godoc
+//
+//	@Summary		Get a discount code
+//	@Tags			Discount codes
+//	@Produce		json
+//	@Param			id	path	int	true	"Discount code ID"
+//	@Success		200	{object}	database.DiscountCode
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/discount-codes/{id}/ [get]
+func GetDiscountCode(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	discountCode, err := database.Db.GetDiscountCode(id)
+	if errors.Is(err, database.ErrDiscountCodeNotFound) {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+	respond(w, err, discountCode)
+}
+
+// UpdateDiscountCode godoc
+//
+//	@Summary		Update a discount code
+//	@Description	Updates the discount, restrictions and limits of a discount code. The code itself and its number of uses can not be changed. Set disabled to stop a campaign.
+//	@Tags			Discount codes
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int						true	"Discount code ID"
+//	@Param			data	body	database.DiscountCode	true	"Discount code"
+//	@Success		200	{object}	database.DiscountCode
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/discount-codes/{id}/ [put]
+func UpdateDiscountCode(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	existing, err := database.Db.GetDiscountCode(id)
+	if errors.Is(err, database.ErrDiscountCodeNotFound) {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	var discountCode database.DiscountCode
+	if err := utils.ReadJSON(w, r, &discountCode); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	discountCode.ID = id
+	discountCode.Code = existing.Code
+	if err := discountCode.Validate(); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	updated, err := database.Db.UpdateDiscountCode(discountCode)
+	respond(w, err, updated)
+}
+
+// GetDiscountRedemptionReport godoc
+//
+//	@Summary		Discount code redemptions
+//	@Description	Lists the orders a discount code was used for in the given period and sums up the discounts per code. Only paid orders count towards paid_amount.
+//	@Tags			Discount codes
+//	@Produce		json
+//	@Param			from	query		string	false	"Start date (RFC3339)"
+//	@Param			to		query		string	false	"End date (RFC3339)"
+//	@Param			code_id	query		int		false	"Only redemptions of this discount code"
+//	@Success		200		{object}	database.DiscountRedemptionReport
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/discount-codes/report/ [get]
+func GetDiscountRedemptionReport(w http.ResponseWriter, r *http.Request) {
+	minDate, maxDate, err := parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	codeID := 0
+	if v := r.URL.Query().Get("code_id"); v != "" {
+		codeID, err = strconv.Atoi(v)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	report, err := database.Db.GetDiscountRedemptionReport(minDate, maxDate, codeID)
+	respond(w, err, report)
+}
+
+// ReleaseUnpaidDiscountCodes gives back the uses of discount codes by
+// orders that were not paid within the reservation time. It is run
+// periodically by the scheduler.
+func ReleaseUnpaidDiscountCodes(ctx context.Context) error {
+	now := time.Now()
+	deadline := now.Add(-time.Duration(config.Config.DiscountReservationMinutes) * time.Minute)
+	released, err := database.Db.ReleaseUnpaidDiscountCodes(deadline, now)
+	if released > 0 {
+		log.Infof("ReleaseUnpaidDiscountCodes: %d uses of discount codes released", released)
+	}
+	return err
+}
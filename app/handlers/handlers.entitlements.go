@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+// ListMyEntitlements godoc
+//
+//	@Summary		List entitlements for the authenticated customer
+//	@Description	Lists all entitlements of the customer, including expired and revoked ones. Active tells whether an entitlement grants access right now.
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	database.Entitlement
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/entitlements/ [get]
+func ListMyEntitlements(w http.ResponseWriter, r *http.Request) {
+	customerID, ok := authCustomerID(w, r)
+	if !ok {
+		return
+	}
+
+	entitlements, err := database.Db.ListEntitlementsByCustomer(customerID)
+	respond(w, err, entitlements)
+}
+
+type introspectEntitlementRequest struct {
+	Token  string `json:"token"`
+	ItemID int    `json:"item_id"`
+}
+
+// EntitlementIntrospection tells an external reader whether the owner of a
+// token may read an item
+type EntitlementIntrospection struct {
+	// Active is false if the token is invalid or expired
+	Active      bool                  `json:"active"`
+	Allowed     bool                  `json:"allowed"`
+	ItemID      int                   `json:"item_id"`
+	Subject     string                `json:"sub,omitempty"`
+	Entitlement *database.Entitlement `json:"entitlement,omitempty"`
+}
+
+// tokenSubject returns the Keycloak user ID of a token. Tokens are verified
+// locally with the realm's signing keys, Keycloak is only asked if the keys
+// are unavailable. It is a variable so tests can replace it.
+var tokenSubject = func(token string) (string, error) {
+	if verifier := keycloak.KeycloakClient.TokenVerifier; verifier != nil {
+		claims, err := verifier.Verify(token)
+		if !errors.Is(err, keycloak.ErrJWKSUnavailable) {
+			if err != nil {
+				return "", err
+			}
+			if claims.Subject == "" {
+				return "", errors.New("token has no subject")
+			}
+			return claims.Subject, nil
+		}
+		log.Warn("IntrospectEntitlement: signing keys unavailable, validating token with Keycloak")
+	}
+	userinfo, err := keycloak.KeycloakClient.GetUserInfo(token)
+	if err != nil {
+		return "", err
+	}
+	if userinfo.Sub == nil {
+		return "", errors.New("token has no subject")
+	}
+	return *userinfo.Sub, nil
+}
+
+// IntrospectEntitlement godoc
+//
+//	@Summary		Check whether a user may read an item
+//	@Description	For external readers like WordPress or the e-paper viewer, which authenticate with an API key of the readers route group with the entitlements:introspect permission. The reader passes the access token of its user and the ID of the online issue. Invalid tokens are reported as not active.
+//	@Tags			Entitlements
+//	@Accept			json
+//	@Produce		json
+//	@Param			data body introspectEntitlementRequest true "Token and item"
+//	@Success		200	{object}	EntitlementIntrospection
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/entitlements/introspect/ [post]
+func IntrospectEntitlement(w http.ResponseWriter, r *http.Request) {
+	var request introspectEntitlementRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	request.Token = strings.TrimSpace(strings.TrimPrefix(request.Token, "Bearer "))
+	if request.Token == "" || request.ItemID <= 0 {
+		utils.ErrorJSON(w, errors.New("token and item_id are required"), http.StatusBadRequest)
+		return
+	}
+
+	result := EntitlementIntrospection{ItemID: request.ItemID}
+	subject, err := tokenSubject(request.Token)
+	if err != nil {
+		log.Info("IntrospectEntitlement: invalid token ", err)
+		respond(w, nil, result)
+		return
+	}
+	result.Active = true
+	result.Subject = subject
+
+	customer, err := database.Db.GetCustomerByKeycloakID(subject)
+	if ent.IsNotFound(err) {
+		respond(w, nil, result)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	entitlement, found, err := database.Db.GetCustomerEntitlementForItem(customer.ID, request.ItemID, time.Now())
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("item not found"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	if found {
+		result.Allowed = true
+		result.Entitlement = &entitlement
+	}
+	respond(w, nil, result)
+}
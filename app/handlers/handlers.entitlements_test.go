@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestIntrospectEntitlement(t *testing.T) {
+	mutex_test.Lock()
+	defer mutex_test.Unlock()
+	require.NoError(t, database.Db.InitEmptyTestDb())
+
+	originalTokenSubject := tokenSubject
+	defer func() { tokenSubject = originalTokenSubject }()
+	tokenSubject = func(token string) (string, error) {
+		if token == "valid-token" {
+			return "reader-customer", nil
+		}
+		return "", errors.New("invalid token")
+	}
+
+	customer, err := database.Db.CreateCustomer(&database.Customer{KeycloakID: "reader-customer", Email: "reader@example.com"})
+	require.NoError(t, err)
+	issueID, err := database.Db.CreateItem(database.Item{Name: "Online issue 602", Description: "Online issue", Price: 300, Type: "online_issue", LicenseGroup: null.StringFrom("digital")})
+	require.NoError(t, err)
+
+	_, key, err := database.Db.CreateAPIKey(database.APIKey{Name: "wordpress", Permissions: []string{"entitlements:introspect"}, RouteGroups: []string{"readers"}})
+	require.NoError(t, err)
+	introspect := func(token string, expectedCode int) EntitlementIntrospection {
+		res := utils.TestRequestWithAuth(t, r, "POST", "/api/entitlements/introspect/", map[string]interface{}{
+			"token":   token,
+			"item_id": issueID,
+		}, expectedCode, &gocloak.JWT{AccessToken: key})
+		var result EntitlementIntrospection
+		if expectedCode == http.StatusOK {
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &result))
+		}
+		return result
+	}
+
+	result := introspect("invalid-token", http.StatusOK)
+	require.False(t, result.Active)
+	require.False(t, result.Allowed)
+
+	result = introspect("Bearer valid-token", http.StatusOK)
+	require.True(t, result.Active)
+	require.False(t, result.Allowed)
+
+	_, err = database.Db.GrantEntitlement(database.Entitlement{
+		CustomerID:   customer.ID,
+		LicenseGroup: "digital",
+		Source:       database.EntitlementSourceManual,
+	})
+	require.NoError(t, err)
+	result = introspect("valid-token", http.StatusOK)
+	require.True(t, result.Allowed)
+	require.Equal(t, "reader-customer", result.Subject)
+	require.Equal(t, "digital", result.Entitlement.LicenseGroup)
+
+	introspect("", http.StatusBadRequest)
+
+	// Readers need an API key
+	utils.TestRequest(t, r, "POST", "/api/entitlements/introspect/", map[string]interface{}{
+		"token":   "valid-token",
+		"item_id": issueID,
+	}, http.StatusUnauthorized)
+}
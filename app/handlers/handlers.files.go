@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/augustin-wien/augustina-backend/storage"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// serveStoredFile writes an object of the storage to the response
+func serveStoredFile(w http.ResponseWriter, r *http.Request, key string) {
+	reader, info, err := storage.Client.Get(r.Context(), key)
+	if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Error("serveStoredFile: ", key, " ", err)
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+		return
+	}
+	defer reader.Close()
+	if info.ContentType != "" {
+		w.Header().Set("Content-Type", info.ContentType)
+	}
+	// Local files support range requests and conditional requests
+	if seeker, ok := reader.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, path.Base(key), info.ModTime, seeker)
+		return
+	}
+	if !info.ModTime.IsZero() {
+		w.Header().Set("Last-Modified", info.ModTime.UTC().Format(http.TimeFormat))
+	}
+	if info.Size > 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	}
+	if _, err := io.Copy(w, reader); err != nil {
+		log.Error("serveStoredFile: ", key, " ", err)
+	}
+}
+
+// storedFiles serves the objects below prefix, e.g. /img/* from img/
+func storedFiles(prefix string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		serveStoredFile(w, r, prefix+"/"+chi.URLParam(r, "*"))
+	}
+}
+
+// ServeSignedFile serves the signed URLs of the local storage backend.
+// Other backends hand out URLs of the object store instead.
+func ServeSignedFile(w http.ResponseWriter, r *http.Request) {
+	local, ok := storage.Client.(*storage.Local)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	key := chi.URLParam(r, "*")
+	if err := local.Verify(key, r.URL.Query().Get("expires"), r.URL.Query().Get("signature")); err != nil {
+		utils.ErrorJSON(w, err, http.StatusForbidden)
+		return
+	}
+	serveStoredFile(w, r, key)
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"gopkg.in/guregu/null.v4"
+)
+
+type redeemGiftCodeRequest struct {
+	Code  string `json:"code"`
+	Email string `json:"email"`
+}
+
+// RedeemedGiftCode tells the redeemer what they received
+type RedeemedGiftCode struct {
+	Code        string   `json:"code"`
+	ItemID      int      `json:"item_id"`
+	AbonementID null.Int `json:"abonement_id" swaggertype:"integer"`
+}
+
+// RedeemGiftCode godoc
+//
+//	@Summary		Redeem a gift code
+//	@Description	Grants the abonement or digital item of a gift code to the customer with the given email. The customer and their Keycloak account are created if they do not exist yet.
+//	@Tags			Gift codes
+//	@Accept			json
+//	@Produce		json
+//	@Param			data body redeemGiftCodeRequest true "Gift code and email of the redeemer"
+//	@Success		200	{object}	RedeemedGiftCode
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Failure		409	{object}	utils.ErrorResponse
+//	@Router			/gift-codes/redeem/ [post]
+func RedeemGiftCode(w http.ResponseWriter, r *http.Request) {
+	var request redeemGiftCodeRequest
+	if err := utils.ReadJSON(w, r, &request); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	email := strings.TrimSpace(request.Email)
+	if request.Code == "" {
+		utils.ErrorJSON(w, errors.New("code is required"), http.StatusBadRequest)
+		return
+	}
+	if _, err := mail.ParseAddress(email); err != nil {
+		utils.ErrorJSON(w, errors.New("a valid email is required"), http.StatusBadRequest)
+		return
+	}
+
+	// Check the code before creating any account for the redeemer
+	giftCode, err := database.Db.GetGiftCodeByCode(request.Code)
+	if errors.Is(err, database.ErrGiftCodeNotFound) {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	if giftCode.RedeemedAt.Valid {
+		utils.ErrorJSON(w, database.ErrGiftCodeRedeemed, http.StatusConflict)
+		return
+	}
+
+	keycloakID, _, err := keycloak.KeycloakClient.GetOrCreateUser(email)
+	if err != nil {
+		log.Error("RedeemGiftCode: failed to create keycloak user: ", err)
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	customer, err := database.Db.GetOrCreateCustomerByEmail(email, keycloakID)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	if err := keycloak.KeycloakClient.AssignGroup(keycloakID, "customer"); err != nil {
+		log.Error("RedeemGiftCode: failed to assign customer group: ", err)
+	}
+
+	now := time.Now()
+	giftCode, err = database.Db.RedeemGiftCode(request.Code, customer.ID, now)
+	if errors.Is(err, database.ErrGiftCodeRedeemed) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	if err != nil {
+		log.Error("RedeemGiftCode: failed to grant gift code ", giftCode.ID, ": ", err)
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+	log.Infof("RedeemGiftCode: customer %d redeemed gift code %d", customer.ID, giftCode.ID)
+
+	svc := database.NewAbonementService(&database.Db)
+	if err := svc.ProjectEntitlements(customer.ID, now); err != nil {
+		log.Error("RedeemGiftCode: failed to project entitlements to keycloak: ", err)
+	}
+
+	err = utils.WriteJSON(w, http.StatusOK, RedeemedGiftCode{
+		Code:        giftCode.Code,
+		ItemID:      giftCode.ItemID,
+		AbonementID: giftCode.AbonementID,
+	})
+	if err != nil {
+		log.Error("RedeemGiftCode", err)
+	}
+}
+
+// ListGiftCodes godoc
+//
+//	@Summary		List gift codes
+//	@Description	Lists gift codes, newest first. Use redeemed=false to list the codes not redeemed yet.
+//	@Tags			Gift codes
+//	@Produce		json
+//	@Param			redeemed query bool false "Only redeemed or unredeemed codes"
+//	@Success		200	{array}	database.GiftCode
+//	@Failure		400	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/gift-codes/ [get]
+func ListGiftCodes(w http.ResponseWriter, r *http.Request) {
+	var redeemed null.Bool
+	if v := r.URL.Query().Get("redeemed"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+		redeemed = null.BoolFrom(b)
+	}
+	codes, err := database.Db.ListGiftCodes(redeemed)
+	respond(w, err, codes)
+}
+
+// DeliverGiftCodes mails gift codes to their recipients on the date chosen
+// by the buyer. It is run periodically by the scheduler.
+func DeliverGiftCodes(ctx context.Context) error {
+	sent, err := database.Db.DeliverDueGiftCodes(time.Now())
+	if err != nil {
+		return err
+	}
+	if sent > 0 {
+		log.Infof("DeliverGiftCodes: %d gift codes sent", sent)
+	}
+	return nil
+}
@@ -1,23 +1,27 @@
 package handlers
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/mail"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/augustin-wien/augustina-backend/storage"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/augustin-wien/augustina-backend/wordpress"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 
 	_ "github.com/swaggo/files"        // swagger embed files
 	_ "github.com/swaggo/http-swagger" // http-swagger middleware
@@ -39,6 +43,18 @@ func respond(w http.ResponseWriter, err error, payload interface{}) {
 	}
 }
 
+// auditedDb returns the database recording the changes it makes in the
+// audit log for the authenticated user of a request
+func auditedDb(r *http.Request) *database.Database {
+	principal := middlewares.AuthPrincipal(r)
+	return database.Db.As(database.AuditActor{
+		Actor:     principal.Username,
+		ActorID:   principal.UserID,
+		RequestID: middleware.GetReqID(r.Context()),
+		IP:        utils.ReadUserIP(r),
+	})
+}
+
 // HelloWorld godoc
 //
 //	@Summary		Return HelloWorld
@@ -100,6 +116,22 @@ type createOrderRequest struct {
 	User            string
 	VendorLicenseID string
 	CustomerEmail   null.String
+	// Buy the abonements and digital items as a gift, see database.GiftCode
+	Gift *createOrderGift
+	// Optional code of a database.DiscountCode
+	DiscountCode string
+	// Optional address for the invoice, see database.Invoice
+	BillingAddress *schema.BillingAddress
+	// Consent to renew the abonements automatically with the payment method
+	AutoRenew bool
+}
+
+// createOrderGift makes an order a gift. The buyer receives the gift codes,
+// if a recipient is given they are also sent to them at SendAt.
+type createOrderGift struct {
+	RecipientEmail null.String
+	SendAt         null.Time
+	Message        string
 }
 
 type createOrderResponse struct {
@@ -172,6 +204,8 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	order.CustomerEmail = requestData.CustomerEmail
+	giftable := false
+	hasAbonement := false
 
 	// Security checks for entries
 	for _, entry := range requestData.Entries {
@@ -189,8 +223,8 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
-		// 3. Check: Transaction costs (id == 3) are not allowed to be in entries
-		if entry.Item == 3 {
+		// 3. Check: Transaction costs (id == 3) and discounts are not allowed to be in entries
+		if entry.Item == 3 || item.Type == "discount" {
 			utils.ErrorJSON(w, errors.New("nice try! You are not allowed to purchase this item"), http.StatusBadRequest)
 			return
 		}
@@ -203,7 +237,59 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 			order.CustomerEmail = requestData.CustomerEmail
+			if !item.IsPDFItem {
+				giftable = true
+			}
+			if item.Type == "abonement" {
+				hasAbonement = true
+			}
+		}
+	}
+
+	// Gifts are granted through codes sent to the buyer, so they need an
+	// abonement or digital item and the buyer's email
+	if requestData.Gift != nil {
+		gift := requestData.Gift
+		if !giftable {
+			utils.ErrorJSON(w, errors.New("only abonements and digital items can be bought as a gift"), http.StatusBadRequest)
+			return
+		}
+		if gift.RecipientEmail.Valid {
+			if _, err := mail.ParseAddress(gift.RecipientEmail.String); err != nil {
+				utils.ErrorJSON(w, errors.New("invalid gift recipient email"), http.StatusBadRequest)
+				return
+			}
+		}
+		if len(gift.Message) > 1000 {
+			utils.ErrorJSON(w, errors.New("gift message is too long"), http.StatusBadRequest)
+			return
+		}
+		order.Gift = true
+		order.GiftRecipientEmail = gift.RecipientEmail
+		order.GiftSendAt = gift.SendAt
+		order.GiftMessage = gift.Message
+	}
+
+	// Only the buyer's own abonements can be renewed with their payment method
+	if requestData.AutoRenew {
+		if !hasAbonement || order.Gift {
+			utils.ErrorJSON(w, errors.New("only abonements bought for yourself can be renewed automatically"), http.StatusBadRequest)
+			return
 		}
+		order.AutoRenew = true
+	}
+
+	if requestData.BillingAddress != nil {
+		if !requestData.CustomerEmail.Valid || requestData.CustomerEmail.String == "" {
+			utils.ErrorJSON(w, errors.New("a billing address needs a customer email to send the invoice to"), http.StatusBadRequest)
+			return
+		}
+		billingAddress, err := cleanBillingAddress(*requestData.BillingAddress)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+		order.BillingAddress = &billingAddress
 	}
 
 	// 5. Check: If there is more than one entry, each item id has to be unique
@@ -256,7 +342,7 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 
 	// Get accounts
 	var buyerAccountID int
-	authenticatedUserID := r.Header.Get("X-Auth-User-Name")
+	authenticatedUserID := middlewares.AuthPrincipal(r).Username
 	if authenticatedUserID != "" {
 		buyerAccount, err := database.Db.GetOrCreateAccountByUserID(authenticatedUserID)
 		if err != nil {
@@ -333,6 +419,69 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 		}
 
 	}
+
+	// Apply the discount code as a separate entry with a negative amount, so
+	// the order total charged by VivaWallet is reduced
+	var discountCode database.DiscountCode
+	var discountShares []database.DiscountShare
+	discountAmount := 0
+	discountRecorded := false
+	if requestData.DiscountCode != "" {
+		discountCode, discountAmount, discountShares, err = orderDiscount(requestData.DiscountCode, order.Entries, settings.DefaultVATRate)
+		if err != nil {
+			writeDiscountError(w, requestData.DiscountCode, err)
+			return
+		}
+		if order.GetTotal()-discountAmount <= 0 {
+			utils.ErrorJSON(w, errors.New("order amount after discount has to be greater than 0"), http.StatusBadRequest)
+			return
+		}
+		discountItem, err := database.Db.GetOrCreateDiscountItem()
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusInternalServerError)
+			return
+		}
+		// One entry per VAT rate of the discounted entries, whose VAT the
+		// discount reduces
+		for _, share := range discountShares {
+			order.Entries = append(order.Entries,
+				database.OrderEntry{
+					Item:     discountItem.ID,
+					Quantity: -share.Amount,
+					Price:    discountItem.Price,
+					Sender:   buyerAccountID,
+					Receiver: vendorAccount.ID,
+					IsSale:   true,
+					VATRate:  share.VATRate,
+				},
+				// The organization covers the discount, so the vendor's share
+				// is the same as without it
+				database.OrderEntry{
+					Item:         discountItem.ID,
+					Quantity:     share.Amount,
+					Price:        discountItem.Price,
+					Sender:       orgaAccount.ID,
+					Receiver:     vendorAccount.ID,
+					SenderName:   orgaAccount.Name,
+					ReceiverName: vendorAccount.Name,
+					VATRate:      share.VATRate,
+				},
+			)
+		}
+		// Count the use now, so concurrent orders can not exceed the cap
+		err = database.Db.ReserveDiscountCode(discountCode.ID, time.Now())
+		if err != nil {
+			writeDiscountError(w, requestData.DiscountCode, err)
+			return
+		}
+		// Give the use back if the order is not created
+		defer func() {
+			if !discountRecorded {
+				database.Db.ReleaseDiscountCode(discountCode.ID)
+			}
+		}()
+	}
+
 	// ignore MaxOrderAmount if its 0
 	if settings.MaxOrderAmount != 0 && order.GetTotal() >= settings.MaxOrderAmount {
 		utils.ErrorJSON(w, errors.New("order amount is too high"), http.StatusBadRequest)
@@ -372,6 +521,13 @@ func CreatePaymentOrder(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	order.ID = id
+	if discountAmount > 0 {
+		err = database.Db.RecordDiscountRedemption(discountCode.ID, id, discountAmount)
+		if err != nil {
+			log.Error("CreatePaymentOrder: recording discount redemption failed: ", err)
+		}
+		discountRecorded = true
+	}
 
 	// Check if VivaWalletSmartCheckoutURL is set
 	if config.Config.VivaWalletSmartCheckoutURL == "" {
@@ -655,7 +811,7 @@ func AdminVerifyPaymentOrderByCode(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = database.Db.VerifyOrderAndCreatePayments(order.ID, transactionTypeID)
+	err = auditedDb(r).VerifyOrderAndCreatePayments(order.ID, transactionTypeID)
 	if err != nil {
 		log.Error("AdminVerifyPaymentOrderByCode: VerifyOrderAndCreatePayments: ", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -746,7 +902,7 @@ func AdminAddTransactionIDToOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Save transaction ID
-	err = database.Db.SetOrderTransactionID(order.ID, req.TransactionID)
+	err = auditedDb(r).SetOrderTransactionID(order.ID, req.TransactionID)
 	if err != nil {
 		log.Error("AdminAddTransactionIDToOrder: SetOrderTransactionID: ", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -849,7 +1005,7 @@ func ListPaymentsForPayout(w http.ResponseWriter, r *http.Request) {
 //	@Security		KeycloakAuth
 //	@Router			/customers/me/payments [get]
 func ListMyPayments(w http.ResponseWriter, r *http.Request) {
-	customerEmail := r.Header.Get("X-Auth-User-Email")
+	customerEmail := middlewares.AuthPrincipal(r).Email
 	if customerEmail == "" {
 		utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
 		return
@@ -1168,10 +1324,10 @@ func CreatePaymentPayout(w http.ResponseWriter, r *http.Request) {
 	// }
 
 	// Get authenticated user
-	authenticatedUserID := r.Header.Get("X-Auth-User-Name")
+	authenticatedUserID := middlewares.AuthPrincipal(r).Username
 
 	// Execute payout
-	paymentID, err := database.Db.CreatePaymentPayout(vendor, vendorAccount.ID, authenticatedUserID, amount, paymentsToBePaidOut)
+	paymentID, err := auditedDb(r).CreatePaymentPayout(vendor, vendorAccount.ID, authenticatedUserID, amount, paymentsToBePaidOut)
 	if err != nil {
 		log.Error("CreatePaymentPayout: db", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -1366,35 +1522,12 @@ func downloadPDF(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, errors.New("missing parameter id"), http.StatusBadRequest)
 		return
 	}
-	tx, err := database.Db.EntClient.Tx(context.Background())
-	if err != nil {
-		log.Error("UpdatePdfDownload: failed to start transaction ", err)
-		return
-	}
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
 
-	// Get PDF from database
-	pdfDownload, err := database.Db.GetPDFDownloadTx(tx, id)
+	// Count the download, this enforces the limits of the link
+	pdfDownload, err := database.Db.RegisterPDFDownload(id, clientIP(r), r.UserAgent())
 	if err != nil {
-		log.Error("DownloadPDF: Failed to get PDF download from database ", err)
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
-	}
-	if pdfDownload.Timestamp.IsZero() {
-		log.Error("DownloadPDF: Timestamp is zero")
-		utils.ErrorJSON(w, errors.New("timestamp is zero"), http.StatusBadRequest)
-		return
-	}
-	// check for expiration < 6 weeks
-	if time.Until(pdfDownload.Timestamp).Hours() < -6*7*24 {
-		log.Error("DownloadPDF: PDF is expired")
-		utils.ErrorJSON(w, errors.New("pdf is expired"), http.StatusBadRequest)
+		log.Error("DownloadPDF: Download of ", id, " denied ", err)
+		utils.ErrorJSON(w, err, pdfDownloadErrorStatus(err))
 		return
 	}
 	// Get PDF from database
@@ -1404,16 +1537,27 @@ func downloadPDF(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	pdfDownload.DownloadCount = pdfDownload.DownloadCount + 1
-	pdfDownload.LastDownload = time.Now()
-	err = database.Db.UpdatePdfDownloadTx(tx, pdfDownload)
-	pdfDownload, err = database.Db.GetPDFDownloadTx(tx, id)
-
-	if err != nil {
-		log.Error("DownloadPDF: Failed to update downloadpdf ", err)
+	// send file, personalized for the buyer if possible
+	key := pdf.Path
+	if config.Config.PDFWatermarkEnabled {
+		stamped, stampErr := stampedPDFKey(r.Context(), pdf, pdfDownload)
+		if stampErr != nil {
+			log.Error("DownloadPDF: Failed to stamp PDF, sending original ", stampErr)
+		} else {
+			key = stamped
+		}
+	}
+	if config.Config.StorageRedirectDownloads {
+		url, err := storage.Client.SignedURL(key, time.Duration(config.Config.StorageSignedURLTTLSeconds)*time.Second)
+		if err != nil {
+			log.Error("DownloadPDF: Failed to sign URL ", err)
+			utils.ErrorJSON(w, errors.New("failed to download pdf"), http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, url, http.StatusFound)
+		return
 	}
-	// send file
-	http.ServeFile(w, r, pdf.Path)
+	serveStoredFile(w, r, key)
 }
 
 func validatePDFLink(w http.ResponseWriter, r *http.Request) {
@@ -1425,22 +1569,10 @@ func validatePDFLink(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get PDF from database
-	pdfDownload, err := database.Db.GetPDFDownload(id)
+	_, err := database.Db.ValidatePDFDownload(id)
 	if err != nil {
-		log.Error("DownloadPDF: Failed to get PDF from database ", err)
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
-	}
-	if pdfDownload.Timestamp.IsZero() {
-		log.Error("DownloadPDF: Timestamp is zero")
-		utils.ErrorJSON(w, errors.New("timestamp is zero"), http.StatusBadRequest)
-		return
-	}
-	// check for expiration < 6 weeks
-	if time.Until(pdfDownload.Timestamp).Hours() < -6*7*24 {
-		log.Error("DownloadPDF: PDF is expired")
-		utils.ErrorJSON(w, errors.New("pdf is expired"), http.StatusBadRequest)
+		log.Error("validatePDFLink: ", id, " ", err)
+		utils.ErrorJSON(w, err, pdfDownloadErrorStatus(err))
 		return
 	}
 	err = utils.WriteJSON(w, http.StatusOK, "valid")
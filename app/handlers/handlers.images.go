@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/augustin-wien/augustina-backend/imaging"
+	"github.com/augustin-wien/augustina-backend/storage"
+)
+
+var errInvalidFilename = errors.New("invalid filename")
+
+// readImageUpload reads and processes the image in a form field. It returns
+// nil if the field holds no file.
+func readImageUpload(r *http.Request, field, format string) (*imaging.Result, error) {
+	file, _, err := r.FormFile(field)
+	if err != nil {
+		return nil, nil // No file passed, which is ok
+	}
+	defer file.Close()
+	buf := bytes.NewBuffer(nil)
+	if _, err = io.Copy(buf, file); err != nil {
+		return nil, err
+	}
+	return imaging.Process(buf.Bytes(), format)
+}
+
+// imageUploadErrorStatus maps errors of image uploads to HTTP status codes
+func imageUploadErrorStatus(err error) int {
+	if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrTooLarge) || errors.Is(err, errInvalidFilename) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// storeImage stores a processed image under base with the extension of its
+// format. The variants are stored next to it, e.g. img/cover_0_thumbnail.jpg
+// and img/cover_0_thumbnail.webp, and returned by name, with the WebP
+// versions as "<name>_webp".
+func storeImage(ctx context.Context, base string, image *imaging.Result) (key string, variants map[string]string, err error) {
+	variants = map[string]string{}
+	for _, file := range image.Variants {
+		variantKey := base + "_" + file.Name + file.Ext()
+		if err = storage.Client.Put(ctx, variantKey, file.Data, file.ContentType()); err != nil {
+			return "", nil, err
+		}
+		name := file.Name
+		if file.Format == imaging.FormatWebP {
+			name += "_webp"
+		}
+		variants[name] = variantKey
+	}
+	key = base + image.Original.Ext()
+	if err = storage.Client.Put(ctx, key, image.Original.Data, image.Original.ContentType()); err != nil {
+		return "", nil, err
+	}
+	return key, variants, nil
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// cleanBillingAddress trims a billing address entered at checkout and
+// checks that it is complete
+func cleanBillingAddress(address schema.BillingAddress) (schema.BillingAddress, error) {
+	for _, field := range []*string{&address.Name, &address.Company, &address.Street, &address.Zip, &address.City, &address.Country, &address.VATID} {
+		*field = strings.TrimSpace(*field)
+		if len(*field) > 200 {
+			return address, errors.New("billing address is too long")
+		}
+	}
+	if address.Name == "" && address.Company == "" {
+		return address, errors.New("billing address needs a name or company")
+	}
+	if address.Street == "" || address.Zip == "" || address.City == "" || address.Country == "" {
+		return address, errors.New("billing address needs street, zip, city and country")
+	}
+	return address, nil
+}
+
+// invoiceErrorStatus maps invoice errors to HTTP status codes
+func invoiceErrorStatus(err error) int {
+	switch {
+	case ent.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrInvoicesDisabled), errors.Is(err, database.ErrInvoiceOrderNotValid):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeInvoicePDF renders an invoice and writes it as download
+func writeInvoicePDF(w http.ResponseWriter, inv database.Invoice) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+inv.Filename()+`"`)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(database.RenderInvoicePDF(inv)); err != nil {
+		log.Error("writeInvoicePDF: ", err)
+	}
+}
+
+// invoiceFromURL returns the invoice of the id URL parameter and writes an
+// error response if there is none
+func invoiceFromURL(w http.ResponseWriter, r *http.Request) (database.Invoice, bool) {
+	id, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return database.Invoice{}, false
+	}
+	inv, err := database.Db.GetInvoice(id)
+	if err != nil {
+		utils.ErrorJSON(w, err, invoiceErrorStatus(err))
+		return database.Invoice{}, false
+	}
+	return inv, true
+}
+
+// ListInvoices godoc
+//
+//	@Summary		List invoices
+//	@Tags			Invoices
+//	@Produce		json
+//	@Param			from	query		string	false	"Issued at or after (RFC3339)"
+//	@Param			to		query		string	false	"Issued before (RFC3339)"
+//	@Success		200		{array}		database.Invoice
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/invoices/ [get]
+func ListInvoices(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	invoices, err := database.Db.ListInvoices(from, to)
+	respond(w, err, invoices)
+}
+
+// GetInvoice godoc
+//
+//	@Summary		Get an invoice
+//	@Tags			Invoices
+//	@Produce		json
+//	@Param			id	path		int	true	"Invoice ID"
+//	@Success		200	{object}	database.Invoice
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/invoices/{id}/ [get]
+func GetInvoice(w http.ResponseWriter, r *http.Request) {
+	inv, ok := invoiceFromURL(w, r)
+	if !ok {
+		return
+	}
+	respond(w, nil, inv)
+}
+
+// GetInvoicePDF godoc
+//
+//	@Summary		Download an invoice as PDF
+//	@Tags			Invoices
+//	@Produce		application/pdf
+//	@Param			id	path	int	true	"Invoice ID"
+//	@Success		200
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/invoices/{id}/pdf/ [get]
+func GetInvoicePDF(w http.ResponseWriter, r *http.Request) {
+	inv, ok := invoiceFromURL(w, r)
+	if !ok {
+		return
+	}
+	writeInvoicePDF(w, inv)
+}
+
+// IssueOrderInvoice godoc
+//
+//	@Summary		Issue the invoice of an order
+//	@Description	Invoices are issued when an order is verified. This issues them for orders verified before invoices were enabled. If the order has an invoice, it is returned.
+//	@Tags			Invoices
+//	@Produce		json
+//	@Param			orderID	path		int	true	"Order ID"
+//	@Success		200		{object}	database.Invoice
+//	@Failure		404		{object}	utils.ErrorResponse
+//	@Failure		409		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/invoices/orders/{orderID}/ [post]
+func IssueOrderInvoice(w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.Atoi(chi.URLParam(r, "orderID"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	inv, err := database.Db.IssueInvoice(orderID, time.Now())
+	if err != nil {
+		utils.ErrorJSON(w, err, invoiceErrorStatus(err))
+		return
+	}
+	respond(w, nil, inv)
+}
+
+// SendInvoice godoc
+//
+//	@Summary		Send an invoice to the customer again
+//	@Tags			Invoices
+//	@Param			id	path	int	true	"Invoice ID"
+//	@Success		204
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/invoices/{id}/send/ [post]
+func SendInvoice(w http.ResponseWriter, r *http.Request) {
+	inv, ok := invoiceFromURL(w, r)
+	if !ok {
+		return
+	}
+	if err := database.Db.SendInvoiceMail(inv); err != nil {
+		log.Error("SendInvoice: ", err)
+		utils.ErrorJSON(w, errors.New("failed to send invoice"), http.StatusBadGateway)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListMyInvoices godoc
+//
+//	@Summary		List the invoices of the authenticated customer
+//	@Tags			Customers
+//	@Produce		json
+//	@Success		200	{array}	database.Invoice
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/invoices/ [get]
+func ListMyInvoices(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	invoices, err := database.Db.ListCustomerInvoices(*customer)
+	respond(w, err, invoices)
+}
+
+// GetMyInvoicePDF godoc
+//
+//	@Summary		Download an invoice of the authenticated customer
+//	@Tags			Customers
+//	@Produce		application/pdf
+//	@Param			id	path	int	true	"Invoice ID"
+//	@Success		200
+//	@Failure		404	{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/customers/me/invoices/{id}/pdf/ [get]
+func GetMyInvoicePDF(w http.ResponseWriter, r *http.Request) {
+	customer, ok := authCustomer(w, r)
+	if !ok {
+		return
+	}
+	inv, ok := invoiceFromURL(w, r)
+	if !ok {
+		return
+	}
+	// Invoices of other customers are not found
+	if _, err := database.Db.GetCustomerOrder(*customer, inv.OrderID); err != nil {
+		utils.ErrorJSON(w, errors.New("invoice not found"), http.StatusNotFound)
+		return
+	}
+	writeInvoicePDF(w, inv)
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCleanBillingAddress(t *testing.T) {
+	address, err := cleanBillingAddress(schema.BillingAddress{Company: " Verein ", Street: "Gasse 1", Zip: "1050", City: "Wien", Country: "AT"})
+	require.NoError(t, err)
+	require.Equal(t, "Verein", address.Company)
+
+	_, err = cleanBillingAddress(schema.BillingAddress{Name: " ", Street: "Gasse 1", Zip: "1050", City: "Wien", Country: "AT"})
+	require.Error(t, err)
+	_, err = cleanBillingAddress(schema.BillingAddress{Name: "Anna", Street: "Gasse 1", City: "Wien", Country: "AT"})
+	require.Error(t, err)
+}
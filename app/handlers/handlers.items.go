@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -15,6 +14,7 @@ import (
 	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/mailer"
+	"github.com/augustin-wien/augustina-backend/storage"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/go-chi/chi/v5"
 	"github.com/mitchellh/mapstructure"
@@ -135,9 +135,14 @@ func CreateItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Handle image field
-	path, _ := updateItemImage(w, r)
+	path, variants, err := updateItemImage(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, imageUploadErrorStatus(err))
+		return
+	}
 	if path != "" {
 		item.Image = path
+		item.ImageVariants = variants
 	}
 
 	// Handle pdf field
@@ -178,7 +183,7 @@ func CreateItem(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Save item to database
-	id, err := database.Db.CreateItem(item)
+	id, err := auditedDb(r).CreateItem(item)
 	if err != nil {
 		log.Error("CreateItem: Database call create item failed", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -207,54 +212,52 @@ func sanitizeUploadFilename(filename string) (base, ext string, err error) {
 	return base, ext, nil
 }
 
-func updateItemImage(w http.ResponseWriter, r *http.Request) (path string, err error) {
-	// Get file from image field
-	file, header, err := r.FormFile("Image")
+// updateItemImage processes an uploaded item image and stores it with its
+// variants under a unique name
+func updateItemImage(r *http.Request) (path string, variants map[string]string, err error) {
+	_, header, err := r.FormFile("Image")
 	if err != nil {
-		return // No file passed, which is ok
+		return "", nil, nil // No file passed, which is ok
 	}
-	defer file.Close()
 
 	// Sanitize the client-supplied filename to prevent path traversal
-	base, ext, err := sanitizeUploadFilename(header.Filename)
+	base, _, err := sanitizeUploadFilename(header.Filename)
 	if err != nil {
 		log.Error("updateItemImage: image name is wrong")
-		utils.ErrorJSON(w, errors.New("invalid filename"), http.StatusBadRequest)
-		return
+		return "", nil, errInvalidFilename
 	}
 
-	buf := bytes.NewBuffer(nil)
-	if _, err = io.Copy(buf, file); err != nil {
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
-	}
-	dir, err := os.Getwd()
+	image, err := readImageUpload(r, "Image", "")
 	if err != nil {
 		log.Error("updateItemImage: ", err)
+		return "", nil, err
 	}
+
 	// Generate unique filename
 	i := 0
 	for {
-		path = "img/" + base + "_" + strconv.Itoa(i) + "." + ext
-		_, err = os.Stat(dir + "/" + path)
-		if errors.Is(err, os.ErrNotExist) {
+		path = "img/" + base + "_" + strconv.Itoa(i)
+		_, err = storage.Client.Stat(r.Context(), path+image.Original.Ext())
+		if errors.Is(err, storage.ErrNotFound) {
 			break
 		}
+		if err != nil {
+			log.Error("updateItemImage: ", err)
+			return "", nil, err
+		}
 		i++
 		if i > 1000 {
 			log.Error("updateItemImage: too many files with same name", err)
-			utils.ErrorJSON(w, errors.New("too many files with same name"), http.StatusBadRequest)
-			return
+			return "", nil, errors.New("too many files with same name")
 		}
 	}
-	// current file path from os
 
-	// Save file with unique name (owner read/write, group/other read)
-	err = os.WriteFile(dir+"/"+path, buf.Bytes(), 0644)
+	// Save file with unique name
+	path, variants, err = storeImage(r.Context(), path, image)
 	if err != nil {
 		log.Error("updateItemImage: failed to write file", err)
 	}
-	return
+	return path, variants, err
 }
 
 func handleItemPDF(w http.ResponseWriter, r *http.Request) (pdfId int64, err error) {
@@ -293,25 +296,10 @@ func handleItemPDF(w http.ResponseWriter, r *http.Request) (pdfId int64, err err
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	// create base dir if not exist
-
-	dir, err := os.Getwd()
-	if err != nil {
-		log.Error("UploadPDF: Failed to get current directory ", err)
-		return
-	}
 	// Add a human readable timestamp to the filename to make it unique
 	timeStamp := time.Now().Format("2006-01-02_15-04-05.000")
 	path := "pdf/" + timeStamp + "_" + base + "." + ext
-	_, err = os.Stat(dir + "/pdf")
-	if errors.Is(err, os.ErrNotExist) {
-		err = os.Mkdir(dir+"/pdf", 0755)
-		if err != nil {
-			log.Error("handleItemPDF: failed to create directory", err)
-			return
-		}
-	}
-	err = os.WriteFile(dir+"/"+path, buf.Bytes(), 0644)
+	err = storage.Client.Put(r.Context(), path, buf.Bytes(), "application/pdf")
 	if err != nil {
 		log.Error("handleItemPDF: failed to write file", err)
 		return
@@ -390,6 +378,42 @@ func updateItemNormal(fields map[string][]string) (item database.Item, err error
 			fieldsClean[key] = null.IntFrom(int64(pdf))
 		} else if key == "LicenseGroup" {
 			fieldsClean[key] = null.StringFrom(value[0])
+		} else if key == "PublishAt" || key == "UnpublishAt" {
+			// An empty value removes the schedule
+			var t null.Time
+			if value[0] != "" {
+				parsed, err := time.Parse(time.RFC3339, value[0])
+				if err != nil {
+					log.Error("updateItemNormal: Parse "+key+" failed ", err)
+					return item, err
+				}
+				t = null.TimeFrom(parsed)
+			}
+			fieldsClean[key] = t
+		} else if key == "PDFDownloadLimit" || key == "PDFDownloadExpiryDays" {
+			// An empty value falls back to the settings
+			var n null.Int
+			if value[0] != "" {
+				parsed, err := strconv.Atoi(value[0])
+				if err != nil || parsed < 0 {
+					log.Error("updateItemNormal: Parse "+key+" failed ", err)
+					return item, errors.New(key + " must be a non-negative integer")
+				}
+				n = null.IntFrom(int64(parsed))
+			}
+			fieldsClean[key] = n
+		} else if key == "VATRate" {
+			// An empty value falls back to the default rate of the settings
+			var rate null.Float
+			if value[0] != "" {
+				parsed, err := strconv.ParseFloat(value[0], 64)
+				if err != nil || parsed < 0 || parsed > 100 {
+					log.Error("updateItemNormal: Parse VATRate failed ", err)
+					return item, errors.New("VATRate must be a percentage")
+				}
+				rate = null.FloatFrom(parsed)
+			}
+			fieldsClean[key] = rate
 		} else if key == "ItemOrder" {
 			fieldsClean[key], err = strconv.Atoi(value[0])
 			if err != nil {
@@ -404,6 +428,9 @@ func updateItemNormal(fields map[string][]string) (item database.Item, err error
 		log.Error("updateItemNormal: Decoding fields failed", err)
 		return
 	}
+	if item.PublishAt.Valid && item.UnpublishAt.Valid && !item.UnpublishAt.Time.After(item.PublishAt.Time) {
+		err = errors.New("UnpublishAt must be after PublishAt")
+	}
 	return
 }
 
@@ -508,11 +535,33 @@ func UpdateItem(w http.ResponseWriter, r *http.Request) {
 	if _, ok := mForm.Value["Type"]; !ok {
 		item.Type = existingItem.Type
 	}
+	if _, ok := mForm.Value["PublishAt"]; !ok {
+		item.PublishAt = existingItem.PublishAt
+	}
+	if _, ok := mForm.Value["UnpublishAt"]; !ok {
+		item.UnpublishAt = existingItem.UnpublishAt
+	}
+	if _, ok := mForm.Value["PDFDownloadLimit"]; !ok {
+		item.PDFDownloadLimit = existingItem.PDFDownloadLimit
+	}
+	if _, ok := mForm.Value["PDFDownloadExpiryDays"]; !ok {
+		item.PDFDownloadExpiryDays = existingItem.PDFDownloadExpiryDays
+	}
+	if _, ok := mForm.Value["VATRate"]; !ok {
+		item.VATRate = existingItem.VATRate
+	}
 
-	path, _ := updateItemImage(w, r)
+	if item.Image == existingItem.Image {
+		item.ImageVariants = existingItem.ImageVariants
+	}
+	path, variants, err := updateItemImage(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, imageUploadErrorStatus(err))
+		return
+	}
 	if path != "" {
-		log.Info("UpdateItem: Path is empty", path)
 		item.Image = path
+		item.ImageVariants = variants
 	}
 
 	pdfId, err := handleItemPDF(w, r)
@@ -540,31 +589,22 @@ func UpdateItem(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	}
-	err = database.Db.UpdateItem(ItemID, item)
+	err = auditedDb(r).UpdateItem(ItemID, item)
 	if err != nil {
 		log.Error("UpdateItem: db update", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
 
-	if existingItem.Type == "online_issue" && existingItem.Disabled && !item.Disabled {
+	// Items with a publish time are published by the scheduler
+	now := time.Now()
+	if existingItem.Type == "online_issue" && !existingItem.IsVisible(now) && item.IsVisible(now) && !item.PublishAt.Valid {
 		updatedItem, getErr := database.Db.GetItemIncludingDisabled(ItemID)
 		if getErr != nil {
 			log.Error("UpdateItem: failed to load updated online_issue for notifications", getErr)
 		} else {
-			if notifyErr := notifyActiveAbonementsOnlineIssue(r, updatedItem); notifyErr != nil {
-				log.Error("UpdateItem: failed sending online_issue notifications", notifyErr)
-			}
+			onlineIssuePublished(r, updatedItem)
 		}
-
-		// Assign license groups to all customers with active abonements so they
-		// immediately get access to the newly published online issue in Keycloak.
-		go func() {
-			svc := database.NewAbonementService(&database.Db)
-			if processErr := svc.ProcessAbonementLicenseGroupsForDate(time.Now()); processErr != nil {
-				log.Error("UpdateItem: failed to process abonement license groups for online_issue publication: ", processErr)
-			}
-		}()
 	}
 
 	err = utils.WriteJSON(w, http.StatusOK, err)
@@ -573,6 +613,22 @@ func UpdateItem(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// onlineIssuePublished notifies customers with active abonements about a
+// newly published online issue and entitles them to it, which also assigns
+// their license groups in Keycloak. r may be nil.
+func onlineIssuePublished(r *http.Request, issue database.Item) {
+	if notifyErr := notifyActiveAbonementsOnlineIssue(r, issue); notifyErr != nil {
+		log.Error("onlineIssuePublished: failed sending online_issue notifications", notifyErr)
+	}
+
+	go func() {
+		svc := database.NewAbonementService(&database.Db)
+		if processErr := svc.GrantIssueToActiveAbonements(issue, time.Now()); processErr != nil {
+			log.Error("onlineIssuePublished: failed to grant online_issue to active abonements: ", processErr)
+		}
+	}()
+}
+
 func notifyActiveAbonementsOnlineIssue(r *http.Request, issue database.Item) error {
 	abonements, err := database.Db.GetActiveAbonementsByDate(time.Now())
 	if err != nil {
@@ -585,7 +641,7 @@ func notifyActiveAbonementsOnlineIssue(r *http.Request, issue database.Item) err
 	recipientSet := make(map[string]struct{})
 	for _, a := range abonements {
 		customer, customerErr := database.Db.GetCustomerByID(a.CustomerID)
-		if customerErr != nil {
+		if customerErr != nil || !customer.Preferences.NotifyNewIssues {
 			continue
 		}
 		email := strings.TrimSpace(customer.Email)
@@ -599,7 +655,11 @@ func notifyActiveAbonementsOnlineIssue(r *http.Request, issue database.Item) err
 		return nil
 	}
 
-	issueImageURL := resolveIssueImageURL(r, issue.Image)
+	issueImage := issue.Image
+	if variant := issue.ImageVariants["email"]; variant != "" {
+		issueImage = variant
+	}
+	issueImageURL := resolveIssueImageURL(r, issueImage)
 	for recipient := range recipientSet {
 		templateData := map[string]interface{}{
 			"IssueName": issue.Name,
@@ -664,7 +724,7 @@ func DeleteItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = database.Db.DeleteItem(ItemID)
+	err = auditedDb(r).DeleteItem(ItemID)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
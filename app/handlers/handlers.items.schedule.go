@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+)
+
+// PublishScheduledItems publishes and unpublishes items whose PublishAt or
+// UnpublishAt time has passed. Published online issues trigger the same
+// abonement processing and notifications as publishing them in UpdateItem.
+// It is run periodically by the scheduler.
+func PublishScheduledItems(ctx context.Context) error {
+	now := time.Now()
+	if _, err := database.Db.UnpublishDueItems(now); err != nil {
+		return err
+	}
+	published, err := database.Db.PublishDueItems(now)
+	for _, item := range published {
+		if ctx.Err() != nil {
+			break
+		}
+		if item.Type == "online_issue" {
+			onlineIssuePublished(nil, item)
+		}
+	}
+	return err
+}
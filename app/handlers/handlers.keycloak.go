@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+// reconcileMu prevents reconciliations from running at the same time, e.g.
+// the scheduled one and one started in the backoffice
+var reconcileMu sync.Mutex
+
+// errReconcileRunning is returned if a reconciliation is already running
+var errReconcileRunning = errors.New("a reconciliation is already running")
+
+// reconcileKeycloak runs a reconciliation unless one is already running
+func reconcileKeycloak(ctx context.Context, direction database.ReconcileDirection, dryRun bool, maxRemovals int) (database.KeycloakReconciliation, error) {
+	if !reconcileMu.TryLock() {
+		return database.KeycloakReconciliation{}, errReconcileRunning
+	}
+	defer reconcileMu.Unlock()
+	reconciler := database.NewKeycloakReconciler(&database.Db)
+	reconciler.MaxRemovals = maxRemovals
+	return reconciler.Reconcile(ctx, direction, dryRun)
+}
+
+// ReconcileKeycloak godoc
+//
+//	@Summary		Reconcile customers and vendors with Keycloak
+//	@Description	Compares every customer and vendor with their Keycloak user and groups and reports missing and unlinked users, missing vendor groups, license groups only in the database or only in Keycloak, and orphaned members of the customer, vendor and license groups. With direction keycloak the mismatches are repaired in Keycloak, with direction database in the database. dry_run reports the repairs without applying them.
+//	@Tags			Keycloak
+//	@Produce		json
+//	@Param			direction	query		string	false	"Side to repair: keycloak or database, empty only reports"
+//	@Param			dry_run		query		bool	false	"Report the repairs without applying them"
+//	@Success		200			{object}	database.KeycloakReconciliation
+//	@Failure		400			{object}	utils.ErrorResponse
+//	@Failure		409			{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/keycloak/reconcile/ [post]
+func ReconcileKeycloak(w http.ResponseWriter, r *http.Request) {
+	direction, err := database.ParseReconcileDirection(r.URL.Query().Get("direction"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+
+	log.Infof("ReconcileKeycloak: %s reconciles Keycloak, direction %q, dry run %t", middlewares.AuthPrincipal(r).Username, direction, dryRun)
+	report, err := reconcileKeycloak(r.Context(), direction, dryRun, 0)
+	if errors.Is(err, errReconcileRunning) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	respond(w, err, report)
+}
+
+// ReconcileKeycloakJob reconciles customers and vendors with Keycloak in the
+// configured direction. It is run periodically by the scheduler and removes
+// at most KeycloakReconcileMaxRemovals group memberships per run, so a wrong
+// database can not lock out every customer at once.
+func ReconcileKeycloakJob(ctx context.Context) error {
+	direction, err := database.ParseReconcileDirection(config.Config.KeycloakReconcileDirection)
+	if err != nil {
+		return err
+	}
+	report, err := reconcileKeycloak(ctx, direction, false, config.Config.KeycloakReconcileMaxRemovals)
+	if err != nil {
+		return err
+	}
+	if len(report.Mismatches) > 0 {
+		log.Warnf("ReconcileKeycloakJob: %d mismatches between the database and Keycloak, %d repaired, %d failed, %d removals skipped",
+			len(report.Mismatches), report.Repaired, report.Failed, report.Skipped)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// clientIP returns the address of the client without port, so that
+// requests of the same client are counted as one IP. Forwarding headers are
+// only trusted from the configured proxies, otherwise clients could avoid or
+// provoke the suspension of a link.
+func clientIP(r *http.Request) string {
+	ip := strings.TrimSpace(utils.ReadClientIP(r))
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		return host
+	}
+	return ip
+}
+
+// pdfDownloadErrorStatus maps download link errors to HTTP status codes
+func pdfDownloadErrorStatus(err error) int {
+	switch {
+	case ent.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, database.ErrPDFDownloadExpired):
+		return http.StatusGone
+	case errors.Is(err, database.ErrPDFDownloadLimitReached), errors.Is(err, database.ErrPDFDownloadSuspended):
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// ListPDFDownloads godoc
+//
+//	@Summary		List PDF download links
+//	@Tags			PDF
+//	@Produce		json
+//	@Param			order		query		int		false	"Only links of this order"
+//	@Param			item		query		int		false	"Only links of this item"
+//	@Param			suspended	query		bool	false	"Only suspended links"
+//	@Success		200			{array}		database.PDFDownload
+//	@Security		KeycloakAuth
+//	@Router			/pdf-downloads/ [get]
+func ListPDFDownloads(w http.ResponseWriter, r *http.Request) {
+	var ids [2]int
+	for i, key := range []string{"order", "item"} {
+		if v := r.URL.Query().Get(key); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				utils.ErrorJSON(w, errors.New("invalid "+key), http.StatusBadRequest)
+				return
+			}
+			ids[i] = id
+		}
+	}
+	downloads, err := database.Db.ListPDFDownloads(ids[0], ids[1], r.URL.Query().Get("suspended") == "true")
+	respond(w, err, downloads)
+}
+
+// GetPDFDownloadDetails godoc
+//
+//	@Summary		Inspect a PDF download link
+//	@Description	Returns the link with the limits that apply to it and the log of all requests
+//	@Tags			PDF
+//	@Produce		json
+//	@Param			linkID	path		string	true	"Link ID"
+//	@Success		200		{object}	database.PDFDownloadDetails
+//	@Security		KeycloakAuth
+//	@Router			/pdf-downloads/{linkID}/ [get]
+func GetPDFDownloadDetails(w http.ResponseWriter, r *http.Request) {
+	details, err := database.Db.GetPDFDownloadDetails(chi.URLParam(r, "linkID"))
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("download link not found"), http.StatusNotFound)
+		return
+	}
+	respond(w, err, details)
+}
+
+type reissuePDFDownloadRequest struct {
+	SendEmail bool `json:"send_email"` // Send the new link to the customer of the order
+}
+
+// ReissuePDFDownload godoc
+//
+//	@Summary		Reissue a PDF download link
+//	@Description	Suspends the link and creates a new one for the same order and item
+//	@Tags			PDF
+//	@Accept			json
+//	@Produce		json
+//	@Param			linkID	path		string						true	"Link ID"
+//	@Param			data	body		reissuePDFDownloadRequest	false	"Options"
+//	@Success		200		{object}	database.PDFDownload
+//	@Security		KeycloakAuth
+//	@Router			/pdf-downloads/{linkID}/reissue/ [post]
+func ReissuePDFDownload(w http.ResponseWriter, r *http.Request) {
+	var req reissuePDFDownloadRequest
+	if r.ContentLength > 0 {
+		if err := utils.ReadJSON(w, r, &req); err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	download, err := database.Db.ReissuePDFDownload(chi.URLParam(r, "linkID"))
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("download link not found"), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	log.Info("ReissuePDFDownload: ", middlewares.AuthPrincipal(r).Username, " reissued ", chi.URLParam(r, "linkID"), " as ", download.LinkID)
+	if req.SendEmail && download.OrderID.Valid {
+		order, err := database.Db.GetOrderByID(int(download.OrderID.Int64))
+		if err == nil && order.CustomerEmail.Valid && order.CustomerEmail.String != "" {
+			if err = database.Db.SendPDFDownloadMail(download, order.CustomerEmail.String); err == nil {
+				download.EmailSent = true
+			}
+		}
+		if err != nil {
+			log.Error("ReissuePDFDownload: failed to send link ", err)
+		}
+	}
+	respond(w, nil, download)
+}
+
+type resendPDFLinksRequest struct {
+	OrderCode string `json:"order_code"`
+	Email     string `json:"email"`
+}
+
+// ResendPDFLinks godoc
+//
+//	@Summary		Resend PDF download links
+//	@Description	Sends the valid download links of an order again to the customer email of the order. The response does not reveal whether the order exists.
+//	@Tags			PDF
+//	@Accept			json
+//	@Produce		json
+//	@Param			data	body	resendPDFLinksRequest	true	"Order code and email"
+//	@Success		200
+//	@Router			/pdf/resend/ [post]
+func ResendPDFLinks(w http.ResponseWriter, r *http.Request) {
+	var req resendPDFLinksRequest
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if req.OrderCode == "" || req.Email == "" {
+		utils.ErrorJSON(w, errors.New("order_code and email are required"), http.StatusBadRequest)
+		return
+	}
+	sent, err := database.Db.ResendPDFDownloadLinks(req.OrderCode, req.Email)
+	if err != nil {
+		log.Error("ResendPDFLinks: ", err)
+		utils.ErrorJSON(w, errors.New("failed to send links"), http.StatusInternalServerError)
+		return
+	}
+	log.Info("ResendPDFLinks: sent ", sent, " links for order ", req.OrderCode)
+	respond(w, nil, "If the order exists, the download links have been sent to its email address")
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+// PurgeExpiredPDFs removes PDFs past the retention interval. It is run
+// periodically by the scheduler.
+func PurgeExpiredPDFs(ctx context.Context) error {
+	_, err := database.Db.PurgePDFs(false)
+	if errors.Is(err, database.ErrPDFRetentionDisabled) {
+		return nil
+	}
+	return err
+}
+
+// PreviewPDFRetention godoc
+//
+//	@Summary		Preview the PDF retention
+//	@Description	Lists the PDFs past the retention interval that would be purged and those that are kept because they are still in use
+//	@Tags			PDF
+//	@Produce		json
+//	@Success		200	{object}	database.PDFRetentionReport
+//	@Security		KeycloakAuth
+//	@Router			/pdf-retention/ [get]
+func PreviewPDFRetention(w http.ResponseWriter, r *http.Request) {
+	report, err := database.Db.PurgePDFs(true)
+	if errors.Is(err, database.ErrPDFRetentionDisabled) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	respond(w, err, report)
+}
+
+// PurgePDFs godoc
+//
+//	@Summary		Purge expired PDFs
+//	@Description	Deletes PDFs past the retention interval with their download links and files. PDFs of active items or with unexpired links are kept.
+//	@Tags			PDF
+//	@Produce		json
+//	@Param			dry_run	query		bool	false	"Only report what would be purged"
+//	@Success		200		{object}	database.PDFRetentionReport
+//	@Security		KeycloakAuth
+//	@Router			/pdf-retention/purge/ [post]
+func PurgePDFs(w http.ResponseWriter, r *http.Request) {
+	report, err := database.Db.PurgePDFs(r.URL.Query().Get("dry_run") == "true")
+	if errors.Is(err, database.ErrPDFRetentionDisabled) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	if err == nil {
+		log.Info("PurgePDFs: triggered by ", middlewares.AuthPrincipal(r).Username)
+	}
+	respond(w, err, report)
+}
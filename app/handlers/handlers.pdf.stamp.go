@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/pdfstamp"
+	"github.com/augustin-wien/augustina-backend/storage"
+)
+
+// pdfStampFor builds the personalization of a download link
+func pdfStampFor(download database.PDFDownload) pdfstamp.Stamp {
+	metadata := map[string]string{"LinkID": download.LinkID}
+	parts := []string{}
+	if download.OrderID.Valid {
+		order, err := database.Db.GetOrderByID(int(download.OrderID.Int64))
+		if err != nil {
+			log.Error("pdfStampFor: failed to get order ", err)
+		} else {
+			if order.CustomerEmail.Valid && order.CustomerEmail.String != "" {
+				metadata["BuyerEmail"] = order.CustomerEmail.String
+				parts = append(parts, "Persönliche Kopie für "+order.CustomerEmail.String)
+			}
+			orderID := strconv.Itoa(order.ID)
+			if order.OrderCode.Valid && order.OrderCode.String != "" {
+				orderID = order.OrderCode.String
+			}
+			metadata["OrderID"] = orderID
+			parts = append(parts, "Bestellung "+orderID)
+		}
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "Persönliche Kopie")
+	}
+	parts = append(parts, "Link "+download.LinkID)
+	return pdfstamp.Stamp{Footer: strings.Join(parts, " · "), Metadata: metadata}
+}
+
+// stampedPDFKey returns the storage key of the personalized copy of a PDF
+// for a download link. Copies are cached per link and recreated when the
+// original file changes.
+func stampedPDFKey(ctx context.Context, pdf database.PDF, download database.PDFDownload) (string, error) {
+	source, err := storage.Client.Stat(ctx, pdf.Path)
+	if err != nil {
+		return "", err
+	}
+	key := database.PDFStampedCopyKey(download.LinkID, pdf.ID)
+	if cached, err := storage.Client.Stat(ctx, key); err == nil && !cached.ModTime.Before(source.ModTime) {
+		return key, nil
+	}
+	reader, _, err := storage.Client.Get(ctx, pdf.Path)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	original, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	stamped, err := pdfstamp.Apply(original, pdfStampFor(download))
+	if err != nil {
+		return "", err
+	}
+	if err := storage.Client.Put(ctx, key, stamped, "application/pdf"); err != nil {
+		return "", err
+	}
+	return key, nil
+}
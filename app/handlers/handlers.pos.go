@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/go-chi/chi/v5"
 	"gopkg.in/guregu/null.v4"
@@ -119,7 +120,7 @@ func CreatePOSOrder(w http.ResponseWriter, r *http.Request) {
 	}
 	cashPortion := total - balancePortion
 
-	authorizedBy := r.Header.Get("X-Auth-User-Name")
+	authorizedBy := middlewares.AuthPrincipal(r).Username
 
 	// Build payment list
 	var payments []database.Payment
@@ -168,8 +169,20 @@ func CreatePOSOrder(w http.ResponseWriter, r *http.Request) {
 	// createPaymentTx skips account-balance updates for these, so they don't
 	// affect the vendor's credit balance — the actual money flow is captured
 	// by the balance-chain and cash payments above.
+	// Copies of stock tracked items leave the office stock.
+	var movements []database.StockMovement
 	for _, e := range req.Entries {
 		item, _ := database.Db.GetItem(e.Item)
+		if item.TrackStock {
+			movements = append(movements, database.StockMovement{
+				ItemID:    item.ID,
+				VendorID:  vendor.ID,
+				Type:      database.StockMovementSale,
+				Quantity:  e.Quantity,
+				Note:      "POS",
+				CreatedBy: authorizedBy,
+			})
+		}
 		payments = append(payments, database.Payment{
 			Sender:       vendorAccount.ID,
 			Receiver:     backofficeAccount.ID,
@@ -183,7 +196,7 @@ func CreatePOSOrder(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 
-	if err := database.Db.CreatePayments(payments); err != nil {
+	if err := database.Db.CreatePaymentsWithStockMovements(payments, movements); err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
 	}
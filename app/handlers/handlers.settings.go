@@ -1,18 +1,17 @@
 package handlers
 
 import (
-	"bytes"
 	"errors"
 	"io"
 	"net/http"
-	"os"
 	"strconv"
-	"strings"
 	"sync"
 
 	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/imaging"
+	"github.com/augustin-wien/augustina-backend/storage"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/mitchellh/mapstructure"
 )
@@ -64,61 +63,38 @@ const (
 	ImagetypeQrCode  Imagetype = "QRCodeLogoImgUrl"
 )
 
-func updateSettingsImg(w http.ResponseWriter, r *http.Request, fileType Imagetype) (path string, err error) {
-
-	// Get file from image field
-	file, header, err := r.FormFile(string(fileType))
-	if err != nil {
-		// Do not return error, as not passing a file is ok
-		// Could be improved by differentiating between not passed and invalid file
-		err = nil
-		return
-	}
-	defer file.Close()
-
-	// Debugging
-	name := strings.Split(header.Filename, ".")
-	if len(name) < 2 {
-		log.Error("updateSettingsLogo: file name too short", err)
-		utils.ErrorJSON(w, errors.New("invalid filename"), http.StatusBadRequest)
-		return
-	}
-	if name[len(name)-1] != "png" {
-		log.Error("updateSettingsLogo: wrong file ending: ", name[1])
-		utils.ErrorJSON(w, errors.New("file type must be png"), http.StatusBadRequest)
-		return
-	}
-
-	buf := bytes.NewBuffer(nil)
-	if _, err = io.Copy(buf, file); err != nil {
-		log.Error("updateSettingsLogo: copying file failed", err)
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
+func updateSettingsImg(r *http.Request, fileType Imagetype) (path string, variants map[string]string, err error) {
+	// Settings images are always converted to png, so their paths stay the same
+	image, err := readImageUpload(r, string(fileType), imaging.FormatPNG)
+	if err != nil || image == nil {
+		// Not passing a file is ok
+		return "", nil, err
 	}
 
-	// Save file with name "logo"
+	var base string
 	switch fType := fileType; fType {
 	case "Logo":
-		path = "/img/logo.png"
+		base = "/img/logo"
 	case "Favicon":
-		path = "/img/favicon.png"
+		base = "/img/favicon"
 	case "QRCodeLogoImgUrl":
-		path = "/img/qrcode.png"
+		base = "/img/qrcode"
 	}
-	dir, err := os.Getwd()
+	path, variants, err = storeImage(r.Context(), base, image)
 	if err != nil {
-		log.Error("updateSettingsLogo: couldn't get wd", err)
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
+		log.Error("updateSettingsImg: saving failed", err)
+		return "", nil, err
 	}
-	// Save with owner rw, group/other read
-	err = os.WriteFile(dir+"/"+path, buf.Bytes(), 0644)
-	if err != nil {
-		log.Error("updateSettingsLogo: saving failed", err)
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
+	log.Info("updateSettingsImg: saved file to ", path)
+	return path, variants, nil
+}
+
+// setSettingsImageVariants records the variants of a settings image
+func setSettingsImageVariants(settings *ent.Settings, fileType Imagetype, variants map[string]string) {
+	if settings.ImageVariants == nil {
+		settings.ImageVariants = map[string]map[string]string{}
 	}
-	log.Info("updateSettingsLogo: saved file to ", dir+path)
-	return
+	settings.ImageVariants[string(fileType)] = variants
 }
 
 var updateSettingsMutex sync.Mutex
@@ -126,7 +102,7 @@ var updateSettingsMutex sync.Mutex
 // updateSettings godoc
 //
 //	 	@Summary 		Update settings
-//		@Description	Update configuration data of the system. Requires multipart form. Logo has to be a png and will always be saved under "img/logo.png"
+//		@Description	Update configuration data of the system. Requires multipart form. Logo, Favicon and QRCodeLogoImgUrl may be png, jpeg or gif files; they are converted to png, saved under fixed paths like "img/logo.png" and resized into the variants listed in ImageVariants
 //		@Tags			Core
 //		@Accept			json
 //		@Produce		json
@@ -247,6 +223,29 @@ func updateSettings(w http.ResponseWriter, r *http.Request) {
 				utils.ErrorJSON(w, errors.New("WordPressInviteTTL is not an integer"), http.StatusBadRequest)
 				return
 			}
+		} else if key == "PDFDownloadLimit" || key == "PDFDownloadExpiryDays" || key == "PDFDownloadMaxIPs" {
+			n, err := strconv.Atoi(value[0])
+			if err != nil || n < 0 {
+				log.Error(key + " is not a non-negative integer")
+				utils.ErrorJSON(w, errors.New(key+" is not a non-negative integer"), http.StatusBadRequest)
+				return
+			}
+			fieldsClean[key] = n
+		} else if key == "InvoicesEnabled" {
+			fieldsClean[key], err = strconv.ParseBool(value[0])
+			if err != nil {
+				log.Error("InvoicesEnabled is not a boolean")
+				utils.ErrorJSON(w, errors.New("InvoicesEnabled is not a boolean"), http.StatusBadRequest)
+				return
+			}
+		} else if key == "DefaultVATRate" {
+			rate, err := strconv.ParseFloat(value[0], 64)
+			if err != nil || rate < 0 || rate > 100 {
+				log.Error("DefaultVATRate is not a percentage")
+				utils.ErrorJSON(w, errors.New("DefaultVATRate is not a percentage"), http.StatusBadRequest)
+				return
+			}
+			fieldsClean[key] = rate
 		} else {
 			fieldsClean[key] = value[0]
 		}
@@ -272,40 +271,43 @@ func updateSettings(w http.ResponseWriter, r *http.Request) {
 		log.Debug("updateSettings: MainItem not set; leaving unchanged")
 	}
 	// update the logo
-	logoPath, err := updateSettingsImg(w, r, ImagetypeLogo)
+	logoPath, logoVariants, err := updateSettingsImg(r, ImagetypeLogo)
 	if err != nil {
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		utils.ErrorJSON(w, err, imageUploadErrorStatus(err))
 		return
 	}
 	if logoPath != "" {
 		settings.Logo = logoPath
+		setSettingsImageVariants(settings, ImagetypeLogo, logoVariants)
 		log.Info("updateSettings: settings.Logo is ", settings.Logo)
 	}
 
 	// update the favicon
-	faviconPath, err := updateSettingsImg(w, r, ImagetypeFavicon)
+	faviconPath, faviconVariants, err := updateSettingsImg(r, ImagetypeFavicon)
 	if err != nil {
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		utils.ErrorJSON(w, err, imageUploadErrorStatus(err))
 		return
 	}
 	if faviconPath != "" {
 		settings.Favicon = faviconPath
+		setSettingsImageVariants(settings, ImagetypeFavicon, faviconVariants)
 		log.Info("updateSettings: settings.Favicon is ", settings.Favicon)
 	}
 
 	// update the qrcode logo
-	qrcodePath, err := updateSettingsImg(w, r, ImagetypeQrCode)
+	qrcodePath, qrcodeVariants, err := updateSettingsImg(r, ImagetypeQrCode)
 	if err != nil {
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		utils.ErrorJSON(w, err, imageUploadErrorStatus(err))
 		return
 	}
 	if qrcodePath != "" {
 		settings.QRCodeLogoImgUrl = qrcodePath
+		setSettingsImageVariants(settings, ImagetypeQrCode, qrcodeVariants)
 		log.Info("updateSettings: settings.QRCodeLogoImgUrl is ", settings.QRCodeLogoImgUrl)
 	}
 
 	// Save settings to database
-	err = database.Db.UpdateSettings(settings)
+	err = auditedDb(r).UpdateSettings(settings)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
@@ -342,20 +344,12 @@ func updateCSS(w http.ResponseWriter, r *http.Request) {
 
 	// Save file with name "style.css"
 	path := "/public/style.css"
-	dir, err := os.Getwd()
-	if err != nil {
-		log.Error("updateCSS: couldn't get wd", err)
-		err = errors.New("failed to update css")
-
-		utils.ErrorJSON(w, err, http.StatusBadRequest)
-		return
-	}
-	// Public CSS should be readable by the webserver
-	err = os.WriteFile(dir+path, body, 0644)
+	err = storage.Client.Put(r.Context(), path, body, "text/css")
 	if err != nil {
 		log.Error("updateCSS: saving failed", err)
 		err = errors.New("failed to update css")
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
 	}
 	log.Info("updateCSS: success")
 }
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/middlewares"
+	"github.com/augustin-wien/augustina-backend/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// parseDateRange reads the optional from and to query parameters (RFC3339)
+func parseDateRange(r *http.Request) (minDate, maxDate time.Time, err error) {
+	if v := r.URL.Query().Get("from"); v != "" {
+		minDate, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			return
+		}
+	}
+	if v := r.URL.Query().Get("to"); v != "" {
+		maxDate, err = time.Parse(time.RFC3339, v)
+	}
+	return
+}
+
+type itemStockSettingsRequest struct {
+	TrackStock        bool `json:"track_stock"`
+	LowStockThreshold int  `json:"low_stock_threshold"`
+}
+
+// UpdateItemStockSettings godoc
+//
+//	@Summary		Enable stock tracking for an item
+//	@Description	Enables or disables tracking of the office stock and sets the low stock alert threshold
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int							true	"Item ID"
+//	@Param			data	body	itemStockSettingsRequest	true	"Stock settings"
+//	@Success		200
+//	@Security		KeycloakAuth
+//	@Router			/items/{id}/stock/ [put]
+func UpdateItemStockSettings(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	var req itemStockSettingsRequest
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if req.LowStockThreshold < 0 {
+		utils.ErrorJSON(w, errors.New("low_stock_threshold must not be negative"), http.StatusBadRequest)
+		return
+	}
+	err = auditedDb(r).UpdateItemStockSettings(itemID, req.TrackStock, req.LowStockThreshold)
+	if ent.IsNotFound(err) {
+		utils.ErrorJSON(w, errors.New("item not found"), http.StatusNotFound)
+		return
+	}
+	respond(w, err, req)
+}
+
+type stockMovementRequest struct {
+	Type     string `json:"type"`
+	Quantity int    `json:"quantity"` // Positive amount, corrections may be negative
+	VendorID int    `json:"vendor_id,omitempty"`
+	Note     string `json:"note"`
+}
+
+// CreateStockMovement godoc
+//
+//	@Summary		Book a stock movement
+//	@Description	Books a delivery, handout, return, write_off, sale or correction and updates the item stock. Handouts and returns require a vendor.
+//	@Tags			Items
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Item ID"
+//	@Param			data	body		stockMovementRequest	true	"Movement"
+//	@Success		200		{object}	database.StockMovement
+//	@Security		KeycloakAuth
+//	@Router			/items/{id}/stock/movements/ [post]
+func CreateStockMovement(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	var req stockMovementRequest
+	if err := utils.ReadJSON(w, r, &req); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if _, err := database.StockDelta(req.Type, req.Quantity); err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if _, err := database.Db.GetItem(itemID); err != nil {
+		utils.ErrorJSON(w, errors.New("item not found"), http.StatusNotFound)
+		return
+	}
+
+	movement, err := database.Db.CreateStockMovement(database.StockMovement{
+		ItemID:    itemID,
+		VendorID:  req.VendorID,
+		Type:      req.Type,
+		Quantity:  req.Quantity,
+		Note:      req.Note,
+		CreatedBy: middlewares.AuthPrincipal(r).Username,
+	})
+	if errors.Is(err, database.ErrStockTrackingDisabled) || errors.Is(err, database.ErrInsufficientStock) {
+		utils.ErrorJSON(w, err, http.StatusConflict)
+		return
+	}
+	if err != nil && (errors.Is(err, database.ErrStockVendorRequired) || ent.IsConstraintError(err)) {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	respond(w, err, movement)
+}
+
+// ListStockMovements godoc
+//
+//	@Summary		List stock movements of an item
+//	@Tags			Items
+//	@Produce		json
+//	@Param			id			path		int		true	"Item ID"
+//	@Param			vendor_id	query		int		false	"Only movements of this vendor"
+//	@Param			from		query		string	false	"Start date (RFC3339)"
+//	@Param			to			query		string	false	"End date (RFC3339)"
+//	@Success		200			{array}		database.StockMovement
+//	@Security		KeycloakAuth
+//	@Router			/items/{id}/stock/movements/ [get]
+func ListStockMovements(w http.ResponseWriter, r *http.Request) {
+	itemID, err := strconv.Atoi(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	vendorID := 0
+	if v := r.URL.Query().Get("vendor_id"); v != "" {
+		vendorID, err = strconv.Atoi(v)
+		if err != nil {
+			utils.ErrorJSON(w, err, http.StatusBadRequest)
+			return
+		}
+	}
+	minDate, maxDate, err := parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	movements, err := database.Db.ListStockMovements(itemID, vendorID, minDate, maxDate)
+	respond(w, err, movements)
+}
+
+// GetStockReport godoc
+//
+//	@Summary		Stock report
+//	@Description	Current stock of all tracked items and the movements per type in the given period
+//	@Tags			Items
+//	@Produce		json
+//	@Param			from	query		string	false	"Start date (RFC3339)"
+//	@Param			to		query		string	false	"End date (RFC3339)"
+//	@Success		200		{array}		database.StockReportEntry
+//	@Security		KeycloakAuth
+//	@Router			/items/stock/ [get]
+func GetStockReport(w http.ResponseWriter, r *http.Request) {
+	minDate, maxDate, err := parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	report, err := database.Db.GetStockReport(minDate, maxDate)
+	respond(w, err, report)
+}
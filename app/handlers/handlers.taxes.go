@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+// GetTaxReport godoc
+//
+//	@Summary		Report the VAT of the sales in a period
+//	@Description	Sums up the sold entries of verified orders placed in the period per VAT rate. Amounts are in cents.
+//	@Tags			Payments
+//	@Produce		json
+//	@Param			from	query		string	true	"Orders placed at or after (RFC3339)"
+//	@Param			to		query		string	true	"Orders placed before (RFC3339)"
+//	@Success		200		{object}	database.TaxReport
+//	@Failure		400		{object}	utils.ErrorResponse
+//	@Security		KeycloakAuth
+//	@Router			/tax-report/ [get]
+func GetTaxReport(w http.ResponseWriter, r *http.Request) {
+	from, to, err := parseDateRange(r)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	if from.IsZero() || to.IsZero() || !to.After(from) {
+		utils.ErrorJSON(w, errors.New("the period needs from and a later to"), http.StatusBadRequest)
+		return
+	}
+	report, err := database.Db.GetTaxReport(from, to)
+	respond(w, err, report)
+}
@@ -54,7 +54,7 @@ func CreateVendorComment(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	err = database.Db.CreateVendorComment(vendorID, comment)
+	err = auditedDb(r).CreateVendorComment(vendorID, comment)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
@@ -76,7 +76,7 @@ func UpdateVendorComment(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	err = database.Db.UpdateVendorComment(vendorID, comment)
+	err = auditedDb(r).UpdateVendorComment(vendorID, comment)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
@@ -105,7 +105,7 @@ func DeleteVendorComment(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	err = database.Db.DeleteVendorComment(vendorID, commentID)
+	err = auditedDb(r).DeleteVendorComment(vendorID, commentID)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
@@ -10,6 +10,7 @@ import (
 	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/ent"
 	"github.com/augustin-wien/augustina-backend/keycloak"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/go-chi/chi/v5"
 	"gopkg.in/guregu/null.v4"
@@ -88,7 +89,7 @@ func ListVendors(w http.ResponseWriter, r *http.Request) {
 //	@Success		200
 //	@Router			/vendors/recalculate-balances/ [post]
 func RecalculateAllVendorBalances(w http.ResponseWriter, r *http.Request) {
-	if err := database.Db.RecalculateAllVendorBalances(); err != nil {
+	if err := auditedDb(r).RecalculateAllVendorBalances(); err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
 	}
@@ -114,7 +115,7 @@ func CreateVendor(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	log.Info(r.Header.Get("X-Auth-User-Name") + " is creating a vendor for" + vendor.Email)
+	log.Info(middlewares.AuthPrincipal(r).Username + " is creating a vendor for" + vendor.Email)
 
 	// Create user in keycloak
 	user, err := keycloak.KeycloakClient.GetOrCreateVendor(vendor.Email)
@@ -132,7 +133,7 @@ func CreateVendor(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	id, err := database.Db.CreateVendor(vendor)
+	id, err := auditedDb(r).CreateVendor(vendor)
 	if err != nil {
 		log.Error("CreateVendor: Create vendor in db failed: ", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -193,8 +194,8 @@ type VendorOverview struct {
 //		@Router			/vendors/me/ [get]
 func GetVendorOverview(w http.ResponseWriter, r *http.Request) {
 
-	// Get vendors email from keycloak header
-	vendorEmail := r.Header.Get("X-Auth-User-Email")
+	// Get vendors email from the authenticated user
+	vendorEmail := middlewares.AuthPrincipal(r).Email
 	if vendorEmail == "" {
 		utils.ErrorJSON(w, fmt.Errorf("user has no email defined"), http.StatusBadRequest)
 		return
@@ -263,7 +264,7 @@ func UpdateVendor(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	log.Info(r.Header.Get("X-Auth-User-Name")+" is updating vendor with id: ", vendorID)
+	log.Info(middlewares.AuthPrincipal(r).Username+" is updating vendor with id: ", vendorID)
 	var vendor database.Vendor
 	err = utils.ReadJSON(w, r, &vendor)
 	if err != nil {
@@ -287,7 +288,7 @@ func UpdateVendor(w http.ResponseWriter, r *http.Request) {
 		vendor.KeycloakID = keycloakId
 	}
 
-	err = database.Db.UpdateVendor(vendorID, vendor)
+	err = auditedDb(r).UpdateVendor(vendorID, vendor)
 	if err != nil {
 		log.Error("UpdateVendor: update vendor in db for "+fmt.Sprint(vendorID)+" failed: ", err)
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
@@ -313,7 +314,7 @@ func DeleteVendor(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	log.Info(r.Header.Get("X-Auth-User-Name")+" is deleting vendor with id: ", vendorID)
+	log.Info(middlewares.AuthPrincipal(r).Username+" is deleting vendor with id: ", vendorID)
 	vendor, err := database.Db.GetVendor(vendorID)
 	if err != nil {
 		log.Error("DeleteVendor: GetVendor failed: ", err)
@@ -328,7 +329,7 @@ func DeleteVendor(w http.ResponseWriter, r *http.Request) {
 		// ignore because not each legacy vendor is in keycloak
 	}
 
-	err = database.Db.DeleteVendor(vendorID)
+	err = auditedDb(r).DeleteVendor(vendorID)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
@@ -361,12 +362,12 @@ func UpdateVendorByLicenseID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	vendor.KeycloakID = keycloakId
-	err = database.Db.UpdateVendor(vendor.ID, updatedVendor)
+	err = auditedDb(r).UpdateVendor(vendor.ID, updatedVendor)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
-	log.Info(r.Header.Get("X-Auth-User-Name") + " is updating vendor via flour with license id: " + licenseID)
+	log.Info(middlewares.AuthPrincipal(r).Username + " is updating vendor via flour with license id: " + licenseID)
 	respond(w, err, updatedVendor)
 }
 
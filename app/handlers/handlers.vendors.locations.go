@@ -1,24 +1,73 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/geocoding"
+	"github.com/augustin-wien/augustina-backend/openinghours"
 	"github.com/augustin-wien/augustina-backend/utils"
 
 	"github.com/go-chi/chi/v5"
 )
 
+// vendorLocationResponse extends a location with its rendered opening hours
+type vendorLocationResponse struct {
+	*ent.Location
+	OpeningHours []string `json:"opening_hours"`
+	OpenNow      bool     `json:"open_now"`
+}
+
+// geocodeLocation fills in the coordinates of a location from its address.
+// Coordinates entered by staff are kept and get full confidence. The
+// geocoder is asked if no coordinates were entered or if the address
+// changed while the coordinates stayed the same. Geocoding errors are
+// logged and recorded, but never fail the request.
+func geocodeLocation(ctx context.Context, location *ent.Location, previous *ent.Location) {
+	addressChanged := previous == nil || previous.Address != location.Address || previous.Zip != location.Zip
+	coordinatesChanged := previous == nil || previous.Latitude != location.Latitude || previous.Longitude != location.Longitude
+	location.GeocodeAttemptedAt = nil
+	if !geocoding.IsDefaultCoordinate(location.Latitude, location.Longitude) {
+		if coordinatesChanged {
+			location.GeocodingConfidence = 1
+			return
+		}
+		if !addressChanged {
+			location.GeocodingConfidence = previous.GeocodingConfidence
+			return
+		}
+	}
+	// Coordinates are missing or stale from here on
+	location.GeocodingConfidence = 0
+	if geocoding.Client == nil {
+		return
+	}
+	result, err := geocoding.Client.Geocode(ctx, location.Address, location.Zip)
+	if err != nil {
+		log.Warnf("geocodeLocation: Can not geocode %q %q: %v", location.Address, location.Zip, err)
+		now := time.Now()
+		location.GeocodeAttemptedAt = &now
+		return
+	}
+	location.Latitude = result.Latitude
+	location.Longitude = result.Longitude
+	location.GeocodingConfidence = result.Confidence
+}
+
 // ListVendorLocations godoc
 //
 // @Summary List vendor locations
-// @Description List vendor locations
+// @Description List vendor locations including human-readable opening hours
 // @ID listVendorLocations
 // @Produce json
-// @Success 200 {array} Location
+// @Param lang query string false "Language of the opening hours (de, en)"
+// @Success 200 {array} vendorLocationResponse
 // @Router /api/vendors/locations/ [get]
 // @Security KeycloakAuth
 
@@ -38,7 +87,17 @@ func ListVendorLocations(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
 		return
 	}
-	respond(w, err, locations)
+	lang := r.URL.Query().Get("lang")
+	now := time.Now()
+	response := make([]vendorLocationResponse, 0, len(locations))
+	for _, location := range locations {
+		response = append(response, vendorLocationResponse{
+			Location:     location,
+			OpeningHours: openinghours.Lines(location.WorkingTime, lang),
+			OpenNow:      openinghours.IsOpenAt(location.WorkingTime, now),
+		})
+	}
+	respond(w, err, response)
 }
 
 // CreateVendorLocation godoc
@@ -63,6 +122,12 @@ func CreateVendorLocation(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
+	err = openinghours.Validate(location.WorkingTime)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	geocodeLocation(r.Context(), &location, nil)
 	err = database.Db.CreateLocation(vendorID, location)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -93,6 +158,17 @@ func UpdateVendorLocation(w http.ResponseWriter, r *http.Request) {
 		utils.ErrorJSON(w, err, http.StatusBadRequest)
 		return
 	}
+	err = openinghours.Validate(location.WorkingTime)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusBadRequest)
+		return
+	}
+	previous, err := database.Db.GetLocationByID(location.ID)
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusNotFound)
+		return
+	}
+	geocodeLocation(r.Context(), &location, previous)
 	err = database.Db.UpdateLocation(location)
 	if err != nil {
 		utils.ErrorJSON(w, err, http.StatusInternalServerError)
@@ -129,3 +205,70 @@ func DeleteVendorLocation(w http.ResponseWriter, r *http.Request) {
 	}
 	respond(w, err, nil)
 }
+
+type geocodeLocationFailure struct {
+	ID    int    `json:"id"`
+	Error string `json:"error"`
+}
+
+type geocodeLocationsResponse struct {
+	Total     int                      `json:"total"`
+	Updated   int                      `json:"updated"`
+	Remaining int                      `json:"remaining"`
+	Failed    []geocodeLocationFailure `json:"failed"`
+}
+
+// defaultGeocodeBatchSize keeps a batch within the server write timeout when
+// the geocoder is rate limited to one request per second
+const defaultGeocodeBatchSize = 20
+
+// GeocodeVendorLocations godoc
+//
+// @Summary Geocode vendor locations without coordinates
+// @Description Re-geocode locations that still have the default coordinate. Locations that can not be resolved are reported, keep their coordinates and are retried after the others.
+// @ID geocodeVendorLocations
+// @Produce json
+// @Param limit query int false "Maximum number of locations to geocode (default 20)"
+// @Success 200 {object} geocodeLocationsResponse
+// @Router /api/vendors/locations/geocode/ [post]
+// @Security KeycloakAuth
+func GeocodeVendorLocations(w http.ResponseWriter, r *http.Request) {
+	if geocoding.Client == nil {
+		utils.ErrorJSON(w, errors.New("geocoding is disabled"), http.StatusServiceUnavailable)
+		return
+	}
+	limit := defaultGeocodeBatchSize
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			utils.ErrorJSON(w, fmt.Errorf("invalid limit %q", value), http.StatusBadRequest)
+			return
+		}
+		limit = parsed
+	}
+	locations, err := database.Db.ListLocationsWithDefaultCoordinates()
+	if err != nil {
+		utils.ErrorJSON(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	response := geocodeLocationsResponse{Total: len(locations), Failed: []geocodeLocationFailure{}}
+	if len(locations) > limit {
+		response.Remaining = len(locations) - limit
+		locations = locations[:limit]
+	}
+	for _, location := range locations {
+		result, err := geocoding.Client.Geocode(r.Context(), location.Address, location.Zip)
+		if err == nil {
+			err = database.Db.UpdateLocationCoordinates(location.ID, result.Latitude, result.Longitude, result.Confidence)
+		}
+		if err != nil {
+			response.Failed = append(response.Failed, geocodeLocationFailure{ID: location.ID, Error: err.Error()})
+			// Failed locations move to the end of the next batch
+			_ = database.Db.RecordLocationGeocodeFailure(location.ID, time.Now())
+			continue
+		}
+		response.Updated++
+	}
+	respond(w, nil, response)
+}
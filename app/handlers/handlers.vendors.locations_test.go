@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/ent"
+	"github.com/augustin-wien/augustina-backend/geocoding"
 	"github.com/augustin-wien/augustina-backend/utils"
 	"github.com/stretchr/testify/require"
 )
@@ -246,3 +248,140 @@ func TestWorkingTimeValidation(t *testing.T) {
 		}
 	}
 }
+
+// TestVendorLocationInvalidWorkingTime verifies that malformed opening hours
+// are rejected on create and update
+func TestVendorLocationInvalidWorkingTime(t *testing.T) {
+	mutex_test.Lock()
+	defer mutex_test.Unlock()
+
+	err := database.Db.InitEmptyTestDb()
+	if err != nil {
+		t.Fatalf("InitEmptyTestDb failed: %v", err)
+	}
+
+	vendorID := createTestVendor(t, "test-invalid-working-time")
+
+	locationBody := map[string]any{
+		"name":      "Overlapping Location",
+		"address":   "Test Address",
+		"longitude": 16.3,
+		"latitude":  48.2,
+		"zip":       "1000",
+		"working_time": map[string]any{
+			"mode": "everyday",
+			"everyday": []map[string]any{
+				{"from": "08:00", "to": "12:00"},
+				{"from": "11:00", "to": "14:00"},
+			},
+		},
+	}
+	utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/"+vendorID+"/locations/", locationBody, 400, adminUserToken)
+
+	locationBody["working_time"] = map[string]any{
+		"mode":     "everyday",
+		"everyday": []map[string]any{{"from": "08:00", "to": "12:00"}},
+	}
+	utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/"+vendorID+"/locations/", locationBody, 200, adminUserToken)
+
+	res := utils.TestRequestWithAuth(t, r, "GET", "/api/vendors/"+vendorID+"/locations/?lang=en", nil, 200, adminUserToken)
+	var locations []map[string]any
+	err = json.Unmarshal(res.Body.Bytes(), &locations)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(locations))
+	require.Equal(t, []any{"Daily: 08:00–12:00"}, locations[0]["opening_hours"])
+	_, hasOpenNow := locations[0]["open_now"]
+	require.True(t, hasOpenNow)
+
+	locationID := strconv.Itoa(int(locations[0]["id"].(float64)))
+	locationBody["id"] = locations[0]["id"]
+	locationBody["working_time"] = map[string]any{
+		"mode":     "everyday",
+		"everyday": []map[string]any{{"from": "12:00", "to": "08:00"}},
+	}
+	utils.TestRequestWithAuth(t, r, "PATCH", "/api/vendors/"+vendorID+"/locations/"+locationID+"/", locationBody, 400, adminUserToken)
+}
+
+// TestVendorLocationGeocoding verifies that locations without coordinates
+// are geocoded on create and by the batch endpoint
+func TestVendorLocationGeocoding(t *testing.T) {
+	mutex_test.Lock()
+	defer mutex_test.Unlock()
+
+	err := database.Db.InitEmptyTestDb()
+	if err != nil {
+		t.Fatalf("InitEmptyTestDb failed: %v", err)
+	}
+
+	originalClient := geocoding.Client
+	geocoding.Client = geocoding.NewFixtureGeocoder([]geocoding.Fixture{
+		{Address: "Marktplatz 1", Zip: "1010", Result: geocoding.Result{Latitude: 48.2082, Longitude: 16.3725, Confidence: 1}},
+		{Address: "Kulturstraße 7", Zip: "1020", Result: geocoding.Result{Latitude: 48.211, Longitude: 16.379, Confidence: 0.8}},
+		{Address: "Bahnhof 3", Zip: "1030", Result: geocoding.Result{Latitude: 48.19, Longitude: 16.39, Confidence: 1}},
+	})
+	defer func() { geocoding.Client = originalClient }()
+
+	vendorID := createTestVendor(t, "test-geocoding")
+	vendorIDInt, err := strconv.Atoi(vendorID)
+	require.NoError(t, err)
+
+	// Created without coordinates: geocoded immediately
+	locationBody := map[string]any{
+		"name":    "Market",
+		"address": "Marktplatz 1",
+		"zip":     "1010",
+	}
+	utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/"+vendorID+"/locations/", locationBody, 200, adminUserToken)
+
+	// Created with manual coordinates: kept as entered
+	locationBody = map[string]any{
+		"name":      "Manual",
+		"address":   "Unknown Street 1",
+		"zip":       "1010",
+		"latitude":  48.3,
+		"longitude": 16.4,
+	}
+	utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/"+vendorID+"/locations/", locationBody, 200, adminUserToken)
+
+	// Created before geocoding was enabled
+	err = database.Db.CreateLocation(vendorIDInt, ent.Location{Name: "Stand", Address: "Kulturstraße 7", Zip: "1020", Latitude: 0.1, Longitude: 0.1})
+	require.NoError(t, err)
+	err = database.Db.CreateLocation(vendorIDInt, ent.Location{Name: "Unknown", Address: "Nowhere 1", Zip: "9999", Latitude: 0.1, Longitude: 0.1})
+	require.NoError(t, err)
+
+	res := utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/locations/geocode/", nil, 200, adminUserToken)
+	var report map[string]any
+	err = json.Unmarshal(res.Body.Bytes(), &report)
+	require.NoError(t, err)
+	require.Equal(t, float64(2), report["total"])
+	require.Equal(t, float64(1), report["updated"])
+	require.Len(t, report["failed"], 1)
+
+	locations, err := database.Db.GetLocationsByVendorID(vendorIDInt)
+	require.NoError(t, err)
+	byName := map[string]*ent.Location{}
+	for _, location := range locations {
+		byName[location.Name] = location
+	}
+	require.Equal(t, 48.2082, byName["Market"].Latitude)
+	require.Equal(t, 1.0, byName["Market"].GeocodingConfidence)
+	require.Equal(t, 48.3, byName["Manual"].Latitude)
+	require.Equal(t, 1.0, byName["Manual"].GeocodingConfidence)
+	require.Equal(t, 48.211, byName["Stand"].Latitude)
+	require.Equal(t, 0.8, byName["Stand"].GeocodingConfidence)
+	require.Equal(t, 0.1, byName["Unknown"].Latitude)
+	require.Equal(t, 0.0, byName["Unknown"].GeocodingConfidence)
+	require.NotNil(t, byName["Unknown"].GeocodeAttemptedAt)
+	require.Nil(t, byName["Stand"].GeocodeAttemptedAt)
+
+	// Failed locations are retried after the ones never tried
+	err = database.Db.CreateLocation(vendorIDInt, ent.Location{Name: "Depot", Address: "Bahnhof 3", Zip: "1030", Latitude: 0.1, Longitude: 0.1})
+	require.NoError(t, err)
+	res = utils.TestRequestWithAuth(t, r, "POST", "/api/vendors/locations/geocode/?limit=1", nil, 200, adminUserToken)
+	report = map[string]any{}
+	err = json.Unmarshal(res.Body.Bytes(), &report)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), report["updated"])
+	require.Equal(t, float64(1), report["remaining"])
+	require.Empty(t, report["failed"])
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/middlewares"
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/guregu/null.v4"
@@ -57,7 +58,7 @@ func TestFlourWebhookUpdateVendorDefinedLicense(t *testing.T) {
 	// Create request with chi URL parameters
 	req := httptest.NewRequest("PUT", "/api/flour/vendors/license/"+licenseID+"/", bytes.NewReader(payloadBytes))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Set chi URL parameter
 	chiCtx := chi.NewRouteContext()
@@ -106,7 +107,7 @@ func TestFlourWebhookUpdateVendorUndefinedLicense(t *testing.T) {
 	// Create request with chi URL parameters for undefined license
 	req := httptest.NewRequest("PUT", "/api/flour/vendors/license/"+undefinedLicenseID+"/", bytes.NewReader(payloadBytes))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Set chi URL parameter
 	chiCtx := chi.NewRouteContext()
@@ -158,7 +159,7 @@ func TestFlourWebhookGetVendorDefinedLicense(t *testing.T) {
 
 	// Create request to GET vendor with chi URL parameters
 	req := httptest.NewRequest("GET", "/api/flour/vendors/license/"+licenseID+"/", nil)
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Set chi URL parameter
 	chiCtx := chi.NewRouteContext()
@@ -197,7 +198,7 @@ func TestFlourWebhookGetVendorUndefinedLicense(t *testing.T) {
 
 	// Create request to GET vendor with chi URL parameters
 	req := httptest.NewRequest("GET", "/api/flour/vendors/license/"+undefinedLicenseID+"/", nil)
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Set chi URL parameter
 	chiCtx := chi.NewRouteContext()
@@ -242,7 +243,7 @@ func TestFlourWebhookUpdateVendorMissingLicenseID(t *testing.T) {
 	// Create request with missing chi URL parameter
 	req := httptest.NewRequest("PUT", "/api/flour/vendors/license//", bytes.NewReader(payloadBytes))
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Don't set chi URL parameter - it will be empty
 	chiCtx := chi.NewRouteContext()
@@ -275,7 +276,7 @@ func TestFlourWebhookGetVendorMissingLicenseID(t *testing.T) {
 
 	// Create request with missing chi URL parameter
 	req := httptest.NewRequest("GET", "/api/flour/vendors/license//", nil)
-	req.Header.Set("X-Auth-User-Name", "flour-system")
+	req = req.WithContext(middlewares.WithPrincipal(req.Context(), &middlewares.Principal{Username: "flour-system"}))
 
 	// Don't set chi URL parameter - it will be empty
 	chiCtx := chi.NewRouteContext()
@@ -5,6 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
@@ -269,7 +273,7 @@ func TestItems(t *testing.T) {
 	// Include Description when updating to satisfy ent validators
 	writer.WriteField("Description", "Updated description")
 	image, _ := writer.CreateFormFile("Image", "test.jpg")
-	image.Write([]byte(`i am the content of a jpg file :D`))
+	image.Write(testJPEG(t, 1000, 500))
 	writer.Close()
 	utils.TestRequestMultiPartWithAuth(t, r, "PUT", "/api/items/"+itemID+"/", body, writer.FormDataContentType(), 200, adminUserToken)
 
@@ -289,7 +293,29 @@ func TestItems(t *testing.T) {
 	}
 	file, err := os.ReadFile(dir + "/" + resItems[1].Image)
 	utils.CheckError(t, err)
-	require.Equal(t, `i am the content of a jpg file :D`, string(file))
+	imgConfig, err := jpeg.DecodeConfig(bytes.NewReader(file))
+	utils.CheckError(t, err)
+	require.Equal(t, 1000, imgConfig.Width)
+
+	// Check variants
+	require.Len(t, resItems[1].ImageVariants, 6)
+	thumbnail, err := os.ReadFile(dir + "/" + resItems[1].ImageVariants["thumbnail"])
+	utils.CheckError(t, err)
+	imgConfig, err = jpeg.DecodeConfig(bytes.NewReader(thumbnail))
+	utils.CheckError(t, err)
+	require.Equal(t, 200, imgConfig.Width)
+	require.Equal(t, 100, imgConfig.Height)
+	webp, err := os.ReadFile(dir + "/" + resItems[1].ImageVariants["shop_webp"])
+	utils.CheckError(t, err)
+	require.Equal(t, "RIFF", string(webp[:4]))
+
+	// Files that are not images are rejected
+	body = new(bytes.Buffer)
+	writer = multipart.NewWriter(body)
+	image, _ = writer.CreateFormFile("Image", "test.jpg")
+	image.Write([]byte(`i am the content of a jpg file :D`))
+	writer.Close()
+	utils.TestRequestMultiPartWithAuth(t, r, "PUT", "/api/items/"+itemID+"/", body, writer.FormDataContentType(), 400, adminUserToken)
 
 	// Update with image as field (not as a file)
 	body = new(bytes.Buffer)
@@ -308,6 +334,7 @@ func TestItems(t *testing.T) {
 	require.Equal(t, 2, len(resItems))
 	require.Equal(t, "Updated item name 2", resItems[1].Name)
 	require.Equal(t, resItems[1].Image, "Test")
+	require.Empty(t, resItems[1].ImageVariants)
 
 	// Update item with certain ID (which should fail)
 	body = new(bytes.Buffer)
@@ -355,6 +382,19 @@ func TestLicenseUnassignedWhenItemDeleted(t *testing.T) {
 }
 
 // Set MaxOrderAmount to avoid errors
+// testJPEG returns a JPEG file with a gradient
+func testJPEG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 128, 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
 func setMaxOrderAmount(t *testing.T, amount int) {
 	// Update settings directly in DB to avoid relying on the HTTP handler
 	settings, err := database.Db.GetSettings()
@@ -1660,8 +1700,8 @@ func TestSettings(t *testing.T) {
 	writer := multipart.NewWriter(body)
 	writer.WriteField("MaxOrderAmount", strconv.Itoa(10))
 	writer.WriteField("MainItem", itemID)
-	image, _ := writer.CreateFormFile("Logo", "test.png")
-	image.Write([]byte(`i am the content of a jpg file :D`))
+	image, _ := writer.CreateFormFile("Logo", "test.jpg")
+	image.Write(testJPEG(t, 400, 400))
 	writer.Close()
 	utils.TestRequestMultiPartWithAuth(t, r, "PUT", "/api/settings/", body, writer.FormDataContentType(), 200, adminUserToken)
 
@@ -1685,7 +1725,11 @@ func TestSettings(t *testing.T) {
 	}
 	file, err := os.ReadFile(dir + "/" + exSettings.Settings.Logo)
 	utils.CheckError(t, err)
-	require.Equal(t, `i am the content of a jpg file :D`, string(file))
+	// The logo is converted to png
+	_, err = png.Decode(bytes.NewReader(file))
+	utils.CheckError(t, err)
+	require.Equal(t, "/img/logo_thumbnail.png", exSettings.Settings.ImageVariants["Logo"]["thumbnail"])
+	require.Equal(t, "/img/logo_email.webp", exSettings.Settings.ImageVariants["Logo"]["email_webp"])
 
 }
 
@@ -144,22 +144,10 @@ func GetRouter() (r *chi.Mux) {
 		log.Infof("Flour integration enabled: %s", config.Config.FlourWebhookURL)
 
 		r.Route("/api/flour_old", func(r chi.Router) {
+			r.Use(middlewares.InRouteGroup(middlewares.RouteGroupIntegrations))
 			r.Use(middlewares.AuthMiddleware)
-			r.Use(middlewares.FlourAuthMiddleware)
-			r.Route("/vendors", func(r chi.Router) {
-
-				r.Put("/license/{licenseID}/", UpdateVendorByLicenseID)
-				r.Get("/license/{licenseID}/", GetVendorByLicenseID)
-
-				r.Put("/{id}/", UpdateVendor)
-				r.Delete("/{id}/", DeleteVendor)
-				r.Get("/{id}/", GetVendor)
-				r.Post("/", CreateVendor)
-
-			})
-			r.Route("/payments", func(r chi.Router) {
-				r.Post("/payout/", CreatePaymentPayout)
-			})
+			r.Use(middlewares.Require(middlewares.PermIntegrationFlour))
+			mountIntegrationRoutes(r)
 		})
 	}
 
@@ -169,23 +157,12 @@ func GetRouter() (r *chi.Mux) {
 		log.Infof("Odoo integration enabled: %s", config.Config.OdooWebhookURL)
 
 		r.Route("/api/flour", func(r chi.Router) {
+			r.Use(middlewares.InRouteGroup(middlewares.RouteGroupIntegrations))
 			r.Use(middlewares.AuthMiddleware)
-			r.Use(middlewares.OdooAuthMiddleware)
-			r.Route("/vendors", func(r chi.Router) {
-
-				r.Put("/license/{licenseID}/", UpdateVendorByLicenseID)
-				r.Get("/license/{licenseID}/", GetVendorByLicenseID)
-
-				r.Put("/{id}/", UpdateVendor)
-				r.Delete("/{id}/", DeleteVendor)
-				r.Get("/{id}/", GetVendor)
-				r.Post("/", CreateVendor)
-
-			})
-			r.Route("/payments", func(r chi.Router) {
-				r.Post("/payout/", CreatePaymentPayout)
-			})
+			r.Use(middlewares.Require(middlewares.PermIntegrationOdoo))
+			mountIntegrationRoutes(r)
 			r.Route("/items", func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermIntegrationItems))
 				r.Get("/", ListItemsBackoffice)
 				r.Post("/", CreateItem)
 				r.Route("/{id}", func(r chi.Router) {
@@ -196,6 +173,16 @@ func GetRouter() (r *chi.Mux) {
 		})
 
 	}
+	// Entitlements of online issues for external readers, which authenticate
+	// with an API key. NO strict security middlewares, readers are servers.
+	r.Route("/api/entitlements", func(r chi.Router) {
+		r.Use(httprate.LimitByIP(120, 1*time.Minute))
+		r.Use(middlewares.InRouteGroup(middlewares.RouteGroupReaders))
+		r.Use(middlewares.AuthMiddleware)
+		r.Use(middlewares.Require(middlewares.PermEntitlementsIntrospect))
+		r.Post("/introspect/", IntrospectEntitlement)
+	})
+
 	// Payment service providers
 	r.Route("/api/webhooks/vivawallet", func(r chi.Router) {
 		r.Post("/success/", VivaWalletWebhookSuccess)
@@ -211,6 +198,7 @@ func GetRouter() (r *chi.Mux) {
 		r.Use(middlewares.BlockBadUserAgents)
 		r.Use(middlewares.BlockFakeBrowsers)
 		r.Use(middlewares.BlockMaliciousPatterns)
+		r.Use(middlewares.InRouteGroup(middlewares.RouteGroupBackoffice))
 
 		// Protected routes
 		r.Group(func(r chi.Router) {
@@ -225,7 +213,7 @@ func GetRouter() (r *chi.Mux) {
 			r.Get("/", getSettings)
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
+				r.Use(middlewares.Require(middlewares.PermSettingsWrite))
 				r.Put("/", updateSettings)
 				r.Put("/css/", updateCSS)
 			})
@@ -236,33 +224,36 @@ func GetRouter() (r *chi.Mux) {
 			r.Get("/check/{licenseID}/", CheckVendorsLicenseID)
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
-				r.Get("/", ListVendors)
-				r.Post("/recalculate-balances/", RecalculateAllVendorBalances)
-				r.Get("/statistics/", ListVendorUsageStatistics)
-				r.Post("/", CreateVendor)
-				r.Get("/{vendorid}/locations/", ListVendorLocations)
-				r.Post("/{vendorid}/locations/", CreateVendorLocation)
-				r.Patch("/{vendorid}/locations/{id}/", UpdateVendorLocation)
-				r.Delete("/{vendorid}/locations/{id}/", DeleteVendorLocation)
-				r.Get("/{vendorid}/comments/", ListVendorComments)
-				r.Post("/{vendorid}/comments/", CreateVendorComment)
-				r.Delete("/{vendorid}/comments/{id}/", DeleteVendorComment)
-				r.Patch("/{vendorid}/comments/{id}/", UpdateVendorComment)
-
-				r.Post("/{licenseID}/pos-order/", CreatePOSOrder)
-				r.Get("/{licenseID}/pos-orders/", ListPOSOrdersForVendor)
-
-				r.Route("/{id}", func(r chi.Router) {
-					r.Put("/", UpdateVendor)
-					r.Delete("/", DeleteVendor)
-					r.Get("/", GetVendor)
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermVendorsRead))
+					r.Get("/", ListVendors)
+					r.Get("/statistics/", ListVendorUsageStatistics)
+					r.Get("/{vendorid}/locations/", ListVendorLocations)
+					r.Get("/{vendorid}/comments/", ListVendorComments)
+					r.Get("/{id}/", GetVendor)
 				})
-			})
-			r.Group(func(r chi.Router) {
-				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.VendorAuthMiddleware)
-				r.Get("/me/", GetVendorOverview)
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermVendorsWrite))
+					r.Post("/locations/geocode/", GeocodeVendorLocations)
+					r.Post("/", CreateVendor)
+					r.Post("/{vendorid}/locations/", CreateVendorLocation)
+					r.Patch("/{vendorid}/locations/{id}/", UpdateVendorLocation)
+					r.Delete("/{vendorid}/locations/{id}/", DeleteVendorLocation)
+					r.Post("/{vendorid}/comments/", CreateVendorComment)
+					r.Delete("/{vendorid}/comments/{id}/", DeleteVendorComment)
+					r.Patch("/{vendorid}/comments/{id}/", UpdateVendorComment)
+					r.Put("/{id}/", UpdateVendor)
+					r.Delete("/{id}/", DeleteVendor)
+				})
+				r.With(middlewares.Require(middlewares.PermVendorsRecalculate)).Post("/recalculate-balances/", RecalculateAllVendorBalances)
+
+				r.With(middlewares.Require(middlewares.PermPOSSell)).Post("/{licenseID}/pos-order/", CreatePOSOrder)
+				r.With(middlewares.Require(middlewares.PermPaymentsRead)).Get("/{licenseID}/pos-orders/", ListPOSOrdersForVendor)
+				r.With(middlewares.Require(middlewares.PermVendorsRead)).Get("/{licenseID}/consignments/", ListConsignmentsForVendor)
+				r.With(middlewares.Require(middlewares.PermConsignmentsWrite)).Post("/{licenseID}/consignments/handout/", HandOutConsignment)
+				r.With(middlewares.Require(middlewares.PermConsignmentsWrite)).Post("/{licenseID}/consignments/return/", ReturnConsignment)
+
+				r.With(middlewares.Require(middlewares.PermVendorSelf)).Get("/me/", GetVendorOverview)
 			})
 		})
 
@@ -271,13 +262,23 @@ func GetRouter() (r *chi.Mux) {
 			r.Get("/", ListItems)
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
-				r.Get("/backoffice/", ListItemsBackoffice)
-				r.Get("/licensegroups/", ListLicenseGroups)
-				r.Post("/", CreateItem)
-				r.Route("/{id}", func(r chi.Router) {
-					r.Put("/", UpdateItem)
-					r.Delete("/", DeleteItem)
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermItemsRead))
+					r.Get("/backoffice/", ListItemsBackoffice)
+					r.Get("/licensegroups/", ListLicenseGroups)
+					r.Get("/stock/", GetStockReport)
+					r.Get("/{id}/stock/movements/", ListStockMovements)
+				})
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermItemsWrite))
+					r.Post("/", CreateItem)
+					r.Put("/{id}/", UpdateItem)
+					r.Delete("/{id}/", DeleteItem)
+				})
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermStockWrite))
+					r.Put("/{id}/stock/", UpdateItemStockSettings)
+					r.Post("/{id}/stock/movements/", CreateStockMovement)
 				})
 			})
 		})
@@ -288,11 +289,13 @@ func GetRouter() (r *chi.Mux) {
 			r.Get("/verify/", VerifyPaymentOrder)
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
-				r.Get("/unverified/", ListUnverifiedOrders)
-				r.Get("/unverified/code/{orderCode}/verify/", AdminVerifyPaymentOrderByCode)
-				r.Post("/unverified/code/{orderCode}/transactionID/", AdminAddTransactionIDToOrder)
-				r.Post("/resend/{orderID}/", ResendOdooWebhook)
+				r.With(middlewares.Require(middlewares.PermOrdersRead)).Get("/unverified/", ListUnverifiedOrders)
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermOrdersVerify))
+					r.Get("/unverified/code/{orderCode}/verify/", AdminVerifyPaymentOrderByCode)
+					r.Post("/unverified/code/{orderCode}/transactionID/", AdminAddTransactionIDToOrder)
+					r.Post("/resend/{orderID}/", ResendOdooWebhook)
+				})
 			})
 		})
 
@@ -300,68 +303,105 @@ func GetRouter() (r *chi.Mux) {
 		r.Route("/api/customers", func(r chi.Router) {
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.CustomerAuthMiddleware)
+				r.Use(middlewares.Require(middlewares.PermCustomerSelf))
 				r.Get("/me/abonements/", ListMyAbonements)
+				r.Post("/me/abonements/{id}/cancel/", CancelMyAbonement)
+				r.Put("/me/abonements/{id}/auto-renew/", SetMyAbonementAutoRenew)
+				r.Get("/me/entitlements/", ListMyEntitlements)
 				r.Get("/me/payments/", ListMyPayments)
+				r.Get("/me/", GetMyProfile)
+				r.Put("/me/", UpdateMyProfile)
+				r.Put("/me/preferences/", UpdateMyPreferences)
+				r.Get("/me/orders/", ListMyOrders)
+				r.Get("/me/orders/{id}/invoice/", GetMyOrderInvoice)
+				r.Get("/me/invoices/", ListMyInvoices)
+				r.Get("/me/invoices/{id}/pdf/", GetMyInvoicePDF)
+				r.Get("/me/pdf-downloads/", ListMyPDFDownloads)
+				r.Post("/me/deletion-request/", RequestMyAccountDeletion)
+				r.Delete("/me/deletion-request/", WithdrawMyAccountDeletion)
 			})
 			r.Group(func(r chi.Router) {
 				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
-				r.Get("/", ListCustomers)
-				r.Post("/", CreateCustomer)
-				r.Route("/{id}", func(r chi.Router) {
-					r.Get("/", GetCustomer)
-					r.Put("/", UpdateCustomer)
-					r.Delete("/", DeleteCustomer)
-					r.Get("/abonements/", ListAbonementsByCustomer)
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermCustomersRead))
+					r.Get("/", ListCustomers)
+					r.Get("/deletion-requests/", ListCustomerDeletionRequests)
+					r.Get("/duplicates/", ListDuplicateCustomers)
+					r.Get("/{id}/", GetCustomer)
+					r.With(middlewares.Require(middlewares.PermAbonementsRead)).Get("/{id}/abonements/", ListAbonementsByCustomer)
+				})
+				r.Group(func(r chi.Router) {
+					r.Use(middlewares.Require(middlewares.PermCustomersWrite))
+					r.Post("/", CreateCustomer)
+					r.Put("/{id}/", UpdateCustomer)
+					r.Delete("/{id}/", DeleteCustomer)
+					r.Post("/{id}/merge/", MergeCustomers)
+					r.With(middlewares.Require(middlewares.PermAbonementsWrite)).Post("/import/", ImportCustomers)
 				})
 			})
 		})
 
 		// Abonements (admin)
 		r.Route("/api/abonements", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
 			r.Group(func(r chi.Router) {
-				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
+				r.Use(middlewares.Require(middlewares.PermAbonementsRead))
 				r.Get("/", ListAbonements)
-				r.Post("/", CreateAbonement)
 				r.Get("/active/", ListActiveAbonementsWithCustomers)
 				r.Get("/by-date/", GetActiveAbonementsByDate)
-				r.Route("/{id}", func(r chi.Router) {
-					r.Get("/", GetAbonement)
-					r.Put("/", UpdateAbonement)
-					r.Delete("/", DeleteAbonement)
-				})
+				r.Get("/{id}/", GetAbonement)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermAbonementsWrite))
+				r.Post("/", CreateAbonement)
+				r.Put("/{id}/", UpdateAbonement)
+				r.Delete("/{id}/", DeleteAbonement)
 			})
 		})
 
 		// POS accounting (all vendors)
 		r.Route("/api/pos-orders", func(r chi.Router) {
-			r.Group(func(r chi.Router) {
-				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
-				r.Get("/", ListAllPOSOrders)
-			})
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermPaymentsRead))
+			r.Get("/", ListAllPOSOrders)
+		})
+
+		// Consignments of issues handed out to vendors
+		r.Route("/api/consignments", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.With(middlewares.Require(middlewares.PermVendorsRead)).Get("/", ListConsignments)
+			r.With(middlewares.Require(middlewares.PermVendorsRead)).Get("/statistics/", GetConsignmentStatistics)
+			r.With(middlewares.Require(middlewares.PermConsignmentsWrite)).Post("/{id}/settle/", SettleConsignment)
 		})
 
 		// Payments
 		r.Route("/api/payments", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
 			r.Group(func(r chi.Router) {
-				r.Use(middlewares.AuthMiddleware)
-				r.Use(middlewares.AdminAuthMiddleware)
+				r.Use(middlewares.Require(middlewares.PermPaymentsRead))
 				r.Get("/", ListPayments)
-				r.Post("/", CreatePayment)
-				r.Post("/batch/", CreatePayments)
 				r.Get("/forpayout/", ListPaymentsForPayout)
 				r.Get("/statistics/", ListPaymentsStatistics)
-				r.Post("/payout/", CreatePaymentPayout)
 			})
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermPaymentsWrite))
+				r.Post("/", CreatePayment)
+				r.Post("/batch/", CreatePayments)
+			})
+			r.With(middlewares.Require(middlewares.PermPaymentsPayout)).Post("/payout/", CreatePaymentPayout)
+		})
+
+		// VAT of the sales per period
+		r.Route("/api/tax-report", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermPaymentsRead))
+			r.Get("/", GetTaxReport)
 		})
 
 		// Online Map
 		r.Group(func(r chi.Router) {
 			r.Use(middlewares.AuthMiddleware)
-			r.Use(middlewares.AdminAuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermVendorsRead))
 			r.Get("/api/map/", GetVendorLocations)
 		})
 
@@ -369,30 +409,133 @@ func GetRouter() (r *chi.Mux) {
 		r.Route("/api/pdf", func(r chi.Router) {
 			r.Get("/{id}/validate/", validatePDFLink)
 			r.Get("/{id}/", downloadPDF)
+			r.With(httprate.LimitByIP(5, 1*time.Minute)).Post("/resend/", ResendPDFLinks)
+		})
+
+		// Gift codes
+		r.Route("/api/gift-codes", func(r chi.Router) {
+			r.With(httprate.LimitByIP(5, 1*time.Minute)).Post("/redeem/", RedeemGiftCode)
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.AuthMiddleware)
+				r.Use(middlewares.Require(middlewares.PermPromotionsRead))
+				r.Get("/", ListGiftCodes)
+			})
+		})
+
+		// Discount codes
+		r.Route("/api/discount-codes", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermPromotionsRead))
+				r.Get("/", ListDiscountCodes)
+				r.Get("/report/", GetDiscountRedemptionReport)
+				r.Get("/{id}/", GetDiscountCode)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermPromotionsWrite))
+				r.Post("/", CreateDiscountCode)
+				r.Put("/{id}/", UpdateDiscountCode)
+			})
+		})
+
+		// PDF download links
+		r.Route("/api/pdf-downloads", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermPDFsManage))
+			r.Get("/", ListPDFDownloads)
+			r.Get("/{linkID}/", GetPDFDownloadDetails)
+			r.Post("/{linkID}/reissue/", ReissuePDFDownload)
+		})
+
+		// PDF retention
+		r.Route("/api/pdf-retention", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.With(middlewares.Require(middlewares.PermPDFsManage)).Get("/", PreviewPDFRetention)
+			r.With(middlewares.Require(middlewares.PermPDFsPurge)).Post("/purge/", PurgePDFs)
+		})
+
+		// API keys of integrations
+		r.Route("/api/api-keys", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermAPIKeysManage))
+			r.Get("/", ListAPIKeys)
+			r.Post("/", CreateAPIKey)
+			r.Get("/{id}/", GetAPIKey)
+			r.Post("/{id}/revoke/", RevokeAPIKey)
+			r.Post("/{id}/rotate/", RotateAPIKey)
+		})
+
+		// Invoices
+		r.Route("/api/invoices", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermInvoicesRead))
+				r.Get("/", ListInvoices)
+				r.Get("/{id}/", GetInvoice)
+				r.Get("/{id}/pdf/", GetInvoicePDF)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermInvoicesWrite))
+				r.Post("/orders/{orderID}/", IssueOrderInvoice)
+				r.Post("/{id}/send/", SendInvoice)
+			})
+		})
+
+		// Audit log
+		r.Route("/api/audit-log", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermAuditRead))
+			r.Get("/", ListAuditLog)
+		})
+
+		// Reconciliation of customers and vendors with Keycloak
+		r.Route("/api/keycloak", func(r chi.Router) {
+			r.Use(middlewares.AuthMiddleware)
+			r.Use(middlewares.Require(middlewares.PermKeycloakReconcile))
+			r.Post("/reconcile/", ReconcileKeycloak)
 		})
 
 		// Mail templates management
 		r.Route("/api/mail-templates", func(r chi.Router) {
 			r.Use(middlewares.AuthMiddleware)
-			r.Use(middlewares.AdminAuthMiddleware)
-			r.Get("/", ListMailTemplates)
-			r.Get("/{name}/", GetMailTemplate)
 			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermMailTemplatesRead))
+				r.Get("/", ListMailTemplates)
+				r.Get("/{name}/", GetMailTemplate)
+			})
+			r.Group(func(r chi.Router) {
+				r.Use(middlewares.Require(middlewares.PermMailTemplatesWrite))
 				r.Post("/", CreateOrUpdateMailTemplate)
 				r.Post("/{name}/send/", SendMailTemplateTest)
 				r.Delete("/{name}/", DeleteMailTemplate)
 			})
 		})
 
-		// Mount static file servers in img folder
-		fsImg := http.FileServer(http.Dir("img"))
-		r.Handle("/img/*", http.StripPrefix("/img/", fsImg))
+		// Serve uploaded images and the custom style.css from the storage
+		r.Get("/img/*", storedFiles("img"))
+		r.Get("/public/*", storedFiles("public"))
 
-		// Mount style.css file server and always serve the same file
-		fsCSS := http.FileServer(http.Dir("public"))
-		r.Handle("/public/*", http.StripPrefix("/public/", fsCSS))
+		// Signed, expiring URLs of the local storage backend
+		r.Get("/api/files/*", ServeSignedFile)
 
 	})
 
 	return r
 }
+
+// mountIntegrationRoutes mounts the vendor and payout routes used by the
+// Flour and Odoo integrations
+func mountIntegrationRoutes(r chi.Router) {
+	r.Route("/vendors", func(r chi.Router) {
+		r.Use(middlewares.Require(middlewares.PermIntegrationVendors))
+		r.Get("/license/{licenseID}/", GetVendorByLicenseID)
+		r.Get("/{id}/", GetVendor)
+		r.Put("/license/{licenseID}/", UpdateVendorByLicenseID)
+		r.Put("/{id}/", UpdateVendor)
+		r.Delete("/{id}/", DeleteVendor)
+		r.Post("/", CreateVendor)
+	})
+	r.Route("/payments", func(r chi.Router) {
+		r.With(middlewares.Require(middlewares.PermIntegrationPayouts)).Post("/payout/", CreatePaymentPayout)
+	})
+}
@@ -16,7 +16,7 @@ func TestFlourResendEndpointRouted(t *testing.T) {
 
 	r := GetRouter()
 
-	// Without auth headers, expect 401 due to FlourAuthMiddleware/AuthMiddleware
+	// Without auth headers, expect 401 due to AuthMiddleware
 	req := httptest.NewRequest(http.MethodPost, "/api/flour/payments/resend/123/", nil)
 	rr := httptest.NewRecorder()
 	r.ServeHTTP(rr, req)
@@ -0,0 +1,174 @@
+// Package imaging validates uploaded images and prepares them for the web.
+// Uploads are decoded and re-encoded, which drops EXIF and GPS metadata,
+// and resized into standard variants that are also encoded as WebP.
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	_ "image/gif" // Register the GIF decoder
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, use png, jpeg or gif")
+	ErrTooLarge          = errors.New("image dimensions are too large")
+)
+
+// MaxPixels limits the size of decoded images
+const MaxPixels = 40_000_000
+
+// JPEGQuality is used for re-encoded photos
+const JPEGQuality = 85
+
+// Formats of encoded images
+const (
+	FormatPNG  = "png"
+	FormatJPEG = "jpeg"
+	FormatWebP = "webp"
+)
+
+// Variant is a standard size for uploaded images. Images are scaled down
+// to fit, never up. A bound of 0 leaves the dimension unbounded.
+type Variant struct {
+	Name      string
+	MaxWidth  int
+	MaxHeight int
+}
+
+// Variants are created for every upload
+var Variants = []Variant{
+	{Name: "thumbnail", MaxWidth: 200, MaxHeight: 200},
+	{Name: "shop", MaxWidth: 800, MaxHeight: 800},
+	{Name: "email", MaxWidth: 600},
+}
+
+// File is an encoded image
+type File struct {
+	Name   string // Variant name, empty for the original
+	Format string
+	Data   []byte
+	Width  int
+	Height int
+}
+
+// Ext returns the file extension of the format including the dot
+func (f File) Ext() string {
+	if f.Format == FormatJPEG {
+		return ".jpg"
+	}
+	return "." + f.Format
+}
+
+// ContentType returns the MIME type of the format
+func (f File) ContentType() string {
+	return "image/" + f.Format
+}
+
+// Result is a processed upload
+type Result struct {
+	Original File
+	Variants []File // Each variant in the format of the original and as WebP
+}
+
+// Process decodes an upload, applies its EXIF orientation and re-encodes
+// it together with all variants. The content decides the format, not the
+// file name. format forces the format of the original and the variants;
+// if empty, PNG and GIF uploads and images with transparency become PNG
+// and all others JPEG.
+func Process(data []byte, format string) (*Result, error) {
+	switch http.DetectContentType(data) {
+	case "image/png", "image/jpeg", "image/gif":
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	config, sourceFormat, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > MaxPixels {
+		return nil, ErrTooLarge
+	}
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	img := toRGBA(decoded)
+	if sourceFormat == "jpeg" {
+		img = orient(img, jpegOrientation(data))
+	}
+	if format == "" {
+		format = FormatJPEG
+		if sourceFormat != "jpeg" || !opaque(img) {
+			format = FormatPNG
+		}
+	}
+
+	original, err := encode(img, format)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Original: original}
+	for _, variant := range Variants {
+		scaled := fit(img, variant.MaxWidth, variant.MaxHeight)
+		for _, f := range []string{format, FormatWebP} {
+			file, err := encode(scaled, f)
+			if err != nil {
+				return nil, err
+			}
+			file.Name = variant.Name
+			result.Variants = append(result.Variants, file)
+		}
+	}
+	return result, nil
+}
+
+func encode(img *image.RGBA, format string) (File, error) {
+	var buf bytes.Buffer
+	var err error
+	switch format {
+	case FormatPNG:
+		err = png.Encode(&buf, img)
+	case FormatJPEG:
+		err = jpeg.Encode(&buf, flatten(img), &jpeg.Options{Quality: JPEGQuality})
+	case FormatWebP:
+		err = EncodeWebP(&buf, img)
+	default:
+		err = ErrUnsupportedFormat
+	}
+	b := img.Bounds()
+	return File{Format: format, Data: buf.Bytes(), Width: b.Dx(), Height: b.Dy()}, err
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, b.Min, draw.Src)
+	return rgba
+}
+
+func opaque(img *image.RGBA) bool {
+	for i := 3; i < len(img.Pix); i += 4 {
+		if img.Pix[i] != 0xff {
+			return false
+		}
+	}
+	return true
+}
+
+// flatten puts transparent areas on white, as JPEG has no alpha channel
+func flatten(img *image.RGBA) *image.RGBA {
+	if opaque(img) {
+		return img
+	}
+	flat := image.NewRGBA(img.Rect)
+	draw.Draw(flat, flat.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Rect, img, image.Point{}, draw.Over)
+	return flat
+}
@@ -0,0 +1,139 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestJPEG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{uint8(x), uint8(y), 100, 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	return buf.Bytes()
+}
+
+// withExif inserts an APP1 segment with an orientation and GPS marker
+// after the start of image
+func withExif(jpegData []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	ifd := make([]byte, 2+12+4)
+	binary.BigEndian.PutUint16(ifd, 1)
+	binary.BigEndian.PutUint16(ifd[2:], exifOrientationTag)
+	binary.BigEndian.PutUint16(ifd[4:], 3) // SHORT
+	binary.BigEndian.PutUint32(ifd[6:], 1)
+	binary.BigEndian.PutUint16(ifd[10:], orientation)
+	payload := append([]byte("Exif\x00\x00"), append(tiff, ifd...)...)
+	payload = append(payload, []byte("GPS 48.2082N 16.3738E")...)
+	segment := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+	return append(append([]byte{0xff, 0xd8}, segment...), jpegData[2:]...)
+}
+
+func TestProcessJPEG(t *testing.T) {
+	data := withExif(encodeTestJPEG(t, 1200, 900), 6)
+	require.Equal(t, 6, jpegOrientation(data))
+
+	result, err := Process(data, "")
+	require.NoError(t, err)
+	require.Equal(t, FormatJPEG, result.Original.Format)
+	// The orientation is applied, so the portrait photo stays upright
+	require.Equal(t, 900, result.Original.Width)
+	require.Equal(t, 1200, result.Original.Height)
+	require.NotContains(t, string(result.Original.Data), "Exif")
+	require.NotContains(t, string(result.Original.Data), "GPS")
+	require.Equal(t, 1, jpegOrientation(result.Original.Data))
+
+	sizes := map[string][2]int{}
+	for _, f := range result.Variants {
+		sizes[f.Name+f.Ext()] = [2]int{f.Width, f.Height}
+	}
+	require.Equal(t, map[string][2]int{
+		"thumbnail.jpg":  {150, 200},
+		"thumbnail.webp": {150, 200},
+		"shop.jpg":       {600, 800},
+		"shop.webp":      {600, 800},
+		"email.jpg":      {600, 800},
+		"email.webp":     {600, 800},
+	}, sizes)
+	for _, f := range result.Variants {
+		if f.Format == FormatJPEG {
+			config, err := jpeg.DecodeConfig(bytes.NewReader(f.Data))
+			require.NoError(t, err)
+			require.Equal(t, f.Width, config.Width)
+		}
+	}
+}
+
+func TestProcessPNG(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 100, 50))
+	img.Set(10, 10, color.NRGBA{255, 0, 0, 128})
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	// Transparent images stay PNG even if JPEG is the default for photos
+	result, err := Process(buf.Bytes(), "")
+	require.NoError(t, err)
+	require.Equal(t, FormatPNG, result.Original.Format)
+	require.Equal(t, "image/png", result.Original.ContentType())
+	decoded, err := png.Decode(bytes.NewReader(result.Original.Data))
+	require.NoError(t, err)
+	require.Equal(t, color.NRGBA{255, 0, 0, 128}, color.NRGBAModel.Convert(decoded.At(10, 10)))
+	// Small images are not scaled up
+	for _, f := range result.Variants {
+		require.Equal(t, 100, f.Width)
+	}
+
+	// Photos can be forced to PNG, e.g. for the logo
+	result, err = Process(encodeTestJPEG(t, 10, 10), FormatPNG)
+	require.NoError(t, err)
+	require.Equal(t, FormatPNG, result.Original.Format)
+}
+
+func TestProcessRejectsNonImages(t *testing.T) {
+	for _, data := range [][]byte{
+		[]byte("i am the content of a jpg file :D"),
+		[]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>"),
+		[]byte("%PDF-1.4"),
+		{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n', 0, 0},
+	} {
+		_, err := Process(data, "")
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// 2x1 image: red, green
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	red, green := color.RGBA{255, 0, 0, 255}, color.RGBA{0, 255, 0, 255}
+	img.Set(0, 0, red)
+	img.Set(1, 0, green)
+	for orientation, want := range map[int][]color.RGBA{
+		1: {red, green},
+		2: {green, red},
+		3: {green, red},
+		6: {red, green}, // Now 1x2, top to bottom
+		8: {green, red},
+	} {
+		rotated := orient(img, orientation)
+		var got []color.RGBA
+		for y := 0; y < rotated.Rect.Dy(); y++ {
+			for x := 0; x < rotated.Rect.Dx(); x++ {
+				got = append(got, rotated.RGBAAt(x, y))
+			}
+		}
+		require.Equal(t, want, got, orientation)
+	}
+}
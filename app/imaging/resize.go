@@ -0,0 +1,104 @@
+package imaging
+
+import "image"
+
+// fit scales img down to fit into maxWidth x maxHeight keeping the aspect
+// ratio. Images that already fit are returned unchanged.
+func fit(img *image.RGBA, maxWidth, maxHeight int) *image.RGBA {
+	width, height := img.Rect.Dx(), img.Rect.Dy()
+	scale := 1.0
+	if maxWidth > 0 && width > maxWidth {
+		scale = float64(maxWidth) / float64(width)
+	}
+	if maxHeight > 0 && height > maxHeight {
+		scale = min(scale, float64(maxHeight)/float64(height))
+	}
+	if scale >= 1 {
+		return img
+	}
+	return resize(img, max(1, int(float64(width)*scale+0.5)), max(1, int(float64(height)*scale+0.5)))
+}
+
+// resize scales img down with a box filter, averaging all source pixels
+// that fall into a target pixel. Premultiplied alpha keeps transparent
+// pixels from bleeding their color into the edges.
+func resize(img *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := img.Rect.Dx(), img.Rect.Dy()
+	xs := boxBounds(srcWidth, width)
+	ys := boxBounds(srcHeight, height)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var sum [4]int
+			n := 0
+			for sy := ys[y]; sy < ys[y+1]; sy++ {
+				row := img.Pix[sy*img.Stride:]
+				for sx := xs[x]; sx < xs[x+1]; sx++ {
+					p := row[sx*4 : sx*4+4]
+					sum[0] += int(p[0])
+					sum[1] += int(p[1])
+					sum[2] += int(p[2])
+					sum[3] += int(p[3])
+					n++
+				}
+			}
+			d := dst.Pix[y*dst.Stride+x*4:]
+			for c := 0; c < 4; c++ {
+				d[c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// boxBounds returns for each of the n target pixels the first source
+// pixel, followed by the end of the last box
+func boxBounds(size, n int) []int {
+	bounds := make([]int, n+1)
+	for i := 0; i <= n; i++ {
+		bounds[i] = i * size / n
+	}
+	for i := 1; i <= n; i++ {
+		if bounds[i] <= bounds[i-1] {
+			bounds[i] = bounds[i-1] + 1
+		}
+	}
+	return bounds
+}
+
+// orient applies an EXIF orientation, so that the image is upright once
+// the metadata is gone
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	w, h := img.Rect.Dx(), img.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // Mirrored horizontally
+				sx, sy = w-1-x, y
+			case 3: // Rotated by 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // Mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // Transposed
+				sx, sy = y, x
+			case 6: // Needs a clockwise rotation
+				sx, sy = y, h-1-x
+			case 7: // Transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // Needs a counterclockwise rotation
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], img.Pix[sy*img.Stride+sx*4:])
+		}
+	}
+	return dst
+}
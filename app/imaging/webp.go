@@ -0,0 +1,465 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"sort"
+)
+
+// The encoder writes lossless WebP (VP8L) with the subtract green and
+// predictor transforms and a single group of prefix codes. It does not use
+// backward references or a color cache, which keeps it small at the cost of
+// larger files than libwebp produces.
+
+const (
+	vp8lSignature       = 0x2f
+	maxWebPDimension    = 1 << 14
+	webpPredictorBits   = 4 // Predictor modes are chosen per 16x16 block
+	numLiteralCodes     = 256
+	numLengthCodes      = 24
+	numDistanceCodes    = 40
+	maxCodeLength       = 15
+	maxCodeLengthLength = 7
+
+	transformPredictor    = 0
+	transformSubtractGrn  = 2
+	predictorLeft         = 1
+	predictorTop          = 2
+	predictorClampAddFull = 12
+)
+
+// codeLengthCodeOrder is the order in which the code lengths of the code
+// length code are written
+var codeLengthCodeOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// EncodeWebP writes img as lossless WebP
+func EncodeWebP(w io.Writer, img image.Image) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width < 1 || height < 1 || width > maxWebPDimension || height > maxWebPDimension {
+		return errors.New("imaging: image size not supported by webp")
+	}
+	nrgba := toNRGBA(img)
+	argb := make([]uint32, width*height)
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			p := nrgba.Pix[y*nrgba.Stride+x*4:]
+			argb[y*width+x] = uint32(p[3])<<24 | uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+			if p[3] != 0xff {
+				hasAlpha = true
+			}
+		}
+	}
+
+	bw := &bitWriter{}
+	bw.write(vp8lSignature, 8)
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	bw.writeBool(hasAlpha)
+	bw.write(0, 3) // Version
+
+	subtractGreen(argb)
+	bw.write(1, 1)
+	bw.write(transformSubtractGrn, 2)
+
+	modes, residuals := predict(argb, width, height)
+	bw.write(1, 1)
+	bw.write(transformPredictor, 2)
+	bw.write(webpPredictorBits-2, 3)
+	writeEntropyImage(bw, modes, false)
+
+	bw.write(0, 1) // No further transforms
+	writeEntropyImage(bw, residuals, true)
+
+	payload := bw.bytes()
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(12+len(payload)+len(payload)%2))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(payload)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if len(payload)%2 == 1 {
+		payload = append(payload, 0)
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+func toNRGBA(img image.Image) *image.NRGBA {
+	if n, ok := img.(*image.NRGBA); ok && n.Rect.Min == (image.Point{}) {
+		return n
+	}
+	b := img.Bounds()
+	n := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(n, n.Rect, img, b.Min, draw.Src)
+	return n
+}
+
+// subtractGreen subtracts the green channel from red and blue
+func subtractGreen(argb []uint32) {
+	for i, p := range argb {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		b := (p - g) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | b
+	}
+}
+
+// subPixels subtracts each channel of b from a modulo 256
+func subPixels(a, b uint32) uint32 {
+	var out uint32
+	for shift := 0; shift < 32; shift += 8 {
+		out |= ((a>>shift - b>>shift) & 0xff) << shift
+	}
+	return out
+}
+
+func clampAddSubtractFull(a, b, c uint32) uint32 {
+	var out uint32
+	for shift := 0; shift < 32; shift += 8 {
+		v := int(a>>shift&0xff) + int(b>>shift&0xff) - int(c>>shift&0xff)
+		if v < 0 {
+			v = 0
+		} else if v > 255 {
+			v = 255
+		}
+		out |= uint32(v) << shift
+	}
+	return out
+}
+
+// predictPixel returns the prediction of a pixel that is neither in the
+// first row nor in the first column
+func predictPixel(argb []uint32, i, width, mode int) uint32 {
+	switch mode {
+	case predictorLeft:
+		return argb[i-1]
+	case predictorTop:
+		return argb[i-width]
+	default:
+		return clampAddSubtractFull(argb[i-1], argb[i-width], argb[i-width-1])
+	}
+}
+
+// residualCost estimates the bits needed for a residual
+func residualCost(r uint32) int {
+	cost := 0
+	for shift := 0; shift < 32; shift += 8 {
+		v := int(int8(r >> shift))
+		if v < 0 {
+			v = -v
+		}
+		cost += v
+	}
+	return cost
+}
+
+// predict chooses a predictor per block and returns the block modes as
+// sub-image together with the residuals
+func predict(argb []uint32, width, height int) (modes, residuals []uint32) {
+	blocksX := subSampleSize(width, webpPredictorBits)
+	blocksY := subSampleSize(height, webpPredictorBits)
+	modes = make([]uint32, blocksX*blocksY)
+	blockModes := make([]int, blocksX*blocksY)
+	for by := 0; by < blocksY; by++ {
+		for bx := 0; bx < blocksX; bx++ {
+			best, bestCost := predictorLeft, -1
+			for _, mode := range []int{predictorLeft, predictorTop, predictorClampAddFull} {
+				cost := 0
+				for y := max(by<<webpPredictorBits, 1); y < min((by+1)<<webpPredictorBits, height); y++ {
+					for x := max(bx<<webpPredictorBits, 1); x < min((bx+1)<<webpPredictorBits, width); x++ {
+						i := y*width + x
+						cost += residualCost(subPixels(argb[i], predictPixel(argb, i, width, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			blockModes[by*blocksX+bx] = best
+			modes[by*blocksX+bx] = uint32(best) << 8 // The mode is stored in the green channel
+		}
+	}
+
+	residuals = make([]uint32, len(argb))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			i := y*width + x
+			var prediction uint32
+			switch {
+			case x == 0 && y == 0:
+				prediction = 0xff000000
+			case y == 0:
+				prediction = argb[i-1]
+			case x == 0:
+				prediction = argb[i-width]
+			default:
+				mode := blockModes[(y>>webpPredictorBits)*blocksX+x>>webpPredictorBits]
+				prediction = predictPixel(argb, i, width, mode)
+			}
+			residuals[i] = subPixels(argb[i], prediction)
+		}
+	}
+	return modes, residuals
+}
+
+func subSampleSize(size, bits int) int {
+	return (size + 1<<bits - 1) >> bits
+}
+
+// writeEntropyImage writes pixels with a single group of prefix codes.
+// Only the main image has the flag for meta prefix codes.
+func writeEntropyImage(bw *bitWriter, pixels []uint32, main bool) {
+	bw.write(0, 1) // No color cache
+	if main {
+		bw.write(0, 1) // No meta prefix codes
+	}
+	green := make([]int, numLiteralCodes+numLengthCodes)
+	red := make([]int, numLiteralCodes)
+	blue := make([]int, numLiteralCodes)
+	alpha := make([]int, numLiteralCodes)
+	for _, p := range pixels {
+		green[p>>8&0xff]++
+		red[p>>16&0xff]++
+		blue[p&0xff]++
+		alpha[p>>24]++
+	}
+	codes := []*prefixCode{
+		newPrefixCode(green, maxCodeLength),
+		newPrefixCode(red, maxCodeLength),
+		newPrefixCode(blue, maxCodeLength),
+		newPrefixCode(alpha, maxCodeLength),
+		newPrefixCode(make([]int, numDistanceCodes), maxCodeLength),
+	}
+	for _, code := range codes {
+		code.writeTo(bw)
+	}
+	for _, p := range pixels {
+		codes[0].writeSymbol(bw, int(p>>8&0xff))
+		codes[1].writeSymbol(bw, int(p>>16&0xff))
+		codes[2].writeSymbol(bw, int(p&0xff))
+		codes[3].writeSymbol(bw, int(p>>24))
+	}
+}
+
+// prefixCode is a canonical Huffman code
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32 // Bit reversed, as they are written least significant bit first
+	symbols []int    // Used symbols
+}
+
+// newPrefixCode builds the code for a histogram. Codes with a single
+// symbol use zero bits per symbol.
+func newPrefixCode(histogram []int, maxLength int) *prefixCode {
+	c := &prefixCode{}
+	for symbol, count := range histogram {
+		if count > 0 {
+			c.symbols = append(c.symbols, symbol)
+		}
+	}
+	if len(c.symbols) == 0 {
+		c.symbols = []int{0}
+	}
+	if len(c.symbols) == 1 {
+		c.lengths = make([]uint8, len(histogram))
+		c.lengths[c.symbols[0]] = 1
+		c.codes = make([]uint32, len(histogram))
+		return c
+	}
+	c.lengths = huffmanLengths(histogram, maxLength)
+	c.codes = canonicalCodes(c.lengths)
+	return c
+}
+
+// simple reports whether the code can be written in the short form for
+// one or two symbols below 256
+func (c *prefixCode) simple() bool {
+	return len(c.symbols) <= 2 && c.symbols[len(c.symbols)-1] < 256
+}
+
+func (c *prefixCode) writeSymbol(bw *bitWriter, symbol int) {
+	if len(c.symbols) > 1 {
+		bw.write(c.codes[symbol], uint(c.lengths[symbol]))
+	}
+}
+
+func (c *prefixCode) writeTo(bw *bitWriter) {
+	if c.simple() {
+		bw.write(1, 1)
+		bw.write(uint32(len(c.symbols)-1), 1)
+		bw.write(1, 1) // The first symbol uses 8 bits
+		for _, symbol := range c.symbols {
+			bw.write(uint32(symbol), 8)
+		}
+		return
+	}
+	bw.write(0, 1)
+	histogram := make([]int, len(codeLengthCodeOrder))
+	for _, length := range c.lengths {
+		histogram[length]++
+	}
+	lengthCode := newPrefixCode(histogram, maxCodeLengthLength)
+	count := 4
+	for i, symbol := range codeLengthCodeOrder {
+		if lengthCode.lengths[symbol] != 0 && i+1 > count {
+			count = i + 1
+		}
+	}
+	bw.write(uint32(count-4), 4)
+	for _, symbol := range codeLengthCodeOrder[:count] {
+		bw.write(uint32(lengthCode.lengths[symbol]), 3)
+	}
+	bw.write(0, 1) // Code lengths for the whole alphabet follow
+	for _, length := range c.lengths {
+		lengthCode.writeSymbol(bw, int(length))
+	}
+}
+
+// huffmanLengths returns code lengths of at most maxLength bits. Rare
+// symbols are made more frequent until the tree is shallow enough.
+func huffmanLengths(histogram []int, maxLength int) []uint8 {
+	counts := append([]int(nil), histogram...)
+	for minCount := 1; ; minCount *= 2 {
+		lengths := huffmanTree(counts)
+		deepest := uint8(0)
+		for _, l := range lengths {
+			deepest = max(deepest, l)
+		}
+		if int(deepest) <= maxLength {
+			return lengths
+		}
+		for i, count := range counts {
+			if count > 0 && count < minCount {
+				counts[i] = minCount
+			}
+		}
+	}
+}
+
+// huffmanTree returns the depths of the leaves of a Huffman tree for at
+// least two used symbols
+func huffmanTree(counts []int) []uint8 {
+	type node struct {
+		weight int
+		parent int
+	}
+	var nodes []node
+	var leaves []int // Symbol of each leaf node
+	for symbol, count := range counts {
+		if count > 0 {
+			nodes = append(nodes, node{weight: count, parent: -1})
+			leaves = append(leaves, symbol)
+		}
+	}
+	order := make([]int, len(nodes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return nodes[order[a]].weight < nodes[order[b]].weight })
+
+	// Two queue construction: leaves sorted by weight and internal nodes in
+	// the order they are created, which is also sorted by weight
+	var internal []int
+	pop := func() int {
+		if len(internal) == 0 || (len(order) > 0 && nodes[order[0]].weight <= nodes[internal[0]].weight) {
+			n := order[0]
+			order = order[1:]
+			return n
+		}
+		n := internal[0]
+		internal = internal[1:]
+		return n
+	}
+	for len(order)+len(internal) > 1 {
+		a, b := pop(), pop()
+		nodes = append(nodes, node{weight: nodes[a].weight + nodes[b].weight, parent: -1})
+		nodes[a].parent = len(nodes) - 1
+		nodes[b].parent = len(nodes) - 1
+		internal = append(internal, len(nodes)-1)
+	}
+
+	lengths := make([]uint8, len(counts))
+	for i, symbol := range leaves {
+		depth := uint8(0)
+		for n := i; nodes[n].parent >= 0; n = nodes[n].parent {
+			depth++
+		}
+		lengths[symbol] = depth
+	}
+	return lengths
+}
+
+// canonicalCodes assigns codes ordered by length and symbol, as the
+// decoder reconstructs them from the lengths
+func canonicalCodes(lengths []uint8) []uint32 {
+	var lengthCount [maxCodeLength + 1]int
+	for _, l := range lengths {
+		if l > 0 {
+			lengthCount[l]++
+		}
+	}
+	var next [maxCodeLength + 1]uint32
+	code := uint32(0)
+	for l := 1; l <= maxCodeLength; l++ {
+		code = (code + uint32(lengthCount[l-1])) << 1
+		next[l] = code
+	}
+	codes := make([]uint32, len(lengths))
+	for symbol, l := range lengths {
+		if l == 0 {
+			continue
+		}
+		codes[symbol] = reverseBits(next[l], l)
+		next[l]++
+	}
+	return codes
+}
+
+func reverseBits(v uint32, n uint8) uint32 {
+	var r uint32
+	for i := uint8(0); i < n; i++ {
+		r = r<<1 | v&1
+		v >>= 1
+	}
+	return r
+}
+
+// bitWriter packs bits least significant bit first
+type bitWriter struct {
+	buf   []byte
+	acc   uint64
+	nbits uint
+}
+
+func (b *bitWriter) write(v uint32, n uint) {
+	b.acc |= uint64(v) << b.nbits
+	b.nbits += n
+	for b.nbits >= 8 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc >>= 8
+		b.nbits -= 8
+	}
+}
+
+func (b *bitWriter) writeBool(v bool) {
+	if v {
+		b.write(1, 1)
+	} else {
+		b.write(0, 1)
+	}
+}
+
+func (b *bitWriter) bytes() []byte {
+	if b.nbits > 0 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc, b.nbits = 0, 0
+	}
+	return b.buf
+}
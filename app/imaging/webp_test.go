@@ -0,0 +1,74 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/webp"
+)
+
+// TestEncodeWebP checks that images survive a round trip through the
+// lossless WebP decoder of golang.org/x/image
+func TestEncodeWebP(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	images := map[string]*image.NRGBA{}
+
+	gradient := image.NewNRGBA(image.Rect(0, 0, 53, 37))
+	for y := 0; y < 37; y++ {
+		for x := 0; x < 53; x++ {
+			gradient.Set(x, y, color.NRGBA{uint8(x * 4), uint8(y * 6), uint8(x + y), 255})
+		}
+	}
+	images["gradient"] = gradient
+
+	noise := image.NewNRGBA(image.Rect(0, 0, 40, 33))
+	rnd.Read(noise.Pix)
+	images["noise with alpha"] = noise
+
+	flat := image.NewNRGBA(image.Rect(0, 0, 20, 20))
+	for i := range flat.Pix {
+		flat.Pix[i] = 200
+	}
+	images["single color"] = flat
+	images["one pixel"] = image.NewNRGBA(image.Rect(0, 0, 1, 1))
+
+	photo := image.NewNRGBA(image.Rect(0, 0, 64, 48))
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 64; x++ {
+			photo.Set(x, y, color.NRGBA{uint8(x*3 + rnd.Intn(8)), uint8(y*5 + rnd.Intn(8)), uint8(128 + rnd.Intn(16)), 255})
+		}
+	}
+	images["noisy gradient"] = photo
+
+	for name, img := range images {
+		var buf bytes.Buffer
+		require.NoError(t, EncodeWebP(&buf, img), name)
+		decoded, err := webp.Decode(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err, name)
+		require.IsType(t, &image.NRGBA{}, decoded, name)
+		require.Equal(t, img.Rect, decoded.Bounds(), name)
+		require.Equal(t, img.Pix, decoded.(*image.NRGBA).Pix, name)
+	}
+}
+
+func TestHuffmanLengthsAreLimited(t *testing.T) {
+	// Fibonacci counts produce the deepest possible trees
+	counts := make([]int, 40)
+	a, b := 1, 1
+	for i := range counts {
+		counts[i] = a
+		a, b = b, a+b
+	}
+	lengths := huffmanLengths(counts, 15)
+	kraft := 0
+	for _, l := range lengths {
+		require.LessOrEqual(t, l, uint8(15))
+		require.Greater(t, l, uint8(0))
+		kraft += 1 << (15 - l)
+	}
+	require.Equal(t, 1<<15, kraft)
+}
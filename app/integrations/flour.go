@@ -92,31 +92,40 @@ func sendJSONToFlourWebhook(payload interface{}) error {
 type FlourPayload struct {
 	OrderID   int                `json:"order_id"`
 	Price     int                `json:"price"`
+	Net       int                `json:"net"`
+	Tax       int                `json:"tax"`
 	LicenseID null.String        `json:"license_id"`
 	Timestamp time.Time          `json:"timestamp"`
 	Items     []FlourPayloadItem `json:"items"`
 }
 
+// FlourPayloadItem is an order entry. Net and Tax are the amounts of the
+// whole entry in cents.
 type FlourPayloadItem struct {
-	ID       int    `json:"id"`
-	Quantity int    `json:"quantity"`
-	Price    int    `json:"price"`
-	Name     string `json:"name,omitempty"`
+	ID       int     `json:"id"`
+	Quantity int     `json:"quantity"`
+	Price    int     `json:"price"`
+	VATRate  float64 `json:"vat_rate"`
+	Net      int     `json:"net"`
+	Tax      int     `json:"tax"`
+	Name     string  `json:"name,omitempty"`
 }
 
 func SendPaymentToFlour(id int, timestamp time.Time, items []database.OrderEntry, vendor database.Vendor, price int) error {
 	log.Info("SendPaymentToFlour: Sending payment to Flour for order ", id)
 	flourItems := make([]FlourPayloadItem, 0)
-	totalPrice := 0
+	totalPrice, totalNet, totalTax := 0, 0, 0
 	for _, item := range items {
-		itemPrice := item.Price
+		itemPrice, itemNet, itemTax := item.Price, item.Net, item.Tax
 		// If the receiver of the OrderEntry is not the Vendor, the price should be negative
 		log.Debugf("SendPaymentToFlour: Processing item %+v for vendor %+v", item, vendor)
 		if item.Receiver <= 5 { // system accounts have IDs 1-5
-			itemPrice = -itemPrice
+			itemPrice, itemNet, itemTax = -itemPrice, -itemNet, -itemTax
 		}
 		// Total price includes the quantity: price * quantity
 		totalPrice += itemPrice * item.Quantity
+		totalNet += itemNet
+		totalTax += itemTax
 
 		itemName := ""
 		if dbItem, err := getItemByID(item.Item); err != nil {
@@ -129,6 +138,9 @@ func SendPaymentToFlour(id int, timestamp time.Time, items []database.OrderEntry
 			ID:       item.Item,
 			Quantity: item.Quantity,
 			Price:    itemPrice,
+			VATRate:  item.VATRate,
+			Net:      itemNet,
+			Tax:      itemTax,
 			Name:     itemName,
 		})
 	}
@@ -136,6 +148,8 @@ func SendPaymentToFlour(id int, timestamp time.Time, items []database.OrderEntry
 	payload := FlourPayload{
 		OrderID:   id,
 		Price:     totalPrice,
+		Net:       totalNet,
+		Tax:       totalTax,
 		LicenseID: vendor.LicenseID,
 		Timestamp: timestamp,
 		Items:     flourItems,
@@ -80,30 +80,39 @@ func sendJSONToOdooWebhook(payload interface{}) error {
 type OdooPayload struct {
 	OrderID   int               `json:"order_id"`
 	Price     int               `json:"price"`
+	Net       int               `json:"net"`
+	Tax       int               `json:"tax"`
 	LicenseID null.String       `json:"license_id"`
 	Timestamp time.Time         `json:"timestamp"`
 	Items     []OdooPayloadItem `json:"items"`
 }
 
+// OdooPayloadItem is an order entry. Net and Tax are the amounts of the
+// whole entry in cents.
 type OdooPayloadItem struct {
-	ID       int    `json:"id"`
-	Quantity int    `json:"quantity"`
-	Price    int    `json:"price"`
-	Name     string `json:"name,omitempty"`
-	Type     string `json:"type,omitempty"`
+	ID       int     `json:"id"`
+	Quantity int     `json:"quantity"`
+	Price    int     `json:"price"`
+	VATRate  float64 `json:"vat_rate"`
+	Net      int     `json:"net"`
+	Tax      int     `json:"tax"`
+	Name     string  `json:"name,omitempty"`
+	Type     string  `json:"type,omitempty"`
 }
 
 func SendPaymentToOdoo(id int, timestamp time.Time, items []database.OrderEntry, vendor database.Vendor, price int) error {
 	log.Info("SendPaymentToOdoo: Sending payment to Odoo for order ", id)
 	odooItems := make([]OdooPayloadItem, 0)
-	totalPrice := 0
+	totalPrice, totalNet, totalTax := 0, 0, 0
 	for _, item := range items {
-		itemPrice := item.Price
+		itemPrice, itemNet, itemTax := item.Price, item.Net, item.Tax
 		log.Debugf("SendPaymentToOdoo: Processing item %+v for vendor %+v", item, vendor)
 		if item.Receiver <= 5 {
-			itemPrice = -itemPrice
+			itemPrice, itemNet, itemTax = -itemPrice, -itemNet, -itemTax
 		}
 		totalPrice += itemPrice * item.Quantity
+		totalNet += itemNet
+		totalTax += itemTax
 
 		itemName := ""
 		itemType := ""
@@ -125,6 +134,9 @@ func SendPaymentToOdoo(id int, timestamp time.Time, items []database.OrderEntry,
 			ID:       item.Item,
 			Quantity: odooQuantity,
 			Price:    odooPrice,
+			VATRate:  item.VATRate,
+			Net:      itemNet,
+			Tax:      itemTax,
 			Name:     itemName,
 			Type:     itemType,
 		})
@@ -132,6 +144,8 @@ func SendPaymentToOdoo(id int, timestamp time.Time, items []database.OrderEntry,
 	payload := OdooPayload{
 		OrderID:   id,
 		Price:     totalPrice,
+		Net:       totalNet,
+		Tax:       totalTax,
 		LicenseID: vendor.LicenseID,
 		Timestamp: timestamp,
 		Items:     odooItems,
@@ -41,6 +41,10 @@ func TestSendPaymentToOdooSuccess(t *testing.T) {
 		require.Equal(t, 2, receivedPayload.Items[0].Quantity)
 		require.Equal(t, 2500, receivedPayload.Items[0].Price)
 		require.Equal(t, "Test Item", receivedPayload.Items[0].Name)
+		require.Equal(t, 10.0, receivedPayload.Items[0].VATRate)
+		require.Equal(t, 4545, receivedPayload.Items[0].Net)
+		require.Equal(t, 455, receivedPayload.Items[0].Tax)
+		require.Equal(t, 455, receivedPayload.Tax)
 		require.Equal(t, 2, receivedPayload.Items[1].ID)
 		require.Equal(t, 1, receivedPayload.Items[1].Quantity)
 		require.Equal(t, 2500, receivedPayload.Items[1].Price)
@@ -63,6 +67,10 @@ func TestSendPaymentToOdooSuccess(t *testing.T) {
 			Quantity: 2,
 			Price:    2500,
 			Receiver: 6234,
+			VATRate:  10,
+			Net:      4545,
+			Tax:      455,
+			Gross:    5000,
 		},
 		{
 			ID:       2,
@@ -0,0 +1,249 @@
+package keycloak
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	// ErrJWKSUnavailable is returned if the signing keys of the realm could
+	// not be fetched yet
+	ErrJWKSUnavailable = errors.New("signing keys of the realm are unavailable")
+	// ErrUnknownSigningKey is returned if a token is signed by a key the
+	// realm does not publish
+	ErrUnknownSigningKey = errors.New("token is signed by an unknown key")
+)
+
+// JWKS caches the public signing keys of a realm. Keys are refetched after
+// the refresh interval and when a token names an unknown key, which happens
+// after Keycloak rotated its keys. If Keycloak is unreachable the previously
+// fetched keys are kept.
+type JWKS struct {
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+	// MinRefreshInterval limits refetching the keys for tokens with an
+	// unknown key ID, so forged tokens can not make us hammer Keycloak
+	MinRefreshInterval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]*rsa.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+// NewJWKS creates a key cache for the JWKS document at url
+func NewJWKS(url string, refreshInterval time.Duration) *JWKS {
+	return &JWKS{
+		url:                url,
+		client:             &http.Client{Timeout: 10 * time.Second},
+		refreshInterval:    refreshInterval,
+		MinRefreshInterval: 30 * time.Second,
+		keys:               map[string]*rsa.PublicKey{},
+	}
+}
+
+// jsonWebKey is a key of a JWKS document, see RFC 7517
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// Key returns the public key with the given key ID
+func (j *JWKS) Key(kid string) (*rsa.PublicKey, error) {
+	now := time.Now()
+	j.mu.RLock()
+	key, ok := j.keys[kid]
+	stale := now.Sub(j.fetchedAt) > j.refreshInterval
+	canRetry := now.Sub(j.lastAttempt) > j.MinRefreshInterval
+	j.mu.RUnlock()
+
+	if (stale || !ok) && canRetry {
+		if err := j.refresh(); err != nil {
+			log.Error("JWKS: refreshing signing keys failed: ", err)
+		}
+		j.mu.RLock()
+		key, ok = j.keys[kid]
+		j.mu.RUnlock()
+	}
+	if ok {
+		return key, nil
+	}
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if len(j.keys) == 0 {
+		return nil, ErrJWKSUnavailable
+	}
+	return nil, ErrUnknownSigningKey
+}
+
+// refresh fetches the keys and replaces the cached ones
+func (j *JWKS) refresh() error {
+	j.mu.Lock()
+	// Another request may have refreshed the keys in the meantime
+	if !j.lastAttempt.IsZero() && time.Since(j.lastAttempt) <= j.MinRefreshInterval {
+		j.mu.Unlock()
+		return nil
+	}
+	j.lastAttempt = time.Now()
+	j.mu.Unlock()
+
+	res, err := j.client.Get(j.url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching %s: unexpected status %d", j.url, res.StatusCode)
+	}
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&document); err != nil {
+		return err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, k := range document.Keys {
+		// Keycloak also publishes encryption keys, only signing keys verify tokens
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+			continue
+		}
+		key, err := parseRSAPublicKey(k)
+		if err != nil {
+			log.Error("JWKS: skipping key ", k.Kid, ": ", err)
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return errors.New("JWKS contains no RSA signing keys")
+	}
+
+	j.mu.Lock()
+	j.keys = keys
+	j.fetchedAt = time.Now()
+	j.mu.Unlock()
+	return nil
+}
+
+func parseRSAPublicKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// TokenClaims are the claims of a Keycloak access token
+type TokenClaims struct {
+	jwt.RegisteredClaims
+	Type              string `json:"typ"`
+	AuthorizedParty   string `json:"azp"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+	RealmAccess       struct {
+		Roles []string `json:"roles"`
+	} `json:"realm_access"`
+	// Groups is only set if the client has a group membership mapper,
+	// otherwise the groups have to be fetched from the admin API
+	Groups *[]string `json:"groups"`
+}
+
+// GroupNames returns the names of the groups in the token. Group mappers
+// may add the full path of a group, e.g. /customer/newspapers, of which
+// only the name is kept like in GetUserGroups.
+func (c *TokenClaims) GroupNames() []string {
+	if c.Groups == nil {
+		return nil
+	}
+	names := make([]string, 0, len(*c.Groups))
+	for _, group := range *c.Groups {
+		if i := strings.LastIndex(group, "/"); i >= 0 {
+			group = group[i+1:]
+		}
+		if group != "" {
+			names = append(names, group)
+		}
+	}
+	return names
+}
+
+// TokenVerifier verifies access tokens locally against the signing keys of
+// the realm
+type TokenVerifier struct {
+	JWKS   *JWKS
+	Issuer string
+	// ClientID of the backend, tokens must name it in aud or azp. Tokens
+	// of other clients need an audience mapper for it in Keycloak.
+	ClientID string
+	// Leeway allows for clock skew between Keycloak and us
+	Leeway time.Duration
+}
+
+// NewTokenVerifier creates a verifier for tokens of the given realm issued
+// for clientID. If issuer is empty the iss claim is not checked.
+func NewTokenVerifier(hostname, realm, issuer, clientID string, refreshInterval time.Duration) *TokenVerifier {
+	certsURL := strings.TrimRight(hostname, "/") + "/realms/" + realm + "/protocol/openid-connect/certs"
+	return &TokenVerifier{
+		JWKS:     NewJWKS(certsURL, refreshInterval),
+		Issuer:   issuer,
+		ClientID: clientID,
+		Leeway:   30 * time.Second,
+	}
+}
+
+// Verify checks the signature, expiry, issuer and audience of an access
+// token and returns its claims
+func (v *TokenVerifier) Verify(token string) (*TokenClaims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(v.Leeway),
+	}
+	if v.Issuer != "" {
+		options = append(options, jwt.WithIssuer(v.Issuer))
+	}
+	claims := &TokenClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.JWKS.Key(kid)
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	// Refresh and ID tokens are signed by the same keys
+	if claims.Type != "" && !strings.EqualFold(claims.Type, "Bearer") {
+		return nil, fmt.Errorf("token of type %s is not an access token", claims.Type)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+	// Tokens of other clients of the realm are signed by the same keys
+	if v.ClientID != "" && claims.AuthorizedParty != v.ClientID && !slices.Contains(claims.Audience, v.ClientID) {
+		return nil, fmt.Errorf("token is not issued for client %s", v.ClientID)
+	}
+	return claims, nil
+}
@@ -0,0 +1,161 @@
+package keycloak_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/keycloak"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// testRealm serves the JWKS of a realm whose signing key can be rotated
+type testRealm struct {
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches int
+}
+
+func (realm *testRealm) addKey(t *testing.T, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	realm.mu.Lock()
+	defer realm.mu.Unlock()
+	realm.keys[kid] = key
+	return key
+}
+
+func (realm *testRealm) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	realm.mu.Lock()
+	defer realm.mu.Unlock()
+	realm.fetches++
+	keys := []map[string]string{}
+	for kid, key := range realm.keys {
+		keys = append(keys, map[string]string{
+			"kid": kid,
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestTokenVerifier(t *testing.T) {
+	realm := &testRealm{keys: map[string]*rsa.PrivateKey{}}
+	key := realm.addKey(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/realms/augustin/protocol/openid-connect/certs", r.URL.Path)
+		realm.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	issuer := server.URL + "/realms/augustin"
+	verifier := keycloak.NewTokenVerifier(server.URL+"/", "augustin", issuer, "backend", time.Hour)
+	verifier.JWKS.MinRefreshInterval = 0
+	claims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":                "user-1",
+			"iss":                issuer,
+			"exp":                time.Now().Add(time.Minute).Unix(),
+			"typ":                "Bearer",
+			"azp":                "frontend",
+			"aud":                []string{"backend", "account"},
+			"preferred_username": "jane",
+			"email":              "jane@example.com",
+			"realm_access":       map[string]interface{}{"roles": []string{"admin", "offline_access"}},
+			"groups":             []string{"/customer", "/customer/newspapers", "vendors"},
+		}
+	}
+
+	verified, err := verifier.Verify(signToken(t, key, "key-1", claims()))
+	require.NoError(t, err)
+	require.Equal(t, "user-1", verified.Subject)
+	require.Equal(t, "jane", verified.PreferredUsername)
+	require.Equal(t, []string{"admin", "offline_access"}, verified.RealmAccess.Roles)
+	require.Equal(t, []string{"customer", "newspapers", "vendors"}, verified.GroupNames())
+
+	// The keys are cached
+	_, err = verifier.Verify(signToken(t, key, "key-1", claims()))
+	require.NoError(t, err)
+	require.Equal(t, 1, realm.fetches)
+
+	// Tokens without a group mapper have no groups claim
+	withoutGroups := claims()
+	delete(withoutGroups, "groups")
+	verified, err = verifier.Verify(signToken(t, key, "key-1", withoutGroups))
+	require.NoError(t, err)
+	require.Nil(t, verified.Groups)
+
+	expired := claims()
+	expired["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = verifier.Verify(signToken(t, key, "key-1", expired))
+	require.ErrorIs(t, err, jwt.ErrTokenExpired)
+
+	otherIssuer := claims()
+	otherIssuer["iss"] = server.URL + "/realms/other"
+	_, err = verifier.Verify(signToken(t, key, "key-1", otherIssuer))
+	require.ErrorIs(t, err, jwt.ErrTokenInvalidIssuer)
+
+	// Tokens of the backend itself or issued for it are accepted, tokens
+	// of other clients are not
+	serviceToken := claims()
+	serviceToken["azp"] = "backend"
+	delete(serviceToken, "aud")
+	_, err = verifier.Verify(signToken(t, key, "key-1", serviceToken))
+	require.NoError(t, err)
+	otherClient := claims()
+	otherClient["aud"] = "account"
+	_, err = verifier.Verify(signToken(t, key, "key-1", otherClient))
+	require.ErrorContains(t, err, "not issued for client backend")
+
+	refreshToken := claims()
+	refreshToken["typ"] = "Refresh"
+	_, err = verifier.Verify(signToken(t, key, "key-1", refreshToken))
+	require.Error(t, err)
+
+	// A token signed by a key the realm does not publish
+	forged, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = verifier.Verify(signToken(t, forged, "key-1", claims()))
+	require.ErrorIs(t, err, jwt.ErrTokenSignatureInvalid)
+	_, err = verifier.Verify(signToken(t, forged, "unknown", claims()))
+	require.ErrorIs(t, err, keycloak.ErrUnknownSigningKey)
+
+	// Rotated keys are fetched when a token names them
+	rotated := realm.addKey(t, "key-2")
+	_, err = verifier.Verify(signToken(t, rotated, "key-2", claims()))
+	require.NoError(t, err)
+}
+
+func TestTokenVerifierWithoutKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	verifier := keycloak.NewTokenVerifier(server.URL, "augustin", "", "backend", time.Hour)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = verifier.Verify(signToken(t, key, "key-1", jwt.MapClaims{"sub": "user-1", "exp": time.Now().Add(time.Minute).Unix()}))
+	require.ErrorIs(t, err, keycloak.ErrJWKSUnavailable)
+}
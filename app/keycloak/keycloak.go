@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -32,7 +33,10 @@ type Keycloak struct {
 	CustomerGroup           string
 	BackofficeGroup         string
 	NewspaperGroup          string
-	mu                      sync.Mutex
+	// TokenVerifier verifies access tokens locally, nil if they are
+	// validated by Keycloak
+	TokenVerifier *TokenVerifier
+	mu            sync.Mutex
 }
 
 // InitializeOauthServer initializes the Keycloak client
@@ -56,6 +60,10 @@ func InitializeOauthServer() (err error) {
 		BackofficeGroup: "backoffice",
 		NewspaperGroup:  "newspapers",
 	}
+	if config.Config.KeycloakLocalTokenValidation {
+		refresh := time.Duration(config.Config.KeycloakJWKSRefreshMinutes) * time.Minute
+		KeycloakClient.TokenVerifier = NewTokenVerifier(host, KeycloakClient.Realm, config.Config.KeycloakIssuer, config.Config.KeycloakClientID, refresh)
+	}
 	// Initialize Keycloak client
 	client := gocloak.NewClient(KeycloakClient.hostname)
 	KeycloakClient.Client = client
@@ -215,7 +223,7 @@ func (k *Keycloak) AssignDigitalLicenseGroup(userID string, licenseGroup string)
 		return fmt.Errorf("AssignDigitalLicenseGroup: keycloak client not initialized")
 	}
 	k.checkAdminToken()
-	licenseGroupPath := "/" + k.CustomerGroup + "/" + k.NewspaperGroup + "/" + licenseGroup
+	licenseGroupPath := k.LicenseGroupPath(licenseGroup)
 	// Check if group exists
 	_, err := k.GetGroupByPath(licenseGroupPath)
 	if err != nil {
@@ -268,13 +276,7 @@ func (k *Keycloak) SyncLicenseGroupsDiffToKeycloak(userID string, oldGroups, new
 	}
 	for g := range oldSet {
 		if !newSet[g] {
-			licenseGroupPath := "/" + k.CustomerGroup + "/" + k.NewspaperGroup + "/" + g
-			group, err := k.GetGroupByPath(licenseGroupPath)
-			if err != nil {
-				continue // group doesn't exist in Keycloak, nothing to remove
-			}
-			k.checkAdminToken()
-			if err := k.Client.DeleteUserFromGroup(k.Context, k.clientToken.AccessToken, k.Realm, userID, *group.ID); err != nil {
+			if err := k.UnassignDigitalLicenseGroup(userID, g); err != nil {
 				log.Errorf("SyncLicenseGroupsDiffToKeycloak: remove %s from %s: %v", g, userID, err)
 			}
 		}
@@ -282,6 +284,89 @@ func (k *Keycloak) SyncLicenseGroupsDiffToKeycloak(userID string, oldGroups, new
 	return nil
 }
 
+// UnassignDigitalLicenseGroup removes a user from a license group. A group
+// that does not exist in Keycloak has no members to remove.
+func (k *Keycloak) UnassignDigitalLicenseGroup(userID string, licenseGroup string) error {
+	if k.Client == nil {
+		return fmt.Errorf("UnassignDigitalLicenseGroup: keycloak client not initialized")
+	}
+	group, err := k.GetGroupByPath(k.LicenseGroupPath(licenseGroup))
+	if err != nil {
+		return nil
+	}
+	k.checkAdminToken()
+	return k.Client.DeleteUserFromGroup(k.Context, k.clientToken.AccessToken, k.Realm, userID, *group.ID)
+}
+
+// UnassignGroup removes a user from the group of the given path
+func (k *Keycloak) UnassignGroup(userID string, groupName string) error {
+	if k.Client == nil {
+		return fmt.Errorf("UnassignGroup: keycloak client not initialized")
+	}
+	if groupName == "" {
+		return fmt.Errorf("UnassignGroup: groupName is empty")
+	}
+	group, err := k.GetGroupByPath(groupName)
+	if err != nil {
+		return err
+	}
+	k.checkAdminToken()
+	return k.Client.DeleteUserFromGroup(k.Context, k.clientToken.AccessToken, k.Realm, userID, *group.ID)
+}
+
+// LicenseGroupPath returns the path of the Keycloak group of a license group
+func (k *Keycloak) LicenseGroupPath(licenseGroup string) string {
+	return "/" + k.CustomerGroup + "/" + k.NewspaperGroup + "/" + licenseGroup
+}
+
+// GetUserLicenseGroups returns the license groups a user is a member of
+func (k *Keycloak) GetUserLicenseGroups(userID string) ([]string, error) {
+	groups, err := k.GetUserGroups(userID)
+	if err != nil {
+		return nil, err
+	}
+	prefix := k.LicenseGroupPath("")
+	licenseGroups := []string{}
+	for _, g := range groups {
+		if g.Path == nil || !strings.HasPrefix(*g.Path, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(*g.Path, prefix)
+		if name != "" && !strings.Contains(name, "/") {
+			licenseGroups = append(licenseGroups, name)
+		}
+	}
+	return licenseGroups, nil
+}
+
+// GetGroupMembers returns the direct members of the group of the given path,
+// members of its subgroups are not included
+func (k *Keycloak) GetGroupMembers(path string) ([]*gocloak.User, error) {
+	if k.Client == nil {
+		return nil, fmt.Errorf("GetGroupMembers: keycloak client not initialized")
+	}
+	group, err := k.GetGroupByPath(path)
+	if err != nil {
+		return nil, err
+	}
+	const pageSize = 100
+	members := []*gocloak.User{}
+	for first := 0; ; first += pageSize {
+		k.checkAdminToken()
+		page, err := k.Client.GetGroupMembers(k.Context, k.clientToken.AccessToken, k.Realm, *group.ID, gocloak.GetGroupsParams{
+			First: gocloak.IntP(first),
+			Max:   gocloak.IntP(pageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, page...)
+		if len(page) < pageSize {
+			return members, nil
+		}
+	}
+}
+
 func (k *Keycloak) CreateGroup(groupName string) (string, error) {
 	k.checkAdminToken()
 	group := gocloak.Group{
@@ -752,6 +837,18 @@ func (k *Keycloak) DeleteUser(username string) error {
 	return k.Client.DeleteUser(k.Context, k.clientToken.AccessToken, k.Realm, *user.ID)
 }
 
+// DisableUser disables a user given by userID, e.g. after it was merged
+// into another user. Unlike deleting, this can be undone in Keycloak.
+func (k *Keycloak) DisableUser(userID string) error {
+	k.checkAdminToken()
+	user, err := k.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	user.Enabled = gocloak.BoolP(false)
+	return k.Client.UpdateUser(k.Context, k.clientToken.AccessToken, k.Realm, *user)
+}
+
 // UpdateUserPassword function updates a user password given by userID
 func (k *Keycloak) UpdateUserPassword(username string, password string) error {
 	username = utils.ToLower(username)
@@ -802,10 +899,68 @@ func (k *Keycloak) UpdateUserById(userID, username, firstName, lastName, email s
 	return k.Client.UpdateUser(k.Context, k.clientToken.AccessToken, k.Realm, *user)
 }
 
+// UpdateCustomerProfile changes name and email of a customer's user, who
+// logs in with their email. A changed email is unverified until the user
+// follows the link of the verification email Keycloak sends.
+func (k *Keycloak) UpdateCustomerProfile(userID, firstName, lastName, email string) error {
+	email = utils.ToLower(email)
+	k.checkAdminToken()
+	user, err := k.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+	changed := gocloak.PString(user.Email) != email
+	user.FirstName = &firstName
+	user.LastName = &lastName
+	if changed {
+		user.Email = &email
+		user.Username = &email
+		user.EmailVerified = gocloak.BoolP(false)
+		if user.RequiredActions == nil || !slices.Contains(*user.RequiredActions, "VERIFY_EMAIL") {
+			actions := append(gocloak.PStringSlice(user.RequiredActions), "VERIFY_EMAIL")
+			user.RequiredActions = &actions
+		}
+	}
+	if err = k.Client.UpdateUser(k.Context, k.clientToken.AccessToken, k.Realm, *user); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	if config.Config.SMTPSenderAddress == "" {
+		log.Infof("UpdateCustomerProfile: skipping verification email for %s because SMTPSenderAddress is not configured", email)
+		return nil
+	}
+	return k.Client.ExecuteActionsEmail(k.Context, k.clientToken.AccessToken, k.Realm, gocloak.ExecuteActionsEmail{
+		UserID:      user.ID,
+		Lifespan:    gocloak.IntP(24 * 60 * 60),
+		Actions:     &[]string{"VERIFY_EMAIL"},
+		ClientID:    gocloak.StringP("frontend"),
+		RedirectURI: gocloak.StringP(config.Config.OnlinePaperUrl),
+	})
+}
+
+// GetVerifiedEmail returns the email of a user if it is verified and an
+// empty string otherwise
+func (k *Keycloak) GetVerifiedEmail(userID string) (string, error) {
+	user, err := k.GetUserByID(userID)
+	if err != nil {
+		return "", err
+	}
+	if !gocloak.PBool(user.EmailVerified) {
+		return "", nil
+	}
+	return utils.ToLower(gocloak.PString(user.Email)), nil
+}
+
 func (k *Keycloak) GetVendorGroup() string {
 	return k.VendorGroup
 }
 
+func (k *Keycloak) GetCustomerGroup() string {
+	return k.CustomerGroup
+}
+
 func (k *Keycloak) UpdateVendor(oldEmail, newEmail, licenseID, firstName, lastName string) (string, error) {
 
 	oldEmail = utils.ToLower(oldEmail)
@@ -94,9 +94,17 @@ func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
 
 // Request struct
 type EmailRequest struct {
-	to      []string
-	subject string
-	body    string
+	to          []string
+	subject     string
+	body        string
+	attachments []Attachment
+}
+
+// Attachment is a file sent along with an email
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
 }
 
 func NewRequestFromTemplate(to []string, subject, templateFileName string, data interface{}) (*EmailRequest, error) {
@@ -158,9 +166,24 @@ func (r *EmailRequest) Body() string {
 	return r.body
 }
 
-// BuildMessage builds the raw email message bytes (multipart/alternative)
-// and returns the message and the boundary used. This is separated so tests
-// can inspect the generated message without sending it.
+// Attach adds a file to the email
+func (r *EmailRequest) Attach(filename, contentType string, data []byte) error {
+	if strings.ContainsAny(filename+contentType, "\r\n\"") {
+		return errors.New("attachment name contains invalid characters")
+	}
+	r.attachments = append(r.attachments, Attachment{Filename: filename, ContentType: contentType, Data: data})
+	return nil
+}
+
+// Attachments returns the files attached to the email
+func (r *EmailRequest) Attachments() []Attachment {
+	return r.attachments
+}
+
+// BuildMessage builds the raw email message bytes (multipart/alternative,
+// wrapped in multipart/mixed if there are attachments) and returns the
+// message and the outermost boundary used. This is separated so tests can
+// inspect the generated message without sending it.
 func (r *EmailRequest) BuildMessage() ([]byte, string, error) {
 	from := config.Config.SMTPSenderAddress
 	toHeader := strings.Join(r.to, ", ")
@@ -188,6 +211,7 @@ func (r *EmailRequest) BuildMessage() ([]byte, string, error) {
 	}
 
 	boundary := fmt.Sprintf("Boundary_%d", time.Now().UnixNano())
+	mixedBoundary := "Mixed_" + boundary
 	var b bytes.Buffer
 
 	// headers
@@ -206,6 +230,11 @@ func (r *EmailRequest) BuildMessage() ([]byte, string, error) {
 	b.WriteString(fmt.Sprintf("Date: %s\r\n", dateHeader))
 	b.WriteString(fmt.Sprintf("Message-ID: %s\r\n", msgID))
 	b.WriteString("MIME-Version: 1.0\r\n")
+	if len(r.attachments) > 0 {
+		b.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=\"%s\"\r\n", mixedBoundary))
+		b.WriteString("\r\n")
+		b.WriteString(fmt.Sprintf("--%s\r\n", mixedBoundary))
+	}
 	b.WriteString(fmt.Sprintf("Content-Type: multipart/alternative; boundary=\"%s\"\r\n", boundary))
 	b.WriteString("\r\n")
 
@@ -240,7 +269,26 @@ func (r *EmailRequest) BuildMessage() ([]byte, string, error) {
 	// end
 	b.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
 
-	return b.Bytes(), boundary, nil
+	if len(r.attachments) == 0 {
+		return b.Bytes(), boundary, nil
+	}
+	for _, a := range r.attachments {
+		b.WriteString(fmt.Sprintf("--%s\r\n", mixedBoundary))
+		b.WriteString(fmt.Sprintf("Content-Type: %s; name=\"%s\"\r\n", a.ContentType, encodeRFC2047(a.Filename)))
+		b.WriteString("Content-Transfer-Encoding: base64\r\n")
+		b.WriteString(fmt.Sprintf("Content-Disposition: attachment; filename=\"%s\"\r\n", encodeRFC2047(a.Filename)))
+		b.WriteString("\r\n")
+		// Lines of base64 must not be longer than 76 characters
+		encoded := base64.StdEncoding.EncodeToString(a.Data)
+		for len(encoded) > 76 {
+			b.WriteString(encoded[:76] + "\r\n")
+			encoded = encoded[76:]
+		}
+		b.WriteString(encoded + "\r\n")
+	}
+	b.WriteString(fmt.Sprintf("--%s--\r\n", mixedBoundary))
+
+	return b.Bytes(), mixedBoundary, nil
 }
 
 func (r *EmailRequest) SendEmail() (bool, error) {
@@ -1,8 +1,10 @@
 package mailer
 
 import (
+	"bytes"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -48,3 +50,28 @@ func TestParseTemplate_EmptyName(t *testing.T) {
 		t.Fatalf("expected error for empty template name")
 	}
 }
+
+func TestBuildMessage_Attachment(t *testing.T) {
+	r, _ := NewRequest([]string{"foo@bar.test"}, "Invoice", "<p>Hello</p>")
+	require.Error(t, r.Attach("in\r\nvoice.pdf", "application/pdf", nil))
+	require.NoError(t, r.Attach("invoice.pdf", "application/pdf", bytes.Repeat([]byte("%PDF"), 40)))
+
+	msg, boundary, err := r.BuildMessage()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(boundary, "Mixed_"))
+	message := string(msg)
+	require.Contains(t, message, "Content-Type: multipart/mixed; boundary=\""+boundary+"\"")
+	require.Contains(t, message, "Content-Type: multipart/alternative")
+	require.Contains(t, message, "Content-Disposition: attachment; filename=\"invoice.pdf\"")
+	require.True(t, strings.HasSuffix(message, "--"+boundary+"--\r\n"))
+	for _, line := range strings.Split(message, "\r\n") {
+		require.LessOrEqual(t, len(line), 998)
+	}
+
+	// Without attachments the message stays multipart/alternative
+	r, _ = NewRequest([]string{"foo@bar.test"}, "Hello", "<p>Hello</p>")
+	msg, boundary, err = r.BuildMessage()
+	require.NoError(t, err)
+	require.NotContains(t, string(msg), "multipart/mixed")
+	require.Contains(t, string(msg), "multipart/alternative; boundary=\""+boundary+"\"")
+}
@@ -10,11 +10,14 @@ import (
 
 	"github.com/augustin-wien/augustina-backend/config"
 	"github.com/augustin-wien/augustina-backend/database"
+	"github.com/augustin-wien/augustina-backend/geocoding"
 	"github.com/augustin-wien/augustina-backend/handlers"
 	"github.com/augustin-wien/augustina-backend/keycloak"
 	"github.com/augustin-wien/augustina-backend/mailer"
 	"github.com/augustin-wien/augustina-backend/middlewares"
 	"github.com/augustin-wien/augustina-backend/notifications"
+	"github.com/augustin-wien/augustina-backend/scheduler"
+	"github.com/augustin-wien/augustina-backend/storage"
 	"github.com/augustin-wien/augustina-backend/utils"
 
 	"github.com/getsentry/sentry-go"
@@ -59,6 +62,12 @@ func main() {
 		log.Fatal("Db init:", err)
 	}
 
+	// Run a maintenance command instead of the server, e.g.
+	// reconcile-keycloak
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(os.Args[1], os.Args[2:]))
+	}
+
 	// Initialize IP Blocker with DB persistence
 	middlewares.InitIPBlocker(database.Db.EntClient)
 
@@ -74,6 +83,55 @@ func main() {
 
 	mailer.Init()
 
+	if err := geocoding.Init(); err != nil {
+		log.Fatal("Geocoding: ", err)
+	}
+
+	if err := storage.Init(); err != nil {
+		log.Fatal("Storage: ", err)
+	}
+
+	// Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	jobs := scheduler.New(
+		scheduler.Job{
+			Name:     "publish scheduled items",
+			Interval: time.Duration(conf.ItemScheduleIntervalSeconds) * time.Second,
+			Run:      handlers.PublishScheduledItems,
+		},
+		scheduler.Job{
+			Name:     "purge expired pdfs",
+			Interval: time.Duration(conf.PDFRetentionIntervalHours) * time.Hour,
+			Run:      handlers.PurgeExpiredPDFs,
+		},
+		scheduler.Job{
+			Name:     "abonement lifecycle",
+			Interval: time.Duration(conf.AbonementLifecycleIntervalHours) * time.Hour,
+			Run:      handlers.AdvanceAbonementLifecycle,
+		},
+		scheduler.Job{
+			Name:     "abonement renewals",
+			Interval: time.Duration(conf.AbonementRenewalIntervalHours) * time.Hour,
+			Run:      handlers.RenewAbonements,
+		},
+		scheduler.Job{
+			Name:     "deliver gift codes",
+			Interval: time.Duration(conf.GiftDeliveryIntervalMinutes) * time.Minute,
+			Run:      handlers.DeliverGiftCodes,
+		},
+		scheduler.Job{
+			Name:     "release discount codes of unpaid orders",
+			Interval: time.Duration(conf.DiscountReleaseIntervalMinutes) * time.Minute,
+			Run:      handlers.ReleaseUnpaidDiscountCodes,
+		},
+		scheduler.Job{
+			Name:     "reconcile keycloak",
+			Interval: time.Duration(conf.KeycloakReconcileIntervalHours) * time.Hour,
+			Run:      handlers.ReconcileKeycloakJob,
+		},
+	)
+	jobs.Start(jobsCtx)
+
 	// Initialize server with graceful shutdown
 	srv := &http.Server{
 		Addr:              ":" + conf.Port,
@@ -98,6 +156,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	log.Info("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
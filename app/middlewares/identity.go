@@ -0,0 +1,155 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/database"
+)
+
+// Principal is the user authenticated by AuthMiddleware. Its vendor and
+// customer are looked up once per request when they are needed.
+type Principal struct {
+	// UserID is the Keycloak ID of the user
+	UserID   string
+	Username string
+	Email    string
+	Roles    []string
+	Groups   []string
+	// APIKeyID is set if the request is authenticated by an API key, which
+	// grants Permissions instead of roles
+	APIKeyID    int
+	Permissions []Permission
+
+	vendorOnce   sync.Once
+	vendorID     int
+	customerOnce sync.Once
+	customerID   int
+}
+
+// HasRole reports whether the user has the realm role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// InGroup reports whether the user is a member of the group
+func (p *Principal) InGroup(group string) bool {
+	return slices.Contains(p.Groups, group)
+}
+
+// VendorID returns the ID of the vendor with the email of the user and
+// false if the user is not a vendor
+func (p *Principal) VendorID() (int, bool) {
+	p.vendorOnce.Do(func() {
+		if p.Email != "" {
+			p.vendorID = lookupVendorID(p.Email)
+		}
+	})
+	return p.vendorID, p.vendorID != 0
+}
+
+// CustomerID returns the ID of the customer of the user and false if the
+// user is not a customer
+func (p *Principal) CustomerID() (int, bool) {
+	p.customerOnce.Do(func() {
+		if p.UserID != "" {
+			p.customerID = lookupCustomerID(p.UserID)
+		}
+	})
+	return p.customerID, p.customerID != 0
+}
+
+// lookupVendorID and lookupCustomerID return 0 if there is no such vendor
+// or customer. They are variables so tests can replace them.
+var (
+	lookupVendorID = func(email string) int {
+		vendor, err := database.Db.GetVendorByEmail(email)
+		if err != nil {
+			return 0
+		}
+		return vendor.ID
+	}
+	lookupCustomerID = func(keycloakID string) int {
+		customer, err := database.Db.GetCustomerByKeycloakID(keycloakID)
+		if err != nil {
+			return 0
+		}
+		return customer.ID
+	}
+)
+
+type ctxKeyPrincipal struct{}
+
+// WithPrincipal returns a new context that carries the authenticated user
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, ctxKeyPrincipal{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated user of a request context
+// and false if the request is not authenticated
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(ctxKeyPrincipal{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// AuthPrincipal returns the authenticated user of a request, or an empty
+// principal without any permission if the request is not authenticated
+func AuthPrincipal(r *http.Request) *Principal {
+	if principal, ok := PrincipalFromContext(r.Context()); ok {
+		return principal
+	}
+	return &Principal{}
+}
+
+// ttlCache is a small cache for answers of the Keycloak API
+type ttlCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]ttlCacheEntry[T]
+}
+
+type ttlCacheEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// ttlCacheMaxEntries bounds the memory used by a cache, expired entries are
+// dropped when it is reached
+const ttlCacheMaxEntries = 10000
+
+func newTTLCache[T any]() *ttlCache[T] {
+	return &ttlCache[T]{entries: map[string]ttlCacheEntry[T]{}}
+}
+
+func (c *ttlCache[T]) get(key string) (value T, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return value, false
+	}
+	return entry.value, true
+}
+
+// set caches value for ttl, a ttl of 0 does not cache it
+func (c *ttlCache[T]) set(key string, value T, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if len(c.entries) >= ttlCacheMaxEntries {
+		for k, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+		if len(c.entries) >= ttlCacheMaxEntries {
+			c.entries = map[string]ttlCacheEntry[T]{}
+		}
+	}
+	c.entries[key] = ttlCacheEntry[T]{value: value, expires: now.Add(ttl)}
+}
@@ -1,10 +1,18 @@
 package middlewares
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/Nerzal/gocloak/v13"
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/keycloak"
 	"github.com/augustin-wien/augustina-backend/utils"
 )
@@ -12,11 +20,11 @@ import (
 var log = utils.GetLogger()
 
 // clearIncomingAuthHeaders removes any client-supplied X-Auth-* headers so they
-// cannot be forged. Only AuthMiddleware is allowed to set these headers, and only
-// from a validated Keycloak token. Stripping the whole X-Auth-* namespace (instead
-// of a hardcoded denylist) prevents privilege escalation via headers like
-// X-Auth-Roles-backoffice or X-Auth-Groups-customer that the authorization
-// middlewares trust.
+// cannot be forged. The authenticated user is passed in the request context,
+// see Principal, but the headers were trusted by earlier versions and may
+// still be by proxies or logging. Stripping the whole X-Auth-* namespace
+// (instead of a hardcoded denylist) prevents privilege escalation via headers
+// like X-Auth-Roles-backoffice or X-Auth-Groups-customer.
 func clearIncomingAuthHeaders(r *http.Request) {
 	for name := range r.Header {
 		if strings.HasPrefix(http.CanonicalHeaderKey(name), "X-Auth-") {
@@ -26,7 +34,22 @@ func clearIncomingAuthHeaders(r *http.Request) {
 	r.Header.Set("X-Auth-User-Validated", "false")
 }
 
-// AuthMiddleware is a middleware to check if the request is authorized
+// Answers of the Keycloak API, cached for config.Config.KeycloakAuthCacheSeconds
+var (
+	userInfoCache   = newTTLCache[*Principal]()
+	userRolesCache  = newTTLCache[[]string]()
+	userGroupsCache = newTTLCache[[]string]()
+)
+
+func authCacheTTL() time.Duration {
+	return time.Duration(config.Config.KeycloakAuthCacheSeconds) * time.Second
+}
+
+// errAuthBackend marks errors of Keycloak, as opposed to invalid tokens
+var errAuthBackend = errors.New("internal Server Error")
+
+// AuthMiddleware is a middleware to check if the request is authorized. The
+// authenticated user is passed on in the request context, see Principal.
 func AuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -40,6 +63,17 @@ func AuthMiddleware(next http.Handler) http.Handler {
 		// they cannot be used to forge identity, roles, or groups.
 		clearIncomingAuthHeaders(r)
 
+		if apiKey := requestAPIKey(r); apiKey != "" {
+			principal, status, err := authenticateAPIKeyRequest(r, apiKey)
+			if err != nil {
+				log.Info("AuthMiddleware: API key rejected from ", utils.ReadUserIP(r), ": ", err)
+				utils.ErrorJSON(w, err, status)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+			return
+		}
+
 		if r.Header.Get("Authorization") == "" {
 			log.Info("AuthMiddleware: No Authorization header on auth from incoming request ", utils.ReadUserIP(r))
 			utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
@@ -51,166 +85,216 @@ func AuthMiddleware(next http.Handler) http.Handler {
 			userToken = splitToken[1]
 		}
 
-		userinfo, err := keycloak.KeycloakClient.GetUserInfo(userToken)
-		if err != nil {
-			log.Info("AuthMiddleware: Error getting userinfo ", err)
-			utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
+		principal, err := authenticate(userToken)
+		if errors.Is(err, errAuthBackend) {
+			utils.ErrorJSON(w, errAuthBackend, http.StatusInternalServerError)
 			return
 		}
-
-		// set user headers
-		r.Header.Set("X-Auth-User", *userinfo.Sub)
-		r.Header.Set("X-Auth-User-Name", *userinfo.PreferredUsername)
-		r.Header.Set("X-Auth-User-Email", *userinfo.Email)
-		r.Header.Set("X-Auth-User-Validated", "true")
-
-		// set user roles headers
-		userRoles, err := keycloak.KeycloakClient.GetUserRoles(*userinfo.Sub)
 		if err != nil {
-			log.Info("Error getting userRoles ", err)
-			utils.ErrorJSON(w, errors.New("internal Server Error"), http.StatusInternalServerError)
-			return
-		}
-
-		for _, role := range userRoles {
-			r.Header.Add("X-Auth-Roles-"+*role.Name, *role.Name)
-		}
-		userGroups, err := keycloak.KeycloakClient.GetUserGroups(*userinfo.Sub)
-		if err != nil {
-			log.Info("AuthMiddleware: Error getting userGroups ", err)
-			utils.ErrorJSON(w, errors.New("internal Server Error"), http.StatusInternalServerError)
+			log.Info("AuthMiddleware: invalid token from ", utils.ReadUserIP(r), ": ", err)
+			utils.ErrorJSON(w, errors.New("Unauthorized"), http.StatusUnauthorized)
 			return
 		}
-		for _, group := range userGroups {
-			log.Debugf("add group %v", *group.Name)
-			r.Header.Add("X-Auth-Groups-"+*group.Name, *group.Name)
-		}
-		next.ServeHTTP(w, r)
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
 	})
 }
 
-// VendorAuthMiddleware is a middleware to check if the request is authorized as vendor
-func VendorAuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-
-		// ignore for options request
-		if r.Method == "OPTIONS" {
-			next.ServeHTTP(w, r)
-			return // skip
-		}
-
-		if r.Header.Get("X-Auth-User-Validated") == "false" {
-			log.Info("VendorAuthMiddleware: No validated user", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-
-		if r.Header.Get("X-Auth-Groups-"+keycloak.KeycloakClient.GetVendorGroup()) != "" || r.Header.Get("X-Auth-Roles-admin") != "" {
-			next.ServeHTTP(w, r)
-		} else {
-			log.Info("VendorAuthMiddleware: user is missing vendor role "+keycloak.KeycloakClient.GetVendorGroup()+" with user id ", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Forbidden", http.StatusForbidden)
-		}
-	})
+// requestAPIKey returns the API key of a request, passed either in the
+// X-API-Key header or as bearer token
+func requestAPIKey(r *http.Request) string {
+	if key := strings.TrimSpace(r.Header.Get("X-API-Key")); key != "" {
+		return key
+	}
+	token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if strings.HasPrefix(token, database.APIKeyPrefix) {
+		return token
+	}
+	return ""
 }
 
-// AdminAuthMiddleware is a middleware to check if the request is authorized as admin
-func AdminAuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-
-		// ignore for options request
-		if r.Method == "OPTIONS" {
-			next.ServeHTTP(w, r)
-			return // skip
-		}
-
-		if r.Header.Get("X-Auth-User-Validated") == "false" {
-			log.Info("AdminAuthMiddleware: No validated user", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-		if r.Header.Get("X-Auth-Roles-admin") == "" && r.Header.Get("X-Auth-Roles-backoffice") == "" {
-			log.Infof("AdminAuthMiddleware: User %v has no admin role", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Forbidden", http.StatusForbidden)
-			return
-		}
-
-		next.ServeHTTP(w, r)
-	})
+// authenticateAPIKey returns the active API key matching key. It is a
+// variable so tests can replace it.
+var authenticateAPIKey = func(key string, ip string) (database.APIKey, error) {
+	return database.Db.AuthenticateAPIKey(key, ip, time.Now())
 }
 
-// CustomerAuthMiddleware is a middleware to check if the request is authorized as customer
-func CustomerAuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-
-		// ignore for options request
-		if r.Method == "OPTIONS" {
-			next.ServeHTTP(w, r)
-			return // skip
-		}
+// authenticateAPIKeyRequest returns the principal of an API key and the
+// status to answer with if the key is not accepted
+func authenticateAPIKeyRequest(r *http.Request, key string) (*Principal, int, error) {
+	// Forwarding headers are only trusted from configured proxies, so they
+	// can not be used to pass the IP allowlist of a key
+	apiKey, err := authenticateAPIKey(key, utils.ReadClientIP(r))
+	if errors.Is(err, database.ErrAPIKeyInvalid) {
+		return nil, http.StatusUnauthorized, errors.New("Unauthorized")
+	}
+	if errors.Is(err, database.ErrAPIKeyIPNotAllowed) {
+		return nil, http.StatusForbidden, errors.New("Forbidden")
+	}
+	if err != nil {
+		return nil, http.StatusInternalServerError, errAuthBackend
+	}
+	group, ok := routeGroupFromContext(r.Context())
+	if !ok || !slices.Contains(apiKey.RouteGroups, string(group)) {
+		log.Infof("AuthMiddleware: API key %d is not allowed on %s", apiKey.ID, r.URL.Path)
+		return nil, http.StatusForbidden, errors.New("Forbidden")
+	}
+	principal := &Principal{
+		UserID:   fmt.Sprintf("apikey:%d", apiKey.ID),
+		Username: "apikey:" + apiKey.Name,
+		APIKeyID: apiKey.ID,
+	}
+	for _, permission := range apiKey.Permissions {
+		principal.Permissions = append(principal.Permissions, Permission(permission))
+	}
+	return principal, 0, nil
+}
 
-		if r.Header.Get("X-Auth-User-Validated") == "false" {
-			log.Info("CustomerAuthMiddleware: No validated user", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
+// authenticate returns the user of an access token. Tokens are verified
+// locally if the realm's signing keys are available and by Keycloak
+// otherwise.
+func authenticate(token string) (*Principal, error) {
+	verifier := keycloak.KeycloakClient.TokenVerifier
+	if verifier == nil {
+		return authenticateRemote(token)
+	}
+	claims, err := verifier.Verify(token)
+	if errors.Is(err, keycloak.ErrJWKSUnavailable) {
+		log.Warn("AuthMiddleware: signing keys unavailable, validating token with Keycloak")
+		return authenticateRemote(token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	principal := &Principal{
+		UserID:   claims.Subject,
+		Username: claims.PreferredUsername,
+		Email:    claims.Email,
+		Roles:    claims.RealmAccess.Roles,
+		Groups:   claims.GroupNames(),
+	}
+	// Without a group mapper on the client the token carries no groups
+	if claims.Groups == nil {
+		principal.Groups, err = userGroups(principal.UserID)
+		if err != nil {
+			return nil, err
 		}
+	}
+	return principal, nil
+}
 
-		if r.Header.Get("X-Auth-Groups-"+keycloak.KeycloakClient.CustomerGroup) == "" && r.Header.Get("X-Auth-Roles-customer") == "" && r.Header.Get("X-Auth-Roles-admin") == "" {
-			log.Infof("CustomerAuthMiddleware: User %v has no customer role", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Forbidden", http.StatusForbidden)
-			return
-		}
+// authenticateRemote validates a token by asking Keycloak for the user
+func authenticateRemote(token string) (principal *Principal, err error) {
+	sum := sha256.Sum256([]byte(token))
+	tokenKey := hex.EncodeToString(sum[:])
+	if cached, ok := userInfoCache.get(tokenKey); ok {
+		// Copy it, the vendor and customer are looked up per request
+		return &Principal{
+			UserID:   cached.UserID,
+			Username: cached.Username,
+			Email:    cached.Email,
+			Roles:    cached.Roles,
+			Groups:   cached.Groups,
+		}, nil
+	}
 
-		next.ServeHTTP(w, r)
-	})
+	userinfo, err := keycloak.KeycloakClient.GetUserInfo(token)
+	if err != nil {
+		return nil, err
+	}
+	principal = &Principal{
+		UserID:   gocloak.PString(userinfo.Sub),
+		Username: gocloak.PString(userinfo.PreferredUsername),
+		Email:    gocloak.PString(userinfo.Email),
+	}
+	principal.Roles, err = userRoles(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	principal.Groups, err = userGroups(principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+	userInfoCache.set(tokenKey, principal, authCacheTTL())
+	return principal, nil
 }
 
-// FlourAuthMiddleware checks that the request carries the flour role.
-func FlourAuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+// userRoles returns the realm roles of a user from the Keycloak admin API
+func userRoles(userID string) ([]string, error) {
+	if roles, ok := userRolesCache.get(userID); ok {
+		return roles, nil
+	}
+	userRoles, err := keycloak.KeycloakClient.GetUserRoles(userID)
+	if err != nil {
+		log.Info("Error getting userRoles ", err)
+		return nil, errAuthBackend
+	}
+	roles := make([]string, 0, len(userRoles))
+	for _, role := range userRoles {
+		roles = append(roles, gocloak.PString(role.Name))
+	}
+	userRolesCache.set(userID, roles, authCacheTTL())
+	return roles, nil
+}
 
-		// ignore for options request
-		if r.Method == "OPTIONS" {
-			next.ServeHTTP(w, r)
-			return // skip
-		}
+// userGroups returns the group names of a user from the Keycloak admin API
+func userGroups(userID string) ([]string, error) {
+	if groups, ok := userGroupsCache.get(userID); ok {
+		return groups, nil
+	}
+	userGroups, err := keycloak.KeycloakClient.GetUserGroups(userID)
+	if err != nil {
+		log.Info("AuthMiddleware: Error getting userGroups ", err)
+		return nil, errAuthBackend
+	}
+	groups := make([]string, 0, len(userGroups))
+	for _, group := range userGroups {
+		groups = append(groups, gocloak.PString(group.Name))
+	}
+	userGroupsCache.set(userID, groups, authCacheTTL())
+	return groups, nil
+}
 
-		if r.Header.Get("X-Auth-User-Validated") == "false" {
-			log.Info("FlourAuthMiddleware: No validated user", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-		if r.Header.Get("X-Auth-Roles-flour") == "" {
-			log.Infof("FlourAuthMiddleware: User %v has no flour role", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Forbidden", http.StatusForbidden)
-			return
-		}
+// VendorAuthMiddleware is a middleware to check if the request is authorized as vendor
+func VendorAuthMiddleware(next http.Handler) http.Handler {
+	return Require(PermVendorSelf)(next)
+}
 
-		next.ServeHTTP(w, r)
-	})
+// AdminAuthMiddleware is a middleware to check if the request is authorized to use the backoffice
+func AdminAuthMiddleware(next http.Handler) http.Handler {
+	return Require(PermBackoffice)(next)
 }
 
-// OdooAuthMiddleware checks that the request carries the odoo role.
-func OdooAuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+// CustomerAuthMiddleware is a middleware to check if the request is authorized as customer
+func CustomerAuthMiddleware(next http.Handler) http.Handler {
+	return Require(PermCustomerSelf)(next)
+}
 
-		// ignore for options request
-		if r.Method == "OPTIONS" {
+// Require is a middleware that only lets requests pass whose principal has
+// all given permissions. It has to run after AuthMiddleware.
+func Require(permissions ...Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+			// ignore for options request
+			if r.Method == "OPTIONS" {
+				next.ServeHTTP(w, r)
+				return // skip
+			}
+
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				log.Info("Require: No validated user")
+				http.Error(w, "Unauthorized", http.StatusUnauthorized)
+				return
+			}
+			for _, permission := range permissions {
+				if !principal.Can(permission) {
+					log.Infof("Require: User %v is missing permission %s for %s %s", principal.UserID, permission, r.Method, r.URL.Path)
+					http.Error(w, "Forbidden", http.StatusForbidden)
+					return
+				}
+			}
 			next.ServeHTTP(w, r)
-			return // skip
-		}
-
-		if r.Header.Get("X-Auth-User-Validated") == "false" {
-			log.Info("OdooAuthMiddleware: No validated user", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-		if r.Header.Get("X-Auth-Roles-odoo") == "" {
-			log.Infof("OdooAuthMiddleware: User %v has no odoo role", r.Header.Get("X-Auth-User"))
-			http.Error(w, "Forbidden", http.StatusForbidden)
-			return
-		}
-
-		next.ServeHTTP(w, r)
-	})
+		})
+	}
 }
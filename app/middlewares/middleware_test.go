@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/augustin-wien/augustina-backend/database"
 	"github.com/augustin-wien/augustina-backend/keycloak"
 	"github.com/stretchr/testify/require"
 )
@@ -23,8 +25,7 @@ func TestCustomerAuthMiddlewareAllowsCustomerGroup(t *testing.T) {
 	}))
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.Header.Set("X-Auth-User-Validated", "true")
-	req.Header.Set("X-Auth-Groups-customer", "customer")
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user", Groups: []string{"customer"}}))
 	res := httptest.NewRecorder()
 
 	handler.ServeHTTP(res, req)
@@ -47,7 +48,7 @@ func TestCustomerAuthMiddlewareRejectsMissingCustomerAccess(t *testing.T) {
 	}))
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
-	req.Header.Set("X-Auth-User-Validated", "true")
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user"}))
 	res := httptest.NewRecorder()
 
 	handler.ServeHTTP(res, req)
@@ -94,8 +95,8 @@ func TestClearIncomingAuthHeadersStripsForgedHeaders(t *testing.T) {
 }
 
 // TestAdminAuthMiddlewareRejectsForgedBackofficeAfterClear proves the escalation is closed:
-// a request carrying a forged X-Auth-Roles-backoffice header no longer reaches admin routes
-// once the auth headers are stripped, even after the request is later marked validated.
+// a request carrying a forged X-Auth-Roles-backoffice header does not reach admin routes,
+// even with a validated low-privilege identity.
 func TestAdminAuthMiddlewareRejectsForgedBackofficeAfterClear(t *testing.T) {
 	called := false
 	handler := AdminAuthMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -110,7 +111,7 @@ func TestAdminAuthMiddlewareRejectsForgedBackofficeAfterClear(t *testing.T) {
 	// AuthMiddleware strips forged headers before validating the token.
 	clearIncomingAuthHeaders(req)
 	// Simulate a successfully validated but low-privilege token.
-	req.Header.Set("X-Auth-User-Validated", "true")
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user"}))
 
 	res := httptest.NewRecorder()
 	handler.ServeHTTP(res, req)
@@ -130,9 +131,8 @@ func TestAdminAuthMiddlewareAllowsGenuineAdminAfterClear(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodGet, "/", nil)
 	clearIncomingAuthHeaders(req)
-	// AuthMiddleware would set these from the validated token.
-	req.Header.Set("X-Auth-User-Validated", "true")
-	req.Header.Add("X-Auth-Roles-admin", "admin")
+	// AuthMiddleware would set this from the validated token.
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "admin", Roles: []string{"admin"}}))
 
 	res := httptest.NewRecorder()
 	handler.ServeHTTP(res, req)
@@ -161,7 +161,7 @@ func TestCustomerAuthMiddlewareRejectsForgedCustomerAfterClear(t *testing.T) {
 	req.Header.Set("X-Auth-Groups-customer", "customer")
 
 	clearIncomingAuthHeaders(req)
-	req.Header.Set("X-Auth-User-Validated", "true")
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{UserID: "user"}))
 
 	res := httptest.NewRecorder()
 	handler.ServeHTTP(res, req)
@@ -169,3 +169,189 @@ func TestCustomerAuthMiddlewareRejectsForgedCustomerAfterClear(t *testing.T) {
 	require.False(t, called, "forged customer role/group must not grant customer access")
 	require.Equal(t, http.StatusForbidden, res.Code)
 }
+
+// TestAuthorizationMiddlewaresRequireIdentity checks that requests which did not pass
+// AuthMiddleware are rejected, whatever headers they carry.
+func TestAuthorizationMiddlewaresRequireIdentity(t *testing.T) {
+	middlewares := map[string]func(http.Handler) http.Handler{
+		"admin":    AdminAuthMiddleware,
+		"vendor":   VendorAuthMiddleware,
+		"customer": CustomerAuthMiddleware,
+		"flour":    Require(PermIntegrationFlour),
+		"odoo":     Require(PermIntegrationOdoo),
+	}
+	for name, middleware := range middlewares {
+		called := false
+		handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Auth-User-Validated", "true")
+		req.Header.Set("X-Auth-Roles-"+name, name)
+		res := httptest.NewRecorder()
+
+		handler.ServeHTTP(res, req)
+
+		require.False(t, called, name)
+		require.Equal(t, http.StatusUnauthorized, res.Code, name)
+	}
+}
+
+func TestRequirePermissions(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  *Principal
+		permission Permission
+		want       int
+	}{
+		{"admin changes settings", &Principal{Roles: []string{"admin"}}, PermSettingsWrite, http.StatusOK},
+		{"backoffice can not change settings", &Principal{Roles: []string{"backoffice"}}, PermSettingsWrite, http.StatusForbidden},
+		{"backoffice pays out", &Principal{Roles: []string{"backoffice"}}, PermPaymentsPayout, http.StatusOK},
+		{"backoffice can not purge PDFs", &Principal{Roles: []string{"backoffice"}}, PermPDFsPurge, http.StatusForbidden},
+		{"flour pays out", &Principal{Roles: []string{"flour"}}, PermIntegrationPayouts, http.StatusOK},
+		{"flour can not change items", &Principal{Roles: []string{"flour"}}, PermIntegrationItems, http.StatusForbidden},
+		{"flour can not use the odoo routes", &Principal{Roles: []string{"flour"}}, PermIntegrationOdoo, http.StatusForbidden},
+		{"flour can not pay out in the backoffice", &Principal{Roles: []string{"flour"}}, PermPaymentsPayout, http.StatusForbidden},
+		{"odoo changes items", &Principal{Roles: []string{"odoo"}}, PermIntegrationItems, http.StatusOK},
+		{"odoo can not use the legacy flour routes", &Principal{Roles: []string{"odoo"}}, PermIntegrationFlour, http.StatusForbidden},
+		{"odoo can not list vendors in the backoffice", &Principal{Roles: []string{"odoo"}}, PermVendorsRead, http.StatusForbidden},
+		{"odoo can not change items in the backoffice", &Principal{Roles: []string{"odoo"}}, PermItemsWrite, http.StatusForbidden},
+		{"customer can not use the backoffice", &Principal{Roles: []string{"customer"}}, PermBackoffice, http.StatusForbidden},
+		{"unknown role", &Principal{Roles: []string{"reader"}}, PermVendorsRead, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		called := false
+		handler := Require(tt.permission)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusOK)
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req = req.WithContext(WithPrincipal(req.Context(), tt.principal))
+		res := httptest.NewRecorder()
+
+		handler.ServeHTTP(res, req)
+
+		require.Equal(t, tt.want, res.Code, tt.name)
+		require.Equal(t, tt.want == http.StatusOK, called, tt.name)
+	}
+}
+
+func TestRequireAllPermissions(t *testing.T) {
+	handler := Require(PermPaymentsRead, PermPaymentsWrite)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{Roles: []string{"backoffice"}}))
+	res := httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/", nil)
+	req = req.WithContext(WithPrincipal(req.Context(), &Principal{Roles: []string{"backoffice", "admin"}}))
+	res = httptest.NewRecorder()
+	handler.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestPrincipalLooksUpCustomerOnce(t *testing.T) {
+	originalLookup := lookupCustomerID
+	defer func() {
+		lookupCustomerID = originalLookup
+	}()
+	lookups := 0
+	lookupCustomerID = func(keycloakID string) int {
+		lookups++
+		if keycloakID == "user" {
+			return 7
+		}
+		return 0
+	}
+
+	principal := &Principal{UserID: "user"}
+	for range 3 {
+		id, ok := principal.CustomerID()
+		require.True(t, ok)
+		require.Equal(t, 7, id)
+	}
+	require.Equal(t, 1, lookups)
+
+	_, ok := (&Principal{UserID: "other"}).CustomerID()
+	require.False(t, ok)
+}
+
+func TestAuthMiddlewareAPIKey(t *testing.T) {
+	originalAuthenticate := authenticateAPIKey
+	defer func() {
+		authenticateAPIKey = originalAuthenticate
+	}()
+	authenticateAPIKey = func(key string, ip string) (database.APIKey, error) {
+		switch key {
+		case "ak_odoo_secret":
+			return database.APIKey{ID: 3, Name: "odoo", Permissions: []string{"items:write"}, RouteGroups: []string{"integrations"}}, nil
+		case "ak_office_secret":
+			return database.APIKey{}, database.ErrAPIKeyIPNotAllowed
+		}
+		return database.APIKey{}, database.ErrAPIKeyInvalid
+	}
+
+	serve := func(group RouteGroup, header string, value string) (*Principal, int) {
+		var principal *Principal
+		handler := http.Handler(AuthMiddleware(Require(PermItemsWrite)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, _ = PrincipalFromContext(r.Context())
+			w.WriteHeader(http.StatusOK)
+		}))))
+		if group != "" {
+			handler = InRouteGroup(group)(handler)
+		}
+		req := httptest.NewRequest(http.MethodPost, "/api/flour/items/", nil)
+		req.Header.Set(header, value)
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return principal, res.Code
+	}
+
+	principal, code := serve(RouteGroupIntegrations, "X-API-Key", "ak_odoo_secret")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 3, principal.APIKeyID)
+	require.Equal(t, "apikey:odoo", principal.Username)
+	require.True(t, principal.Can(PermItemsWrite))
+	require.False(t, principal.Can(PermItemsRead))
+
+	_, code = serve(RouteGroupIntegrations, "Authorization", "Bearer ak_odoo_secret")
+	require.Equal(t, http.StatusOK, code)
+
+	_, code = serve(RouteGroupBackoffice, "X-API-Key", "ak_odoo_secret")
+	require.Equal(t, http.StatusForbidden, code, "key is not scoped to the backoffice")
+	_, code = serve("", "X-API-Key", "ak_odoo_secret")
+	require.Equal(t, http.StatusForbidden, code, "keys are not accepted outside of route groups")
+	_, code = serve(RouteGroupIntegrations, "X-API-Key", "ak_office_secret")
+	require.Equal(t, http.StatusForbidden, code)
+	_, code = serve(RouteGroupIntegrations, "Authorization", "Bearer ak_unknown_secret")
+	require.Equal(t, http.StatusUnauthorized, code)
+}
+
+func TestAPIKeyPermission(t *testing.T) {
+	require.True(t, APIKeyPermission(PermPaymentsPayout))
+	require.True(t, APIKeyPermission(PermIntegrationItems))
+	require.False(t, APIKeyPermission(PermAPIKeysManage))
+	require.False(t, APIKeyPermission(PermCustomerSelf))
+	require.False(t, APIKeyPermission("everything"))
+}
+
+func TestTTLCache(t *testing.T) {
+	cache := newTTLCache[[]string]()
+	cache.set("user", []string{"admin"}, time.Minute)
+	roles, ok := cache.get("user")
+	require.True(t, ok)
+	require.Equal(t, []string{"admin"}, roles)
+
+	cache.set("disabled", []string{"admin"}, 0)
+	_, ok = cache.get("disabled")
+	require.False(t, ok)
+
+	cache.set("expired", []string{"admin"}, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	_, ok = cache.get("expired")
+	require.False(t, ok)
+}
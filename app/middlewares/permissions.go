@@ -0,0 +1,194 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/augustin-wien/augustina-backend/keycloak"
+)
+
+// Permission allows an action, routes state the permissions they require
+// with Require
+type Permission string
+
+// Permissions of the backoffice and integrations
+const (
+	// Use the backoffice at all, e.g. to list things shown on its dashboard
+	PermBackoffice Permission = "backoffice"
+
+	PermSettingsWrite      Permission = "settings:write"
+	PermVendorsRead        Permission = "vendors:read"
+	PermVendorsWrite       Permission = "vendors:write"
+	PermVendorsRecalculate Permission = "vendors:recalculate"
+	PermItemsRead          Permission = "items:read"
+	PermItemsWrite         Permission = "items:write"
+	PermStockWrite         Permission = "stock:write"
+	PermOrdersRead         Permission = "orders:read"
+	PermOrdersVerify       Permission = "orders:verify"
+	PermPOSSell            Permission = "pos:sell"
+	PermConsignmentsWrite  Permission = "consignments:write"
+	PermCustomersRead      Permission = "customers:read"
+	PermCustomersWrite     Permission = "customers:write"
+	PermAbonementsRead     Permission = "abonements:read"
+	PermAbonementsWrite    Permission = "abonements:write"
+	PermPaymentsRead       Permission = "payments:read"
+	PermPaymentsWrite      Permission = "payments:write"
+	PermPaymentsPayout     Permission = "payments:payout"
+	PermInvoicesRead       Permission = "invoices:read"
+	PermInvoicesWrite      Permission = "invoices:write"
+	PermPromotionsRead     Permission = "promotions:read"
+	PermPromotionsWrite    Permission = "promotions:write"
+	PermPDFsManage         Permission = "pdfs:manage"
+	PermPDFsPurge          Permission = "pdfs:purge"
+	PermMailTemplatesRead  Permission = "mail-templates:read"
+	PermMailTemplatesWrite Permission = "mail-templates:write"
+	PermVendorSelf         Permission = "vendor:self"
+	PermCustomerSelf       Permission = "customer:self"
+	PermAPIKeysManage      Permission = "api-keys:manage"
+	PermAuditRead          Permission = "audit:read"
+	PermKeycloakReconcile  Permission = "keycloak:reconcile"
+	// Ask whether a reader's token grants access to an item, granted to the
+	// API keys of external readers
+	PermEntitlementsIntrospect Permission = "entitlements:introspect"
+
+	// Integrations are confined to their own route trees, the legacy Flour
+	// tree and the Odoo tree, and only hold the permissions checked there
+	PermIntegrationFlour   Permission = "integrations:flour"
+	PermIntegrationOdoo    Permission = "integrations:odoo"
+	PermIntegrationVendors Permission = "integrations:vendors"
+	PermIntegrationPayouts Permission = "integrations:payouts"
+	PermIntegrationItems   Permission = "integrations:items"
+)
+
+// integrationPermissions are only checked by the routes of the
+// integrations
+var integrationPermissions = []Permission{
+	PermIntegrationFlour, PermIntegrationOdoo,
+	PermIntegrationVendors, PermIntegrationPayouts, PermIntegrationItems,
+}
+
+// backofficePermissions are granted to the backoffice role. Compared to
+// admins it can not change settings and mail templates, create payments
+// by hand, recalculate balances, run promotions or purge PDFs.
+var backofficePermissions = []Permission{
+	PermBackoffice,
+	PermVendorsRead, PermVendorsWrite,
+	PermItemsRead, PermItemsWrite, PermStockWrite,
+	PermOrdersRead, PermOrdersVerify,
+	PermPOSSell, PermConsignmentsWrite,
+	PermCustomersRead, PermCustomersWrite,
+	PermAbonementsRead, PermAbonementsWrite,
+	PermPaymentsRead, PermPaymentsPayout,
+	PermInvoicesRead, PermInvoicesWrite,
+	PermPromotionsRead,
+	PermPDFsManage,
+	PermMailTemplatesRead,
+}
+
+// RolePermissions maps Keycloak realm roles to the permissions they grant
+var RolePermissions = map[string][]Permission{
+	"admin": {
+		PermBackoffice,
+		PermSettingsWrite,
+		PermVendorsRead, PermVendorsWrite, PermVendorsRecalculate,
+		PermItemsRead, PermItemsWrite, PermStockWrite,
+		PermOrdersRead, PermOrdersVerify,
+		PermPOSSell, PermConsignmentsWrite,
+		PermCustomersRead, PermCustomersWrite,
+		PermAbonementsRead, PermAbonementsWrite,
+		PermPaymentsRead, PermPaymentsWrite, PermPaymentsPayout,
+		PermInvoicesRead, PermInvoicesWrite,
+		PermPromotionsRead, PermPromotionsWrite,
+		PermPDFsManage, PermPDFsPurge,
+		PermMailTemplatesRead, PermMailTemplatesWrite,
+		PermVendorSelf, PermCustomerSelf,
+		PermAPIKeysManage,
+		PermAuditRead,
+		PermKeycloakReconcile,
+		PermEntitlementsIntrospect,
+	},
+	"backoffice": backofficePermissions,
+	"customer":   {PermCustomerSelf},
+	// Integrations syncing vendors and payouts, they have no access to the
+	// backoffice
+	"flour": {PermIntegrationFlour, PermIntegrationVendors, PermIntegrationPayouts},
+	"odoo":  {PermIntegrationOdoo, PermIntegrationVendors, PermIntegrationPayouts, PermIntegrationItems},
+}
+
+// groupPermissions returns the permissions granted by membership in a
+// Keycloak group
+func groupPermissions(group string) []Permission {
+	switch group {
+	case keycloak.KeycloakClient.GetVendorGroup():
+		return []Permission{PermVendorSelf}
+	case keycloak.KeycloakClient.CustomerGroup:
+		return []Permission{PermCustomerSelf}
+	}
+	return nil
+}
+
+// APIKeyPermission reports whether a permission may be granted to an API
+// key. Keys act for the organisation or an integration, not for a vendor or
+// customer, and can not issue further keys.
+func APIKeyPermission(permission Permission) bool {
+	switch permission {
+	case PermVendorSelf, PermCustomerSelf, PermAPIKeysManage:
+		return false
+	}
+	return slices.Contains(RolePermissions["admin"], permission) || slices.Contains(integrationPermissions, permission)
+}
+
+// Can reports whether the principal has a permission through one of its
+// roles or groups or was granted it directly
+func (p *Principal) Can(permission Permission) bool {
+	if slices.Contains(p.Permissions, permission) {
+		return true
+	}
+	for _, role := range p.Roles {
+		if slices.Contains(RolePermissions[role], permission) {
+			return true
+		}
+	}
+	for _, group := range p.Groups {
+		if group != "" && slices.Contains(groupPermissions(group), permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// RouteGroup names a set of routes API keys can be scoped to
+type RouteGroup string
+
+// Route groups of the API
+const (
+	// Routes of the Odoo and Flour integrations
+	RouteGroupIntegrations RouteGroup = "integrations"
+	// Routes of the backoffice
+	RouteGroupBackoffice RouteGroup = "backoffice"
+	// Routes of external readers like WordPress or the e-paper viewer
+	RouteGroupReaders RouteGroup = "readers"
+)
+
+// RouteGroups lists all route groups
+var RouteGroups = []RouteGroup{RouteGroupIntegrations, RouteGroupBackoffice, RouteGroupReaders}
+
+type ctxKeyRouteGroup struct{}
+
+// InRouteGroup is a middleware marking the routes of a route group. API keys
+// are only accepted on routes of their route groups, so it has to run before
+// AuthMiddleware.
+func InRouteGroup(group RouteGroup) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), ctxKeyRouteGroup{}, group)))
+		})
+	}
+}
+
+// routeGroupFromContext returns the route group of a request
+func routeGroupFromContext(ctx context.Context) (RouteGroup, bool) {
+	group, ok := ctx.Value(ctxKeyRouteGroup{}).(RouteGroup)
+	return group, ok
+}
@@ -0,0 +1,5 @@
+-- Store how confident the geocoder was about the coordinates of a location
+-- and when geocoding its address last failed
+ALTER TABLE locations
+    ADD COLUMN IF NOT EXISTS geocoding_confidence DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS geocode_attempted_at TIMESTAMPTZ;
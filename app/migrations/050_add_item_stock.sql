@@ -0,0 +1,24 @@
+-- Add stock tracking to items and a ledger of stock movements
+
+BEGIN;
+
+ALTER TABLE item ADD COLUMN IF NOT EXISTS trackstock BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE item ADD COLUMN IF NOT EXISTS stock BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE item ADD COLUMN IF NOT EXISTS lowstockthreshold BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS stock_movement (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(50) NOT NULL,
+    quantity BIGINT NOT NULL,
+    stock_after BIGINT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    item INTEGER NOT NULL REFERENCES item(id) ON DELETE CASCADE,
+    vendor INTEGER REFERENCES vendor(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movement_item_created_at ON stock_movement(item, created_at);
+CREATE INDEX IF NOT EXISTS idx_stock_movement_vendor ON stock_movement(vendor);
+
+COMMIT;
@@ -0,0 +1,21 @@
+-- Track copies of an issue handed out to and returned by vendors
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS consignment (
+    id BIGSERIAL PRIMARY KEY,
+    handed_out BIGINT NOT NULL DEFAULT 0,
+    returned BIGINT NOT NULL DEFAULT 0,
+    settled BIGINT NOT NULL DEFAULT 0,
+    price BIGINT NOT NULL,
+    settled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    vendor INTEGER NOT NULL REFERENCES vendor(id) ON DELETE CASCADE,
+    item INTEGER NOT NULL REFERENCES item(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_consignment_vendor_item ON consignment(vendor, item);
+CREATE INDEX IF NOT EXISTS idx_consignment_item ON consignment(item);
+
+COMMIT;
@@ -0,0 +1,7 @@
+-- Schedule publishing and unpublishing of items
+
+ALTER TABLE item ADD COLUMN IF NOT EXISTS publishat TIMESTAMPTZ;
+ALTER TABLE item ADD COLUMN IF NOT EXISTS unpublishat TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_item_publishat ON item(publishat) WHERE publishat IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_item_unpublishat ON item(unpublishat) WHERE unpublishat IS NOT NULL;
@@ -0,0 +1,31 @@
+-- Download limits, expiry windows and abuse detection for PDF download links
+
+BEGIN;
+
+ALTER TABLE settings
+    ADD COLUMN IF NOT EXISTS pdfdownloadlimit      INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS pdfdownloadexpirydays INT NOT NULL DEFAULT 42,
+    ADD COLUMN IF NOT EXISTS pdfdownloadmaxips     INT NOT NULL DEFAULT 10;
+
+ALTER TABLE item
+    ADD COLUMN IF NOT EXISTS pdfdownloadlimit      INT,
+    ADD COLUMN IF NOT EXISTS pdfdownloadexpirydays INT;
+
+ALTER TABLE pdf_download
+    ADD COLUMN IF NOT EXISTS suspended      BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS suspended_at   TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS suspend_reason TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS pdf_download_access (
+    id BIGSERIAL PRIMARY KEY,
+    pdf_download_id INTEGER NOT NULL REFERENCES pdf_download(id) ON DELETE CASCADE,
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    allowed BOOLEAN NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pdf_download_access_download ON pdf_download_access(pdf_download_id, created_at);
+
+COMMIT;
@@ -0,0 +1,49 @@
+-- Store PDF.timestamp as a real time column and allow the retention job to
+-- delete PDFs and their download links. Other deletes are still refused.
+
+BEGIN;
+
+UPDATE pdf SET timestamp = NULL WHERE timestamp::text = '';
+ALTER TABLE pdf ALTER COLUMN timestamp TYPE TIMESTAMPTZ USING timestamp::text::timestamptz;
+UPDATE pdf SET timestamp = now() WHERE timestamp IS NULL;
+ALTER TABLE pdf ALTER COLUMN timestamp SET DEFAULT now();
+ALTER TABLE pdf ALTER COLUMN timestamp SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pdf_timestamp ON pdf(timestamp);
+CREATE INDEX IF NOT EXISTS idx_pdf_download_pdf ON pdf_download(pdf_id);
+
+-- Deletion is restricted to the retention job, which checks references
+-- first and sets augustina.purge_pdfs for its transaction with SET LOCAL
+CREATE OR REPLACE FUNCTION prevent_delete_pdf()
+RETURNS trigger AS $$
+BEGIN
+    IF current_setting('augustina.purge_pdfs', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'Cannot delete from table PDF';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION prevent_delete_pdfdownloads()
+RETURNS trigger AS $$
+BEGIN
+    IF current_setting('augustina.purge_pdfs', true) = 'on' THEN
+        RETURN OLD;
+    END IF;
+    RAISE EXCEPTION 'Cannot delete from table PDFDownloads';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS prevent_deleting_on_table_pdf ON pdf;
+CREATE TRIGGER prevent_deleting_on_table_pdf
+BEFORE DELETE ON pdf
+FOR EACH ROW
+EXECUTE FUNCTION prevent_delete_pdf();
+
+DROP TRIGGER IF EXISTS prevent_deleting_on_table_pdfdownloads ON pdf_download;
+CREATE TRIGGER prevent_deleting_on_table_pdfdownloads
+BEFORE DELETE ON pdf_download
+FOR EACH ROW
+EXECUTE FUNCTION prevent_delete_pdfdownloads();
+
+COMMIT;
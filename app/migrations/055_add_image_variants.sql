@@ -0,0 +1,8 @@
+-- Resized and WebP variants of uploaded item and settings images
+
+BEGIN;
+
+ALTER TABLE item ADD COLUMN IF NOT EXISTS imagevariants JSONB;
+ALTER TABLE settings ADD COLUMN IF NOT EXISTS imagevariants JSONB;
+
+COMMIT;
@@ -0,0 +1,46 @@
+-- Entitlements grant customers access to online issues. Keycloak license
+-- groups are derived from them.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS entitlement (
+    id BIGSERIAL PRIMARY KEY,
+    customer BIGINT NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    item BIGINT REFERENCES item(id) ON DELETE CASCADE,
+    license_group TEXT NOT NULL DEFAULT '',
+    source VARCHAR(50) NOT NULL,
+    paymentorder BIGINT REFERENCES paymentorder(id) ON DELETE SET NULL,
+    abonement BIGINT REFERENCES abonement(id) ON DELETE CASCADE,
+    valid_from TIMESTAMPTZ NOT NULL,
+    valid_to TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_entitlement_customer ON entitlement(customer);
+CREATE INDEX IF NOT EXISTS idx_entitlement_item ON entitlement(item);
+CREATE INDEX IF NOT EXISTS idx_entitlement_abonement ON entitlement(abonement);
+
+-- Abonements grant their license group while they run
+INSERT INTO entitlement (customer, license_group, source, abonement, valid_from, valid_to, revoked_at)
+SELECT a.customer_id, i.licensegroup, 'abonement', a.id, a.from_date, a.to_date,
+       CASE WHEN a.status = 'active' THEN NULL ELSE now() END
+FROM abonement a
+JOIN item i ON i.id = a.abonement_item
+WHERE COALESCE(i.licensegroup, '') <> '';
+
+-- Keep the other license groups customers already have in Keycloak. Groups
+-- of their abonements are left out, they end with the abonement.
+INSERT INTO entitlement (customer, license_group, source, valid_from)
+SELECT c.id, g.license_group, 'legacy', COALESCE(c.created_at, now())
+FROM customer c
+CROSS JOIN LATERAL unnest(string_to_array(c.licensegroups, ',')) AS g(license_group)
+WHERE g.license_group <> ''
+  AND NOT EXISTS (
+      SELECT 1
+      FROM abonement a
+      JOIN item i ON i.id = a.abonement_item
+      WHERE a.customer_id = c.id AND i.licensegroup = g.license_group
+  );
+
+COMMIT;
@@ -0,0 +1,31 @@
+-- Abonement lifecycle: cancellation by customers and expiry reminders
+-- Note: {{ "{{" }} and {{ "}}" }} are used to escape Go template delimiters,
+-- because tern processes migration files as Go templates.
+
+BEGIN;
+
+ALTER TABLE abonement
+    ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS reminded_at  TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_abonement_status_to_date ON abonement(status, to_date);
+
+-- Admins could set abonements to "inactive" before the lifecycle statuses
+-- existed. Ended ones are expired, the others are paused until reactivated.
+UPDATE abonement SET status = 'expired' WHERE status = 'inactive' AND to_date <= now();
+UPDATE abonement SET status = 'paused' WHERE status = 'inactive';
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'abonementExpiryReminder',
+    'Your {{ "{{" }}.ItemName{{ "}}" }} subscription ends on {{ "{{" }}.ToDate{{ "}}" }}',
+    '<p>Hello {{ "{{" }}.CustomerName{{ "}}" }},</p>
+<p>Your subscription to <strong>{{ "{{" }}.ItemName{{ "}}" }}</strong> ends in {{ "{{" }}.DaysLeft{{ "}}" }} days, on {{ "{{" }}.ToDate{{ "}}" }}.</p>
+<p>Renew it to keep reading the online issues: <a href="{{ "{{" }}.RenewURL{{ "}}" }}">{{ "{{" }}.RenewURL{{ "}}" }}</a></p>
+<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+COMMIT;
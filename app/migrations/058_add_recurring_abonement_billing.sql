@@ -0,0 +1,60 @@
+-- Recurring abonement billing: payment mandates stored at the first
+-- purchase are charged again before the abonement ends, if the buyer
+-- agreed to automatic renewal at checkout.
+-- Note: {{ "{{" }} and {{ "}}" }} are used to escape Go template delimiters,
+-- because tern processes migration files as Go templates.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS payment_mandate (
+    id BIGSERIAL PRIMARY KEY,
+    customer BIGINT NOT NULL REFERENCES customer(id) ON DELETE CASCADE,
+    provider VARCHAR(50) NOT NULL,
+    token TEXT NOT NULL,
+    card_number VARCHAR(50) NOT NULL DEFAULT '',
+    paymentorder BIGINT REFERENCES paymentorder(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_payment_mandate_customer ON payment_mandate(customer);
+
+ALTER TABLE paymentorder
+    ADD COLUMN IF NOT EXISTS auto_renew BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE abonement
+    ADD COLUMN IF NOT EXISTS paymentorder BIGINT REFERENCES paymentorder(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS auto_renew BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS payment_mandate BIGINT REFERENCES payment_mandate(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS renewal_attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_renewal_at TIMESTAMPTZ;
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'abonementRenewed',
+    'Your {{ "{{" }}.ItemName{{ "}}" }} subscription has been renewed',
+    '<p>Hello {{ "{{" }}.CustomerName{{ "}}" }},</p>
+<p>Your subscription to <strong>{{ "{{" }}.ItemName{{ "}}" }}</strong> has been renewed until {{ "{{" }}.ToDate{{ "}}" }}.</p>
+<p>We charged {{ "{{" }}.Amount{{ "}}" }} EUR to your stored payment method.</p>
+<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'abonementRenewalFailed',
+    'We could not renew your {{ "{{" }}.ItemName{{ "}}" }} subscription',
+    '<p>Hello {{ "{{" }}.CustomerName{{ "}}" }},</p>
+<p>We could not charge your stored payment method to renew your subscription to <strong>{{ "{{" }}.ItemName{{ "}}" }}</strong>, which ends on {{ "{{" }}.ToDate{{ "}}" }}.</p>
+{{ "{{" }}if .FinalAttempt{{ "}}" }}<p>This was our last attempt, automatic renewal has been turned off.</p>
+{{ "{{" }}else{{ "}}" }}<p>We will try again on {{ "{{" }}.NextAttempt{{ "}}" }}.</p>
+{{ "{{" }}end{{ "}}" }}<p>To keep reading the online issues, please renew your subscription here: <a href="{{ "{{" }}.RenewURL{{ "}}" }}">{{ "{{" }}.RenewURL{{ "}}" }}</a></p>
+<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,64 @@
+-- Gift purchases: abonements and digital items bought as a gift are granted
+-- through redeemable gift codes.
+-- Note: {{ "{{" }} and {{ "}}" }} are used to escape Go template delimiters,
+-- because tern processes migration files as Go templates.
+
+BEGIN;
+
+ALTER TABLE paymentorder
+    ADD COLUMN IF NOT EXISTS gift BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS gift_recipient_email TEXT,
+    ADD COLUMN IF NOT EXISTS gift_send_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS gift_message TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS gift_code (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    item BIGINT NOT NULL REFERENCES item(id) ON DELETE CASCADE,
+    paymentorder BIGINT REFERENCES paymentorder(id) ON DELETE SET NULL,
+    buyer_email TEXT NOT NULL,
+    recipient_email TEXT,
+    message TEXT NOT NULL DEFAULT '',
+    send_at TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ,
+    redeemed_at TIMESTAMPTZ,
+    customer BIGINT REFERENCES customer(id) ON DELETE SET NULL,
+    abonement BIGINT REFERENCES abonement(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_code_paymentorder ON gift_code(paymentorder);
+CREATE INDEX IF NOT EXISTS idx_gift_code_redeemed_at ON gift_code(redeemed_at);
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'giftPurchased',
+    'Your Augustin gift',
+    '<p>Hello,</p>
+<p>Thank you for your gift! These are the gift codes for your purchase:</p>
+<ul>
+{{ "{{" }}range .Codes{{ "}}" }}  <li>{{ "{{" }}.ItemName{{ "}}" }}: <strong>{{ "{{" }}.Code{{ "}}" }}</strong></li>
+{{ "{{" }}end{{ "}}" }}</ul>
+{{ "{{" }}if .RecipientEmail{{ "}}" }}<p>We will send them to {{ "{{" }}.RecipientEmail{{ "}}" }} on {{ "{{" }}.SendAt{{ "}}" }}.</p>
+{{ "{{" }}else{{ "}}" }}<p>Pass them on to the lucky recipient, who can redeem them here: <a href="{{ "{{" }}.RedeemURL{{ "}}" }}">{{ "{{" }}.RedeemURL{{ "}}" }}</a></p>
+{{ "{{" }}end{{ "}}" }}<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'giftReceived',
+    'You received {{ "{{" }}.ItemName{{ "}}" }} as a gift',
+    '<p>Hello,</p>
+<p>{{ "{{" }}.BuyerEmail{{ "}}" }} gave you <strong>{{ "{{" }}.ItemName{{ "}}" }}</strong> as a gift.</p>
+{{ "{{" }}if .Message{{ "}}" }}<blockquote>{{ "{{" }}.Message{{ "}}" }}</blockquote>
+{{ "{{" }}end{{ "}}" }}<p>Redeem it with the code <strong>{{ "{{" }}.Code{{ "}}" }}</strong> here: <a href="{{ "{{" }}.RedeemURL{{ "}}" }}">{{ "{{" }}.RedeemURL{{ "}}" }}</a></p>
+<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+COMMIT;
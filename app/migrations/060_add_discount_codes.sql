@@ -0,0 +1,35 @@
+-- Discount codes reduce the price of orders by a separate order entry of
+-- the discount item, which is created when the first code is used. Uses
+-- of orders that are not paid in time are released again.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS discount_code (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(64) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    kind VARCHAR(20) NOT NULL,
+    value INTEGER NOT NULL,
+    item BIGINT REFERENCES item(id) ON DELETE CASCADE,
+    license_group TEXT NOT NULL DEFAULT '',
+    valid_from TIMESTAMPTZ,
+    valid_to TIMESTAMPTZ,
+    max_uses INTEGER NOT NULL DEFAULT 0,
+    uses INTEGER NOT NULL DEFAULT 0,
+    disabled BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS discount_redemption (
+    id BIGSERIAL PRIMARY KEY,
+    discount_code BIGINT NOT NULL REFERENCES discount_code(id) ON DELETE CASCADE,
+    paymentorder BIGINT NOT NULL REFERENCES paymentorder(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    released_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_discount_redemption_discount_code ON discount_redemption(discount_code);
+CREATE INDEX IF NOT EXISTS idx_discount_redemption_paymentorder ON discount_redemption(paymentorder);
+
+COMMIT;
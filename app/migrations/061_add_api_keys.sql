@@ -0,0 +1,23 @@
+-- API keys authenticate integrations like Odoo and Flour alongside Keycloak
+-- tokens. Only the SHA-256 hash of a key is stored.
+
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS api_key (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    prefix VARCHAR(32) NOT NULL UNIQUE,
+    key_hash VARCHAR(64) NOT NULL,
+    permissions JSONB NOT NULL DEFAULT '[]',
+    route_groups JSONB NOT NULL DEFAULT '[]',
+    allowed_ips JSONB NOT NULL DEFAULT '[]',
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    last_used_ip TEXT NOT NULL DEFAULT '',
+    rotated_from BIGINT REFERENCES api_key(id) ON DELETE SET NULL,
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+COMMIT;
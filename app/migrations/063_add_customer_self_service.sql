@@ -0,0 +1,18 @@
+-- Customer self-service: preferences customers manage themselves,
+-- changed emails waiting for verification and requests to delete their
+-- account, which an admin carries out.
+
+BEGIN;
+
+ALTER TABLE customer
+    ADD COLUMN IF NOT EXISTS pending_email TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS newsletter BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS notify_new_issues BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN IF NOT EXISTS notify_abonement_reminders BOOLEAN NOT NULL DEFAULT true,
+    ADD COLUMN IF NOT EXISTS deletion_requested_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS deletion_reason TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_customer_deletion_requested_at ON customer(deletion_requested_at)
+    WHERE deletion_requested_at IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,60 @@
+-- Invoices for verified online orders with sequential numbers per year,
+-- the billing address entered at checkout and the issuer details.
+-- Note: {{ "{{" }} and {{ "}}" }} are used to escape Go template delimiters,
+-- because tern processes migration files as Go templates.
+
+BEGIN;
+
+ALTER TABLE paymentorder
+    ADD COLUMN IF NOT EXISTS billing_address JSONB;
+
+ALTER TABLE settings
+    ADD COLUMN IF NOT EXISTS invoicesenabled      BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS invoiceissuername    TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS invoiceissueraddress TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS invoiceissuervatid   TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS invoicefooter        TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS defaultvatrate       DOUBLE PRECISION NOT NULL DEFAULT 10;
+
+CREATE TABLE IF NOT EXISTS invoice_sequence (
+    id BIGSERIAL PRIMARY KEY,
+    year INT NOT NULL UNIQUE,
+    last INT NOT NULL DEFAULT 0
+);
+
+-- Invoices have to be kept, so their orders can not be deleted
+CREATE TABLE IF NOT EXISTS invoice (
+    id BIGSERIAL PRIMARY KEY,
+    number VARCHAR(32) NOT NULL UNIQUE,
+    year INT NOT NULL,
+    sequence INT NOT NULL,
+    paymentorder BIGINT NOT NULL UNIQUE REFERENCES paymentorder(id) ON DELETE RESTRICT,
+    customer BIGINT REFERENCES customer(id) ON DELETE SET NULL,
+    email TEXT NOT NULL,
+    billing_address JSONB,
+    issuer JSONB NOT NULL,
+    lines JSONB NOT NULL,
+    net INT NOT NULL,
+    tax INT NOT NULL,
+    gross INT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS invoice_year_sequence ON invoice(year, sequence);
+CREATE INDEX IF NOT EXISTS invoice_customer ON invoice(customer);
+CREATE INDEX IF NOT EXISTS invoice_issued_at ON invoice(issued_at);
+
+INSERT INTO mail_templates (name, subject, body, created_at, updated_at)
+VALUES (
+    'invoiceIssued',
+    'Your invoice {{ "{{" }}.Number{{ "}}" }}',
+    '<p>Hello {{ "{{" }}.CustomerName{{ "}}" }},</p>
+<p>Thank you for your purchase! Attached you find the invoice {{ "{{" }}.Number{{ "}}" }} over {{ "{{" }}.Total{{ "}}" }}.</p>
+<p>Best regards,<br/>The Augustin Team</p>',
+    now(),
+    now()
+)
+ON CONFLICT (name) DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,15 @@
+-- VAT rates per item and the taxes of order entries at the time of
+-- purchase. Items without a rate use the default rate of the settings.
+
+BEGIN;
+
+ALTER TABLE item
+    ADD COLUMN IF NOT EXISTS vatrate DOUBLE PRECISION;
+
+ALTER TABLE orderentry
+    ADD COLUMN IF NOT EXISTS vat_rate DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS net INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS gross INTEGER NOT NULL DEFAULT 0;
+
+COMMIT;
@@ -0,0 +1,155 @@
+package openinghours
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+)
+
+// labels holds the translated texts used to render opening hours
+type labels struct {
+	days      []string
+	daily     string
+	fullDay   string
+	closed    string
+	allWeek   string
+	holidays  string
+	separator string
+	date      string
+}
+
+var translations = map[string]labels{
+	"de": {
+		days:      []string{"Mo", "Di", "Mi", "Do", "Fr", "Sa", "So"},
+		daily:     "Täglich",
+		fullDay:   "ganztägig",
+		closed:    "geschlossen",
+		allWeek:   "Rund um die Uhr geöffnet",
+		holidays:  "An Feiertagen geschlossen",
+		separator: " und ",
+		date:      "02.01.2006",
+	},
+	"en": {
+		days:      []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+		daily:     "Daily",
+		fullDay:   "all day",
+		closed:    "closed",
+		allWeek:   "Open 24/7",
+		holidays:  "Closed on public holidays",
+		separator: " and ",
+		date:      "2006-01-02",
+	},
+}
+
+// DefaultLanguage is used when an unknown language is requested
+const DefaultLanguage = "de"
+
+func translationFor(lang string) labels {
+	if l, ok := translations[strings.ToLower(lang)]; ok {
+		return l
+	}
+	return translations[DefaultLanguage]
+}
+
+// formatClock renders minutes since midnight as HH:MM
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// formatInterval renders an interval as HH:MM–HH:MM
+func formatInterval(iv interval) string {
+	return formatClock(iv.start) + "–" + formatClock(iv.end)
+}
+
+// formatRanges renders the ranges of one day, e.g. "08:00–12:00 und 13:00–17:00"
+func formatRanges(ranges []schema.TimeRange, l labels) string {
+	if len(ranges) == 0 {
+		return l.closed
+	}
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		iv, err := parseRange(r)
+		if err != nil {
+			continue
+		}
+		if iv.start == 0 && iv.end == minutesPerDay {
+			return l.fullDay
+		}
+		parts = append(parts, formatInterval(iv))
+	}
+	if len(parts) == 0 {
+		return l.closed
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, l.separator)
+}
+
+// Lines renders the opening hours as a list of human-readable lines in the
+// given language ("de" or "en"). Consecutive weekdays with the same hours
+// are grouped, e.g. "Mo–Fr: 09:00–17:00". Date exceptions are listed after
+// the regular hours in chronological order.
+func Lines(wt *schema.WorkingTime, lang string) []string {
+	l := translationFor(lang)
+	if wt == nil {
+		return []string{}
+	}
+
+	var lines []string
+	if wt.WholeWeek || wt.Mode == ModeWholeWeek {
+		lines = append(lines, l.allWeek)
+	} else {
+		daily := make([]string, len(WeekDays))
+		for i, day := range WeekDays {
+			daily[i] = formatRanges(regularRanges(wt, day), l)
+		}
+		for start := 0; start < len(daily); {
+			end := start
+			for end+1 < len(daily) && daily[end+1] == daily[start] {
+				end++
+			}
+			var label string
+			switch {
+			case start == 0 && end == len(daily)-1:
+				label = l.daily
+			case start == end:
+				label = l.days[start]
+			default:
+				label = l.days[start] + "–" + l.days[end]
+			}
+			lines = append(lines, label+": "+daily[start])
+			start = end + 1
+		}
+	}
+
+	if wt.ClosedOnHolidays {
+		lines = append(lines, l.holidays)
+	}
+
+	exceptions := append([]schema.DateException(nil), wt.Exceptions...)
+	sort.Slice(exceptions, func(i, j int) bool { return exceptions[i].Date < exceptions[j].Date })
+	for _, exception := range exceptions {
+		date, err := time.ParseInLocation(DateLayout, exception.Date, Vienna)
+		if err != nil {
+			continue
+		}
+		hours := l.closed
+		if !exception.Closed {
+			hours = formatRanges(exception.Ranges, l)
+		}
+		line := date.Format(l.date) + ": " + hours
+		if exception.Note != "" {
+			line += " (" + exception.Note + ")"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Format renders the opening hours as multi-line text, e.g. for printed
+// material.
+func Format(wt *schema.WorkingTime, lang string) string {
+	return strings.Join(Lines(wt, lang), "\n")
+}
@@ -0,0 +1,60 @@
+package openinghours
+
+import "time"
+
+// easterSunday returns the date of Easter Sunday in the given year using the
+// anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, Vienna)
+}
+
+// Holidays returns the Austrian public holidays of the given year keyed by
+// date (YYYY-MM-DD) with their German name.
+func Holidays(year int) map[string]string {
+	fixed := func(month time.Month, day int) string {
+		return time.Date(year, month, day, 0, 0, 0, 0, Vienna).Format(DateLayout)
+	}
+	easter := easterSunday(year)
+	relative := func(days int) string {
+		return easter.AddDate(0, 0, days).Format(DateLayout)
+	}
+	return map[string]string{
+		fixed(time.January, 1):   "Neujahr",
+		fixed(time.January, 6):   "Heilige Drei Könige",
+		relative(0):              "Ostersonntag",
+		relative(1):              "Ostermontag",
+		fixed(time.May, 1):       "Staatsfeiertag",
+		relative(39):             "Christi Himmelfahrt",
+		relative(49):             "Pfingstsonntag",
+		relative(50):             "Pfingstmontag",
+		relative(60):             "Fronleichnam",
+		fixed(time.August, 15):   "Mariä Himmelfahrt",
+		fixed(time.October, 26):  "Nationalfeiertag",
+		fixed(time.November, 1):  "Allerheiligen",
+		fixed(time.December, 8):  "Mariä Empfängnis",
+		fixed(time.December, 25): "Christtag",
+		fixed(time.December, 26): "Stefanitag",
+	}
+}
+
+// IsHoliday reports whether the given day is an Austrian public holiday in
+// Europe/Vienna.
+func IsHoliday(day time.Time) bool {
+	day = day.In(Vienna)
+	_, ok := Holidays(day.Year())[day.Format(DateLayout)]
+	return ok
+}
@@ -0,0 +1,246 @@
+// Package openinghours interprets the structured opening hours stored in
+// schema.WorkingTime. It validates user input, answers whether a location is
+// open at a given moment (in Europe/Vienna, honoring public holidays and
+// date exceptions) and renders the hours as human-readable text.
+package openinghours
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // the production image is alpine without tzdata
+
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+)
+
+// Modes supported by schema.WorkingTime.Mode
+const (
+	ModeByDay     = "by_day"
+	ModeEveryday  = "everyday"
+	ModeWholeWeek = "whole_week"
+	ModeCustom    = "custom"
+)
+
+// DateLayout is the layout of schema.DateException.Date
+const DateLayout = "2006-01-02"
+
+// minutesPerDay is used as the end of a full day range ("24:00")
+const minutesPerDay = 24 * 60
+
+// WeekDays lists the keys of schema.WorkingTime.WeekDays starting on Monday
+var WeekDays = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+// Vienna is the time zone all opening hours are expressed in
+var Vienna = mustLoadLocation("Europe/Vienna")
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic("openinghours: can not load time zone " + name + ": " + err.Error())
+	}
+	return loc
+}
+
+// interval is a parsed TimeRange in minutes since midnight, end exclusive
+type interval struct {
+	start int
+	end   int
+}
+
+// parseClock parses a HH:MM string in 24h format. "24:00" is accepted as
+// the end of the day.
+func parseClock(value string) (int, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	if hours == 24 && minutes == 0 {
+		return minutesPerDay, nil
+	}
+	if hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+// parseRange converts a TimeRange into an interval
+func parseRange(r schema.TimeRange) (interval, error) {
+	if r.FullDay {
+		return interval{start: 0, end: minutesPerDay}, nil
+	}
+	start, err := parseClock(r.From)
+	if err != nil {
+		return interval{}, err
+	}
+	end, err := parseClock(r.To)
+	if err != nil {
+		return interval{}, err
+	}
+	if start >= end {
+		return interval{}, fmt.Errorf("from %q must be before to %q", r.From, r.To)
+	}
+	return interval{start: start, end: end}, nil
+}
+
+// parseRanges converts and sorts a list of TimeRanges. Overlapping ranges
+// are rejected. Errors are prefixed with path to point at the bad input.
+func parseRanges(path string, ranges []schema.TimeRange) ([]interval, error) {
+	intervals := make([]interval, 0, len(ranges))
+	for i, r := range ranges {
+		iv, err := parseRange(r)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", path, i, err)
+		}
+		intervals = append(intervals, iv)
+	}
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].start < intervals[j].start })
+	for i := 1; i < len(intervals); i++ {
+		if intervals[i].start < intervals[i-1].end {
+			return nil, fmt.Errorf("%s: ranges %s and %s overlap", path, formatInterval(intervals[i-1]), formatInterval(intervals[i]))
+		}
+	}
+	return intervals, nil
+}
+
+// Validate checks that the working time only contains known modes and
+// weekdays, well-formed HH:MM times with from before to, no overlapping
+// ranges per day and valid exception dates. A nil working time is valid.
+func Validate(wt *schema.WorkingTime) error {
+	if wt == nil {
+		return nil
+	}
+	switch wt.Mode {
+	case "", ModeByDay, ModeEveryday, ModeWholeWeek, ModeCustom:
+	default:
+		return fmt.Errorf("working_time: unknown mode %q", wt.Mode)
+	}
+	if _, err := parseRanges("working_time.everyday", wt.Everyday); err != nil {
+		return err
+	}
+	days := make([]string, 0, len(wt.WeekDays))
+	for day := range wt.WeekDays {
+		if weekdayIndex(day) < 0 {
+			return fmt.Errorf("working_time.week_days: unknown day %q", day)
+		}
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return weekdayIndex(days[i]) < weekdayIndex(days[j]) })
+	for _, day := range days {
+		if _, err := parseRanges("working_time.week_days."+day, wt.WeekDays[day]); err != nil {
+			return err
+		}
+	}
+	seen := make(map[string]bool, len(wt.Exceptions))
+	for i, exception := range wt.Exceptions {
+		if _, err := time.ParseInLocation(DateLayout, exception.Date, Vienna); err != nil {
+			return fmt.Errorf("working_time.exceptions[%d]: invalid date %q, expected YYYY-MM-DD", i, exception.Date)
+		}
+		if seen[exception.Date] {
+			return fmt.Errorf("working_time.exceptions[%d]: duplicate date %s", i, exception.Date)
+		}
+		seen[exception.Date] = true
+		if exception.Closed {
+			continue
+		}
+		if len(exception.Ranges) == 0 {
+			return fmt.Errorf("working_time.exceptions[%d]: either closed or ranges must be set", i)
+		}
+		if _, err := parseRanges(fmt.Sprintf("working_time.exceptions[%d].ranges", i), exception.Ranges); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// weekdayIndex returns the position of a weekday key in WeekDays or -1
+func weekdayIndex(day string) int {
+	for i, d := range WeekDays {
+		if d == day {
+			return i
+		}
+	}
+	return -1
+}
+
+// weekdayKey maps a time.Weekday to the key used in WeekDays
+func weekdayKey(day time.Weekday) string {
+	// time.Sunday is 0, WeekDays starts on Monday
+	return WeekDays[(int(day)+6)%7]
+}
+
+// regularRanges returns the opening ranges of a weekday without taking
+// holidays or exceptions into account
+func regularRanges(wt *schema.WorkingTime, day string) []schema.TimeRange {
+	if wt.WholeWeek || wt.Mode == ModeWholeWeek {
+		return []schema.TimeRange{{FullDay: true}}
+	}
+	switch wt.Mode {
+	case ModeEveryday:
+		return wt.Everyday
+	case ModeByDay:
+		return wt.WeekDays[day]
+	}
+	// custom or unset mode: per-day ranges take precedence over everyday
+	if ranges, ok := wt.WeekDays[day]; ok {
+		return ranges
+	}
+	return wt.Everyday
+}
+
+// exceptionFor returns the exception for the given date, if any
+func exceptionFor(wt *schema.WorkingTime, date string) *schema.DateException {
+	for i := range wt.Exceptions {
+		if wt.Exceptions[i].Date == date {
+			return &wt.Exceptions[i]
+		}
+	}
+	return nil
+}
+
+// RangesOn returns the opening ranges of the given calendar day in Vienna.
+// Exceptions take precedence over holidays which take precedence over the
+// regular hours. Closed days return nil.
+func RangesOn(wt *schema.WorkingTime, day time.Time) []schema.TimeRange {
+	if wt == nil {
+		return nil
+	}
+	day = day.In(Vienna)
+	if exception := exceptionFor(wt, day.Format(DateLayout)); exception != nil {
+		if exception.Closed {
+			return nil
+		}
+		return exception.Ranges
+	}
+	if wt.ClosedOnHolidays && IsHoliday(day) {
+		return nil
+	}
+	return regularRanges(wt, weekdayKey(day.Weekday()))
+}
+
+// IsOpenAt reports whether the location is open at the given moment. The
+// moment is converted to Europe/Vienna before it is evaluated. Invalid
+// ranges are treated as closed.
+func IsOpenAt(wt *schema.WorkingTime, t time.Time) bool {
+	t = t.In(Vienna)
+	minute := t.Hour()*60 + t.Minute()
+	for _, r := range RangesOn(wt, t) {
+		iv, err := parseRange(r)
+		if err != nil {
+			continue
+		}
+		if minute >= iv.start && minute < iv.end {
+			return true
+		}
+	}
+	return false
+}
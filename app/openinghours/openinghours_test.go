@@ -0,0 +1,146 @@
+package openinghours
+
+import (
+	"testing"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/ent/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func viennaTime(t *testing.T, value string) time.Time {
+	parsed, err := time.ParseInLocation("2006-01-02 15:04", value, Vienna)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		workingTime *schema.WorkingTime
+		errContains string
+	}{
+		{name: "nil", workingTime: nil},
+		{name: "everyday", workingTime: &schema.WorkingTime{Mode: ModeEveryday, Everyday: []schema.TimeRange{{From: "08:00", To: "12:00"}, {From: "13:00", To: "24:00"}}}},
+		{name: "whole week", workingTime: &schema.WorkingTime{Mode: ModeWholeWeek, WholeWeek: true}},
+		{name: "full day", workingTime: &schema.WorkingTime{Mode: ModeByDay, WeekDays: map[string][]schema.TimeRange{"sat": {{FullDay: true}}}}},
+		{name: "unknown mode", workingTime: &schema.WorkingTime{Mode: "v"}, errContains: "unknown mode"},
+		{name: "unknown day", workingTime: &schema.WorkingTime{Mode: ModeByDay, WeekDays: map[string][]schema.TimeRange{"monday": {{From: "08:00", To: "12:00"}}}}, errContains: "unknown day"},
+		{name: "bad format", workingTime: &schema.WorkingTime{Mode: ModeEveryday, Everyday: []schema.TimeRange{{From: "8:00", To: "12:00"}}}, errContains: "expected HH:MM"},
+		{name: "bad hour", workingTime: &schema.WorkingTime{Mode: ModeEveryday, Everyday: []schema.TimeRange{{From: "08:00", To: "25:00"}}}, errContains: "expected HH:MM"},
+		{name: "from after to", workingTime: &schema.WorkingTime{Mode: ModeEveryday, Everyday: []schema.TimeRange{{From: "12:00", To: "08:00"}}}, errContains: "must be before"},
+		{name: "overlap", workingTime: &schema.WorkingTime{Mode: ModeByDay, WeekDays: map[string][]schema.TimeRange{"mon": {{From: "08:00", To: "12:00"}, {From: "11:00", To: "14:00"}}}}, errContains: "working_time.week_days.mon: ranges 08:00–12:00 and 11:00–14:00 overlap"},
+		{name: "full day overlap", workingTime: &schema.WorkingTime{Mode: ModeByDay, WeekDays: map[string][]schema.TimeRange{"mon": {{FullDay: true}, {From: "11:00", To: "14:00"}}}}, errContains: "overlap"},
+		{name: "bad exception date", workingTime: &schema.WorkingTime{Exceptions: []schema.DateException{{Date: "24.12.2026", Closed: true}}}, errContains: "invalid date"},
+		{name: "empty exception", workingTime: &schema.WorkingTime{Exceptions: []schema.DateException{{Date: "2026-12-24"}}}, errContains: "either closed or ranges"},
+		{name: "duplicate exception", workingTime: &schema.WorkingTime{Exceptions: []schema.DateException{{Date: "2026-12-24", Closed: true}, {Date: "2026-12-24", Closed: true}}}, errContains: "duplicate date"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.workingTime)
+			if tc.errContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestHolidays(t *testing.T) {
+	require.Equal(t, "2026-04-05", easterSunday(2026).Format(DateLayout))
+	require.Equal(t, "2024-03-31", easterSunday(2024).Format(DateLayout))
+
+	holidays := Holidays(2026)
+	require.Equal(t, "Ostermontag", holidays["2026-04-06"])
+	require.Equal(t, "Christi Himmelfahrt", holidays["2026-05-14"])
+	require.Equal(t, "Fronleichnam", holidays["2026-06-04"])
+	require.Equal(t, "Nationalfeiertag", holidays["2026-10-26"])
+
+	require.True(t, IsHoliday(viennaTime(t, "2026-12-25 10:00")))
+	require.False(t, IsHoliday(viennaTime(t, "2026-12-23 10:00")))
+	// 23:30 UTC on Christmas Eve is already Christmas Day in Vienna
+	require.True(t, IsHoliday(time.Date(2026, time.December, 24, 23, 30, 0, 0, time.UTC)))
+}
+
+func TestIsOpenAt(t *testing.T) {
+	wt := &schema.WorkingTime{
+		Mode: ModeByDay,
+		WeekDays: map[string][]schema.TimeRange{
+			"mon": {{From: "08:00", To: "12:00"}, {From: "13:00", To: "17:00"}},
+			"sat": {{FullDay: true}},
+		},
+		ClosedOnHolidays: true,
+		Exceptions: []schema.DateException{
+			{Date: "2026-10-19", Closed: true},
+			{Date: "2026-11-02", Ranges: []schema.TimeRange{{From: "10:00", To: "11:00"}}},
+		},
+	}
+
+	// Monday 2026-10-12
+	require.True(t, IsOpenAt(wt, viennaTime(t, "2026-10-12 08:00")))
+	require.True(t, IsOpenAt(wt, viennaTime(t, "2026-10-12 11:59")))
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-10-12 12:00")))
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-10-12 07:59")))
+	require.True(t, IsOpenAt(wt, viennaTime(t, "2026-10-12 16:30")))
+	// Times are evaluated in Vienna: 06:30 UTC is 08:30 CEST
+	require.True(t, IsOpenAt(wt, time.Date(2026, time.October, 12, 6, 30, 0, 0, time.UTC)))
+	// Tuesday has no ranges
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-10-13 09:00")))
+	// Saturday is open all day
+	require.True(t, IsOpenAt(wt, viennaTime(t, "2026-10-17 23:59")))
+	// Monday closed by exception
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-10-19 09:00")))
+	// Nationalfeiertag on Monday 2026-10-26
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-10-26 09:00")))
+	// Monday with special hours
+	require.False(t, IsOpenAt(wt, viennaTime(t, "2026-11-02 09:00")))
+	require.True(t, IsOpenAt(wt, viennaTime(t, "2026-11-02 10:30")))
+
+	require.True(t, IsOpenAt(&schema.WorkingTime{Mode: ModeWholeWeek}, viennaTime(t, "2026-12-25 03:00")))
+	require.False(t, IsOpenAt(nil, viennaTime(t, "2026-10-12 09:00")))
+}
+
+func TestLines(t *testing.T) {
+	weekdays := []schema.TimeRange{{From: "13:00", To: "17:00"}, {From: "09:00", To: "12:00"}}
+	wt := &schema.WorkingTime{
+		Mode: ModeByDay,
+		WeekDays: map[string][]schema.TimeRange{
+			"mon": weekdays,
+			"tue": weekdays,
+			"wed": weekdays,
+			"thu": weekdays,
+			"fri": weekdays,
+			"sat": {{FullDay: true}},
+		},
+		ClosedOnHolidays: true,
+		Exceptions: []schema.DateException{
+			{Date: "2026-12-31", Ranges: []schema.TimeRange{{From: "09:00", To: "12:00"}}},
+			{Date: "2026-12-24", Closed: true, Note: "Weihnachten"},
+		},
+	}
+
+	require.Equal(t, []string{
+		"Mo–Fr: 09:00–12:00 und 13:00–17:00",
+		"Sa: ganztägig",
+		"So: geschlossen",
+		"An Feiertagen geschlossen",
+		"24.12.2026: geschlossen (Weihnachten)",
+		"31.12.2026: 09:00–12:00",
+	}, Lines(wt, "de"))
+
+	require.Equal(t, []string{
+		"Mon–Fri: 09:00–12:00 and 13:00–17:00",
+		"Sat: all day",
+		"Sun: closed",
+		"Closed on public holidays",
+		"2026-12-24: closed (Weihnachten)",
+		"2026-12-31: 09:00–12:00",
+	}, Lines(wt, "en"))
+
+	everyday := &schema.WorkingTime{Mode: ModeEveryday, Everyday: []schema.TimeRange{{From: "08:00", To: "12:00"}}}
+	require.Equal(t, "Täglich: 08:00–12:00", Format(everyday, "unknown"))
+	require.Equal(t, "Open 24/7", Format(&schema.WorkingTime{WholeWeek: true}, "en"))
+	require.Empty(t, Lines(nil, "de"))
+}
@@ -121,6 +121,9 @@ func CreatePaymentOrder(accessToken string, order database.Order, vendorLicenseI
 
 	// Create string slice listing every item name in order
 	items := []string{}
+	// Abonements are renewed by charging the card of this order again, if
+	// the buyer agreed to it
+	allowRecurring := false
 
 	// Iterate through the order entries and retrieve item names
 	for _, entry := range order.Entries {
@@ -129,6 +132,9 @@ func CreatePaymentOrder(accessToken string, order database.Order, vendorLicenseI
 			log.Error("AuthenticateToVivaWallet: Item could not be found", zap.Error(err))
 		}
 		items = append(items, item.Name)
+		if item.Type == "abonement" && order.AutoRenew {
+			allowRecurring = true
+		}
 	}
 
 	if config.Config.VivaWalletSourceCode == "" {
@@ -142,7 +148,7 @@ func CreatePaymentOrder(accessToken string, order database.Order, vendorLicenseI
 		Customer:            customer,
 		PaymentTimeout:      300,
 		Preauth:             false,
-		AllowRecurring:      false,
+		AllowRecurring:      allowRecurring,
 		MaxInstallments:     0,
 		PaymentNotification: true,
 		TipAmount:           0,
@@ -329,6 +335,9 @@ func HandlePaymentSuccessfulResponse(paymentSuccessful TransactionSuccessRequest
 		if item.IsLicenseItem {
 			continue // Skip license items
 		}
+		if item.Type == "discount" && !entry.IsSale {
+			continue // Skip discounts covered by the organization
+		}
 
 		sum += float64(entry.Price * entry.Quantity)
 	}
@@ -347,6 +356,21 @@ func HandlePaymentSuccessfulResponse(paymentSuccessful TransactionSuccessRequest
 		log.Error("Verifying order and creating payments failed: ", err)
 		return err
 	}
+
+	// Keep the transaction as mandate to renew the abonements of the order,
+	// if the buyer agreed to it and the card supports recurring payments
+	if order.AutoRenew && transactionVerificationResponse.RecurringSupport {
+		_, err = database.Db.AttachPaymentMandateToOrder(order.ID, database.PaymentMandate{
+			Provider:   database.PaymentProviderVivaWallet,
+			Token:      paymentSuccessful.EventData.TransactionID,
+			CardNumber: transactionVerificationResponse.CardNumber,
+		})
+		if err != nil {
+			// The order is paid, the customer can still renew manually
+			log.Error("HandlePaymentSuccessfulResponse: storing payment mandate failed: ", err)
+			err = nil
+		}
+	}
 	// flour
 	if config.Config.FlourWebhookURL != "" {
 		log.Info("Flour Webhook set, sending webhook for order", order.ID)
@@ -0,0 +1,89 @@
+package paymentprovider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/database"
+)
+
+// VivaWalletCharger charges VivaWallet payment mandates to renew abonements
+type VivaWalletCharger struct{}
+
+// Charge charges amount in cents to the card of the mandate's initial
+// transaction and returns the ID of the new transaction
+func (VivaWalletCharger) Charge(mandate database.PaymentMandate, amount int, description string) (string, error) {
+	return CreateRecurringPayment(mandate.Token, amount, description)
+}
+
+// CreateRecurringPayment charges a card again with the ID of the initial
+// transaction, which must have been created with allowRecurring. See
+// https://developer.vivawallet.com/apis-for-payments/payment-api/#tag/Recurring-Payments
+func CreateRecurringPayment(initialTransactionID string, amount int, description string) (string, error) {
+	apiURL := config.Config.VivaWalletMerchantAPIURL
+	if apiURL == "" {
+		return "", errors.New("viva wallet merchant api url is not set")
+	}
+	if config.Config.VivaWalletMerchantID == "" || config.Config.VivaWalletAPIKey == "" {
+		return "", errors.New("viva wallet merchant credentials not set")
+	}
+	u, err := url.ParseRequestURI(apiURL)
+	if err != nil {
+		log.Error("CreateRecurringPayment: parsing URL failed: ", err)
+		return "", err
+	}
+	u.Path = "/api/transactions/" + url.PathEscape(initialTransactionID)
+
+	jsonPost, err := json.Marshal(RecurringPaymentRequest{
+		Amount:       amount,
+		Installments: 1,
+		CustomerTrns: description,
+		MerchantTrns: "Ein gutes Leben für alle!",
+		SourceCode:   config.Config.VivaWalletSourceCode,
+	})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(jsonPost))
+	if err != nil {
+		log.Error("CreateRecurringPayment: building request failed: ", err)
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(config.Config.VivaWalletMerchantID, config.Config.VivaWalletAPIKey)
+
+	client := http.Client{Timeout: 30 * time.Second}
+	res, err := client.Do(req)
+	if err != nil {
+		log.Error("CreateRecurringPayment: sending request failed: ", err)
+		return "", err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		log.Error("CreateRecurringPayment: reading body failed: ", err)
+		return "", err
+	}
+	if res.StatusCode != 200 {
+		return "", errors.New("request failed: status " + strconv.Itoa(res.StatusCode) + " " + string(body))
+	}
+
+	var payment RecurringPaymentResponse
+	if err = json.Unmarshal(body, &payment); err != nil {
+		log.Error("CreateRecurringPayment: unmarshalling body failed: ", err)
+		return "", err
+	}
+	// Only status "F" is a finished payment, see VerifyTransactionID
+	if !payment.Success || payment.StatusID != "F" || payment.TransactionID == "" {
+		return "", errors.New("recurring payment declined: status " + payment.StatusID + " " + strconv.Itoa(payment.ErrorCode) + " " + payment.ErrorText)
+	}
+	return payment.TransactionID, nil
+}
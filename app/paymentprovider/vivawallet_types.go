@@ -182,3 +182,23 @@ type EventData struct {
 type VivaWalletVerificationKeyResponse struct {
 	Key string
 }
+
+// RecurringPaymentRequest is the request body for charging a card again
+// with the ID of its initial transaction
+type RecurringPaymentRequest struct {
+	Amount       int    `json:"amount"`
+	Installments int    `json:"installments"`
+	CustomerTrns string `json:"customerTrns"`
+	MerchantTrns string `json:"merchantTrns"`
+	SourceCode   string `json:"sourceCode"`
+}
+
+// RecurringPaymentResponse is the response body for a recurring payment
+type RecurringPaymentResponse struct {
+	TransactionID string  `json:"TransactionId"`
+	StatusID      string  `json:"StatusId"`
+	Amount        float64 `json:"Amount"`
+	ErrorCode     int     `json:"ErrorCode"`
+	ErrorText     string  `json:"ErrorText"`
+	Success       bool    `json:"Success"`
+}
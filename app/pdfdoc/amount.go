@@ -0,0 +1,20 @@
+package pdfdoc
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FormatEuro formats an amount in cents the Austrian way, like 1.234,50 €
+func FormatEuro(cents int) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	euros := strconv.Itoa(cents / 100)
+	for i := len(euros) - 3; i > 0; i -= 3 {
+		euros = euros[:i] + "." + euros[i:]
+	}
+	return fmt.Sprintf("%s%s,%02d €", sign, euros, cents%100)
+}
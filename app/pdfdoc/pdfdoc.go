@@ -0,0 +1,159 @@
+// Package pdfdoc writes simple PDF documents like receipts and invoices:
+// A4 pages with text in the standard Helvetica fonts and lines. The
+// standard fonts are not embedded, every PDF reader provides them.
+package pdfdoc
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Size of an A4 page in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document is a PDF document under construction
+type Document struct {
+	Title  string
+	Author string
+	pages  []*Page
+}
+
+// Page is a page of a document. Coordinates are in points from the bottom
+// left corner.
+type Page struct {
+	content bytes.Buffer
+}
+
+// New creates an empty document
+func New(title, author string) *Document {
+	return &Document{Title: title, Author: author}
+}
+
+// AddPage appends an empty page
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Text draws text with its baseline starting at x, y
+func (p *Page) Text(x, y, size float64, bold bool, text string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td ", font, number(size), number(x), number(y))
+	writeString(&p.content, EncodeWinAnsi(text))
+	p.content.WriteString(" Tj ET\n")
+}
+
+// TextRight draws text ending at x, e.g. for amounts in a column
+func (p *Page) TextRight(x, y, size float64, bold bool, text string) {
+	p.Text(x-TextWidth(text, size), y, size, bold, text)
+}
+
+// Line draws a thin line
+func (p *Page) Line(x1, y1, x2, y2 float64) {
+	fmt.Fprintf(&p.content, "0.5 w %s %s m %s %s l S\n", number(x1), number(y1), number(x2), number(y2))
+}
+
+// Bytes returns the PDF file
+func (d *Document) Bytes() []byte {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = []*Page{{}}
+	}
+	var buf bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	// Objects 1-4 are the catalog, page tree, fonts and document
+	// information, each page is followed by its content stream
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = strconv.Itoa(6+2*i) + " 0 R"
+	}
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	var info bytes.Buffer
+	info.WriteString("<< /Producer (augustina-backend)")
+	if d.Title != "" {
+		info.WriteString(" /Title ")
+		writeString(&info, textString(d.Title))
+	}
+	if d.Author != "" {
+		info.WriteString(" /Author ")
+		writeString(&info, textString(d.Author))
+	}
+	info.WriteString(" >>")
+	object(info.String())
+	for i, page := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			number(PageWidth), number(PageHeight), 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// number formats a coordinate without needless decimals
+func number(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// writeString writes a literal string, escaping delimiters
+func writeString(buf *bytes.Buffer, s []byte) {
+	buf.WriteByte('(')
+	for _, c := range s {
+		switch c {
+		case '(', ')', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\n':
+			buf.WriteString(`\n`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte(')')
+}
+
+// textString encodes s as PDF text string: ASCII as is, everything else as
+// UTF-16BE with byte order mark
+func textString(s string) []byte {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return []byte(s)
+	}
+	out := []byte{0xfe, 0xff}
+	for _, v := range utf16.Encode([]rune(s)) {
+		out = append(out, byte(v>>8), byte(v))
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package pdfdoc_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/augustin-wien/augustina-backend/pdfdoc"
+	"github.com/augustin-wien/augustina-backend/pdfstamp"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument(t *testing.T) {
+	doc := pdfdoc.New("Rechnung 2026-0001", "Augustin")
+	page := doc.AddPage()
+	page.Text(50, 800, 16, true, "Rechnung (Kopie)")
+	page.TextRight(545, 780, 10, false, "12,50 €")
+	page.Line(50, 770, 545, 770)
+	doc.AddPage().Text(50, 800, 10, false, "Seite 2")
+	data := doc.Bytes()
+
+	require.True(t, bytes.HasPrefix(data, []byte("%PDF-1.4")))
+	require.Contains(t, string(data), "/Count 2")
+	require.Contains(t, string(data), `(Rechnung \(Kopie\)) Tj`)
+	require.Contains(t, string(data), "(12,50 \x80) Tj")
+
+	// The cross-reference table must be valid for other tools to read it
+	stamped, err := pdfstamp.Apply(data, pdfstamp.Stamp{Footer: "Kopie"})
+	require.NoError(t, err)
+	require.Greater(t, len(stamped), len(data))
+}
+
+func TestTextWidth(t *testing.T) {
+	require.InDelta(t, 5.56, pdfdoc.TextWidth("0", 10), 0.001)
+	require.InDelta(t, 19.46, pdfdoc.TextWidth("1,00", 10), 0.001)
+	require.Equal(t, []byte("Gr\xfc\xdfe \x80?"), pdfdoc.EncodeWinAnsi("Grüße €✓"))
+}
+
+func TestFormatEuro(t *testing.T) {
+	require.Equal(t, "0,05 €", pdfdoc.FormatEuro(5))
+	require.Equal(t, "12,50 €", pdfdoc.FormatEuro(1250))
+	require.Equal(t, "1.234.567,00 €", pdfdoc.FormatEuro(123456700))
+	require.Equal(t, "-3,00 €", pdfdoc.FormatEuro(-300))
+}
@@ -0,0 +1,502 @@
+package pdfstamp
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ErrEncrypted is returned for encrypted documents, which can not be
+// stamped without the password
+var ErrEncrypted = errors.New("pdfstamp: document is encrypted")
+
+// ErrInvalid is returned if the data is not a readable PDF
+var ErrInvalid = errors.New("pdfstamp: not a valid PDF")
+
+// xrefEntry locates an object in the file
+type xrefEntry struct {
+	compressed bool
+	offset     int // Byte offset of uncompressed objects
+	stream     int // Object stream number of compressed objects
+	index      int // Index within the object stream
+	gen        int
+}
+
+// document is a parsed PDF file
+type document struct {
+	data       []byte
+	xref       map[int]xrefEntry
+	trailer    Dict
+	startxref  int
+	xrefStream bool // The newest cross-reference section is a stream
+	cache      map[int]Object
+	loading    map[int]bool
+}
+
+// parseDocument reads the cross-reference sections of a PDF file
+func parseDocument(data []byte) (*document, error) {
+	if !bytes.Contains(data[:min(len(data), 1024)], []byte("%PDF-")) {
+		return nil, ErrInvalid
+	}
+	tail := data[max(0, len(data)-2048):]
+	idx := bytes.LastIndex(tail, []byte("startxref"))
+	if idx < 0 {
+		return nil, ErrInvalid
+	}
+	p := &parser{data: tail, pos: idx + len("startxref")}
+	o, err := p.parseObject()
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	offset, ok := o.(int64)
+	if !ok || offset <= 0 || int(offset) >= len(data) {
+		return nil, ErrInvalid
+	}
+
+	d := &document{
+		data:      data,
+		xref:      map[int]xrefEntry{},
+		startxref: int(offset),
+		cache:     map[int]Object{},
+		loading:   map[int]bool{},
+	}
+	visited := map[int]bool{}
+	next := int(offset)
+	first := true
+	for next > 0 {
+		if visited[next] {
+			return nil, fmt.Errorf("%w: cross-reference loop", ErrInvalid)
+		}
+		visited[next] = true
+		trailer, isStream, err := d.readXrefSection(next)
+		if err != nil {
+			return nil, err
+		}
+		if first {
+			d.trailer = trailer
+			d.xrefStream = isStream
+			first = false
+		}
+		// Hybrid files keep additional entries in a cross-reference stream
+		if stm, ok := trailer["XRefStm"].(int64); ok && !isStream {
+			if _, _, err := d.readXrefSection(int(stm)); err != nil {
+				return nil, err
+			}
+		}
+		prev, _ := trailer["Prev"].(int64)
+		next = int(prev)
+	}
+	if _, encrypted := d.trailer["Encrypt"]; encrypted {
+		return nil, ErrEncrypted
+	}
+	if _, ok := d.trailer["Root"].(Ref); !ok {
+		return nil, fmt.Errorf("%w: missing document catalog", ErrInvalid)
+	}
+	return d, nil
+}
+
+// addEntry records an entry unless a newer section already defined it
+func (d *document) addEntry(num int, entry xrefEntry) {
+	if _, ok := d.xref[num]; !ok {
+		d.xref[num] = entry
+	}
+}
+
+// readXrefSection reads a cross-reference table or stream at offset
+func (d *document) readXrefSection(offset int) (Dict, bool, error) {
+	if offset < 0 || offset >= len(d.data) {
+		return nil, false, fmt.Errorf("%w: cross-reference offset out of range", ErrInvalid)
+	}
+	p := &parser{data: d.data, pos: offset}
+	p.skipSpace()
+	if bytes.HasPrefix(d.data[p.pos:], []byte("xref")) {
+		p.pos += len("xref")
+		trailer, err := d.readXrefTable(p)
+		return trailer, false, err
+	}
+	trailer, err := d.readXrefStream(p)
+	return trailer, true, err
+}
+
+func (d *document) readXrefTable(p *parser) (Dict, error) {
+	for {
+		o, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		if o == keyword("trailer") {
+			break
+		}
+		start, ok1 := o.(int64)
+		countObj, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		count, ok2 := countObj.(int64)
+		if !ok1 || !ok2 {
+			return nil, p.errorf("invalid cross-reference subsection")
+		}
+		for i := 0; i < int(count); i++ {
+			offsetObj, err := p.parseObject()
+			if err != nil {
+				return nil, err
+			}
+			genObj, err := p.parseObject()
+			if err != nil {
+				return nil, err
+			}
+			kind := p.readKeyword()
+			offset, ok1 := offsetObj.(int64)
+			gen, ok2 := genObj.(int64)
+			if !ok1 || !ok2 || (kind != "n" && kind != "f") {
+				return nil, p.errorf("invalid cross-reference entry")
+			}
+			num := int(start) + i
+			if kind == "f" {
+				d.addEntry(num, xrefEntry{offset: -1, gen: int(gen)})
+			} else {
+				d.addEntry(num, xrefEntry{offset: int(offset), gen: int(gen)})
+			}
+		}
+	}
+	o, err := p.parseObject()
+	if err != nil {
+		return nil, err
+	}
+	trailer, ok := o.(Dict)
+	if !ok {
+		return nil, p.errorf("invalid trailer")
+	}
+	return trailer, nil
+}
+
+func (d *document) readXrefStream(p *parser) (Dict, error) {
+	_, _, o, err := d.parseIndirect(p)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := o.(*Stream)
+	if !ok || stream.Dict["Type"] != Name("XRef") {
+		return nil, fmt.Errorf("%w: invalid cross-reference stream", ErrInvalid)
+	}
+	data, err := d.decodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	w, ok := stream.Dict["W"].(Array)
+	if !ok || len(w) != 3 {
+		return nil, fmt.Errorf("%w: invalid /W in cross-reference stream", ErrInvalid)
+	}
+	widths := make([]int, 3)
+	rowLen := 0
+	for i, v := range w {
+		n, ok := v.(int64)
+		if !ok || n < 0 || n > 8 {
+			return nil, fmt.Errorf("%w: invalid /W in cross-reference stream", ErrInvalid)
+		}
+		widths[i] = int(n)
+		rowLen += int(n)
+	}
+	size, _ := stream.Dict["Size"].(int64)
+	index := Array{int64(0), size}
+	if idx, ok := stream.Dict["Index"].(Array); ok {
+		index = idx
+	}
+	if rowLen == 0 {
+		return nil, fmt.Errorf("%w: invalid /W in cross-reference stream", ErrInvalid)
+	}
+	pos := 0
+	for i := 0; i+1 < len(index); i += 2 {
+		start, ok1 := index[i].(int64)
+		count, ok2 := index[i+1].(int64)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("%w: invalid /Index in cross-reference stream", ErrInvalid)
+		}
+		for j := 0; j < int(count); j++ {
+			if pos+rowLen > len(data) {
+				return nil, fmt.Errorf("%w: truncated cross-reference stream", ErrInvalid)
+			}
+			fields := make([]int, 3)
+			for k := 0; k < 3; k++ {
+				for b := 0; b < widths[k]; b++ {
+					fields[k] = fields[k]<<8 | int(data[pos])
+					pos++
+				}
+			}
+			if widths[0] == 0 {
+				fields[0] = 1
+			}
+			num := int(start) + j
+			switch fields[0] {
+			case 0:
+				d.addEntry(num, xrefEntry{offset: -1, gen: fields[2]})
+			case 1:
+				d.addEntry(num, xrefEntry{offset: fields[1], gen: fields[2]})
+			case 2:
+				d.addEntry(num, xrefEntry{compressed: true, stream: fields[1], index: fields[2]})
+			}
+		}
+	}
+	return stream.Dict, nil
+}
+
+// parseIndirect reads "N G obj ... endobj" at the parser position
+func (d *document) parseIndirect(p *parser) (num, gen int, o Object, err error) {
+	numObj, err := p.parseObject()
+	if err != nil {
+		return
+	}
+	genObj, err := p.parseObject()
+	if err != nil {
+		return
+	}
+	n, ok1 := numObj.(int64)
+	g, ok2 := genObj.(int64)
+	if !ok1 || !ok2 {
+		err = p.errorf("expected object header")
+		return
+	}
+	if err = p.expectKeyword("obj"); err != nil {
+		return
+	}
+	o, err = p.parseObject()
+	if err != nil {
+		return
+	}
+	if dict, ok := o.(Dict); ok {
+		save := p.pos
+		if p.readKeyword() == "stream" {
+			p.pos = save
+			length := -1
+			if l, err := d.resolve(dict["Length"]); err == nil {
+				if v, ok := l.(int64); ok {
+					length = int(v)
+				}
+			}
+			data, err := p.streamData(length)
+			if err != nil {
+				return 0, 0, nil, err
+			}
+			o = &Stream{Dict: dict, Data: data}
+		} else {
+			p.pos = save
+		}
+	}
+	return int(n), int(g), o, nil
+}
+
+// object loads an indirect object by number
+func (d *document) object(num int) (Object, error) {
+	if o, ok := d.cache[num]; ok {
+		return o, nil
+	}
+	entry, ok := d.xref[num]
+	if !ok || (!entry.compressed && entry.offset < 0) {
+		// Missing objects are null
+		return nil, nil
+	}
+	if d.loading[num] {
+		return nil, fmt.Errorf("%w: object %d references itself", ErrInvalid, num)
+	}
+	d.loading[num] = true
+	defer delete(d.loading, num)
+
+	var o Object
+	var err error
+	if entry.compressed {
+		o, err = d.compressedObject(entry)
+	} else {
+		if entry.offset >= len(d.data) {
+			return nil, fmt.Errorf("%w: object %d out of range", ErrInvalid, num)
+		}
+		var n int
+		n, _, o, err = d.parseIndirect(&parser{data: d.data, pos: entry.offset})
+		if err == nil && n != num {
+			err = fmt.Errorf("%w: expected object %d at offset %d, found %d", ErrInvalid, num, entry.offset, n)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.cache[num] = o
+	return o, nil
+}
+
+// compressedObject loads an object from an object stream
+func (d *document) compressedObject(entry xrefEntry) (Object, error) {
+	o, err := d.object(entry.stream)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := o.(*Stream)
+	if !ok {
+		return nil, fmt.Errorf("%w: object stream %d missing", ErrInvalid, entry.stream)
+	}
+	data, err := d.decodeStream(stream)
+	if err != nil {
+		return nil, err
+	}
+	n, _ := stream.Dict["N"].(int64)
+	first, _ := stream.Dict["First"].(int64)
+	if entry.index >= int(n) || int(first) > len(data) {
+		return nil, fmt.Errorf("%w: invalid object stream %d", ErrInvalid, entry.stream)
+	}
+	header := &parser{data: data[:first]}
+	offset := -1
+	for i := 0; i <= entry.index; i++ {
+		if _, err := header.parseObject(); err != nil {
+			return nil, err
+		}
+		off, err := header.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		v, ok := off.(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid object stream %d", ErrInvalid, entry.stream)
+		}
+		offset = int(v)
+	}
+	p := &parser{data: data, pos: int(first) + offset}
+	return p.parseObject()
+}
+
+// resolve follows references
+func (d *document) resolve(o Object) (Object, error) {
+	for i := 0; i < 32; i++ {
+		ref, ok := o.(Ref)
+		if !ok {
+			return o, nil
+		}
+		var err error
+		if o, err = d.object(ref.Num); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("%w: reference chain too long", ErrInvalid)
+}
+
+// resolveDict resolves o and returns it if it is a dictionary
+func (d *document) resolveDict(o Object) (Dict, error) {
+	r, err := d.resolve(o)
+	if err != nil {
+		return nil, err
+	}
+	dict, _ := r.(Dict)
+	return dict, nil
+}
+
+// decodeStream returns the decoded data of a stream. Only FlateDecode with
+// optional PNG predictors is supported, which is what PDF writers use for
+// cross-reference and object streams.
+func (d *document) decodeStream(s *Stream) ([]byte, error) {
+	filter, err := d.resolve(s.Dict["Filter"])
+	if err != nil {
+		return nil, err
+	}
+	params, err := d.resolve(s.Dict["DecodeParms"])
+	if err != nil {
+		return nil, err
+	}
+	if arr, ok := filter.(Array); ok {
+		if len(arr) > 1 {
+			return nil, fmt.Errorf("%w: unsupported filter chain", ErrInvalid)
+		}
+		filter = nil
+		if len(arr) == 1 {
+			filter = arr[0]
+		}
+		if parr, ok := params.(Array); ok && len(parr) == 1 {
+			params, _ = d.resolve(parr[0])
+		}
+	}
+	switch filter {
+	case nil:
+		return s.Data, nil
+	case Name("FlateDecode"):
+	default:
+		return nil, fmt.Errorf("%w: unsupported filter %v", ErrInvalid, filter)
+	}
+	r, err := zlib.NewReader(bytes.NewReader(s.Data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	dict, _ := params.(Dict)
+	predictor, _ := dict["Predictor"].(int64)
+	if predictor < 10 {
+		if predictor > 1 {
+			return nil, fmt.Errorf("%w: unsupported predictor %d", ErrInvalid, predictor)
+		}
+		return data, nil
+	}
+	columns := int64(1)
+	if c, ok := dict["Columns"].(int64); ok {
+		columns = c
+	}
+	return unpredictPNG(data, int(columns))
+}
+
+// unpredictPNG reverses PNG row filters with one byte per pixel
+func unpredictPNG(data []byte, columns int) ([]byte, error) {
+	rowLen := columns + 1
+	if columns <= 0 || len(data)%rowLen != 0 {
+		return nil, fmt.Errorf("%w: invalid predictor data", ErrInvalid)
+	}
+	out := make([]byte, 0, len(data)/rowLen*columns)
+	prev := make([]byte, columns)
+	for row := 0; row < len(data); row += rowLen {
+		filterType := data[row]
+		cur := append([]byte(nil), data[row+1:row+rowLen]...)
+		for i := range cur {
+			var left, up, upLeft byte
+			if i > 0 {
+				left = cur[i-1]
+				upLeft = prev[i-1]
+			}
+			up = prev[i]
+			switch filterType {
+			case 0:
+			case 1:
+				cur[i] += left
+			case 2:
+				cur[i] += up
+			case 3:
+				cur[i] += byte((int(left) + int(up)) / 2)
+			case 4:
+				cur[i] += paeth(left, up, upLeft)
+			default:
+				return nil, fmt.Errorf("%w: invalid PNG filter %s", ErrInvalid, strconv.Itoa(int(filterType)))
+			}
+		}
+		out = append(out, cur...)
+		prev = cur
+	}
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	if pa <= pb && pa <= pc {
+		return a
+	}
+	if pb <= pc {
+		return b
+	}
+	return c
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
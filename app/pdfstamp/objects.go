@@ -0,0 +1,155 @@
+package pdfstamp
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Object is a PDF object: nil (null), bool, int64, float64, Name, String,
+// Array, Dict, Ref or *Stream
+type Object interface{}
+
+// Name is a PDF name without the leading slash
+type Name string
+
+// String is a PDF string
+type String []byte
+
+// Array is a PDF array
+type Array []Object
+
+// Dict is a PDF dictionary
+type Dict map[Name]Object
+
+// Ref is an indirect reference
+type Ref struct {
+	Num int
+	Gen int
+}
+
+// Stream is a stream object. Data is kept encoded as found in the file.
+type Stream struct {
+	Dict Dict
+	Data []byte
+}
+
+// copyDict returns a shallow copy of d
+func copyDict(d Dict) Dict {
+	c := make(Dict, len(d)+1)
+	for k, v := range d {
+		c[k] = v
+	}
+	return c
+}
+
+// isRegular reports whether c is neither whitespace nor a delimiter
+func isRegular(c byte) bool {
+	return !isWhitespace(c) && !isDelimiter(c)
+}
+
+func isWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+// writeObject serializes o. Dictionary keys are sorted so the output is
+// deterministic.
+func writeObject(buf *bytes.Buffer, o Object) error {
+	switch v := o.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case int:
+		buf.WriteString(strconv.Itoa(v))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case Name:
+		writeName(buf, v)
+	case String:
+		writeString(buf, v)
+	case Ref:
+		fmt.Fprintf(buf, "%d %d R", v.Num, v.Gen)
+	case Array:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			if err := writeObject(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case Dict:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, string(k))
+		}
+		sort.Strings(keys)
+		buf.WriteString("<<")
+		for _, k := range keys {
+			writeName(buf, Name(k))
+			buf.WriteByte(' ')
+			if err := writeObject(buf, v[Name(k)]); err != nil {
+				return err
+			}
+		}
+		buf.WriteString(">>")
+	case *Stream:
+		dict := copyDict(v.Dict)
+		dict["Length"] = int64(len(v.Data))
+		if err := writeObject(buf, dict); err != nil {
+			return err
+		}
+		buf.WriteString("\nstream\n")
+		buf.Write(v.Data)
+		buf.WriteString("\nendstream")
+	default:
+		return fmt.Errorf("pdfstamp: can not serialize %T", o)
+	}
+	return nil
+}
+
+func writeName(buf *bytes.Buffer, n Name) {
+	buf.WriteByte('/')
+	for i := 0; i < len(n); i++ {
+		c := n[i]
+		if c < 0x21 || c > 0x7e || c == '#' || isDelimiter(c) {
+			fmt.Fprintf(buf, "#%02X", c)
+		} else {
+			buf.WriteByte(c)
+		}
+	}
+}
+
+func writeString(buf *bytes.Buffer, s String) {
+	buf.WriteByte('(')
+	for _, c := range s {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(buf, "\\%03o", c)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte(')')
+}
@@ -0,0 +1,321 @@
+package pdfstamp
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// keyword is a bare word such as obj, endobj, stream, R or xref
+type keyword string
+
+// parser reads PDF objects from a byte slice
+type parser struct {
+	data []byte
+	pos  int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("pdfstamp: offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+// skipSpace skips whitespace and comments
+func (p *parser) skipSpace() {
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if isWhitespace(c) {
+			p.pos++
+			continue
+		}
+		if c == '%' {
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' && p.data[p.pos] != '\r' {
+				p.pos++
+			}
+			continue
+		}
+		return
+	}
+}
+
+// readKeyword reads the next bare word without consuming anything else
+func (p *parser) readKeyword() keyword {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.data) && isRegular(p.data[p.pos]) {
+		p.pos++
+	}
+	return keyword(p.data[start:p.pos])
+}
+
+// expectKeyword consumes the keyword k or fails
+func (p *parser) expectKeyword(k keyword) error {
+	if got := p.readKeyword(); got != k {
+		return p.errorf("expected %q, got %q", k, got)
+	}
+	return nil
+}
+
+// parseObject reads the next object. References (N G R) are recognized
+// inside arrays, dictionaries and at the top level. Bare keywords are
+// returned as keyword.
+func (p *parser) parseObject() (Object, error) {
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("unexpected end of data")
+	}
+	c := p.data[p.pos]
+	switch {
+	case c == '/':
+		return p.parseName(), nil
+	case c == '(':
+		return p.parseLiteralString()
+	case c == '<':
+		if p.pos+1 < len(p.data) && p.data[p.pos+1] == '<' {
+			return p.parseDict()
+		}
+		return p.parseHexString()
+	case c == '[':
+		return p.parseArray()
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.parseNumberOrRef()
+	case isRegular(c):
+		switch k := p.readKeyword(); k {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return k, nil
+		}
+	}
+	return nil, p.errorf("unexpected character %q", c)
+}
+
+func (p *parser) parseName() Name {
+	p.pos++ // slash
+	var name []byte
+	for p.pos < len(p.data) && isRegular(p.data[p.pos]) {
+		c := p.data[p.pos]
+		if c == '#' && p.pos+2 < len(p.data) {
+			if v, err := strconv.ParseUint(string(p.data[p.pos+1:p.pos+3]), 16, 8); err == nil {
+				name = append(name, byte(v))
+				p.pos += 3
+				continue
+			}
+		}
+		name = append(name, c)
+		p.pos++
+	}
+	return Name(name)
+}
+
+func (p *parser) parseLiteralString() (Object, error) {
+	p.pos++ // opening parenthesis
+	var s []byte
+	depth := 1
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return String(s), nil
+			}
+		case '\\':
+			if p.pos >= len(p.data) {
+				return nil, p.errorf("unterminated string")
+			}
+			e := p.data[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				// Line continuation
+				if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+					p.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.data[p.pos]-'0')
+						p.pos++
+					}
+					c = byte(v)
+				} else {
+					c = e
+				}
+			}
+		}
+		s = append(s, c)
+	}
+	return nil, p.errorf("unterminated string")
+}
+
+func (p *parser) parseHexString() (Object, error) {
+	p.pos++ // <
+	var digits []byte
+	for p.pos < len(p.data) && p.data[p.pos] != '>' {
+		if c := p.data[p.pos]; !isWhitespace(c) {
+			digits = append(digits, c)
+		}
+		p.pos++
+	}
+	if p.pos >= len(p.data) {
+		return nil, p.errorf("unterminated hex string")
+	}
+	p.pos++ // >
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	s := make([]byte, len(digits)/2)
+	for i := range s {
+		v, err := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+		if err != nil {
+			return nil, p.errorf("invalid hex string")
+		}
+		s[i] = byte(v)
+	}
+	return String(s), nil
+}
+
+func (p *parser) parseArray() (Object, error) {
+	p.pos++ // [
+	arr := Array{}
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return nil, p.errorf("unterminated array")
+		}
+		if p.data[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		o, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := o.(keyword); ok {
+			return nil, p.errorf("unexpected keyword %q in array", k)
+		}
+		arr = append(arr, o)
+	}
+}
+
+func (p *parser) parseDict() (Object, error) {
+	p.pos += 2 // <<
+	dict := Dict{}
+	for {
+		p.skipSpace()
+		if p.pos+1 < len(p.data) && p.data[p.pos] == '>' && p.data[p.pos+1] == '>' {
+			p.pos += 2
+			return dict, nil
+		}
+		if p.pos >= len(p.data) || p.data[p.pos] != '/' {
+			return nil, p.errorf("expected name as dictionary key")
+		}
+		key := p.parseName()
+		value, err := p.parseObject()
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := value.(keyword); ok {
+			return nil, p.errorf("unexpected keyword %q in dictionary", k)
+		}
+		dict[key] = value
+	}
+}
+
+// parseNumberOrRef reads a number. An integer followed by another integer
+// and R is returned as Ref.
+func (p *parser) parseNumberOrRef() (Object, error) {
+	num, isInt, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	if !isInt {
+		return num, nil
+	}
+	save := p.pos
+	p.skipSpace()
+	if p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '9' {
+		gen, genIsInt, err := p.parseNumber()
+		if err == nil && genIsInt {
+			p.skipSpace()
+			if p.pos < len(p.data) && p.data[p.pos] == 'R' && (p.pos+1 == len(p.data) || !isRegular(p.data[p.pos+1])) {
+				p.pos++
+				return Ref{Num: int(num.(int64)), Gen: int(gen.(int64))}, nil
+			}
+		}
+	}
+	p.pos = save
+	return num, nil
+}
+
+func (p *parser) parseNumber() (Object, bool, error) {
+	start := p.pos
+	for p.pos < len(p.data) && isRegular(p.data[p.pos]) {
+		p.pos++
+	}
+	token := string(p.data[start:p.pos])
+	if i, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return i, true, nil
+	}
+	if f, err := strconv.ParseFloat(token, 64); err == nil {
+		return f, false, nil
+	}
+	return nil, false, p.errorf("invalid number %q", token)
+}
+
+// errStreamLength is returned if a stream has no usable /Length
+var errStreamLength = errors.New("pdfstamp: invalid stream length")
+
+// streamData returns the raw data of a stream whose dictionary was just
+// parsed. length is the resolved /Length or -1 if unknown, in which case
+// the data ends at the next endstream keyword.
+func (p *parser) streamData(length int) ([]byte, error) {
+	if err := p.expectKeyword("stream"); err != nil {
+		return nil, err
+	}
+	// The keyword is followed by CRLF or LF
+	if p.pos < len(p.data) && p.data[p.pos] == '\r' {
+		p.pos++
+	}
+	if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+		p.pos++
+	}
+	start := p.pos
+	if length >= 0 && start+length <= len(p.data) {
+		rest := p.data[start+length:]
+		trimmed := bytes.TrimLeft(rest, "\r\n \t")
+		if bytes.HasPrefix(trimmed, []byte("endstream")) {
+			p.pos = start + length
+			return p.data[start : start+length], nil
+		}
+	}
+	end := bytes.Index(p.data[start:], []byte("endstream"))
+	if end < 0 {
+		return nil, errStreamLength
+	}
+	data := bytes.TrimRight(p.data[start:start+end], "\r\n")
+	p.pos = start + end
+	return data, nil
+}
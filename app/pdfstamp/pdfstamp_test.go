@@ -0,0 +1,275 @@
+package pdfstamp
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+	"github.com/stretchr/testify/require"
+)
+
+// samplePDF builds a document with a classic cross-reference table. The
+// page inherits its resources and media box from the page tree.
+func samplePDF() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	content := "BT /F1 24 Tf 72 720 Td (Hello Augustin) Tj ET"
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 /MediaBox [0 0 595 842] /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Title (Augustin Nr. 600) /Producer (test) >>",
+	}
+	offsets := make([]int, len(objects))
+	for i, o := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n\r\n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 6 0 R /ID [<0102> <0102>] >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// compressedPDF builds a PDF 1.5 document whose dictionaries live in an
+// object stream and whose cross-reference stream uses the PNG Up
+// predictor. The page is rotated and has a content array.
+func compressedPDF() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.5\n%\xe2\xe3\xcf\xd3\n")
+
+	content := "BT /F1 12 Tf 72 500 Td (Rotated) Tj ET"
+	offsets := map[int]int{}
+	offsets[4] = buf.Len()
+	fmt.Fprintf(&buf, "4 0 obj\n<< /Length %d >>\nstream\n%s\nendstream\nendobj\n", len(content), content)
+
+	compressed := []struct {
+		num  int
+		body string
+	}{
+		{1, "<< /Type /Catalog /Pages 2 0 R >>"},
+		{2, "<< /Type /Pages /Kids [3 0 R] /Count 1 >>"},
+		{3, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 842 595] /Rotate 90 /Contents [4 0 R] /Resources 8 0 R >>"},
+		{5, "<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>"},
+		{8, "<< /Font << /F1 5 0 R >> >>"},
+	}
+	var header, body bytes.Buffer
+	for _, o := range compressed {
+		fmt.Fprintf(&header, "%d %d ", o.num, body.Len())
+		body.WriteString(o.body + "\n")
+	}
+	var packed bytes.Buffer
+	zw := zlib.NewWriter(&packed)
+	zw.Write(header.Bytes())
+	zw.Write(body.Bytes())
+	zw.Close()
+	offsets[6] = buf.Len()
+	fmt.Fprintf(&buf, "6 0 obj\n<< /Type /ObjStm /N %d /First %d /Filter /FlateDecode /Length %d >>\nstream\n", len(compressed), header.Len(), packed.Len())
+	buf.Write(packed.Bytes())
+	buf.WriteString("\nendstream\nendobj\n")
+
+	offsets[7] = buf.Len()
+	rows := [][]byte{}
+	row := func(kind byte, field2 int, field3 int) []byte {
+		return []byte{kind, byte(field2 >> 24), byte(field2 >> 16), byte(field2 >> 8), byte(field2), byte(field3 >> 8), byte(field3)}
+	}
+	rows = append(rows, row(0, 0, 0xffff))
+	for num := 1; num <= 8; num++ {
+		switch num {
+		case 4, 6, 7:
+			rows = append(rows, row(1, offsets[num], 0))
+		default:
+			index := 0
+			for i, o := range compressed {
+				if o.num == num {
+					index = i
+				}
+			}
+			rows = append(rows, row(2, 6, index))
+		}
+	}
+	// PNG Up predictor
+	var predicted bytes.Buffer
+	prev := make([]byte, 7)
+	for _, r := range rows {
+		predicted.WriteByte(2)
+		for i := range r {
+			predicted.WriteByte(r[i] - prev[i])
+		}
+		prev = r
+	}
+	packed.Reset()
+	zw = zlib.NewWriter(&packed)
+	zw.Write(predicted.Bytes())
+	zw.Close()
+	fmt.Fprintf(&buf, "7 0 obj\n<< /Type /XRef /Size 9 /W [1 4 2] /Root 1 0 R /Filter /FlateDecode /DecodeParms << /Columns 7 /Predictor 12 >> /Length %d >>\nstream\n", packed.Len())
+	buf.Write(packed.Bytes())
+	fmt.Fprintf(&buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", offsets[7])
+	return buf.Bytes()
+}
+
+// footerOf returns the decoded footer stream and resources of the first page
+func footerOf(t *testing.T, data []byte) (string, Dict) {
+	d, err := parseDocument(data)
+	require.NoError(t, err)
+	pages, err := d.pages()
+	require.NoError(t, err)
+	require.Len(t, pages, 1)
+	contents, ok := pages[0].dict["Contents"].(Array)
+	require.True(t, ok)
+	footer, err := d.resolve(contents[len(contents)-1])
+	require.NoError(t, err)
+	stream, ok := footer.(*Stream)
+	require.True(t, ok)
+	resources, err := d.resolveDict(pages[0].resources)
+	require.NoError(t, err)
+	return string(stream.Data), resources
+}
+
+func TestApplyClassicXref(t *testing.T) {
+	original := samplePDF()
+	stamped, err := Apply(original, Stamp{
+		Footer:   "Persönliche Kopie für reader@example.com – Bestellung 42",
+		Metadata: map[string]string{"BuyerEmail": "reader@example.com", "OrderID": "42", "LinkID": "abc"},
+	})
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(stamped, original), "the update must be appended")
+
+	footer, resources := footerOf(t, stamped)
+	require.Contains(t, footer, "(Pers\\366nliche Kopie f\\374r reader@example.com \\226 Bestellung 42) Tj")
+	require.Contains(t, footer, "1 0 0 1 12 12 Tm")
+	fonts := resources["Font"].(Dict)
+	require.Equal(t, Ref{Num: 5}, fonts["F1"])
+	require.Contains(t, fonts, Name("AugStamp"))
+
+	d, err := parseDocument(stamped)
+	require.NoError(t, err)
+	require.False(t, d.xrefStream)
+	require.Equal(t, d.trailer["ID"], Array{String{1, 2}, String{1, 2}})
+	info, err := d.resolveDict(d.trailer["Info"])
+	require.NoError(t, err)
+	require.Equal(t, String("reader@example.com"), info["BuyerEmail"])
+	require.Equal(t, String("42"), info["OrderID"])
+	require.Equal(t, String("abc"), info["LinkID"])
+	require.Equal(t, String("Augustin Nr. 600"), info["Title"])
+
+	// The original content is still drawn
+	pages, err := d.pages()
+	require.NoError(t, err)
+	contents := pages[0].dict["Contents"].(Array)
+	require.Len(t, contents, 3)
+	require.Equal(t, Ref{Num: 4}, contents[1])
+
+	// Stamping twice uses a new font name
+	restamped, err := Apply(stamped, Stamp{Footer: "second"})
+	require.NoError(t, err)
+	footer, resources = footerOf(t, restamped)
+	require.Contains(t, footer, "/AugStamp1 7 Tf")
+	require.Contains(t, resources["Font"].(Dict), Name("AugStamp"))
+}
+
+func TestApplyCompressed(t *testing.T) {
+	original := compressedPDF()
+	stamped, err := Apply(original, Stamp{Footer: "Link xyz", Metadata: map[string]string{"LinkID": "xyz"}})
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(stamped, original))
+
+	footer, resources := footerOf(t, stamped)
+	require.Contains(t, footer, "(Link xyz) Tj")
+	// Rotated by 90 degrees: the text runs up along the right edge
+	require.Contains(t, footer, "0 1 -1 0 830 12 Tm")
+	require.Equal(t, Ref{Num: 5}, resources["Font"].(Dict)["F1"])
+
+	d, err := parseDocument(stamped)
+	require.NoError(t, err)
+	require.True(t, d.xrefStream)
+	info, err := d.resolveDict(d.trailer["Info"])
+	require.NoError(t, err)
+	require.Equal(t, String("xyz"), info["LinkID"])
+	// Objects of the original object stream are still readable
+	font, err := d.resolveDict(Ref{Num: 5})
+	require.NoError(t, err)
+	require.Equal(t, Name("Courier"), font["BaseFont"])
+}
+
+// TestApplySamples stamps files written by pdfcpu, see testdata/README.md,
+// and checks that pdfcpu still reads the stamped files
+func TestApplySamples(t *testing.T) {
+	model.ConfigPath = "disable"
+	samples := []struct {
+		file       string
+		pages      int
+		xrefStream bool
+	}{
+		{"classic.pdf", 1, false},
+		{"incremental-classic.pdf", 1, false},
+		{"objectstreams.pdf", 3, true},
+		{"incremental.pdf", 3, true},
+	}
+	for _, sample := range samples {
+		t.Run(sample.file, func(t *testing.T) {
+			original, err := os.ReadFile(filepath.Join("testdata", sample.file))
+			require.NoError(t, err)
+			stamped, err := Apply(original, Stamp{Footer: "Persönliche Kopie für reader@example.com", Metadata: map[string]string{"LinkID": "sample"}})
+			require.NoError(t, err)
+			require.True(t, bytes.HasPrefix(stamped, original))
+
+			d, err := parseDocument(stamped)
+			require.NoError(t, err)
+			require.Equal(t, sample.xrefStream, d.xrefStream)
+			pages, err := d.pages()
+			require.NoError(t, err)
+			require.Len(t, pages, sample.pages)
+			for _, p := range pages {
+				contents, ok := p.dict["Contents"].(Array)
+				require.True(t, ok)
+				footer, err := d.resolve(contents[len(contents)-1])
+				require.NoError(t, err)
+				require.Contains(t, string(footer.(*Stream).Data), "reader@example.com) Tj")
+			}
+
+			ctx, err := api.ReadAndValidate(bytes.NewReader(stamped), model.NewDefaultConfiguration())
+			require.NoError(t, err)
+			require.Equal(t, sample.pages, ctx.PageCount)
+			require.Equal(t, "sample", ctx.Properties["LinkID"])
+		})
+	}
+}
+
+func TestApplyInvalid(t *testing.T) {
+	_, err := Apply([]byte("not a pdf"), Stamp{})
+	require.ErrorIs(t, err, ErrInvalid)
+
+	encrypted := bytes.Replace(samplePDF(), []byte("/Root 1 0 R"), []byte("/Root 1 0 R /Encrypt 6 0 R"), 1)
+	_, err = Apply(encrypted, Stamp{})
+	require.ErrorIs(t, err, ErrEncrypted)
+}
+
+func TestStampFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "issue.pdf")
+	require.NoError(t, os.WriteFile(src, samplePDF(), 0o600))
+	dst := filepath.Join(dir, "stamped", "link.pdf")
+	require.NoError(t, StampFile(src, dst, Stamp{Footer: "footer"}))
+	data, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	footer, _ := footerOf(t, data)
+	require.Contains(t, footer, "(footer) Tj")
+
+	require.Error(t, StampFile(filepath.Join(dir, "missing.pdf"), dst, Stamp{}))
+}
+
+func TestTextString(t *testing.T) {
+	require.Equal(t, String("plain"), textString("plain"))
+	require.Equal(t, String{0xfe, 0xff, 0, 'x', 0, 0xe4}, textString("xä"))
+}
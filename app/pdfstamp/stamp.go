@@ -0,0 +1,292 @@
+// Package pdfstamp personalizes PDF files with a visible footer on every
+// page and custom document information entries. The original file is left
+// untouched and the changes are appended as an incremental update, so the
+// stamping works for any PDF version without re-encoding its content.
+//
+// The package reads only the objects it needs, the page tree and the
+// document information, instead of using pdfcpu. A download is stamped on
+// every request, and the watermark and property functions of pdfcpu read,
+// validate and write the whole issue again, which takes time and memory for
+// large issues and changes the bytes of the original file. pdfcpu also sets
+// up a configuration directory in the home of the user on first use and
+// exits the process if that fails. The tests use pdfcpu as an independent
+// writer of sample files and to check that the stamped files stay readable.
+package pdfstamp
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/pdfdoc"
+)
+
+// Stamp describes the personalization of a document
+type Stamp struct {
+	Footer   string            // Shown at the bottom of every page
+	Metadata map[string]string // Added to the document information dictionary
+}
+
+const (
+	fontSize     = 7
+	footerMargin = 12
+)
+
+// page is a leaf of the page tree with its inherited attributes
+type page struct {
+	ref       Ref
+	dict      Dict
+	resources Object
+	box       Array
+	rotate    int64
+}
+
+// Apply returns the stamped document
+func Apply(data []byte, stamp Stamp) ([]byte, error) {
+	d, err := parseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	pages, err := d.pages()
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("%w: no pages", ErrInvalid)
+	}
+
+	size, _ := d.trailer["Size"].(int64)
+	for num := range d.xref {
+		if int64(num) >= size {
+			size = int64(num) + 1
+		}
+	}
+	u := &update{doc: d, next: int(size), objects: map[int]Object{}, gens: map[int]int{}}
+
+	fontRef := u.add(Dict{
+		"Type":     Name("Font"),
+		"Subtype":  Name("Type1"),
+		"BaseFont": Name("Helvetica"),
+		"Encoding": Name("WinAnsiEncoding"),
+	})
+	// Saves the graphics state before the original content so the footer
+	// is drawn with the default coordinate system
+	saveRef := u.add(&Stream{Dict: Dict{}, Data: []byte("q\n")})
+	text := pdfdoc.EncodeWinAnsi(stamp.Footer)
+
+	for _, pg := range pages {
+		resources, err := d.resolveDict(pg.resources)
+		if err != nil {
+			return nil, err
+		}
+		resources = copyDict(resources)
+		fonts, err := d.resolveDict(resources["Font"])
+		if err != nil {
+			return nil, err
+		}
+		fonts = copyDict(fonts)
+		fontName := Name("AugStamp")
+		for i := 1; fonts[fontName] != nil; i++ {
+			fontName = Name(fmt.Sprintf("AugStamp%d", i))
+		}
+		fonts[fontName] = fontRef
+		resources["Font"] = fonts
+
+		box, err := d.numbers(pg.box)
+		if err != nil || len(box) != 4 {
+			box = []float64{0, 0, 612, 792}
+		}
+		footer := footerContent(fontName, text, box, pg.rotate)
+		footerRef := u.add(&Stream{Dict: Dict{}, Data: footer})
+
+		contents := Array{saveRef}
+		original, err := d.resolve(pg.dict["Contents"])
+		if err != nil {
+			return nil, err
+		}
+		switch v := original.(type) {
+		case Array:
+			contents = append(contents, v...)
+		case *Stream:
+			contents = append(contents, pg.dict["Contents"])
+		}
+		contents = append(contents, footerRef)
+
+		newPage := copyDict(pg.dict)
+		newPage["Contents"] = contents
+		newPage["Resources"] = resources
+		u.set(pg.ref, newPage)
+	}
+
+	info, err := d.resolveDict(d.trailer["Info"])
+	if err != nil {
+		return nil, err
+	}
+	info = copyDict(info)
+	keys := make([]string, 0, len(stamp.Metadata))
+	for k := range stamp.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		info[Name(k)] = textString(stamp.Metadata[k])
+	}
+	info["ModDate"] = String(time.Now().UTC().Format("D:20060102150405Z"))
+	infoRef := u.add(info)
+
+	return u.write(infoRef)
+}
+
+// StampFile stamps src and writes the result to dst. The file is written
+// to a temporary file first so readers never see a partial document.
+func StampFile(src, dst string, stamp Stamp) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	stamped, err := Apply(data, stamp)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".stamp-*.pdf")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(stamped); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
+
+// pages returns the leaves of the page tree in document order
+func (d *document) pages() ([]page, error) {
+	catalog, err := d.resolveDict(d.trailer["Root"])
+	if err != nil {
+		return nil, err
+	}
+	root, ok := catalog["Pages"].(Ref)
+	if !ok {
+		return nil, fmt.Errorf("%w: missing page tree", ErrInvalid)
+	}
+	var pages []page
+	visited := map[int]bool{}
+	var walk func(ref Ref, resources Object, box Array, rotate int64) error
+	walk = func(ref Ref, resources Object, box Array, rotate int64) error {
+		if visited[ref.Num] {
+			return fmt.Errorf("%w: page tree loop", ErrInvalid)
+		}
+		visited[ref.Num] = true
+		node, err := d.resolveDict(ref)
+		if err != nil {
+			return err
+		}
+		if node == nil {
+			return fmt.Errorf("%w: missing page tree node %d", ErrInvalid, ref.Num)
+		}
+		if r, ok := node["Resources"]; ok {
+			resources = r
+		}
+		if b, err := d.resolve(node["MediaBox"]); err == nil {
+			if arr, ok := b.(Array); ok {
+				box = arr
+			}
+		}
+		if r, err := d.resolve(node["Rotate"]); err == nil {
+			if v, ok := r.(int64); ok {
+				rotate = v
+			}
+		}
+		if node["Type"] == Name("Page") || node["Kids"] == nil {
+			// The footer has to be in the visible area
+			if b, err := d.resolve(node["CropBox"]); err == nil {
+				if arr, ok := b.(Array); ok {
+					box = arr
+				}
+			}
+			pages = append(pages, page{ref: ref, dict: node, resources: resources, box: box, rotate: rotate})
+			return nil
+		}
+		kids, err := d.resolve(node["Kids"])
+		if err != nil {
+			return err
+		}
+		arr, _ := kids.(Array)
+		for _, kid := range arr {
+			kidRef, ok := kid.(Ref)
+			if !ok {
+				return fmt.Errorf("%w: page tree kid is not a reference", ErrInvalid)
+			}
+			if err := walk(kidRef, resources, box, rotate); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(root, nil, nil, 0); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
+
+// numbers resolves an array of numbers
+func (d *document) numbers(arr Array) ([]float64, error) {
+	out := make([]float64, len(arr))
+	for i, o := range arr {
+		v, err := d.resolve(o)
+		if err != nil {
+			return nil, err
+		}
+		switch n := v.(type) {
+		case int64:
+			out[i] = float64(n)
+		case float64:
+			out[i] = n
+		default:
+			return nil, fmt.Errorf("%w: expected number", ErrInvalid)
+		}
+	}
+	return out, nil
+}
+
+// footerContent draws text at the visual bottom left of the page box,
+// taking the page rotation into account
+func footerContent(font Name, text []byte, box []float64, rotate int64) []byte {
+	llx, lly := min(box[0], box[2]), min(box[1], box[3])
+	urx, ury := max(box[0], box[2]), max(box[1], box[3])
+	m := float64(footerMargin)
+	// Text matrix: direction of the baseline, upward direction, origin
+	var tm [6]float64
+	switch ((rotate % 360) + 360) % 360 {
+	case 90:
+		tm = [6]float64{0, 1, -1, 0, urx - m, lly + m}
+	case 180:
+		tm = [6]float64{-1, 0, 0, -1, urx - m, ury - m}
+	case 270:
+		tm = [6]float64{0, -1, 1, 0, llx + m, ury - m}
+	default:
+		tm = [6]float64{1, 0, 0, 1, llx + m, lly + m}
+	}
+	var buf bytes.Buffer
+	buf.WriteString("Q\nq\n0.4 g\nBT\n")
+	writeName(&buf, font)
+	fmt.Fprintf(&buf, " %d Tf\n", fontSize)
+	for _, v := range tm {
+		writeObject(&buf, v)
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("Tm\n")
+	writeString(&buf, text)
+	buf.WriteString(" Tj\nET\nQ\n")
+	return buf.Bytes()
+}
@@ -0,0 +1,145 @@
+package pdfstamp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"unicode/utf16"
+)
+
+// update collects the objects of an incremental update
+type update struct {
+	doc     *document
+	next    int
+	objects map[int]Object
+	gens    map[int]int
+}
+
+// add appends a new object and returns its reference
+func (u *update) add(o Object) Ref {
+	ref := Ref{Num: u.next}
+	u.next++
+	u.objects[ref.Num] = o
+	return ref
+}
+
+// set replaces an existing object
+func (u *update) set(ref Ref, o Object) {
+	u.objects[ref.Num] = o
+	u.gens[ref.Num] = ref.Gen
+}
+
+// write appends the objects, a cross-reference section of the same kind as
+// the newest one in the original file and the trailer
+func (u *update) write(infoRef Ref) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Grow(len(u.doc.data) + 4096)
+	buf.Write(u.doc.data)
+	if len(u.doc.data) > 0 && u.doc.data[len(u.doc.data)-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+
+	nums := make([]int, 0, len(u.objects))
+	for num := range u.objects {
+		nums = append(nums, num)
+	}
+	sort.Ints(nums)
+	offsets := make(map[int]int, len(nums))
+	for _, num := range nums {
+		offsets[num] = buf.Len()
+		fmt.Fprintf(&buf, "%d %d obj\n", num, u.gens[num])
+		if err := writeObject(&buf, u.objects[num]); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\nendobj\n")
+	}
+
+	trailer := Dict{
+		"Root": u.doc.trailer["Root"],
+		"Info": infoRef,
+		"Prev": int64(u.doc.startxref),
+	}
+	if id, ok := u.doc.trailer["ID"]; ok {
+		trailer["ID"] = id
+	}
+
+	xrefOffset := buf.Len()
+	if u.doc.xrefStream {
+		// The cross-reference stream lists itself
+		self := u.next
+		u.next++
+		nums = append(nums, self)
+		offsets[self] = xrefOffset
+		trailer["Type"] = Name("XRef")
+		trailer["Size"] = int64(u.next)
+		trailer["W"] = Array{int64(1), int64(4), int64(2)}
+		trailer["Index"] = xrefIndex(nums)
+		var rows bytes.Buffer
+		for _, num := range nums {
+			rows.WriteByte(1)
+			_ = binary.Write(&rows, binary.BigEndian, uint32(offsets[num]))
+			_ = binary.Write(&rows, binary.BigEndian, uint16(u.gens[num]))
+		}
+		fmt.Fprintf(&buf, "%d 0 obj\n", self)
+		if err := writeObject(&buf, &Stream{Dict: trailer, Data: rows.Bytes()}); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\nendobj\n")
+	} else {
+		trailer["Size"] = int64(u.next)
+		buf.WriteString("xref\n")
+		for i := 0; i < len(nums); {
+			j := i
+			for j+1 < len(nums) && nums[j+1] == nums[j]+1 {
+				j++
+			}
+			fmt.Fprintf(&buf, "%d %d\n", nums[i], j-i+1)
+			for _, num := range nums[i : j+1] {
+				fmt.Fprintf(&buf, "%010d %05d n\r\n", offsets[num], u.gens[num])
+			}
+			i = j + 1
+		}
+		buf.WriteString("trailer\n")
+		if err := writeObject(&buf, trailer); err != nil {
+			return nil, err
+		}
+		buf.WriteByte('\n')
+	}
+	fmt.Fprintf(&buf, "startxref\n%d\n%%%%EOF\n", xrefOffset)
+	return buf.Bytes(), nil
+}
+
+// xrefIndex returns the /Index pairs for sorted object numbers
+func xrefIndex(nums []int) Array {
+	index := Array{}
+	for i := 0; i < len(nums); {
+		j := i
+		for j+1 < len(nums) && nums[j+1] == nums[j]+1 {
+			j++
+		}
+		index = append(index, int64(nums[i]), int64(j-i+1))
+		i = j + 1
+	}
+	return index
+}
+
+// textString encodes s as PDF text string: ASCII as is, everything else as
+// UTF-16BE with byte order mark
+func textString(s string) String {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return String(s)
+	}
+	out := []byte{0xfe, 0xff}
+	for _, v := range utf16.Encode([]rune(s)) {
+		out = append(out, byte(v>>8), byte(v))
+	}
+	return String(out)
+}
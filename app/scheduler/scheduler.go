@@ -0,0 +1,78 @@
+// Package scheduler runs periodic background jobs of the server
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+var log = utils.GetLogger()
+
+// Job is a task run periodically
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Scheduler runs jobs until its context is cancelled
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+// New creates a scheduler for the given jobs
+func New(jobs ...Job) *Scheduler {
+	return &Scheduler{jobs: jobs}
+}
+
+// Add registers another job. It must be called before Start.
+func (s *Scheduler) Add(job Job) {
+	s.jobs = append(s.jobs, job)
+}
+
+// Start runs every job once right away and then at its interval. Jobs with
+// an interval <= 0 are skipped.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		if job.Interval <= 0 {
+			log.Infof("Scheduler: job %s is disabled", job.Name)
+			continue
+		}
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			ticker := time.NewTicker(job.Interval)
+			defer ticker.Stop()
+			for {
+				runJob(ctx, job)
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+			}
+		}(job)
+	}
+}
+
+// Wait blocks until all jobs returned after the context was cancelled
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+// runJob runs a job once and logs errors and panics
+func runJob(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("Scheduler: job "+job.Name+" panicked: ", fmt.Sprint(r))
+		}
+	}()
+	if err := job.Run(ctx); err != nil {
+		log.Error("Scheduler: job "+job.Name+" failed: ", err)
+	}
+}
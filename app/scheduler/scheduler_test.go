@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduler(t *testing.T) {
+	var runs, failures, disabled atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := New(Job{
+		Name:     "count",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+	s.Add(Job{
+		Name:     "fail",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			if failures.Add(1) == 1 {
+				panic("boom")
+			}
+			return errors.New("failed")
+		},
+	})
+	s.Add(Job{
+		Name: "disabled",
+		Run: func(ctx context.Context) error {
+			disabled.Add(1)
+			return nil
+		},
+	})
+	s.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		return runs.Load() >= 3 && failures.Load() >= 3
+	}, time.Second, 5*time.Millisecond)
+	cancel()
+	s.Wait()
+	require.Equal(t, int32(0), disabled.Load())
+}
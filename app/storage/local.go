@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// LocalFilesRoute is the route that serves signed URLs of the local backend
+const LocalFilesRoute = "/api/files/"
+
+// ErrInvalidSignature is returned for signed URLs that were tampered with
+// or have expired
+var ErrInvalidSignature = errors.New("invalid or expired signature")
+
+// Local stores objects as files below a root directory
+type Local struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+}
+
+// NewLocal returns a storage rooted at dir. Signed URLs are prefixed with
+// publicURL, which may be empty for URLs relative to the backend.
+func NewLocal(dir, publicURL string, signingKey []byte) *Local {
+	return &Local{root: dir, publicURL: publicURL, signingKey: signingKey}
+}
+
+func (l *Local) path(key string) (string, string, error) {
+	key, err := CleanKey(key)
+	if err != nil {
+		return "", "", err
+	}
+	return key, filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes the object. A temporary file is renamed so that readers never
+// see a partial file.
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	_, name, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// Owner read/write, group/other read
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// Get opens the file of the object
+func (l *Local) Get(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error) {
+	key, name, err := l.path(key)
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	f, err := os.Open(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ObjectInfo{}, ErrNotFound
+	}
+	if err != nil {
+		return nil, ObjectInfo{}, err
+	}
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, ObjectInfo{}, err
+	}
+	if stat.IsDir() {
+		f.Close()
+		return nil, ObjectInfo{}, ErrNotFound
+	}
+	return f, ObjectInfo{Size: stat.Size(), ModTime: stat.ModTime(), ContentType: guessContentType(key)}, nil
+}
+
+// Stat returns the size and modification time of the file
+func (l *Local) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	key, name, err := l.path(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	stat, err := os.Stat(name)
+	if errors.Is(err, os.ErrNotExist) || (err == nil && stat.IsDir()) {
+		return ObjectInfo{}, ErrNotFound
+	}
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	return ObjectInfo{Size: stat.Size(), ModTime: stat.ModTime(), ContentType: guessContentType(key)}, nil
+}
+
+// Delete removes the file. Missing files are not an error.
+func (l *Local) Delete(ctx context.Context, key string) error {
+	_, name, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(name)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// SignedURL returns a URL to LocalFilesRoute that is checked by Verify
+func (l *Local) SignedURL(key string, ttl time.Duration) (string, error) {
+	key, err := CleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	expires := strconv.FormatInt(time.Now().Add(ttl).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", l.signature(key, expires))
+	return l.publicURL + LocalFilesRoute + (&url.URL{Path: key}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+// Verify checks the expiry and signature of a signed URL
+func (l *Local) Verify(key, expires, signature string) error {
+	key, err := CleanKey(key)
+	if err != nil {
+		return err
+	}
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > unix {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(l.signature(key, expires))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (l *Local) signature(key, expires string) string {
+	mac := hmac.New(sha256.New, l.signingKey)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,117 @@
+// Package storage stores uploaded files such as PDFs, images and the custom
+// CSS. The Storage interface has a local filesystem implementation and an
+// implementation for S3-compatible object stores, so that several replicas
+// of the backend can share their files.
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/augustin-wien/augustina-backend/config"
+	"github.com/augustin-wien/augustina-backend/utils"
+)
+
+var log = utils.GetLogger()
+
+// ErrNotFound is returned if an object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// ErrInvalidKey is returned for keys that are empty or leave the storage root
+var ErrInvalidKey = errors.New("invalid object key")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Size        int64
+	ModTime     time.Time
+	ContentType string
+}
+
+// Storage stores objects under slash separated keys such as "img/logo.png"
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Get returns the content of an object. The reader is an io.ReadSeeker
+	// if the backend supports it.
+	Get(ctx context.Context, key string) (io.ReadCloser, ObjectInfo, error)
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL that grants read access to the object until
+	// it expires
+	SignedURL(key string, ttl time.Duration) (string, error)
+}
+
+// Client is the storage used by the handlers. It defaults to the working
+// directory until Init is called.
+var Client Storage = NewLocal(".", "", randomKey())
+
+// Init sets up Client from the configuration
+func Init() error {
+	signingKey := []byte(config.Config.StorageSigningKey)
+	if len(signingKey) == 0 {
+		log.Warn("STORAGE_SIGNING_KEY is not set, signed URLs are only valid on this instance until restart")
+		signingKey = randomKey()
+	}
+	switch config.Config.StorageBackend {
+	case "", "local":
+		log.Info("Storing files in ", config.Config.StorageLocalDir)
+		Client = NewLocal(config.Config.StorageLocalDir, config.Config.StoragePublicURL, signingKey)
+	case "s3":
+		log.Info("Storing files in bucket ", config.Config.StorageS3Bucket, " at ", config.Config.StorageS3Endpoint)
+		s3, err := NewS3(S3Config{
+			Endpoint:  config.Config.StorageS3Endpoint,
+			Region:    config.Config.StorageS3Region,
+			Bucket:    config.Config.StorageS3Bucket,
+			AccessKey: config.Config.StorageS3AccessKey,
+			SecretKey: config.Config.StorageS3SecretKey,
+			PathStyle: config.Config.StorageS3PathStyle,
+		})
+		if err != nil {
+			return err
+		}
+		Client = s3
+	default:
+		return errors.New("unknown storage backend " + config.Config.StorageBackend)
+	}
+	return nil
+}
+
+// CleanKey normalizes a key and rejects keys that would leave the root.
+// Paths like "/img/logo.png" as stored in the settings are accepted.
+func CleanKey(key string) (string, error) {
+	key = strings.TrimLeft(strings.ReplaceAll(key, `\`, "/"), "/")
+	if key == "" {
+		return "", ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == ".." {
+			return "", ErrInvalidKey
+		}
+	}
+	key = path.Clean(key)
+	if key == "." {
+		return "", ErrInvalidKey
+	}
+	return key, nil
+}
+
+// guessContentType guesses the content type from the extension of the key
+func guessContentType(key string) string {
+	if t := mime.TypeByExtension(path.Ext(key)); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+func randomKey() []byte {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+	return key
+}
@@ -259,6 +259,16 @@ func ReadUserIP(r *http.Request) string {
 	return remoteHost
 }
 
+// ReadClientIP returns the IP of the client for access decisions like IP
+// allowlists. Unlike ReadUserIP it never trusts forwarding headers, which
+// clients can set freely, unless config.TrustedProxies is configured.
+func ReadClientIP(r *http.Request) string {
+	if len(config.Config.TrustedProxies) == 0 {
+		return hostOnly(r.RemoteAddr)
+	}
+	return ReadUserIP(r)
+}
+
 func FileExists(path string) bool {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return false
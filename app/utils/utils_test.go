@@ -84,3 +84,25 @@ func TestReadUserIPStrictTrustsListedProxy(t *testing.T) {
 		t.Fatalf("expected client IP 203.0.113.9 from trusted proxy, got %q", ip)
 	}
 }
+
+// TestReadClientIPIgnoresHeadersWithoutTrustedProxies ensures that clients
+// can not pass an IP allowlist by sending a forwarding header.
+func TestReadClientIPIgnoresHeadersWithoutTrustedProxies(t *testing.T) {
+	original := config.Config.TrustedProxies
+	config.Config.TrustedProxies = nil
+	defer func() { config.Config.TrustedProxies = original }()
+
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "1.2.3.4:5555"
+	req.Header.Set("X-Forwarded-For", "203.0.113.9")
+	req.Header.Set("X-Real-Ip", "203.0.113.9")
+
+	if ip := ReadClientIP(req); ip != "1.2.3.4" {
+		t.Fatalf("expected peer IP 1.2.3.4, got %q", ip)
+	}
+
+	config.Config.TrustedProxies = []string{"1.2.3.4"}
+	if ip := ReadClientIP(req); ip != "203.0.113.9" {
+		t.Fatalf("expected client IP 203.0.113.9 from trusted proxy, got %q", ip)
+	}
+}